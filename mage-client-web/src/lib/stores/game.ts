@@ -100,7 +100,8 @@ function createGameStore() {
 		// Pass priority
 		passPriority: () => {
 			ws?.send({
-				type: 'pass_priority'
+				type: 'pass_priority',
+				nonce: crypto.randomUUID()
 			});
 		},
 		
@@ -58,5 +58,6 @@ export interface WSMessage {
 	type: string;
 	game_id?: string;
 	player_id?: string;
+	nonce?: string; // Identifies this submission of a game action, so the server drops it if it's sent twice
 	data?: any;
 }
@@ -97,6 +97,7 @@ func main() {
 	// Initialize repositories
 	userRepo := repository.NewUserRepository(db)
 	statsRepo := repository.NewStatsRepository(db)
+	gameRepo := repository.NewGameRepository(db)
 
 	// Initialize user manager
 	userMgr := user.NewManager(userRepo, statsRepo, cfg.Validation, logger)
@@ -128,6 +129,34 @@ func main() {
 
 	// Initialize game engine adapter
 	mageEngine := game.NewMageEngine(logger)
+	// Save in-progress games at the start of each turn so they can be loaded after a crash
+	mageEngine.SetGameSaver(func(gameID, gameType string, turnNumber int, data []byte) error {
+		return gameRepo.Save(ctx, &repository.GameRecord{
+			GameID:     gameID,
+			GameType:   gameType,
+			TurnNumber: turnNumber,
+			StateData:  data,
+		})
+	}, func(gameID string) error {
+		return gameRepo.Delete(ctx, gameID)
+	})
+	// Load the games that were in progress when the server last stopped
+	savedGames, err := gameRepo.List(ctx)
+	if err != nil {
+		logger.Error("failed to list saved games", zap.Error(err))
+	}
+	loadedGames := 0
+	for _, record := range savedGames {
+		if _, loadErr := mageEngine.LoadGame(record.StateData); loadErr != nil {
+			logger.Error("failed to load saved game",
+				zap.String("game_id", record.GameID),
+				zap.Error(loadErr),
+			)
+			continue
+		}
+		loadedGames++
+	}
+	logger.Info("saved games loaded", zap.Int("count", loadedGames))
 	gameAdapter := game.NewEngineAdapter(mageEngine, logger)
 
 	// Initialize tournament manager
@@ -221,6 +250,8 @@ func main() {
 
 	// Graceful shutdown
 	logger.Info("shutting down gracefully...")
+	// Finish writing saved games while the database context is still live
+	mageEngine.FlushSavedGames()
 	cancel()
 
 	// Close all active sessions
@@ -2,12 +2,16 @@ package main
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/magefree/mage-server-go/internal/game"
+	"go.uber.org/zap"
 )
 
 var upgrader = websocket.Upgrader{
@@ -62,6 +66,27 @@ type GameState struct {
 	Stack          []any    `json:"stack"`
 }
 
+// LogEntry is an action in a game's log, resent to clients that rejoin the game
+type LogEntry struct {
+	Text      string    `json:"text"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// maxLogBacklog is the number of log entries kept per game for rejoining clients
+const maxLogBacklog = 100
+
+// notificationBuffer is the number of engine notifications waiting for the hub before new ones are
+// dropped, so a busy hub never blocks the engine
+const notificationBuffer = 256
+
+// forwardedNotifications are the engine notifications pushed to clients
+var forwardedNotifications = map[string]bool{
+	"PRIORITY_CHANGE": true,
+	"STACK_UPDATE":    true,
+	"PHASE_CHANGE":    true,
+	"TRIGGER":         true,
+}
+
 type WSMessage struct {
 	Type     string `json:"type"`
 	GameID   string `json:"game_id,omitempty"`
@@ -83,15 +108,66 @@ type Hub struct {
 	unregister chan *Client
 	mu         sync.RWMutex
 	games      map[string]*GameState
+	logs       map[string][]LogEntry // gameID -> most recent log entries, oldest first
+
+	engine        *game.MageEngine
+	notifications chan game.GameNotification
+}
+
+func newHub(engine *game.MageEngine) *Hub {
+	h := &Hub{
+		clients:       make(map[*Client]bool),
+		broadcast:     make(chan []byte),
+		register:      make(chan *Client),
+		unregister:    make(chan *Client),
+		games:         make(map[string]*GameState),
+		logs:          make(map[string][]LogEntry),
+		engine:        engine,
+		notifications: make(chan game.GameNotification, notificationBuffer),
+	}
+	engine.SetNotificationHandler(h.handleNotification)
+	return h
 }
 
-func newHub() *Hub {
-	return &Hub{
-		clients:    make(map[*Client]bool),
-		broadcast:  make(chan []byte),
-		register:   make(chan *Client),
-		unregister: make(chan *Client),
-		games:      make(map[string]*GameState),
+// handleNotification queues an engine notification for the hub to deliver. The engine calls it from
+// its own goroutines; if the hub has fallen behind, the notification is dropped instead of waiting.
+func (h *Hub) handleNotification(notification game.GameNotification) {
+	if !forwardedNotifications[notification.Type] {
+		return
+	}
+	select {
+	case h.notifications <- notification:
+	default:
+		log.Printf("Dropping %s notification for game %s: hub is busy", notification.Type, notification.GameID)
+	}
+}
+
+// deliverNotification sends an engine notification to the clients in its game: to every client, or
+// only to the player it's for if it names one. Clients whose send buffer is full miss it.
+func (h *Hub) deliverNotification(notification game.GameNotification) {
+	response, err := json.Marshal(WSMessage{
+		Type:     strings.ToLower(notification.Type),
+		GameID:   notification.GameID,
+		PlayerID: notification.PlayerID,
+		Data:     notification.Data,
+	})
+	if err != nil {
+		log.Printf("Error marshaling %s notification: %v", notification.Type, err)
+		return
+	}
+
+	for client := range h.clients {
+		if client.gameID != notification.GameID {
+			continue
+		}
+		if notification.PlayerID != "" && client.playerID != notification.PlayerID {
+			continue
+		}
+		select {
+		case client.send <- response:
+		default:
+			log.Printf("Client %s is too slow, dropping %s notification", client.playerID, notification.Type)
+		}
 	}
 }
 
@@ -107,8 +183,16 @@ func (h *Hub) run() {
 				delete(h.clients, client)
 				close(client.send)
 				log.Printf("Client unregistered: %s", client.playerID)
+				if client.gameID != "" && client.playerID != "" {
+					h.mu.Lock()
+					h.appendLog(client.gameID, client.playerID+" disconnected")
+					h.mu.Unlock()
+				}
 			}
 
+		case notification := <-h.notifications:
+			h.deliverNotification(notification)
+
 		case message := <-h.broadcast:
 			for client := range h.clients {
 				select {
@@ -176,12 +260,25 @@ func (h *Hub) createDemoGame(gameID string) *GameState {
 	}
 
 	h.games[gameID] = game
+
+	// Run the game in the engine too, so its notifications reach the game's clients
+	if err := h.engine.StartGame(gameID, []string{"player1", "player2"}, "Duel"); err != nil {
+		log.Printf("Error starting engine game %s: %v", gameID, err)
+	}
 	return game
 }
 
-func (h *Hub) handleMessage(client *Client, msg WSMessage) {
+func (h *Hub) handleMessage(client *Client, msg WSRequest) {
 	log.Printf("Received message: %s from %s", msg.Type, client.playerID)
 
+	// A bug handling one message must not take down the client's connection
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("Panic handling %s from %s: %v", msg.Type, client.playerID, r)
+			client.sendError(fmt.Sprintf("failed to handle %s", msg.Type))
+		}
+	}()
+
 	switch msg.Type {
 	case "create_game":
 		gameID := "game-" + time.Now().Format("20060102-150405")
@@ -195,6 +292,12 @@ func (h *Hub) handleMessage(client *Client, msg WSMessage) {
 		client.send <- response
 
 	case "join_game":
+		req, err := decodeJoinGame(msg)
+		if err != nil {
+			client.sendError(err.Error())
+			return
+		}
+
 		h.mu.RLock()
 		game, exists := h.games[msg.GameID]
 		h.mu.RUnlock()
@@ -206,24 +309,40 @@ func (h *Hub) handleMessage(client *Client, msg WSMessage) {
 		client.gameID = msg.GameID
 		client.playerID = msg.PlayerID
 
+		h.mu.RLock()
 		response, _ := json.Marshal(WSMessage{
 			Type: "game_state",
 			Data: game,
 		})
+		rejoining := exists && hasPlayer(game, msg.PlayerID)
+		priority := game.PriorityPlayer == msg.PlayerID
+		h.mu.RUnlock()
 		client.send <- response
 
+		if rejoining {
+			since := time.Time{}
+			if req.Since != nil {
+				since = *req.Since
+			}
+			h.resendGame(client, since, priority)
+		}
+
 	case "declare_attacker":
+		req, err := decodeDeclareAttacker(msg)
+		if err != nil {
+			client.sendError(err.Error())
+			return
+		}
+
 		h.mu.Lock()
 		game := h.games[client.gameID]
 		if game != nil {
-			data := msg.Data.(map[string]any)
-			cardID := data["card_id"].(string)
-
 			// Find and update card
 			for i := range game.Battlefield {
-				if game.Battlefield[i].ID == cardID {
+				if game.Battlefield[i].ID == req.CardID {
 					game.Battlefield[i].Attacking = true
 					game.Battlefield[i].Tapped = true
+					h.appendLog(client.gameID, client.playerID+" attacks with "+game.Battlefield[i].Name)
 					break
 				}
 			}
@@ -234,21 +353,88 @@ func (h *Hub) handleMessage(client *Client, msg WSMessage) {
 		h.broadcastGameState(client.gameID)
 
 	case "pass_priority":
+		// The engine checks the pass and drops a duplicate submission of it
+		if err := h.engine.ProcessAction(client.gameID, game.PlayerAction{
+			PlayerID:   client.playerID,
+			ActionType: "PLAYER_ACTION",
+			Data:       "PASS",
+			Timestamp:  time.Now(),
+			Nonce:      msg.Nonce,
+		}); err != nil {
+			client.sendError(fmt.Sprintf("pass_priority failed: %v", err))
+			return
+		}
+
 		h.mu.Lock()
 		game := h.games[client.gameID]
 		if game != nil {
 			// Simple turn passing
+			h.appendLog(client.gameID, client.playerID+" passes priority")
 			if game.CurrentPlayer == "player1" {
 				game.CurrentPlayer = "player2"
 			} else {
 				game.CurrentPlayer = "player1"
 				game.Turn++
 			}
+			game.PriorityPlayer = game.CurrentPlayer
 		}
 		h.mu.Unlock()
 
 		h.broadcastGameState(client.gameID)
+
+	default:
+		client.sendError(fmt.Sprintf("unknown message type %q", msg.Type))
+	}
+}
+
+// resendGame catches a client rejoining a game up after it reconnects: the game's log since the
+// client last saw it (the whole backlog if it doesn't say), and a priority prompt if the player had
+// priority when they disconnected, since the prompt they were sent before is gone
+func (h *Hub) resendGame(client *Client, since time.Time, priority bool) {
+	h.mu.RLock()
+	backlog := make([]LogEntry, 0)
+	for _, entry := range h.logs[client.gameID] {
+		if entry.Timestamp.After(since) {
+			backlog = append(backlog, entry)
+		}
+	}
+	h.mu.RUnlock()
+
+	response, _ := json.Marshal(WSMessage{
+		Type:   "game_log",
+		GameID: client.gameID,
+		Data:   backlog,
+	})
+	client.send <- response
+
+	if priority {
+		response, _ = json.Marshal(WSMessage{
+			Type:     "priority",
+			GameID:   client.gameID,
+			PlayerID: client.playerID,
+		})
+		client.send <- response
+	}
+	log.Printf("Client rejoined: %s (%d log entries resent)", client.playerID, len(backlog))
+}
+
+// appendLog adds an entry to a game's log, dropping the oldest past maxLogBacklog; the caller holds h.mu
+func (h *Hub) appendLog(gameID, text string) {
+	entries := append(h.logs[gameID], LogEntry{Text: text, Timestamp: time.Now()})
+	if len(entries) > maxLogBacklog {
+		entries = entries[len(entries)-maxLogBacklog:]
+	}
+	h.logs[gameID] = entries
+}
+
+// hasPlayer reports whether a player is seated in a game
+func hasPlayer(game *GameState, playerID string) bool {
+	for _, player := range game.Players {
+		if player.ID == playerID {
+			return true
+		}
 	}
+	return false
 }
 
 func (h *Hub) broadcastGameState(gameID string) {
@@ -288,9 +474,10 @@ func (c *Client) readPump(hub *Hub) {
 			break
 		}
 
-		var msg WSMessage
+		var msg WSRequest
 		if err := json.Unmarshal(message, &msg); err != nil {
 			log.Printf("Error unmarshaling message: %v", err)
+			c.sendError(fmt.Sprintf("invalid message: %v", err))
 			continue
 		}
 
@@ -327,7 +514,7 @@ func serveWS(hub *Hub, w http.ResponseWriter, r *http.Request) {
 }
 
 func main() {
-	hub := newHub()
+	hub := newHub(game.NewMageEngine(zap.NewNop()))
 	go hub.run()
 
 	http.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/magefree/mage-server-go/internal/game"
+	"go.uber.org/zap"
+)
+
+// TestHandleMessage_MalformedDeclareAttackerKeepsConnection verifies that a declare_attacker with
+// malformed data gets an error response and the connection keeps working
+func TestHandleMessage_MalformedDeclareAttackerKeepsConnection(t *testing.T) {
+	hub := newHub(game.NewMageEngine(zap.NewNop()))
+	go hub.run()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serveWS(hub, w, r)
+	}))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer conn.Close()
+
+	for _, malformed := range []string{
+		`{"type":"declare_attacker"}`,
+		`{"type":"declare_attacker","data":"card-1"}`,
+		`{"type":"declare_attacker","data":{"card_id":1}}`,
+		`{"type":"join_game","data":{"since":"yesterday"}}`,
+		`not json`,
+	} {
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(malformed)); err != nil {
+			t.Fatalf("failed to send %s: %v", malformed, err)
+		}
+		if msg := readMessage(t, conn, "error"); msg.Data == nil {
+			t.Errorf("expected an error message for %s", malformed)
+		}
+	}
+
+	if err := conn.WriteJSON(WSRequest{Type: "create_game"}); err != nil {
+		t.Fatalf("failed to send create_game: %v", err)
+	}
+	readMessage(t, conn, "game_state")
+}
+
+// TestHandleMessage_DuplicatePassIgnored verifies that a pass_priority sent twice with the same nonce
+// is passed to the engine once and the duplicate is answered with an error
+func TestHandleMessage_DuplicatePassIgnored(t *testing.T) {
+	hub := newHub(game.NewMageEngine(zap.NewNop()))
+	go hub.run()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serveWS(hub, w, r)
+	}))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer conn.Close()
+
+	if err := conn.WriteJSON(WSRequest{Type: "join_game", GameID: "nonce-game", PlayerID: "player1"}); err != nil {
+		t.Fatalf("failed to send join_game: %v", err)
+	}
+	readMessage(t, conn, "game_state")
+
+	pass := WSRequest{Type: "pass_priority", Nonce: "pass-1"}
+	if err := conn.WriteJSON(pass); err != nil {
+		t.Fatalf("failed to send pass_priority: %v", err)
+	}
+	if msg := readMessage(t, conn, "game_state"); msg.Data.(map[string]any)["priority_player"] != "player2" {
+		t.Fatalf("expected priority to pass to player2, got %v", msg.Data)
+	}
+
+	if err := conn.WriteJSON(pass); err != nil {
+		t.Fatalf("failed to resend pass_priority: %v", err)
+	}
+	msg := readMessage(t, conn, "error")
+	if text, _ := msg.Data.(map[string]any)["message"].(string); !strings.Contains(text, "already been processed") {
+		t.Fatalf("expected the duplicate pass to be rejected, got %v", msg.Data)
+	}
+}
+
+// readMessage reads messages from the connection until one of the given type arrives
+func readMessage(t *testing.T, conn *websocket.Conn, msgType string) WSMessage {
+	t.Helper()
+	if err := conn.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatalf("failed to set read deadline: %v", err)
+	}
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatalf("connection failed waiting for %s: %v", msgType, err)
+		}
+		var msg WSMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			t.Fatalf("failed to decode message: %v", err)
+		}
+		if msg.Type == msgType {
+			return msg
+		}
+	}
+}
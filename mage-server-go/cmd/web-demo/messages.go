@@ -0,0 +1,89 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// WSRequest is a message from a client. Data is decoded into the request struct of its Type. A game
+// action may carry a nonce identifying this submission of it, so the engine drops it if it's sent twice.
+type WSRequest struct {
+	Type     string          `json:"type"`
+	GameID   string          `json:"game_id,omitempty"`
+	PlayerID string          `json:"player_id,omitempty"`
+	Nonce    string          `json:"nonce,omitempty"`
+	Data     json.RawMessage `json:"data,omitempty"`
+}
+
+// JoinGameRequest is the data of a join_game message. A client rejoining a game sends the time it last
+// saw the game, to be sent the log entries it missed.
+type JoinGameRequest struct {
+	Since *time.Time `json:"since,omitempty"`
+}
+
+// DeclareAttackerRequest is the data of a declare_attacker message
+type DeclareAttackerRequest struct {
+	CardID string `json:"card_id"`
+}
+
+// ErrorResponse is the data of an error message sent to a client whose message couldn't be handled
+type ErrorResponse struct {
+	Message string `json:"message"`
+}
+
+// decodeJoinGame checks a join_game message and decodes its data
+func decodeJoinGame(msg WSRequest) (JoinGameRequest, error) {
+	var req JoinGameRequest
+	if msg.GameID == "" {
+		return req, errors.New("join_game requires game_id")
+	}
+	if msg.PlayerID == "" {
+		return req, errors.New("join_game requires player_id")
+	}
+	if err := decodeData(msg, &req, false); err != nil {
+		return req, err
+	}
+	return req, nil
+}
+
+// decodeDeclareAttacker checks a declare_attacker message and decodes its data
+func decodeDeclareAttacker(msg WSRequest) (DeclareAttackerRequest, error) {
+	var req DeclareAttackerRequest
+	if err := decodeData(msg, &req, true); err != nil {
+		return req, err
+	}
+	if req.CardID == "" {
+		return req, errors.New("declare_attacker requires data.card_id")
+	}
+	return req, nil
+}
+
+// decodeData unmarshals a message's data into its request struct; a message without data is an error
+// if required
+func decodeData(msg WSRequest, req any, required bool) error {
+	if len(msg.Data) == 0 || string(msg.Data) == "null" {
+		if required {
+			return fmt.Errorf("%s requires data", msg.Type)
+		}
+		return nil
+	}
+	if err := json.Unmarshal(msg.Data, req); err != nil {
+		return fmt.Errorf("invalid %s data: %w", msg.Type, err)
+	}
+	return nil
+}
+
+// sendError tells a client its message couldn't be handled. It never blocks: a client too slow to
+// take the error misses it.
+func (c *Client) sendError(message string) {
+	response, _ := json.Marshal(WSMessage{
+		Type: "error",
+		Data: ErrorResponse{Message: message},
+	})
+	select {
+	case c.send <- response:
+	default:
+	}
+}
@@ -1,6 +1,7 @@
 package chat
 
 import (
+	"fmt"
 	"sync"
 	"time"
 
@@ -16,11 +17,18 @@ type Message struct {
 	Type      string
 }
 
+// Access decides whether a user may take part in a chat room (join it, send and receive messages)
+type Access func(username string) bool
+
+// DeliveryHandler delivers a chat message to a user in a chat room
+type DeliveryHandler func(roomID, username string, msg Message)
+
 // ChatRoom represents a chat room
 type ChatRoom struct {
 	ID          string
 	messages    []Message
 	users       map[string]bool // username -> present
+	access      Access          // nil = open to everyone
 	mu          sync.RWMutex
 	maxMessages int
 }
@@ -79,6 +87,32 @@ func (c *ChatRoom) RemoveUser(username string) {
 	delete(c.users, username)
 }
 
+// SetAccess restricts who may take part in the chat room; nil opens it to everyone
+func (c *ChatRoom) SetAccess(access Access) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.access = access
+}
+
+// Allows checks if a user may take part in the chat room
+func (c *ChatRoom) Allows(username string) bool {
+	c.mu.RLock()
+	access := c.access
+	c.mu.RUnlock()
+	return access == nil || access(username)
+}
+
+// recipients returns the users in the chat room who may receive messages
+func (c *ChatRoom) recipients() []string {
+	recipients := make([]string, 0)
+	for _, user := range c.GetUsers() {
+		if c.Allows(user) {
+			recipients = append(recipients, user)
+		}
+	}
+	return recipients
+}
+
 // GetUsers returns list of users in the chat room
 func (c *ChatRoom) GetUsers() []string {
 	c.mu.RLock()
@@ -93,9 +127,10 @@ func (c *ChatRoom) GetUsers() []string {
 
 // Manager manages chat rooms
 type Manager struct {
-	rooms  map[string]*ChatRoom
-	mu     sync.RWMutex
-	logger *zap.Logger
+	rooms   map[string]*ChatRoom
+	deliver DeliveryHandler
+	mu      sync.RWMutex
+	logger  *zap.Logger
 }
 
 // NewManager creates a new chat manager
@@ -106,6 +141,13 @@ func NewManager(logger *zap.Logger) *Manager {
 	}
 }
 
+// SetDeliveryHandler sets the handler that delivers sent messages to the users in a room
+func (m *Manager) SetDeliveryHandler(handler DeliveryHandler) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deliver = handler
+}
+
 // CreateRoom creates a new chat room
 func (m *Manager) CreateRoom(id string) *ChatRoom {
 	m.mu.Lock()
@@ -147,13 +189,17 @@ func (m *Manager) RemoveRoom(id string) {
 	m.logger.Debug("chat room removed", zap.String("room_id", id))
 }
 
-// SendMessage sends a message to a chat room
+// SendMessage sends a message to a chat room and delivers it to the users who may receive it
 func (m *Manager) SendMessage(roomID, username, text string) error {
 	room, ok := m.GetRoom(roomID)
 	if !ok {
 		return nil // Room doesn't exist, ignore
 	}
 
+	if !room.Allows(username) {
+		return fmt.Errorf("user %s may not send messages to chat room %s", username, roomID)
+	}
+
 	msg := Message{
 		UserName:  username,
 		Text:      text,
@@ -162,23 +208,55 @@ func (m *Manager) SendMessage(roomID, username, text string) error {
 		Type:      "TALK",
 	}
 
+	m.broadcast(room, msg)
+	return nil
+}
+
+// SendStatusMessage sends a server status message (e.g. a player was kicked) to a chat room
+// Per Java ChatManager.broadcast() with MessageType.STATUS
+func (m *Manager) SendStatusMessage(roomID, text string) {
+	room, ok := m.GetRoom(roomID)
+	if !ok {
+		return // Room doesn't exist, ignore
+	}
+
+	m.broadcast(room, Message{
+		Text:      text,
+		Timestamp: time.Now(),
+		Color:     "BLUE",
+		Type:      "STATUS",
+	})
+}
+
+// broadcast adds a message to a chat room and delivers it to the users who may receive it
+func (m *Manager) broadcast(room *ChatRoom, msg Message) {
 	room.AddMessage(msg)
 
-	// TODO: Broadcast message to all users in room via WebSocket
-	// This would iterate through connected sessions and send callback
+	m.mu.RLock()
+	deliver := m.deliver
+	m.mu.RUnlock()
 
-	return nil
+	if deliver != nil {
+		for _, recipient := range room.recipients() {
+			deliver(room.ID, recipient, msg)
+		}
+	}
 }
 
 // JoinRoom adds a user to a chat room
-func (m *Manager) JoinRoom(roomID, username string) {
+func (m *Manager) JoinRoom(roomID, username string) error {
 	room := m.GetOrCreateRoom(roomID)
+	if !room.Allows(username) {
+		return fmt.Errorf("user %s may not join chat room %s", username, roomID)
+	}
 	room.AddUser(username)
 
 	m.logger.Debug("user joined chat room",
 		zap.String("room_id", roomID),
 		zap.String("username", username),
 	)
+
+	return nil
 }
 
 // LeaveRoom removes a user from a chat room
@@ -0,0 +1,413 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// actionLogVersion is the version of the exported action log format; ReplayGame rejects other versions
+const actionLogVersion = 3
+
+// ActionLog is everything needed to play a game again from the start: how it was started, the seed of
+// its random source and the actions and engine calls it accepted, in order. Unlike a Replay, which holds snapshots of the
+// game to step through, an action log is re-simulated, so it can reproduce a bug or settle a dispute.
+type ActionLog struct {
+	Version      int
+	GameID       string
+	GameType     string
+	Players      []string
+	RulesOptions RulesOptions
+	Decks        map[string][]string // Decklists the game was started with (nil = the placeholder decks)
+	Seed         RandomSeed          // Seed of the game's random source, which gives the same shuffles
+	Setup        LoggedRandomness    // Decision IDs generated while setting the game up
+	Actions      []LoggedAction
+
+	// Methods that gave the game code (e.g. RegisterActivatedAbility), which a log can't hold; a
+	// game given any can't be replayed
+	Unreplayable []string `json:",omitempty"`
+
+	// Decision IDs since the last logged action, given to the next one; dropped if it fails
+	pending LoggedRandomness
+}
+
+// LoggedAction is an accepted player action, or a call to another engine method that changed the game,
+// with the decision IDs it generated and a checksum of the game state after it
+type LoggedAction struct {
+	Action    PlayerAction
+	Call      *LoggedCall `json:",omitempty"` // Set instead of Action for a call
+	Timestamp time.Time
+	Random    LoggedRandomness
+	StateHash string
+}
+
+// LoggedCall is a call to an engine method other than ProcessAction, such as RespondToDecision or
+// PlayerConcede, with its arguments after the game ID
+type LoggedCall struct {
+	Method string
+	Args   []json.RawMessage
+}
+
+// replayableCalls are the methods logged by logCall, which ReplayGame calls again by name
+var replayableCalls = map[string]bool{
+	"AcceptBlockers":              true,
+	"ActivateAbility":             true,
+	"ActivateLoyaltyAbility":      true,
+	"ActivateManaAbility":         true,
+	"AddCombatRequirement":        true,
+	"AddCombatRestriction":        true,
+	"AddEnergy":                   true,
+	"AddMana":                     true,
+	"ApplyCombatDamage":           true,
+	"ApproveRollback":             true,
+	"AssignAttackerDamage":        true,
+	"AssignBlockerDamage":         true,
+	"AssignCombatDamage":          true,
+	"AssignTrampleDamage":         true,
+	"BookmarkState":               true,
+	"ChangeControl":               true,
+	"CheckForRemoveFromCombat":    true,
+	"CopySpellOnStackWithTargets": true,
+	"DealDamage":                  true,
+	"DeclareAttacker":             true,
+	"DeclareBlocker":              true,
+	"DeclineRollback":             true,
+	"DestroyPermanent":            true,
+	"DrawCard":                    true,
+	"EndCombat":                   true,
+	"EndGame":                     true,
+	"EndGameAtLimit":              true,
+	"EndMulligan":                 true,
+	"FinishDeclaringAttackers":    true,
+	"GrantAbility":                true,
+	"GrantRegeneration":           true,
+	"Mill":                        true,
+	"OrderBlockers":               true,
+	"OrderTriggers":               true,
+	"PauseGame":                   true,
+	"PayManaCost":                 true,
+	"PlayLand":                    true,
+	"PlayerConcede":               true,
+	"PlayerIdleTimeout":           true,
+	"PlayerKeepHand":              true,
+	"PlayerMulligan":              true,
+	"PlayerQuit":                  true,
+	"PlayerTimerTimeout":          true,
+	"Proliferate":                 true,
+	"ProposeDraw":                 true,
+	"RegisterManaAbility":         true,
+	"RemoveAttacker":              true,
+	"RemoveBlocker":               true,
+	"RemoveContinuousEffect":      true,
+	"RemoveFromCombat":            true,
+	"ReorderScry":                 true,
+	"ReorderSurveil":              true,
+	"RequestRollback":             true,
+	"ResetPlayerStoredBookmark":   true,
+	"ResetCombat":                 true,
+	"ResolveOptionalTrigger":      true,
+	"RespondToDecision":           true,
+	"RespondToDraw":               true,
+	"RestoreState":                true,
+	"ResumeGame":                  true,
+	"RollbackTurns":               true,
+	"Sacrifice":                   true,
+	"Scry":                        true,
+	"SetAlwaysPromptTriggers":     true,
+	"SetAttacker":                 true,
+	"SetAttackerDamageOrder":      true,
+	"SetBlockerDamageOrder":       true,
+	"SetCommander":                true,
+	"SetDefenders":                true,
+	"SetMonarch":                  true,
+	"SetPlayerStoredBookmark":     true,
+	"SetPriorityTimeout":          true,
+	"StartMatchClock":             true,
+	"StartMulligan":               true,
+	"Surveil":                     true,
+	"TapForMana":                  true,
+	"TapPermanent":                true,
+	"Undo":                        true,
+	"UntapPermanent":              true,
+}
+
+// timerCalls are the timers of a game whose expiry is logged, by the name logCall logs them under;
+// each takes the generation of what it expired
+var timerCalls = map[string]func(e *MageEngine, gameState *engineGameState, generation int){
+	"drawProposalExpired": (*MageEngine).drawProposalExpired,
+	"rollbackVoteExpired": (*MageEngine).rollbackVoteExpired,
+}
+
+// LoggedRandomness is what a game generated that doesn't come from its seed: the random IDs of
+// decisions, which later actions refer to
+type LoggedRandomness struct {
+	DecisionIDs []string `json:",omitempty"`
+}
+
+// ExportReplay returns the action log of a game as JSON, for ReplayGame. Besides the actions processed
+// by ProcessAction, it logs automatic passes, expired votes and every call to an engine method that
+// changed the game, such as RespondToDecision, ActivateManaAbility or PlayerConcede. Methods that give a
+// game code, such as RegisterActivatedAbility, can't be logged, and a game given any can't be replayed.
+func (e *MageEngine) ExportReplay(gameID string) ([]byte, error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+
+	if gameState.actionLog == nil {
+		return nil, fmt.Errorf("game %s has no action log", gameID)
+	}
+	return json.Marshal(gameState.actionLog)
+}
+
+// ReplayGame plays a game again in this engine from an action log exported by ExportReplay, under its
+// original ID and seed, feeding it the logged decision IDs so it plays out the same way. It returns the ID of
+// the replayed game and fails at the first action or call that is rejected or leaves the game in a different
+// state than it was logged with; the game is left as it was at that point.
+func (e *MageEngine) ReplayGame(data []byte) (string, error) {
+	var log ActionLog
+	if err := json.Unmarshal(data, &log); err != nil {
+		return "", fmt.Errorf("failed to decode action log: %w", err)
+	}
+	if log.Version != actionLogVersion {
+		return "", fmt.Errorf("unsupported action log version %d", log.Version)
+	}
+	if len(log.Unreplayable) > 0 {
+		return "", fmt.Errorf("game %s can't be replayed: it was given code with %v", log.GameID, log.Unreplayable)
+	}
+
+	source := &replaySource{}
+	source.load(log.Setup)
+	if err := e.startGame(log.GameID, log.Players, log.GameType, log.RulesOptions, log.Decks, log.Seed, source); err != nil {
+		return "", fmt.Errorf("failed to start replayed game: %w", err)
+	}
+
+	e.mu.RLock()
+	gameState := e.games[log.GameID]
+	e.mu.RUnlock()
+
+	defer func() {
+		gameState.mu.Lock()
+		gameState.replaySource = nil
+		gameState.mu.Unlock()
+	}()
+
+	for i, logged := range log.Actions {
+		gameState.mu.Lock()
+		source.load(logged.Random)
+		gameState.mu.Unlock()
+
+		if err := e.replayAction(gameState, logged); err != nil {
+			return log.GameID, fmt.Errorf("action %d (%s) failed on replay: %w", i+1, logged.describe(), err)
+		}
+
+		gameState.mu.RLock()
+		actions := gameState.actionLog.Actions
+		hash := ""
+		if len(actions) > 0 {
+			hash = actions[len(actions)-1].StateHash
+		}
+		gameState.mu.RUnlock()
+		if hash != logged.StateHash {
+			return log.GameID, fmt.Errorf("game state after action %d (%s) differs from the logged game", i+1, logged.describe())
+		}
+	}
+
+	if e.logger != nil {
+		e.logger.Info("game replayed",
+			zap.String("game_id", log.GameID),
+			zap.Int("actions", len(log.Actions)),
+		)
+	}
+	return log.GameID, nil
+}
+
+// replayAction makes a logged action or call again
+func (e *MageEngine) replayAction(gameState *engineGameState, logged LoggedAction) error {
+	if logged.Call != nil {
+		return e.replayCall(gameState, logged.Call)
+	}
+	action, err := restoreActionData(logged.Action)
+	if err != nil {
+		return err
+	}
+	return e.ProcessAction(gameState.gameID, action)
+}
+
+// replayCall calls the method of a logged call again with the logged arguments
+func (e *MageEngine) replayCall(gameState *engineGameState, call *LoggedCall) error {
+	if expire, ok := timerCalls[call.Method]; ok {
+		var generation int
+		if len(call.Args) != 1 || json.Unmarshal(call.Args[0], &generation) != nil {
+			return fmt.Errorf("invalid arguments to %s", call.Method)
+		}
+		expire(e, gameState, generation)
+		return nil
+	}
+	if !replayableCalls[call.Method] {
+		return fmt.Errorf("%s can't be replayed", call.Method)
+	}
+
+	method := reflect.ValueOf(e).MethodByName(call.Method)
+	methodType := method.Type()
+	if methodType.NumIn() != len(call.Args)+1 {
+		return fmt.Errorf("%s takes %d arguments after the game ID, got %d", call.Method, methodType.NumIn()-1, len(call.Args))
+	}
+	in := make([]reflect.Value, 0, methodType.NumIn())
+	in = append(in, reflect.ValueOf(gameState.gameID))
+	for i, raw := range call.Args {
+		arg := reflect.New(methodType.In(i + 1))
+		if err := json.Unmarshal(raw, arg.Interface()); err != nil {
+			return fmt.Errorf("invalid argument %d to %s: %w", i+1, call.Method, err)
+		}
+		in = append(in, arg.Elem())
+	}
+	out := method.Call(in)
+	if err, _ := out[len(out)-1].Interface().(error); err != nil {
+		return err
+	}
+	return nil
+}
+
+// describe names a logged action or call for errors
+func (l LoggedAction) describe() string {
+	if l.Call != nil {
+		return l.Call.Method
+	}
+	return fmt.Sprintf("%s by %s", l.Action.ActionType, l.Action.PlayerID)
+}
+
+// restoreActionData turns the data of an action decoded from JSON back into the type its handler expects
+func restoreActionData(action PlayerAction) (PlayerAction, error) {
+	if action.ActionType != "CAST_SPELL" {
+		return action, nil
+	}
+	raw, err := json.Marshal(action.Data)
+	if err != nil {
+		return action, fmt.Errorf("failed to decode CAST_SPELL data: %w", err)
+	}
+	var data CastSpellData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return action, fmt.Errorf("failed to decode CAST_SPELL data: %w", err)
+	}
+	action.Data = data
+	return action, nil
+}
+
+// newActionLog starts the action log of a game
+func newActionLog(gameID, gameType string, players []string, options RulesOptions, decks map[string][]string, seed RandomSeed) *ActionLog {
+	return &ActionLog{
+		Version:      actionLogVersion,
+		GameID:       gameID,
+		GameType:     gameType,
+		Players:      append([]string(nil), players...),
+		RulesOptions: options,
+		Decks:        decks,
+		Seed:         seed,
+		Actions:      make([]LoggedAction, 0),
+	}
+}
+
+// finishSetup moves the decision IDs generated so far into the log's setup
+func (l *ActionLog) finishSetup() {
+	l.Setup = l.pending
+	l.pending = LoggedRandomness{}
+}
+
+// logAction logs an accepted player action; the caller holds the game's lock
+func (e *MageEngine) logAction(gameState *engineGameState, action PlayerAction) {
+	e.appendToLog(gameState, LoggedAction{Action: action, Timestamp: action.Timestamp})
+}
+
+// logCall logs a call to an engine method that changed a game (see replayableCalls and timerCalls),
+// with its arguments after the game ID, so ReplayGame can make it again. Methods defer it with a pointer
+// to their error; a call that failed isn't logged. The caller holds the game's lock.
+func (e *MageEngine) logCall(gameState *engineGameState, err *error, method string, args ...any) {
+	if err != nil && *err != nil {
+		gameState.discardActionRandomness()
+		return
+	}
+	if gameState.actionLog == nil {
+		return
+	}
+	call := &LoggedCall{Method: method, Args: make([]json.RawMessage, 0, len(args))}
+	for _, arg := range args {
+		raw, marshalErr := json.Marshal(arg)
+		if marshalErr != nil {
+			// Without the call the log wouldn't replay the game
+			gameState.markUnreplayable(method)
+			return
+		}
+		call.Args = append(call.Args, raw)
+	}
+	e.appendToLog(gameState, LoggedAction{Call: call})
+}
+
+// markUnreplayable notes that a game was given code through method, which its action log can't hold
+func (s *engineGameState) markUnreplayable(method string) {
+	if s.actionLog != nil && !containsString(s.actionLog.Unreplayable, method) {
+		s.actionLog.Unreplayable = append(s.actionLog.Unreplayable, method)
+	}
+}
+
+// appendToLog appends an action or call to a game's action log with the decision IDs it generated and
+// a checksum of the resulting state; the caller holds the game's lock
+func (e *MageEngine) appendToLog(gameState *engineGameState, logged LoggedAction) {
+	log := gameState.actionLog
+	if log == nil {
+		return
+	}
+
+	if checksum, err := e.createSnapshot(gameState).ComputeChecksum(); err == nil {
+		logged.StateHash = checksum.Hash
+	}
+	if logged.Timestamp.IsZero() {
+		logged.Timestamp = time.Now()
+	}
+	logged.Random = log.pending
+	log.Actions = append(log.Actions, logged)
+	log.pending = LoggedRandomness{}
+}
+
+// discardActionRandomness drops the decision IDs of an action that failed, since the game was
+// restored to before it
+func (s *engineGameState) discardActionRandomness() {
+	if s.actionLog != nil {
+		s.actionLog.pending = LoggedRandomness{}
+	}
+}
+
+// replaySource hands a replayed game the decision IDs of the game it replays
+type replaySource struct {
+	decisionIDs []string
+}
+
+// load queues the decision IDs of the next part of the game
+func (r *replaySource) load(random LoggedRandomness) {
+	r.decisionIDs = append([]string(nil), random.DecisionIDs...)
+}
+
+// newDecisionID returns the ID of a new decision: the logged one if the game is being replayed,
+// otherwise a random one, which is logged
+func (s *engineGameState) newDecisionID(random func() string) string {
+	id := ""
+	if source := s.replaySource; source != nil && len(source.decisionIDs) > 0 {
+		id = source.decisionIDs[0]
+		source.decisionIDs = source.decisionIDs[1:]
+	} else {
+		id = random()
+	}
+	if s.actionLog != nil {
+		s.actionLog.pending.DecisionIDs = append(s.actionLog.pending.DecisionIDs, id)
+	}
+	return id
+}
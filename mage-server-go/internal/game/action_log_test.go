@@ -0,0 +1,193 @@
+package game
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestActionLog_ReplayReproducesGame verifies that an exported action log replays to the same game,
+// shuffles included, and that a replay which diverges from the log is reported
+func TestActionLog_ReplayReproducesGame(t *testing.T) {
+	deck := make([]string, 0, 60)
+	for i := 0; i < 30; i++ {
+		deck = append(deck, "Forest", "Grizzly Bears")
+	}
+	decks := map[string][]string{"Alice": deck, "Bob": deck}
+
+	engine := NewMageEngine(zaptest.NewLogger(t))
+	if err := engine.StartGameWithDecks("test-action-log", []string{"Alice", "Bob"}, "Duel", decks); err != nil {
+		t.Fatalf("failed to start game: %v", err)
+	}
+	original := engine.games["test-action-log"]
+	for i := 0; i < 4; i++ {
+		original.mu.RLock()
+		playerID := original.turnManager.PriorityPlayer()
+		original.mu.RUnlock()
+		if err := engine.ProcessAction("test-action-log", PlayerAction{PlayerID: playerID, ActionType: "PLAYER_ACTION", Data: "PASS"}); err != nil {
+			t.Fatalf("pass %d failed: %v", i+1, err)
+		}
+	}
+	if err := engine.ProcessAction("test-action-log", PlayerAction{PlayerID: "Bob", ActionType: "PLAYER_ACTION", Data: "CONCEDE"}); err == nil {
+		t.Fatal("expected an unknown player action to be rejected")
+	}
+
+	data, err := engine.ExportReplay("test-action-log")
+	if err != nil {
+		t.Fatalf("failed to export replay: %v", err)
+	}
+	var log ActionLog
+	if err := json.Unmarshal(data, &log); err != nil {
+		t.Fatalf("failed to decode exported replay: %v", err)
+	}
+	seed, err := engine.GameSeed("test-action-log")
+	if err != nil {
+		t.Fatalf("failed to get seed: %v", err)
+	}
+	if len(log.Actions) != 4 || log.Seed != seed {
+		t.Fatalf("expected the 4 accepted actions and the game's seed to be logged, got %d actions and seed %s (want %s)",
+			len(log.Actions), log.Seed, seed)
+	}
+
+	replayer := NewMageEngine(zaptest.NewLogger(t))
+	gameID, err := replayer.ReplayGame(data)
+	if err != nil {
+		t.Fatalf("failed to replay game: %v", err)
+	}
+	replayed := replayer.games[gameID]
+	if got, want := cardIDs(replayed.players["Alice"].Library), cardIDs(original.players["Alice"].Library); !equalStrings(got, want) {
+		t.Error("expected the replayed library to be in the original order")
+	}
+	originalHash, _ := engine.createSnapshot(original).ComputeChecksum()
+	replayedHash, _ := replayer.createSnapshot(replayed).ComputeChecksum()
+	if originalHash.Hash != replayedHash.Hash {
+		t.Error("expected the replayed game to end in the original state")
+	}
+
+	// A log whose states don't match what the game does is reported at the diverging action
+	log.Actions[2].StateHash = "tampered"
+	tampered, _ := json.Marshal(log)
+	if _, err := NewMageEngine(zaptest.NewLogger(t)).ReplayGame(tampered); err == nil || !strings.Contains(err.Error(), "action 3") {
+		t.Errorf("expected the replay to fail at action 3, got %v", err)
+	}
+}
+
+// TestActionLog_ReplaysDirectEngineCalls verifies that a game played through engine methods other than
+// ProcessAction (decision responses, lands and mana, a draw vote and a concession) replays to the same game
+func TestActionLog_ReplaysDirectEngineCalls(t *testing.T) {
+	const gameID = "test-action-log-calls"
+	deck := make([]string, 60)
+	for i := range deck {
+		deck[i] = "Forest"
+	}
+	decks := map[string][]string{"Alice": deck, "Bob": deck}
+
+	engine := NewMageEngine(zaptest.NewLogger(t))
+	if err := engine.StartGameWithDecks(gameID, []string{"Alice", "Bob"}, "Duel", decks); err != nil {
+		t.Fatalf("failed to start game: %v", err)
+	}
+	original := engine.games[gameID]
+
+	// Pass until Alice can play a land, answering the priority decision if there is one and otherwise
+	// passing with an action
+	for i := 0; ; i++ {
+		original.mu.RLock()
+		step := original.turnManager.CurrentStep()
+		playerID := original.turnManager.PriorityPlayer()
+		original.mu.RUnlock()
+		if step == rules.StepMain1 && playerID == "Alice" {
+			break
+		}
+		if i == 10 {
+			t.Fatalf("expected to reach Alice's main phase, still in %s", step)
+		}
+		decisions, err := engine.GetPendingDecisions(gameID, playerID)
+		if err != nil {
+			t.Fatalf("failed to get pending decisions: %v", err)
+		}
+		if len(decisions) > 0 && decisions[0].Kind == DecisionPriority {
+			err = engine.RespondToDecision(gameID, playerID, decisions[0].ID, Response{})
+		} else {
+			err = engine.ProcessAction(gameID, PlayerAction{PlayerID: playerID, ActionType: "PLAYER_ACTION", Data: "PASS"})
+		}
+		if err != nil {
+			t.Fatalf("failed to pass: %v", err)
+		}
+	}
+
+	original.mu.RLock()
+	forestID := original.players["Alice"].Hand[0].ID
+	original.mu.RUnlock()
+	if err := engine.PlayLand(gameID, forestID, "Alice"); err != nil {
+		t.Fatalf("failed to play a land: %v", err)
+	}
+	if err := engine.TapForMana(gameID, forestID, "Alice"); err != nil {
+		t.Fatalf("failed to tap for mana: %v", err)
+	}
+	if err := engine.ProposeDraw(gameID, "Alice"); err != nil {
+		t.Fatalf("failed to propose a draw: %v", err)
+	}
+	if err := engine.RespondToDraw(gameID, "Bob", false); err != nil {
+		t.Fatalf("failed to decline the draw: %v", err)
+	}
+	if err := engine.PlayerConcede(gameID, "Bob"); err != nil {
+		t.Fatalf("failed to concede: %v", err)
+	}
+
+	data, err := engine.ExportReplay(gameID)
+	if err != nil {
+		t.Fatalf("failed to export replay: %v", err)
+	}
+	var log ActionLog
+	if err := json.Unmarshal(data, &log); err != nil {
+		t.Fatalf("failed to decode exported replay: %v", err)
+	}
+	methods := make([]string, 0, len(log.Actions))
+	for _, logged := range log.Actions {
+		if logged.Call != nil {
+			methods = append(methods, logged.Call.Method)
+		}
+	}
+	if !containsString(methods, "RespondToDecision") {
+		t.Errorf("expected the priority decisions answered to be logged, got %v", methods)
+	}
+	if want := []string{"PlayLand", "TapForMana", "ProposeDraw", "RespondToDraw", "PlayerConcede"}; len(methods) < len(want) || !equalStrings(methods[len(methods)-len(want):], want) {
+		t.Fatalf("expected the calls to be logged in order, got %v", methods)
+	}
+
+	replayer := NewMageEngine(zaptest.NewLogger(t))
+	if _, err := replayer.ReplayGame(data); err != nil {
+		t.Fatalf("failed to replay game: %v", err)
+	}
+	replayed := replayer.games[gameID]
+	originalHash, _ := engine.createSnapshot(original).ComputeChecksum()
+	replayedHash, _ := replayer.createSnapshot(replayed).ComputeChecksum()
+	if originalHash.Hash != replayedHash.Hash {
+		t.Error("expected the replayed game to end in the original state")
+	}
+	if !replayed.players["Bob"].Conceded {
+		t.Error("expected Bob to concede in the replayed game")
+	}
+}
+
+// TestActionLog_GameGivenCodeIsNotReplayed verifies that a game given code, which its action log can't
+// hold, is refused by ReplayGame instead of replaying differently
+func TestActionLog_GameGivenCodeIsNotReplayed(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-action-log-code", []string{"Alice", "Bob"})
+	bolt := h.CreateCreature(CreatureSpec{ID: "bolt", Name: "Lightning Bolt", Controller: "Alice", Power: "0", Toughness: "1"})
+	effect := func(gameState *engineGameState, spell *internalCard, xValue int) error { return nil }
+	if err := h.engine.RegisterSpellEffect(h.gameID, bolt, effect); err != nil {
+		t.Fatalf("failed to register spell effect: %v", err)
+	}
+
+	data, err := h.engine.ExportReplay(h.gameID)
+	if err != nil {
+		t.Fatalf("failed to export replay: %v", err)
+	}
+	if _, err := NewMageEngine(zaptest.NewLogger(t)).ReplayGame(data); err == nil || !strings.Contains(err.Error(), "RegisterSpellEffect") {
+		t.Errorf("expected the replay to be refused for RegisterSpellEffect, got %v", err)
+	}
+}
@@ -0,0 +1,54 @@
+package game
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestActionNonce_DuplicateCastRejected verifies that submitting the same cast twice (e.g. a double
+// click) is rejected the second time without touching the game state
+func TestActionNonce_DuplicateCastRejected(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-action-nonce", []string{"Alice", "Bob"})
+	castTestSetup(t, h, "Alice", "alice-bolt", "Shock", "Instant", rules.StepMain1)
+	castTestSetup(t, h, "Alice", "alice-bolt-2", "Shock", "Instant", rules.StepMain1)
+
+	action := PlayerAction{PlayerID: "Alice", ActionType: "SEND_STRING", Data: "Shock", Nonce: "cast-1"}
+	if err := h.engine.ProcessAction(h.gameID, action); err != nil {
+		t.Fatalf("failed to cast: %v", err)
+	}
+
+	gameState := h.GetGameState()
+	gameState.mu.RLock()
+	sequence := gameState.actionSequence
+	gameState.mu.RUnlock()
+
+	err := h.engine.ProcessAction(h.gameID, action)
+	if err == nil || !strings.Contains(err.Error(), "already been processed") {
+		t.Fatalf("expected the duplicate cast to be rejected, got %v", err)
+	}
+
+	gameState.mu.RLock()
+	if items := gameState.stack.List(); len(items) != 1 {
+		t.Errorf("expected one spell on the stack, got %d", len(items))
+	}
+	if zone := gameState.cards["alice-bolt-2"].Zone; zone != zoneHand {
+		t.Errorf("expected the second copy to stay in hand, got zone %d", zone)
+	}
+	if gameState.actionSequence != sequence {
+		t.Errorf("expected the duplicate not to count as an action, got sequence %d, want %d", gameState.actionSequence, sequence)
+	}
+	gameState.mu.RUnlock()
+
+	// A new submission of the same action is accepted
+	action.Nonce = "cast-2"
+	if err := h.engine.ProcessAction(h.gameID, action); err != nil {
+		t.Fatalf("failed to cast the second copy: %v", err)
+	}
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if items := gameState.stack.List(); len(items) != 2 {
+		t.Errorf("expected two spells on the stack, got %d", len(items))
+	}
+}
@@ -0,0 +1,310 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/magefree/mage-server-go/internal/game/counters"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+	"github.com/magefree/mage-server-go/internal/game/targeting"
+)
+
+// loyaltyAbilityUseKey tracks loyalty activations in a permanent's AbilityUses.
+// Per rule 606.3 only one loyalty ability of a permanent can be activated each turn.
+const loyaltyAbilityUseKey = "loyalty"
+
+// activatedAbility is a non-mana activated ability of a permanent, including loyalty abilities.
+// Per Java ActivatedAbilityImpl / LoyaltyAbility: costs are paid on activation and the effect
+// happens when the ability resolves from the stack.
+type activatedAbility struct {
+	ID          string
+	SourceID    string
+	Text        string
+	ManaCost    string // Paid from the controller's mana pool ("" = none)
+	TapCost     bool   // {T} is part of the cost
+	Loyalty     bool   // Loyalty ability: LoyaltyCost is added to the permanent's loyalty
+	LoyaltyCost int    // e.g. +1 or -3
+	OncePerTurn bool   // "Activate only once each turn" (rule 602.5b)
+	Sacrifice   bool   // Sacrificing the source is part of the cost
+	// Costs are the ability's other costs, such as sacrificing a creature (see abilityCost)
+	Costs []abilityCost
+	// Target is chosen as the ability is activated (nil = the ability doesn't target)
+	Target *targeting.TargetRequirement
+	// Resolve applies the ability's effect with the targets chosen on activation
+	Resolve func(gameState *engineGameState, controllerID string, targets []string) error
+}
+
+// copyAbilityUses copies a permanent's per-turn ability usage
+func copyAbilityUses(uses map[string]int) map[string]int {
+	if uses == nil {
+		return nil
+	}
+	copied := make(map[string]int, len(uses))
+	for abilityID, count := range uses {
+		copied[abilityID] = count
+	}
+	return copied
+}
+
+// recordAbilityUse counts an activation of an ability this turn
+func (c *internalCard) recordAbilityUse(key string) {
+	if c.AbilityUses == nil {
+		c.AbilityUses = make(map[string]int)
+	}
+	c.AbilityUses[key]++
+}
+
+// RegisterActivatedAbility registers an activated ability for a permanent and returns its ID
+func (e *MageEngine) RegisterActivatedAbility(gameID string, ability *activatedAbility) (string, error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return "", fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+
+	if _, exists := gameState.cards[ability.SourceID]; !exists {
+		return "", fmt.Errorf("source %s not found", ability.SourceID)
+	}
+	if ability.ID == "" {
+		ability.ID = uuid.New().String()
+	}
+
+	gameState.activatedAbilities = append(gameState.activatedAbilities, ability)
+	gameState.markUnreplayable("RegisterActivatedAbility")
+	return ability.ID, nil
+}
+
+// ActivateAbility activates an activated ability of a permanent and puts it on the stack.
+// If the ability targets, the player is asked to choose its targets before its costs are paid.
+func (e *MageEngine) ActivateAbility(gameID, cardID, abilityID, playerID string) error {
+	return e.activateRegisteredAbility(gameID, cardID, playerID, abilityID, false)
+}
+
+// ActivateLoyaltyAbility activates a planeswalker's loyalty ability and puts it on the stack
+func (e *MageEngine) ActivateLoyaltyAbility(gameID, playerID, abilityID string) error {
+	return e.activateRegisteredAbility(gameID, "", playerID, abilityID, true)
+}
+
+// activateRegisteredAbility activates a registered ability; a non-empty cardID must be its source
+func (e *MageEngine) activateRegisteredAbility(gameID, cardID, playerID, abilityID string, loyalty bool) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	if loyalty {
+		defer e.logCall(gameState, &err, "ActivateLoyaltyAbility", playerID, abilityID)
+	} else {
+		defer e.logCall(gameState, &err, "ActivateAbility", cardID, abilityID, playerID)
+	}
+
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+
+	var ability *activatedAbility
+	for _, candidate := range gameState.activatedAbilities {
+		if candidate.ID == abilityID {
+			ability = candidate
+			break
+		}
+	}
+	if ability == nil {
+		return fmt.Errorf("ability %s not found", abilityID)
+	}
+	if cardID != "" && ability.SourceID != cardID {
+		return fmt.Errorf("ability %s is not an ability of %s", abilityID, cardID)
+	}
+	if ability.Loyalty != loyalty {
+		if loyalty {
+			return fmt.Errorf("ability %s is not a loyalty ability", abilityID)
+		}
+		return fmt.Errorf("ability %s is a loyalty ability", abilityID)
+	}
+
+	if err := e.activateAbility(gameState, playerID, ability); err != nil {
+		return err
+	}
+
+	e.notifyPlayerAction(gameID, playerID, map[string]interface{}{
+		"type":       "activate_ability",
+		"ability_id": abilityID,
+	})
+	return nil
+}
+
+// activateAbility checks activation restrictions, chooses targets, pays costs and puts the ability on
+// the stack; caller must hold the game lock
+// Per rule 602.2: restrictions are checked and targets chosen before any cost is paid
+func (e *MageEngine) activateAbility(gameState *engineGameState, playerID string, ability *activatedAbility) error {
+	if err := e.checkActivation(gameState, playerID, ability); err != nil {
+		return err
+	}
+	if ability.Target == nil {
+		return e.chooseAbilityCosts(gameState, playerID, ability, nil)
+	}
+
+	// Per rule 602.2b and 601.2c: an ability without enough legal targets can't be activated
+	source := gameState.cards[ability.SourceID]
+	requirement := *ability.Target
+	requirement.SourceColors = objectColors(source)
+	if legal := e.legalTargets(gameState, playerID, requirement); len(legal) < requirement.MinTargets {
+		return fmt.Errorf("%s has no legal targets", ability.Text)
+	}
+	e.requestTargetDecision(gameState, playerID, fmt.Sprintf("Choose targets for %s: %s", source.Name, ability.Text), requirement,
+		func(gameState *engineGameState, targets []string) error {
+			// The game may have changed while the player chose
+			if err := e.checkActivation(gameState, playerID, ability); err != nil {
+				return err
+			}
+			return e.chooseAbilityCosts(gameState, playerID, ability, targets)
+		})
+	return nil
+}
+
+// chooseAbilityCosts asks the player what to pay the ability's other costs with, then puts it on the
+// stack with its chosen targets
+func (e *MageEngine) chooseAbilityCosts(gameState *engineGameState, playerID string, ability *activatedAbility, targets []string) error {
+	return e.chooseCostPayments(gameState, playerID, ability.SourceID, ability.Costs, nil,
+		func(gameState *engineGameState, payments [][]string) error {
+			if err := e.checkActivation(gameState, playerID, ability); err != nil {
+				return err
+			}
+			return e.putAbilityOnStack(gameState, playerID, ability, targets, payments)
+		})
+}
+
+// checkActivation checks that a player may activate an ability and can pay its costs
+func (e *MageEngine) checkActivation(gameState *engineGameState, playerID string, ability *activatedAbility) error {
+	source, exists := gameState.cards[ability.SourceID]
+	if !exists || source.Zone != zoneBattlefield {
+		return fmt.Errorf("source %s is not on the battlefield", ability.SourceID)
+	}
+	if source.ControllerID != playerID {
+		return fmt.Errorf("player %s does not control %s", playerID, source.Name)
+	}
+	if gameState.turnManager.PriorityPlayer() != playerID {
+		return fmt.Errorf("player %s does not have priority", playerID)
+	}
+	// Per rule 702.61a: only mana abilities can be activated while a spell with split second is on the stack
+	if err := gameState.checkSplitSecond(); err != nil {
+		return err
+	}
+
+	if ability.OncePerTurn && source.AbilityUses[ability.ID] > 0 {
+		return fmt.Errorf("%s can only be activated once each turn", ability.Text)
+	}
+
+	if ability.Loyalty {
+		// Per rule 606.3: sorcery timing, and only one loyalty ability per permanent each turn
+		if !sorceryTiming(gameState, playerID) {
+			return fmt.Errorf("loyalty abilities can only be activated during your main phase while the stack is empty")
+		}
+		if source.AbilityUses[loyaltyAbilityUseKey] > 0 {
+			return fmt.Errorf("a loyalty ability of %s has already been activated this turn", source.Name)
+		}
+		loyalty := 0
+		if source.Counters != nil {
+			loyalty = source.Counters.GetCount(string(counters.CounterTypeLoyalty))
+		}
+		// Per rule 606.6: a negative loyalty cost can't be paid with too few loyalty counters
+		if loyalty+ability.LoyaltyCost < 0 {
+			return fmt.Errorf("%s doesn't have enough loyalty to pay %d", source.Name, ability.LoyaltyCost)
+		}
+	}
+
+	if ability.TapCost {
+		if source.Tapped {
+			return fmt.Errorf("%s is already tapped", source.Name)
+		}
+		if e.isCreature(source) && e.isSummoningSick(gameState, source) {
+			return fmt.Errorf("%s has summoning sickness", source.Name)
+		}
+	}
+	return e.checkCostsPayable(gameState, playerID, source.ID, ability.Costs)
+}
+
+// putAbilityOnStack pays an ability's costs, its other costs with payments, and puts it on the stack
+// with its chosen targets
+func (e *MageEngine) putAbilityOnStack(gameState *engineGameState, playerID string, ability *activatedAbility, targets []string, payments [][]string) error {
+	source := gameState.cards[ability.SourceID]
+	payments, err := e.checkCostPayments(gameState, playerID, source.ID, ability.Costs, payments)
+	if err != nil {
+		return err
+	}
+
+	// Pay costs (mana first: payManaCost spends nothing if it fails)
+	if ability.ManaCost != "" {
+		if err := e.payManaCost(gameState, playerID, ability.ManaCost); err != nil {
+			return err
+		}
+	}
+	if ability.TapCost {
+		if err := e.tapPermanent(gameState, source, tapReasonCost); err != nil {
+			return err
+		}
+	}
+	if ability.Loyalty {
+		if source.Counters == nil {
+			source.Counters = counters.NewCounters()
+		}
+		if ability.LoyaltyCost > 0 {
+			source.Counters.AddCounter(counters.CounterTypeLoyalty.CreateInstance(ability.LoyaltyCost))
+		} else if ability.LoyaltyCost < 0 {
+			source.Counters.RemoveCounter(string(counters.CounterTypeLoyalty), -ability.LoyaltyCost)
+		}
+		source.recordAbilityUse(loyaltyAbilityUseKey)
+	}
+	source.recordAbilityUse(ability.ID)
+	if err := e.payCosts(gameState, playerID, source.ID, ability.Costs, payments); err != nil {
+		return err
+	}
+	if ability.Sacrifice && source.Zone == zoneBattlefield {
+		if err := e.sacrificePermanent(gameState, playerID, source); err != nil {
+			return err
+		}
+	}
+
+	metadata := map[string]string{"ability_id": ability.ID}
+	if len(targets) > 0 {
+		metadata["targets"] = strings.Join(targets, ",")
+	}
+	controllerID := playerID
+	chosenTargets := append([]string(nil), targets...)
+	gameState.stack.Push(rules.StackItem{
+		ID:          uuid.New().String(),
+		Controller:  playerID,
+		Description: fmt.Sprintf("%s: %s", source.Name, ability.Text),
+		Kind:        rules.StackItemKindActivated,
+		SourceID:    source.ID,
+		Metadata:    metadata,
+		Resolve: func() error {
+			if ability.Resolve == nil {
+				return nil
+			}
+			return ability.Resolve(gameState, controllerID, chosenTargets)
+		},
+	})
+	gameState.trackStackItem()
+	gameState.trackStackDepth()
+	gameState.trackAction()
+
+	event := rules.NewEvent(rules.EventActivatedAbility, ability.ID, source.ID, playerID)
+	gameState.eventBus.Publish(event)
+	gameState.addMessage(fmt.Sprintf("%s activates %s: %s", playerID, source.Name, ability.Text), "action")
+
+	// Per rule 117.3c: the player who activated an ability retains priority
+	gameState.resetPassed()
+	return nil
+}
@@ -0,0 +1,296 @@
+package game
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/counters"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+	"github.com/magefree/mage-server-go/internal/game/targeting"
+)
+
+// TestActivatedAbility_OncePerTurn verifies that an "activate only once each turn" ability rejects a
+// second activation in the same turn and can be activated again the following turn
+func TestActivatedAbility_OncePerTurn(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-once-per-turn", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	gameState.cards["relic"] = &internalCard{ID: "relic", Name: "Healing Relic", Type: "Artifact", Zone: zoneBattlefield, OwnerID: "Alice", ControllerID: "Alice", Counters: counters.NewCounters()}
+	gameState.turnManager.SetPriority("Alice")
+	gameState.mu.Unlock()
+
+	abilityID, err := h.engine.RegisterActivatedAbility(h.gameID, &activatedAbility{
+		SourceID:    "relic",
+		Text:        "{T}: You gain 1 life. Activate only once each turn.",
+		TapCost:     true,
+		OncePerTurn: true,
+		Resolve: func(gameState *engineGameState, controllerID string, _ []string) error {
+			gameState.players[controllerID].Life++
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to register ability: %v", err)
+	}
+
+	if err := h.engine.ActivateAbility(h.gameID, "relic", abilityID, "Alice"); err != nil {
+		t.Fatalf("failed to activate ability: %v", err)
+	}
+
+	gameState.mu.Lock()
+	if err := h.engine.resolveStack(gameState); err != nil {
+		t.Fatalf("failed to resolve stack: %v", err)
+	}
+	if life := gameState.players["Alice"].Life; life != 21 {
+		t.Errorf("expected Alice to gain 1 life, got %d", life)
+	}
+	// Untap the relic so only the once-per-turn restriction applies
+	gameState.cards["relic"].Tapped = false
+	gameState.turnManager.SetPriority("Alice")
+	gameState.mu.Unlock()
+
+	err = h.engine.ActivateAbility(h.gameID, "relic", abilityID, "Alice")
+	if err == nil || !strings.Contains(err.Error(), "once each turn") {
+		t.Fatalf("expected a second activation this turn to be rejected, got %v", err)
+	}
+
+	// Start Bob's turn
+	gameState.mu.Lock()
+	h.engine.beginTurn(gameState, "Bob")
+	if uses := gameState.cards["relic"].AbilityUses; len(uses) != 0 {
+		t.Errorf("expected ability usage to reset at the start of the turn, got %v", uses)
+	}
+	gameState.turnManager.SetPriority("Alice")
+	gameState.mu.Unlock()
+
+	if err := h.engine.ActivateAbility(h.gameID, "relic", abilityID, "Alice"); err != nil {
+		t.Fatalf("expected the ability to be usable again the following turn: %v", err)
+	}
+}
+
+// TestActivatedAbility_LoyaltyOncePerTurn verifies loyalty costs and that only one loyalty ability of a
+// planeswalker can be activated each turn, at sorcery speed
+func TestActivatedAbility_LoyaltyOncePerTurn(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-loyalty", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	walker := &internalCard{ID: "walker", Name: "Jace Beleren", Type: "Planeswalker", Zone: zoneBattlefield, OwnerID: "Alice", ControllerID: "Alice", Counters: counters.NewCounters()}
+	walker.Counters.AddCounter(counters.CounterTypeLoyalty.CreateInstance(3))
+	gameState.cards[walker.ID] = walker
+	gameState.turnManager.SetPriority("Alice")
+	gameState.mu.Unlock()
+
+	plus, err := h.engine.RegisterActivatedAbility(h.gameID, &activatedAbility{SourceID: "walker", Text: "+2: Each player draws a card.", Loyalty: true, LoyaltyCost: 2})
+	if err != nil {
+		t.Fatalf("failed to register ability: %v", err)
+	}
+	minus, err := h.engine.RegisterActivatedAbility(h.gameID, &activatedAbility{SourceID: "walker", Text: "-10: Target player mills twenty cards.", Loyalty: true, LoyaltyCost: -10})
+	if err != nil {
+		t.Fatalf("failed to register ability: %v", err)
+	}
+
+	// Not during a main phase
+	if err := h.engine.ActivateLoyaltyAbility(h.gameID, "Alice", plus); err == nil {
+		t.Fatal("expected a loyalty ability to require sorcery timing")
+	}
+
+	gameState.mu.Lock()
+	for gameState.turnManager.CurrentStep() != rules.StepMain1 {
+		gameState.turnManager.AdvanceStep("Alice")
+	}
+	gameState.turnManager.SetPriority("Alice")
+	gameState.mu.Unlock()
+
+	if err := h.engine.ActivateAbility(h.gameID, "walker", plus, "Alice"); err == nil {
+		t.Error("expected loyalty abilities to be activated with ActivateLoyaltyAbility")
+	}
+	if err := h.engine.ActivateLoyaltyAbility(h.gameID, "Alice", minus); err == nil {
+		t.Error("expected -10 to be unpayable with 3 loyalty")
+	}
+	if err := h.engine.ActivateLoyaltyAbility(h.gameID, "Alice", plus); err != nil {
+		t.Fatalf("failed to activate +2: %v", err)
+	}
+
+	gameState.mu.Lock()
+	if loyalty := walker.Counters.GetCount(string(counters.CounterTypeLoyalty)); loyalty != 5 {
+		t.Errorf("expected loyalty 5 after +2, got %d", loyalty)
+	}
+	if err := h.engine.resolveStack(gameState); err != nil {
+		t.Fatalf("failed to resolve stack: %v", err)
+	}
+	gameState.turnManager.SetPriority("Alice")
+	gameState.mu.Unlock()
+
+	if err := h.engine.ActivateLoyaltyAbility(h.gameID, "Alice", plus); err == nil {
+		t.Error("expected a second loyalty ability this turn to be rejected")
+	}
+}
+
+// TestActivatedAbility_TargetsChosenBeforeCosts verifies that a targeting ability asks for its targets
+// before its costs are paid, and resolves from the stack against the chosen target
+func TestActivatedAbility_TargetsChosenBeforeCosts(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-ability-targets", []string{"Alice", "Bob"})
+	sorcererID := h.CreateCreature(CreatureSpec{ID: "sorcerer", Name: "Prodigal Sorcerer", Power: "1", Toughness: "1", Controller: "Alice"})
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	sorcerer := gameState.cards[sorcererID]
+	sorcerer.SummoningSickness = false
+	gameState.battlefield = append(gameState.battlefield, sorcerer)
+	gameState.turnManager.SetPriority("Alice")
+	gameState.mu.Unlock()
+
+	abilityID, err := h.engine.RegisterActivatedAbility(h.gameID, &activatedAbility{
+		SourceID: sorcererID,
+		Text:     "{T}: Prodigal Sorcerer deals 1 damage to any target.",
+		TapCost:  true,
+		Target:   &targeting.TargetRequirement{Type: targeting.TargetTypePlayer, MinTargets: 1, MaxTargets: 1},
+		Resolve: func(gameState *engineGameState, controllerID string, targets []string) error {
+			gameState.players[targets[0]].Life--
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to register ability: %v", err)
+	}
+
+	if err := h.engine.ActivateAbility(h.gameID, "bob-bear", abilityID, "Alice"); err == nil {
+		t.Error("expected activating the ability through another card to be rejected")
+	}
+	if err := h.engine.ActivateAbility(h.gameID, sorcererID, abilityID, "Alice"); err != nil {
+		t.Fatalf("failed to activate ability: %v", err)
+	}
+
+	decisions, err := h.engine.GetPendingDecisions(h.gameID, "Alice")
+	if err != nil || len(decisions) != 1 || decisions[0].Kind != DecisionChooseTarget {
+		t.Fatalf("expected a target decision, got %v (%v)", decisions, err)
+	}
+	gameState.mu.RLock()
+	tappedBeforeTargets := sorcerer.Tapped
+	gameState.mu.RUnlock()
+	if tappedBeforeTargets {
+		t.Error("expected costs to be paid after targets are chosen")
+	}
+
+	if err := h.engine.RespondToDecision(h.gameID, "Alice", decisions[0].ID, Response{Choices: []string{"Bob"}}); err != nil {
+		t.Fatalf("failed to choose target: %v", err)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	items := gameState.stack.List()
+	if len(items) != 1 || items[0].Kind != rules.StackItemKindActivated || items[0].Metadata["targets"] != "Bob" {
+		t.Fatalf("expected the ability on the stack targeting Bob, got %+v", items)
+	}
+	if !sorcerer.Tapped {
+		t.Error("expected Prodigal Sorcerer to be tapped to pay the cost")
+	}
+	if err := h.engine.resolveStack(gameState); err != nil {
+		t.Fatalf("failed to resolve stack: %v", err)
+	}
+	if life := gameState.players["Bob"].Life; life != 19 {
+		t.Errorf("expected Bob to take 1 damage, got life %d", life)
+	}
+}
+
+// TestActivatedAbility_SacrificeCost verifies that sacrificing the source is paid on activation and
+// the ability still resolves once its source is gone
+func TestActivatedAbility_SacrificeCost(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-ability-sacrifice", []string{"Alice", "Bob"})
+	gnomesID := h.CreateCreature(CreatureSpec{ID: "gnomes", Name: "Bottle Gnomes", Power: "1", Toughness: "3", Controller: "Alice"})
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	gameState.battlefield = append(gameState.battlefield, gameState.cards[gnomesID])
+	gameState.turnManager.SetPriority("Alice")
+	gameState.mu.Unlock()
+
+	abilityID, err := h.engine.RegisterActivatedAbility(h.gameID, &activatedAbility{
+		SourceID:  gnomesID,
+		Text:      "Sacrifice Bottle Gnomes: You gain 3 life.",
+		Sacrifice: true,
+		Resolve: func(gameState *engineGameState, controllerID string, _ []string) error {
+			gameState.players[controllerID].Life += 3
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to register ability: %v", err)
+	}
+	if err := h.engine.ActivateAbility(h.gameID, gnomesID, abilityID, "Alice"); err != nil {
+		t.Fatalf("failed to activate ability: %v", err)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	if zone := gameState.cards[gnomesID].Zone; zone != zoneGraveyard {
+		t.Errorf("expected Bottle Gnomes to be sacrificed, got zone %v", zone)
+	}
+	if err := h.engine.resolveStack(gameState); err != nil {
+		t.Fatalf("failed to resolve stack: %v", err)
+	}
+	if life := gameState.players["Alice"].Life; life != 23 {
+		t.Errorf("expected Alice to gain 3 life, got %d", life)
+	}
+}
+
+// TestActivatedAbility_EnergyCost verifies that {E} in an ability's cost is paid with the player's
+// energy counters and that the ability can't be activated with too little energy
+func TestActivatedAbility_EnergyCost(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-energy-cost", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	gameState.cards["dynamo"] = &internalCard{ID: "dynamo", Name: "Aether Hub Dynamo", Type: "Artifact", Zone: zoneBattlefield, OwnerID: "Alice", ControllerID: "Alice", Counters: counters.NewCounters()}
+	gameState.turnManager.SetPriority("Alice")
+	gameState.mu.Unlock()
+
+	abilityID, err := h.engine.RegisterActivatedAbility(h.gameID, &activatedAbility{
+		SourceID: "dynamo",
+		Text:     "Pay {E}{E}: You gain 2 life.",
+		ManaCost: "{E}{E}",
+		Resolve: func(gameState *engineGameState, controllerID string, _ []string) error {
+			gameState.players[controllerID].Life += 2
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to register ability: %v", err)
+	}
+
+	if err := h.engine.AddEnergy(h.gameID, "Alice", 3); err != nil {
+		t.Fatalf("failed to add energy: %v", err)
+	}
+	if err := h.engine.ActivateAbility(h.gameID, "dynamo", abilityID, "Alice"); err != nil {
+		t.Fatalf("failed to activate ability: %v", err)
+	}
+
+	gameState.mu.Lock()
+	if energy := gameState.players["Alice"].Energy; energy != 1 {
+		t.Errorf("expected Alice to have 1 energy left, got %d", energy)
+	}
+	if err := h.engine.resolveStack(gameState); err != nil {
+		t.Fatalf("failed to resolve stack: %v", err)
+	}
+	if life := gameState.players["Alice"].Life; life != 22 {
+		t.Errorf("expected Alice to gain 2 life, got %d", life)
+	}
+	gameState.turnManager.SetPriority("Alice")
+	gameState.mu.Unlock()
+
+	err = h.engine.ActivateAbility(h.gameID, "dynamo", abilityID, "Alice")
+	if err == nil || !strings.Contains(err.Error(), "energy") {
+		t.Fatalf("expected activation with 1 energy to be rejected, got %v", err)
+	}
+	view, err := h.engine.GetGameView(h.gameID, "Alice")
+	if err != nil {
+		t.Fatalf("failed to get game view: %v", err)
+	}
+	for _, player := range view.(*EngineGameView).Players {
+		if player.PlayerID == "Alice" && player.Energy != 1 {
+			t.Errorf("expected the view to show 1 energy, got %d", player.Energy)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package game
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/magefree/mage-server-go/internal/game/counters"
+)
+
+// CardData is a card's printed characteristics as stored in a card-data file.
+// Per Java CardInfo: the card repository keeps what is printed on a card, not its behavior.
+type CardData struct {
+	Name      string   `json:"name"`
+	ManaCost  string   `json:"mana_cost,omitempty"`
+	TypeLine  string   `json:"type_line"` // e.g. "Legendary Creature — Human Wizard"
+	Power     string   `json:"power,omitempty"`
+	Toughness string   `json:"toughness,omitempty"`
+	Loyalty   string   `json:"loyalty,omitempty"`
+	RulesText string   `json:"rules_text,omitempty"`
+	Abilities []string `json:"abilities,omitempty"` // Ability IDs, e.g. "FlyingAbility"
+	Set       string   `json:"set,omitempty"`
+	Number    int      `json:"number,omitempty"`
+	Rarity    string   `json:"rarity,omitempty"`
+}
+
+// CardDatabase looks up cards by name
+// Per Java CardRepository
+type CardDatabase struct {
+	cards map[string]CardData // Lowercased name -> card data
+}
+
+//go:embed data/cards.json
+var defaultCardData []byte
+
+var (
+	defaultCardDatabaseOnce sync.Once
+	defaultCardDatabase     *CardDatabase
+	defaultCardDatabaseErr  error
+)
+
+// DefaultCardDatabase returns the database of the card data bundled with the engine
+func DefaultCardDatabase() (*CardDatabase, error) {
+	defaultCardDatabaseOnce.Do(func() {
+		defaultCardDatabase, defaultCardDatabaseErr = ParseCardDatabase(defaultCardData)
+	})
+	return defaultCardDatabase, defaultCardDatabaseErr
+}
+
+// LoadCardDatabase reads a card-data file: a JSON array of CardData
+func LoadCardDatabase(path string) (*CardDatabase, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read card data: %w", err)
+	}
+	return ParseCardDatabase(data)
+}
+
+// ParseCardDatabase parses card data in the card-data file format
+func ParseCardDatabase(data []byte) (*CardDatabase, error) {
+	var cards []CardData
+	if err := json.Unmarshal(data, &cards); err != nil {
+		return nil, fmt.Errorf("failed to parse card data: %w", err)
+	}
+	return NewCardDatabase(cards)
+}
+
+// NewCardDatabase creates a database of cards; names must be unique, ignoring case
+func NewCardDatabase(cards []CardData) (*CardDatabase, error) {
+	db := &CardDatabase{cards: make(map[string]CardData, len(cards))}
+	for _, card := range cards {
+		key := strings.ToLower(strings.TrimSpace(card.Name))
+		if key == "" {
+			return nil, fmt.Errorf("card without a name")
+		}
+		if card.TypeLine == "" {
+			return nil, fmt.Errorf("card %s has no type line", card.Name)
+		}
+		if _, exists := db.cards[key]; exists {
+			return nil, fmt.Errorf("duplicate card %s", card.Name)
+		}
+		db.cards[key] = card
+	}
+	return db, nil
+}
+
+// Len returns the number of cards in the database
+func (db *CardDatabase) Len() int {
+	return len(db.cards)
+}
+
+// LoadCard returns a new card with the characteristics of the named card, ignoring case.
+// The card has no ID, owner or controller yet.
+func (db *CardDatabase) LoadCard(name string) (*internalCard, error) {
+	data, exists := db.cards[strings.ToLower(strings.TrimSpace(name))]
+	if !exists {
+		return nil, fmt.Errorf("card %s not found", name)
+	}
+
+	superTypes, cardType, subTypes := parseTypeLine(data.TypeLine)
+	abilities := make([]EngineAbilityView, 0, len(data.Abilities))
+	for _, abilityID := range data.Abilities {
+		abilities = append(abilities, EngineAbilityView{ID: abilityID})
+	}
+	return &internalCard{
+		Name:         data.Name,
+		DisplayName:  data.Name,
+		ManaCost:     data.ManaCost,
+		Type:         cardType,
+		SubTypes:     subTypes,
+		SuperTypes:   superTypes,
+		Color:        colorOfManaCost(data.ManaCost),
+		Power:        data.Power,
+		Toughness:    data.Toughness,
+		Loyalty:      data.Loyalty,
+		CardNumber:   data.Number,
+		ExpansionSet: data.Set,
+		Rarity:       data.Rarity,
+		RulesText:    data.RulesText,
+		Abilities:    abilities,
+		Zone:         zoneLibrary,
+		Counters:     counters.NewCounters(),
+	}, nil
+}
+
+// superTypeNames are the supertypes of rule 205.4a that appear on cards
+var superTypeNames = map[string]bool{"Basic": true, "Legendary": true, "Snow": true, "World": true, "Ongoing": true}
+
+// parseTypeLine splits a type line into supertypes, card types and subtypes (rule 205), e.g.
+// "Legendary Creature — Human Wizard" -> [Legendary], "Creature", [Human Wizard]
+func parseTypeLine(typeLine string) (superTypes []string, cardType string, subTypes []string) {
+	types, subs := typeLine, ""
+	for _, dash := range []string{"—", " - "} {
+		if i := strings.Index(typeLine, dash); i >= 0 {
+			types, subs = typeLine[:i], typeLine[i+len(dash):]
+			break
+		}
+	}
+
+	superTypes, subTypes = []string{}, strings.Fields(subs)
+	cardTypes := make([]string, 0)
+	for _, word := range strings.Fields(types) {
+		if superTypeNames[word] {
+			superTypes = append(superTypes, word)
+		} else {
+			cardTypes = append(cardTypes, word)
+		}
+	}
+	return superTypes, strings.Join(cardTypes, " "), subTypes
+}
+
+// colorOfManaCost returns the colors of a card from the colored mana symbols in its mana cost
+// (rule 202.2), e.g. "{1}{W}{U}" -> "White Blue"
+func colorOfManaCost(manaCost string) string {
+	symbols := strings.ToUpper(manaCost)
+	colors := make([]string, 0)
+	for _, color := range []struct{ symbol, name string }{
+		{"W", "White"}, {"U", "Blue"}, {"B", "Black"}, {"R", "Red"}, {"G", "Green"},
+	} {
+		if strings.Contains(symbols, color.symbol) {
+			colors = append(colors, color.name)
+		}
+	}
+	return strings.Join(colors, " ")
+}
+
+// buildLibraries creates the cards of each player's decklist. It fails if a decklist belongs to a
+// player who isn't in the game, has fewer than minimumSize cards, or names cards that aren't in the
+// card database.
+func (e *MageEngine) buildLibraries(players []string, decks map[string][]string, minimumSize int) (map[string][]*internalCard, error) {
+	libraries := make(map[string][]*internalCard, len(decks))
+	if len(decks) == 0 {
+		return libraries, nil
+	}
+	for playerID := range decks {
+		if !containsString(players, playerID) {
+			return nil, fmt.Errorf("decklist for unknown player %s", playerID)
+		}
+	}
+
+	e.mu.RLock()
+	db := e.cardDatabase
+	e.mu.RUnlock()
+	if db == nil {
+		var err error
+		if db, err = DefaultCardDatabase(); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, playerID := range players {
+		deck, hasDeck := decks[playerID]
+		if !hasDeck {
+			continue
+		}
+		if len(deck) < minimumSize {
+			return nil, fmt.Errorf("decklist of %s has %d cards, at least %d required", playerID, len(deck), minimumSize)
+		}
+
+		library := make([]*internalCard, 0, len(deck))
+		unknown := make([]string, 0)
+		for i, name := range deck {
+			card, err := db.LoadCard(name)
+			if err != nil {
+				if !containsString(unknown, name) {
+					unknown = append(unknown, name)
+				}
+				continue
+			}
+			card.ID = fmt.Sprintf("%s-library-%d", playerID, i)
+			card.OwnerID = playerID
+			card.ControllerID = playerID
+			library = append(library, card)
+		}
+		if len(unknown) > 0 {
+			return nil, fmt.Errorf("decklist of %s has cards that aren't in the card database: %s", playerID, strings.Join(unknown, ", "))
+		}
+		libraries[playerID] = library
+	}
+	return libraries, nil
+}
+
+// drawOpeningHand shuffles a player's deck into their library and draws their opening hand from it
+// Per rule 103.2 and 103.5
+func (e *MageEngine) drawOpeningHand(gameState *engineGameState, player *internalPlayer, library []*internalCard) {
+	for _, card := range library {
+		gameState.cards[card.ID] = card
+	}
+	player.Library = library
+	e.shuffleLibrary(gameState, player)
+
+	for i := 0; i < gameState.rulesOptions.StartingHandSize && len(player.Library) > 0; i++ {
+		card := player.Library[0]
+		player.Library = player.Library[1:]
+		card.Zone = zoneHand
+		player.Hand = append(player.Hand, card)
+	}
+}
@@ -0,0 +1,101 @@
+package game
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap/zaptest"
+)
+
+// TestCardDatabase_LoadCard verifies that cards are looked up by name, ignoring case, with their
+// type line split into supertypes, card types and subtypes
+func TestCardDatabase_LoadCard(t *testing.T) {
+	db, err := DefaultCardDatabase()
+	if err != nil {
+		t.Fatalf("failed to load the bundled card data: %v", err)
+	}
+
+	isamaru, err := db.LoadCard("isamaru, hound of konda")
+	if err != nil {
+		t.Fatalf("failed to load card: %v", err)
+	}
+	if isamaru.Name != "Isamaru, Hound of Konda" || isamaru.Type != "Creature" || isamaru.Power != "2" || isamaru.Color != "White" ||
+		!reflect.DeepEqual(isamaru.SuperTypes, []string{"Legendary"}) || !reflect.DeepEqual(isamaru.SubTypes, []string{"Dog"}) {
+		t.Errorf("unexpected card %+v", isamaru)
+	}
+
+	angel, err := db.LoadCard("Serra Angel")
+	if err != nil {
+		t.Fatalf("failed to load card: %v", err)
+	}
+	engine := NewMageEngine(zaptest.NewLogger(t))
+	if !engine.hasAbility(angel, abilityFlying) || !engine.hasAbility(angel, abilityVigilance) {
+		t.Errorf("expected Serra Angel to have flying and vigilance, got %+v", angel.Abilities)
+	}
+
+	if _, err := db.LoadCard("Black Lotus"); err == nil {
+		t.Error("expected an unknown card to be rejected")
+	}
+
+	path := filepath.Join(t.TempDir(), "cards.json")
+	if err := os.WriteFile(path, []byte(`[{"name": "Memnite", "mana_cost": "{0}", "type_line": "Artifact Creature - Construct", "power": "1", "toughness": "1"}]`), 0o600); err != nil {
+		t.Fatalf("failed to write card data: %v", err)
+	}
+	custom, err := LoadCardDatabase(path)
+	if err != nil {
+		t.Fatalf("failed to load card data file: %v", err)
+	}
+	memnite, err := custom.LoadCard("Memnite")
+	if err != nil || memnite.Type != "Artifact Creature" || memnite.Color != "" || !reflect.DeepEqual(memnite.SubTypes, []string{"Construct"}) {
+		t.Errorf("unexpected card %+v (%v)", memnite, err)
+	}
+}
+
+// TestStartGameWithDecks_BuildsLibrariesFromDecklists verifies that decklists are turned into libraries
+// and opening hands of cards from the database, and that unknown cards and short decks are rejected
+func TestStartGameWithDecks_BuildsLibrariesFromDecklists(t *testing.T) {
+	engine := NewMageEngine(zaptest.NewLogger(t))
+
+	deck := make([]string, 0, 60)
+	for i := 0; i < 30; i++ {
+		deck = append(deck, "Forest", "Grizzly Bears")
+	}
+	badDeck := append(append([]string(nil), deck[:58]...), "Black Lotus", "Mox Pearl")
+	err := engine.StartGameWithDecks("test-decks-bad", []string{"Alice", "Bob"}, "Duel", map[string][]string{"Alice": badDeck})
+	if err == nil || !strings.Contains(err.Error(), "Black Lotus, Mox Pearl") {
+		t.Errorf("expected a decklist with unknown cards to be rejected naming them, got %v", err)
+	}
+	if err := engine.StartGameWithDecks("test-decks-short", []string{"Alice", "Bob"}, "Duel", map[string][]string{"Alice": deck[:40]}); err == nil {
+		t.Error("expected a 40-card decklist to be rejected")
+	}
+	if err := engine.StartGameWithDecks("test-decks", []string{"Alice", "Bob"}, "Duel", map[string][]string{"Alice": deck}); err != nil {
+		t.Fatalf("failed to start game: %v", err)
+	}
+
+	engine.mu.RLock()
+	gameState := engine.games["test-decks"]
+	engine.mu.RUnlock()
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+
+	alice := gameState.players["Alice"]
+	if len(alice.Hand) != 7 || len(alice.Library) != 53 {
+		t.Fatalf("expected a 7-card hand and a 53-card library, got %d and %d", len(alice.Hand), len(alice.Library))
+	}
+	counts := make(map[string]int)
+	for _, card := range append(append([]*internalCard(nil), alice.Hand...), alice.Library...) {
+		counts[card.Name]++
+		if card.OwnerID != "Alice" || gameState.cards[card.ID] != card {
+			t.Errorf("expected %s to be an Alice-owned card of the game", card.ID)
+		}
+	}
+	if counts["Forest"] != 30 || counts["Grizzly Bears"] != 30 {
+		t.Errorf("expected 30 Forests and 30 Grizzly Bears, got %v", counts)
+	}
+	if bob := gameState.players["Bob"]; len(bob.Hand)+len(bob.Library) != 60 {
+		t.Errorf("expected Bob without a decklist to get the placeholder deck, got %d cards", len(bob.Hand)+len(bob.Library))
+	}
+}
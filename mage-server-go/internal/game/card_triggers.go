@@ -0,0 +1,142 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+	"go.uber.org/zap"
+)
+
+// TriggerEffect is the effect of a card's triggered ability, applied as it resolves; sourceID is the
+// card the ability is printed on and controllerID the player who controls the ability
+type TriggerEffect func(gameState *engineGameState, sourceID, controllerID string) error
+
+// TriggerDefinition is a triggered ability printed on a card, triggering on an event that happens to
+// the card itself: it is cast (EventSpellCast), enters the battlefield (EventEntersTheBattlefield) or
+// dies (EventPermanentDies); or, for a permanent, at the beginning of its controller's upkeep
+// (EventUpkeepStep)
+// Per Java SpellCastControllerTriggeredAbility / EntersBattlefieldTriggeredAbility / DiesSourceTriggeredAbility
+// / BeginningOfUpkeepTriggeredAbility
+type TriggerDefinition struct {
+	Event       rules.EventType
+	Description string // e.g. "you gain 1 life"
+	Optional    bool   // "you may ..."; see ResolveOptionalTrigger
+	Effect      TriggerEffect
+}
+
+// triggerEvents are the events card triggers can be registered for
+var triggerEvents = map[rules.EventType]bool{
+	rules.EventSpellCast:            true,
+	rules.EventEntersTheBattlefield: true,
+	rules.EventPermanentDies:        true,
+	rules.EventUpkeepStep:           true,
+}
+
+// RegisterTrigger adds a triggered ability to the card with the given name, ignoring case, in all games
+// of the engine. Card implementations register their triggers here; they are queued when the event
+// they trigger on happens to a card with that name.
+func (e *MageEngine) RegisterTrigger(cardName string, def TriggerDefinition) error {
+	key := strings.ToLower(strings.TrimSpace(cardName))
+	if key == "" {
+		return fmt.Errorf("card name is required")
+	}
+	if !triggerEvents[def.Event] {
+		return fmt.Errorf("unsupported trigger event %s", def.Event)
+	}
+	if def.Effect == nil {
+		return fmt.Errorf("trigger of %s has no effect", cardName)
+	}
+
+	e.triggersMu.Lock()
+	defer e.triggersMu.Unlock()
+	if e.cardTriggers == nil {
+		e.cardTriggers = make(map[string][]TriggerDefinition)
+	}
+	e.cardTriggers[key] = append(e.cardTriggers[key], def)
+	return nil
+}
+
+// GainLifeEffect returns a trigger effect that makes the ability's controller gain life
+func (e *MageEngine) GainLifeEffect(amount int) TriggerEffect {
+	return func(gameState *engineGameState, sourceID, controllerID string) error {
+		e.gainLife(gameState, controllerID, amount, sourceID)
+		return nil
+	}
+}
+
+// DrawCardsEffect returns a trigger effect that makes the ability's controller draw cards
+func (e *MageEngine) DrawCardsEffect(count int) TriggerEffect {
+	return func(gameState *engineGameState, _, controllerID string) error {
+		player, exists := gameState.players[controllerID]
+		if !exists {
+			return fmt.Errorf("player %s not found", controllerID)
+		}
+		e.drawCards(gameState, player, count)
+		return nil
+	}
+}
+
+// collectCardTriggers queues the registered triggered abilities of the card an event happened to, or
+// for an upkeep, the upkeep triggers of the active player's permanents
+// Per rule 603.2: an ability triggers whenever its trigger event occurs
+func (e *MageEngine) collectCardTriggers(gameState *engineGameState, event rules.Event) {
+	if !triggerEvents[event.Type] {
+		return
+	}
+	if event.Type == rules.EventUpkeepStep {
+		permanentIDs := make([]string, 0)
+		for _, card := range gameState.cards {
+			if card.Zone == zoneBattlefield && card.ControllerID == event.Controller {
+				permanentIDs = append(permanentIDs, card.ID)
+			}
+		}
+		sort.Strings(permanentIDs)
+		for _, permanentID := range permanentIDs {
+			e.queueCardTriggers(gameState, gameState.cards[permanentID], event, event.Controller)
+		}
+		return
+	}
+	if card, exists := gameState.cards[event.TargetID]; exists {
+		e.queueCardTriggers(gameState, card, event, event.Controller)
+	}
+}
+
+// queueCardTriggers queues a card's registered triggered abilities for an event
+func (e *MageEngine) queueCardTriggers(gameState *engineGameState, card *internalCard, event rules.Event, controllerID string) {
+	e.triggersMu.RLock()
+	defs := e.cardTriggers[strings.ToLower(card.Name)]
+	e.triggersMu.RUnlock()
+
+	for _, def := range defs {
+		if def.Event != event.Type {
+			continue
+		}
+		def := def
+		sourceID := card.ID
+		ability := &triggeredAbilityQueueItem{
+			ID:          uuid.New().String(),
+			SourceID:    sourceID,
+			Controller:  controllerID,
+			Description: fmt.Sprintf("%s: %s", card.Name, def.Description),
+			UsesStack:   true,
+			Optional:    def.Optional,
+			Resolve: func(gs *engineGameState) error {
+				return def.Effect(gs, sourceID, controllerID)
+			},
+		}
+		gameState.triggeredQueue = append(gameState.triggeredQueue, ability)
+		gameState.addMessage(fmt.Sprintf("Triggered: %s (queued)", ability.Description), "action")
+
+		if e.logger != nil {
+			e.logger.Debug("queued triggered ability",
+				zap.String("trigger_id", ability.ID),
+				zap.String("source_id", sourceID),
+				zap.String("event", string(event.Type)),
+				zap.String("controller", controllerID),
+			)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/counters"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestRegisterTrigger_CardTriggersOnItsOwnEvents verifies that registered enters-the-battlefield and
+// dies triggers are queued for the card with that name and resolve their effect for its controller,
+// while other cards and events don't trigger them
+func TestRegisterTrigger_CardTriggersOnItsOwnEvents(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-card-triggers", []string{"Alice", "Bob"})
+	if err := h.engine.RegisterTrigger("Elvish Visionary", TriggerDefinition{
+		Event:       rules.EventEntersTheBattlefield,
+		Description: "draw a card",
+		Effect:      h.engine.DrawCardsEffect(1),
+	}); err != nil {
+		t.Fatalf("failed to register trigger: %v", err)
+	}
+	if err := h.engine.RegisterTrigger("elvish visionary", TriggerDefinition{
+		Event:       rules.EventPermanentDies,
+		Description: "you gain 2 life",
+		Effect:      h.engine.GainLifeEffect(2),
+	}); err != nil {
+		t.Fatalf("failed to register trigger: %v", err)
+	}
+	if err := h.engine.RegisterTrigger("Elvish Visionary", TriggerDefinition{Event: rules.EventDrewCard, Effect: h.engine.GainLifeEffect(1)}); err == nil {
+		t.Error("expected a trigger on an unsupported event to be rejected")
+	}
+
+	h.CreateAttacker("visionary", "Elvish Visionary", "Alice", "1", "1")
+	h.CreateAttacker("bears", "Grizzly Bears", "Alice", "2", "2")
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	alice := gameState.players["Alice"]
+	handSize := len(alice.Hand)
+
+	resolveTriggers := func() {
+		t.Helper()
+		h.engine.processTriggeredAbilities(gameState)
+		for !gameState.stack.IsEmpty() {
+			item, err := gameState.stack.Pop()
+			if err != nil {
+				t.Fatalf("failed to pop from stack: %v", err)
+			}
+			if err := item.Resolve(); err != nil {
+				t.Fatalf("failed to resolve %s: %v", item.ID, err)
+			}
+		}
+	}
+
+	for _, id := range []string{"visionary", "bears"} {
+		gameState.cards[id].Zone = zoneHand
+		if err := h.engine.moveCard(gameState, gameState.cards[id], zoneBattlefield, "Alice"); err != nil {
+			t.Fatalf("failed to move %s: %v", id, err)
+		}
+	}
+	if len(gameState.triggeredQueue) != 1 || gameState.triggeredQueue[0].Description != "Elvish Visionary: draw a card" {
+		t.Fatalf("expected only Elvish Visionary's enters trigger to be queued, got %d", len(gameState.triggeredQueue))
+	}
+	resolveTriggers()
+	if len(alice.Hand) != handSize+1 {
+		t.Errorf("expected Alice to draw a card, got %d cards in hand", len(alice.Hand))
+	}
+
+	life := alice.Life
+	if err := h.engine.moveCard(gameState, gameState.cards["visionary"], zoneGraveyard, ""); err != nil {
+		t.Fatalf("failed to move Elvish Visionary: %v", err)
+	}
+	resolveTriggers()
+	if alice.Life != life+2 {
+		t.Errorf("expected Alice to gain 2 life when Elvish Visionary dies, got %d", alice.Life)
+	}
+}
+
+// TestRegisterTrigger_UpkeepTriggerResolvesAtOwnersUpkeep verifies that an "at the beginning of your
+// upkeep" trigger is put on the stack as its controller's upkeep begins, before they get priority, and
+// not during other players' upkeeps
+func TestRegisterTrigger_UpkeepTriggerResolvesAtOwnersUpkeep(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-upkeep-trigger", []string{"Alice", "Bob"})
+	if err := h.engine.RegisterTrigger("Ajani's Mantra", TriggerDefinition{
+		Event:       rules.EventUpkeepStep,
+		Description: "you gain 1 life",
+		Effect:      h.engine.GainLifeEffect(1),
+	}); err != nil {
+		t.Fatalf("failed to register trigger: %v", err)
+	}
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.cards["mantra"] = &internalCard{ID: "mantra", Name: "Ajani's Mantra", Type: "Enchantment", Zone: zoneBattlefield, OwnerID: "Bob", ControllerID: "Bob", Counters: counters.NewCounters()}
+	gameState.mu.Unlock()
+
+	for i := 0; i < 50; i++ {
+		gameState.mu.RLock()
+		turn, priority := gameState.turnManager.TurnNumber(), gameState.turnManager.PriorityPlayer()
+		gameState.mu.RUnlock()
+		if turn > 1 {
+			break
+		}
+		if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: priority, ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+			t.Fatalf("failed to pass priority: %v", err)
+		}
+	}
+
+	gameState.mu.RLock()
+	step, items := gameState.turnManager.CurrentStep(), gameState.stack.List()
+	gameState.mu.RUnlock()
+	if step != rules.StepUpkeep {
+		t.Fatalf("expected Bob's turn to start in the upkeep, got %v", step)
+	}
+	if len(items) != 1 || items[0].Controller != "Bob" || items[0].Description != "Ajani's Mantra: you gain 1 life" {
+		t.Fatalf("expected Bob's upkeep trigger on the stack, got %+v", items)
+	}
+
+	for _, playerID := range []string{"Bob", "Alice"} {
+		if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: playerID, ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+			t.Fatalf("failed to pass priority: %v", err)
+		}
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if life := gameState.players["Bob"].Life; life != 21 {
+		t.Errorf("expected Bob to gain 1 life at the beginning of their upkeep, got %d", life)
+	}
+	if life := gameState.players["Alice"].Life; life != 20 {
+		t.Errorf("expected Alice's life to be unchanged, got %d", life)
+	}
+}
@@ -0,0 +1,112 @@
+package game
+
+import (
+	"slices"
+	"sync"
+)
+
+// cardViewCache keeps the last view built of each card in a game, so views of cards that haven't
+// changed aren't rebuilt on every GetGameView. Cards are changed by writing their fields all over the
+// engine, so instead of being invalidated, a cached view is checked against the card before it is
+// reused (see matchesCard), which allocates nothing. It has its own mutex because views are built
+// under the game's read lock.
+//
+// Views are handed out as copies (see clone), so callers can't change the cached views.
+type cardViewCache struct {
+	mu    sync.Mutex
+	views map[string]EngineCardView // Card ID -> last view built
+}
+
+func newCardViewCache() *cardViewCache {
+	return &cardViewCache{views: make(map[string]EngineCardView)}
+}
+
+// cachedCardViews builds the views of cards like buildCardViews, reusing the cached views of cards
+// that haven't changed since
+func (e *MageEngine) cachedCardViews(gameState *engineGameState, cards []*internalCard) []EngineCardView {
+	cache := gameState.cardViews
+	if cache == nil {
+		return e.buildCardViews(cards)
+	}
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	views := make([]EngineCardView, len(cards))
+	for i, card := range cards {
+		cached, exists := cache.views[card.ID]
+		if !exists || !cached.matchesCard(card) {
+			cached = e.buildCardView(card)
+			cache.views[card.ID] = cached
+		}
+		views[i] = cached.clone()
+	}
+	return views
+}
+
+// prune drops the views of cards no longer in the game, such as spell copies that ceased to exist or
+// cards a rollback took back out of the game
+func (c *cardViewCache) prune(cards map[string]*internalCard) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for id := range c.views {
+		if _, exists := cards[id]; !exists {
+			delete(c.views, id)
+		}
+	}
+}
+
+// clone returns a copy of a view that shares no slices with it
+func (view EngineCardView) clone() EngineCardView {
+	view.SubTypes = slices.Clone(view.SubTypes)
+	view.SuperTypes = slices.Clone(view.SuperTypes)
+	view.AttachedToCard = slices.Clone(view.AttachedToCard)
+	view.Abilities = slices.Clone(view.Abilities)
+	view.Counters = slices.Clone(view.Counters)
+	return view
+}
+
+// matchesCard reports whether a view still shows a card as it is
+func (view *EngineCardView) matchesCard(card *internalCard) bool {
+	printedPower, printedToughness := printedPowerToughness(card)
+	if view.ID != card.ID || view.Name != card.Name || view.DisplayName != card.DisplayName ||
+		view.ManaCost != card.ManaCost || view.Type != card.Type || view.Color != card.Color ||
+		view.Power != card.Power || view.Toughness != card.Toughness ||
+		view.PrintedPower != printedPower || view.PrintedToughness != printedToughness ||
+		view.Loyalty != card.Loyalty || view.CardNumber != card.CardNumber ||
+		view.ExpansionSet != card.ExpansionSet || view.Rarity != card.Rarity || view.RulesText != card.RulesText ||
+		view.Tapped != card.Tapped || view.TapReason != card.TapReason || view.Flipped != card.Flipped ||
+		view.Transformed != card.Transformed || view.FaceDown != card.FaceDown || view.Zone != card.Zone ||
+		view.ControllerID != card.ControllerID || view.OwnerID != card.OwnerID {
+		return false
+	}
+	if !equalStrings(view.SubTypes, card.SubTypes) || !equalStrings(view.SuperTypes, card.SuperTypes) ||
+		!equalStrings(view.AttachedToCard, card.AttachedToCard) {
+		return false
+	}
+	if len(view.Abilities) != len(card.Abilities) {
+		return false
+	}
+	for i := range view.Abilities {
+		if view.Abilities[i] != card.Abilities[i] {
+			return false
+		}
+	}
+
+	count := 0
+	if card.Counters != nil {
+		count = len(card.Counters.Counters)
+	}
+	if len(view.Counters) != count {
+		return false
+	}
+	for _, counter := range view.Counters {
+		current, exists := card.Counters.Counters[counter.Name]
+		if !exists || current.Count != counter.Count {
+			return false
+		}
+	}
+	return true
+}
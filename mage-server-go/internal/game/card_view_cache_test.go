@@ -0,0 +1,160 @@
+package game
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/counters"
+)
+
+// TestCardViewCache_RebuildsChangedCards verifies that cached card views are reused until the card
+// changes in any way the view shows
+func TestCardViewCache_RebuildsChangedCards(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-card-view-cache", []string{"Alice", "Bob"})
+	h.CreateAttacker("bears", "Grizzly Bears", "Alice", "2", "2")
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	card := gameState.cards["bears"]
+	card.SubTypes = []string{"Bear"}
+	views := func() EngineCardView {
+		return h.engine.cachedCardViews(gameState, []*internalCard{card})[0]
+	}
+
+	first := views()
+	cached := gameState.cardViews.views["bears"].SubTypes
+	second := views()
+	if &gameState.cardViews.views["bears"].SubTypes[0] != &cached[0] {
+		t.Error("expected the view of an unchanged card to be reused")
+	}
+	// Views are handed out as copies, so changing one doesn't change the cache or other views
+	first.SubTypes[0] = "Changed"
+	if second.SubTypes[0] != "Bear" || cached[0] != "Bear" {
+		t.Error("expected views handed out not to share slices with the cache")
+	}
+
+	mutations := []struct {
+		name   string
+		mutate func()
+		check  func(view EngineCardView) bool
+	}{
+		{"tap", func() { card.Tapped = true }, func(view EngineCardView) bool { return view.Tapped }},
+		{"counter", func() { card.Counters.AddCounter(counters.NewCounter("+1/+1", 1)) },
+			func(view EngineCardView) bool { return len(view.Counters) == 1 && view.Counters[0].Count == 1 }},
+		{"more counters", func() { card.Counters.AddCounter(counters.NewCounter("+1/+1", 1)) },
+			func(view EngineCardView) bool { return len(view.Counters) == 1 && view.Counters[0].Count == 2 }},
+		{"power", func() { card.Power = "4" }, func(view EngineCardView) bool { return view.Power == "4" }},
+		{"zone", func() { card.Zone = zoneGraveyard }, func(view EngineCardView) bool { return view.Zone == zoneGraveyard }},
+		{"controller", func() { card.ControllerID = "Bob" }, func(view EngineCardView) bool { return view.ControllerID == "Bob" }},
+		{"subtype", func() { card.SubTypes[0] = "Elf" }, func(view EngineCardView) bool { return view.SubTypes[0] == "Elf" }},
+		{"attached", func() { card.AttachedToCard = append(card.AttachedToCard, "aura") },
+			func(view EngineCardView) bool { return len(view.AttachedToCard) == 1 }},
+	}
+	for _, mutation := range mutations {
+		mutation.mutate()
+		if view := views(); !mutation.check(view) {
+			t.Errorf("expected the view to show the %s change, got %+v", mutation.name, view)
+		}
+	}
+}
+
+// TestCardViewCache_MatchesEveryViewedField verifies that matchesCard notices a change to any card field
+// buildCardView shows, so a field added to the view but not to matchesCard can't serve stale views
+func TestCardViewCache_MatchesEveryViewedField(t *testing.T) {
+	engine := NewMageEngine(nil)
+	base := internalCard{
+		ID: "card", Name: "Grizzly Bears", Type: "Creature", SubTypes: []string{"Bear"}, SuperTypes: []string{},
+		Power: "2", Toughness: "2", BasePower: "2", BaseToughness: "2", Zone: zoneBattlefield,
+		ControllerID: "Alice", OwnerID: "Alice", AttachedToCard: []string{},
+		Abilities: []EngineAbilityView{{ID: "ability", Text: "text"}},
+	}
+	view := engine.buildCardView(&base)
+
+	fields := reflect.TypeOf(base)
+	for i := 0; i < fields.NumField(); i++ {
+		field := fields.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		changed := base
+		value := reflect.ValueOf(&changed).Elem().Field(i)
+		switch value.Kind() {
+		case reflect.String:
+			value.SetString(value.String() + "-changed")
+		case reflect.Bool:
+			value.SetBool(!value.Bool())
+		case reflect.Int:
+			value.SetInt(value.Int() + 1)
+		case reflect.Slice:
+			grown := reflect.MakeSlice(value.Type(), value.Len()+1, value.Len()+1)
+			reflect.Copy(grown, value)
+			value.Set(grown)
+		default:
+			continue // Counters are covered by TestCardViewCache_RebuildsChangedCards
+		}
+		if reflect.DeepEqual(engine.buildCardView(&changed), view) {
+			continue // Not a field the view shows
+		}
+		if view.matchesCard(&changed) {
+			t.Errorf("matchesCard doesn't notice a change to %s, which the view shows", field.Name)
+		}
+	}
+}
+
+// TestCardViewCache_PrunesRemovedCards verifies that the views of cards no longer in the game are dropped
+func TestCardViewCache_PrunesRemovedCards(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-card-view-prune", []string{"Alice", "Bob"})
+	h.CreateAttacker("bears", "Grizzly Bears", "Alice", "2", "2")
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	card := gameState.cards["bears"]
+	h.engine.cachedCardViews(gameState, []*internalCard{card})
+
+	card.CopyOf = "original"
+	h.engine.removeSpellCopy(gameState, card)
+	if _, exists := gameState.cardViews.views["bears"]; exists {
+		t.Error("expected the view of a copy that ceased to exist to be dropped")
+	}
+}
+
+// BenchmarkCardViews builds the views of a 40-permanent battlefield with and without the cache
+func BenchmarkCardViews(b *testing.B) {
+	engine := NewMageEngine(nil)
+	if err := engine.StartGame("bench-card-views", []string{"Alice", "Bob"}, "Duel"); err != nil {
+		b.Fatalf("failed to start game: %v", err)
+	}
+	gameState := engine.games["bench-card-views"]
+	cards := make([]*internalCard, 0, 40)
+	for i := 0; i < 40; i++ {
+		card := &internalCard{
+			ID:           fmt.Sprintf("bench-%d", i),
+			Name:         "Grizzly Bears",
+			Type:         "Creature",
+			SubTypes:     []string{"Bear"},
+			Power:        "2",
+			Toughness:    "2",
+			Zone:         zoneBattlefield,
+			ControllerID: "Alice",
+			OwnerID:      "Alice",
+			Abilities:    []EngineAbilityView{{ID: "trample", Text: "Trample"}},
+			Counters:     counters.NewCounters(),
+		}
+		card.Counters.AddCounter(counters.NewCounter("+1/+1", 1))
+		cards = append(cards, card)
+	}
+
+	b.Run("uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			engine.buildCardViews(cards)
+		}
+	})
+	b.Run("cached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			engine.cachedCardViews(gameState, cards)
+		}
+	})
+}
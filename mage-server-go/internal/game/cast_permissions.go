@@ -0,0 +1,125 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// castPermission changes when spells can be cast.
+// Per Java AsThoughEffect / CastOnlyDuringYourTurn: a permission covers the spells matched by
+// Filter and only applies while Condition holds.
+type castPermission struct {
+	ID          string
+	SourceID    string                                                 // Object that grants the permission
+	PlayerID    string                                                 // Player the permission applies to ("" = all players)
+	Description string                                                 // Human-readable text (e.g. "creature spells have flash")
+	Filter      func(card *internalCard) bool                          // Spells covered by the permission (nil = all spells)
+	Condition   func(gameState *engineGameState, playerID string) bool // When the permission applies (nil = always)
+	// AsThoughFlash lets covered spells be cast any time their controller could cast an instant
+	AsThoughFlash bool
+	// Required means covered spells can only be cast while Condition holds
+	// (e.g. "you may cast this spell only during your turn")
+	Required bool
+}
+
+// duringYourTurn is a cast permission condition that holds only on the caster's own turn
+func duringYourTurn(gameState *engineGameState, playerID string) bool {
+	return gameState.turnManager.ActivePlayer() == playerID
+}
+
+// addCastPermission registers a cast permission for a game. Permissions come from effects inside the
+// engine, since their filters and conditions look at engine state.
+func (e *MageEngine) addCastPermission(gameID string, permission *castPermission) error {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+
+	if permission.ID == "" {
+		permission.ID = uuid.New().String()
+	}
+	gameState.castPermissions = append(gameState.castPermissions, permission)
+	return nil
+}
+
+// removeCastPermission removes a previously registered cast permission
+func (e *MageEngine) removeCastPermission(gameID, permissionID string) error {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+
+	for i, permission := range gameState.castPermissions {
+		if permission.ID == permissionID {
+			gameState.castPermissions = append(gameState.castPermissions[:i], gameState.castPermissions[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("cast permission %s not found", permissionID)
+}
+
+// applicableCastPermissions returns the permissions covering a spell cast by a player
+func (s *engineGameState) applicableCastPermissions(playerID string, card *internalCard) []*castPermission {
+	result := make([]*castPermission, 0)
+	for _, permission := range s.castPermissions {
+		if permission.PlayerID != "" && permission.PlayerID != playerID {
+			continue
+		}
+		if permission.Filter != nil && !permission.Filter(card) {
+			continue
+		}
+		result = append(result, permission)
+	}
+	return result
+}
+
+// checkCastTiming checks whether a player may cast a spell right now.
+// Per rule 307.1 / 117.1a: spells without flash can only be cast by the active player during a
+// main phase while the stack is empty; instants and spells with flash can be cast any time the
+// player has priority. Cast permissions can grant flash or restrict casting to a condition.
+func (e *MageEngine) checkCastTiming(gameState *engineGameState, playerID string, card *internalCard) error {
+	instantSpeed := strings.Contains(strings.ToLower(card.Type), "instant") || e.hasAbility(card, abilityFlash)
+
+	for _, permission := range gameState.applicableCastPermissions(playerID, card) {
+		holds := permission.Condition == nil || permission.Condition(gameState, playerID)
+		if permission.Required && !holds {
+			return fmt.Errorf("%s can't be cast now (%s)", card.Name, permission.Description)
+		}
+		if permission.AsThoughFlash && holds {
+			instantSpeed = true
+		}
+	}
+
+	if instantSpeed {
+		return nil
+	}
+
+	if !sorceryTiming(gameState, playerID) {
+		return fmt.Errorf("%s can only be cast during your main phase while the stack is empty", card.Name)
+	}
+	return nil
+}
+
+// sorceryTiming reports whether a player could cast a sorcery now: during their main phase
+// while the stack is empty (rule 307.1, also used for loyalty abilities per rule 606.3)
+func sorceryTiming(gameState *engineGameState, playerID string) bool {
+	step := gameState.turnManager.CurrentStep()
+	return gameState.turnManager.ActivePlayer() == playerID &&
+		(step == rules.StepMain1 || step == rules.StepMain2) &&
+		gameState.stack.IsEmpty()
+}
@@ -0,0 +1,110 @@
+package game
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/mana"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// castTestSetup puts a card costing {R} in a player's hand, adds {R} to their mana pool and gives that
+// player priority in the given step of Alice's turn
+func castTestSetup(t *testing.T, h *CombatTestHarness, playerID, cardID, name, cardType string, step rules.Step) {
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+
+	for i := 0; gameState.turnManager.CurrentStep() != step; i++ {
+		if i > 20 {
+			t.Fatalf("could not reach step %s", step)
+		}
+		gameState.turnManager.AdvanceStep("Alice")
+	}
+	if gameState.turnManager.ActivePlayer() != "Alice" {
+		t.Fatalf("expected Alice to be the active player, got %s", gameState.turnManager.ActivePlayer())
+	}
+
+	card := h.engine.createStarterCard(cardID, playerID, name)
+	card.Type = cardType
+	card.Zone = zoneHand
+	gameState.cards[card.ID] = card
+	gameState.players[playerID].Hand = append(gameState.players[playerID].Hand, card)
+	gameState.players[playerID].ManaPool.Add(mana.ManaRed, 1)
+	gameState.turnManager.SetPriority(playerID)
+}
+
+func cast(h *CombatTestHarness, playerID, name string) error {
+	return h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: playerID, ActionType: "SEND_STRING", Data: name})
+}
+
+// TestCastPermission_YourTurnOnly verifies that a "cast only during your turn" permission blocks
+// casting on an opponent's turn
+func TestCastPermission_YourTurnOnly(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-cast-your-turn-only", []string{"Alice", "Bob"})
+	castTestSetup(t, h, "Bob", "bob-spell", "Fact or Fiction", "Instant", rules.StepMain1)
+
+	if err := h.engine.addCastPermission(h.gameID, &castPermission{
+		PlayerID:    "Bob",
+		Description: "cast only during your turn",
+		Filter:      func(card *internalCard) bool { return card.ID == "bob-spell" },
+		Condition:   duringYourTurn,
+		Required:    true,
+	}); err != nil {
+		t.Fatalf("failed to add cast permission: %v", err)
+	}
+
+	err := cast(h, "Bob", "Fact or Fiction")
+	if err == nil {
+		t.Fatal("expected casting on the opponent's turn to be rejected")
+	}
+	if !strings.Contains(err.Error(), "cast only during your turn") {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	gameState := h.GetGameState()
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if gameState.cards["bob-spell"].Zone != zoneHand {
+		t.Errorf("expected spell to stay in hand, got zone %d", gameState.cards["bob-spell"].Zone)
+	}
+}
+
+// TestCastPermission_CreaturesHaveFlash verifies that "creature spells have flash" allows a creature
+// at instant speed but not a sorcery
+func TestCastPermission_CreaturesHaveFlash(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-cast-creatures-flash", []string{"Alice", "Bob"})
+	castTestSetup(t, h, "Bob", "bob-creature", "Grizzly Bears", "Creature", rules.StepMain1)
+	castTestSetup(t, h, "Bob", "bob-sorcery", "Divination", "Sorcery", rules.StepMain1)
+
+	// Without the permission, neither can be cast on Alice's turn
+	if err := cast(h, "Bob", "Grizzly Bears"); err == nil {
+		t.Fatal("expected creature to require sorcery timing without flash")
+	}
+
+	if err := h.engine.addCastPermission(h.gameID, &castPermission{
+		PlayerID:      "Bob",
+		Description:   "creature spells have flash",
+		Filter:        func(card *internalCard) bool { return strings.Contains(card.Type, "Creature") },
+		AsThoughFlash: true,
+	}); err != nil {
+		t.Fatalf("failed to add cast permission: %v", err)
+	}
+
+	if err := cast(h, "Bob", "Divination"); err == nil {
+		t.Error("expected sorcery to still require sorcery timing")
+	}
+	if err := cast(h, "Bob", "Grizzly Bears"); err != nil {
+		t.Fatalf("expected creature to be castable at instant speed: %v", err)
+	}
+
+	gameState := h.GetGameState()
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if gameState.cards["bob-creature"].Zone != zoneStack {
+		t.Errorf("expected creature on the stack, got zone %d", gameState.cards["bob-creature"].Zone)
+	}
+	if gameState.cards["bob-sorcery"].Zone != zoneHand {
+		t.Errorf("expected sorcery to stay in hand, got zone %d", gameState.cards["bob-sorcery"].Zone)
+	}
+}
@@ -0,0 +1,25 @@
+package game
+
+import (
+	"github.com/magefree/mage-server-go/internal/game/effects"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// handleCleanupStepBegin performs the cleanup step's turn-based actions as it begins: marked damage
+// is removed from permanents and "until end of turn" effects (including granted abilities) end,
+// simultaneously, before P/T is recomputed (rule 514.2)
+// Per Java CleanupStep.beginStep() and ContinuousEffects.removeEndOfTurnEffects()
+func (e *MageEngine) handleCleanupStepBegin(gameState *engineGameState, step rules.Step, activePlayerID string) {
+	if step != rules.StepCleanup {
+		return
+	}
+
+	e.clearMarkedDamage(gameState)
+	e.clearRegenerationShields(gameState)
+	if gameState.layerSystem != nil {
+		effects.CleanupEndOfTurnEffects(gameState.layerSystem)
+		e.recomputeContinuousEffects(gameState)
+	}
+
+	gameState.eventBus.Publish(rules.NewEvent(rules.EventCleanupStep, "", "", activePlayerID))
+}
@@ -0,0 +1,71 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/effects"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestCleanupStep_EndsUntilEndOfTurnEffects verifies that flying granted until end of turn lasts only
+// until the cleanup step, where marked damage is also removed, so on the next turn the creature can be
+// blocked by ground creatures again
+func TestCleanupStep_EndsUntilEndOfTurnEffects(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-cleanup-step", []string{"Alice", "Bob"})
+	bird := h.CreateCreature(CreatureSpec{ID: "bird", Name: "Storm Crow", Power: "1", Toughness: "2", Controller: "Alice"})
+	bears := h.CreateCreature(CreatureSpec{ID: "bears", Name: "Grizzly Bears", Power: "2", Toughness: "2", Controller: "Bob"})
+	gameState := h.GetGameState()
+
+	cleanups := 0
+	gameState.eventBus.SubscribeTyped(rules.EventCleanupStep, func(rules.Event) {
+		cleanups++
+	})
+	canBlock := func() bool {
+		h.SetupCombat("Alice")
+		h.DeclareAttacker(bird, "Bob", "Alice")
+		gameState.mu.RLock()
+		defer gameState.mu.RUnlock()
+		ok, err := h.engine.canBlockInternal(gameState, bears, bird)
+		return ok && err == nil
+	}
+
+	if _, err := h.engine.GrantAbility(h.gameID, bird, abilityFlying, effects.DurationUntilEndOfTurn); err != nil {
+		t.Fatalf("failed to grant flying: %v", err)
+	}
+	if canBlock() {
+		t.Fatal("expected Grizzly Bears to be unable to block Storm Crow with flying")
+	}
+	if err := h.engine.ResetCombat(h.gameID); err != nil {
+		t.Fatalf("failed to reset combat: %v", err)
+	}
+
+	gameState.mu.Lock()
+	gameState.cards[bears].Damage = 1
+	gameState.cards[bird].untap()
+	gameState.mu.Unlock()
+
+	for i := 0; i < 50; i++ {
+		gameState.mu.RLock()
+		turn, priority := gameState.turnManager.TurnNumber(), gameState.turnManager.PriorityPlayer()
+		gameState.mu.RUnlock()
+		if turn > 1 {
+			break
+		}
+		if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: priority, ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+			t.Fatalf("failed to pass priority: %v", err)
+		}
+	}
+
+	gameState.mu.RLock()
+	turn, damage := gameState.turnManager.TurnNumber(), gameState.cards[bears].Damage
+	gameState.mu.RUnlock()
+	if turn != 2 || cleanups != 1 {
+		t.Fatalf("expected to reach turn 2 through one cleanup step, got turn %d with %d cleanups", turn, cleanups)
+	}
+	if damage != 0 {
+		t.Errorf("expected marked damage to be removed in the cleanup step, got %d", damage)
+	}
+	if !canBlock() {
+		t.Error("expected Grizzly Bears to block Storm Crow once its flying ended")
+	}
+}
@@ -253,13 +253,18 @@ func TestCombatFlow_Menace(t *testing.T) {
 	// Declare one blocker - this is illegal for menace
 	h.DeclareBlocker(blocker1, attacker, "Bob")
 
-	// AcceptBlockers should succeed but automatically remove the illegal blocker
-	// Per Java implementation: menace violations result in blockers being removed, not an error
+	// AcceptBlockers rejects the illegal block so it can be redeclared
+	if err := h.engine.AcceptBlockers(h.gameID); err == nil {
+		t.Fatal("expected a single blocker on a menace attacker to be rejected")
+	}
+	if err := h.engine.RemoveBlocker(h.gameID, blocker1); err != nil {
+		t.Fatalf("failed to remove blocker: %v", err)
+	}
 	h.AcceptBlockers()
 
 	// Verify blocker was removed (no longer blocking)
 	if h.IsCreatureBlocking(blocker1) {
-		t.Error("blocker should have been removed due to menace violation")
+		t.Error("blocker should have been removed from combat")
 	}
 
 	// Complete combat
@@ -414,3 +414,129 @@ func TestOrderBlockersDamageAssignment(t *testing.T) {
 
 	engine.EndCombat(gameID)
 }
+
+// TestSetBlockerDamageOrder_LethalInOrder verifies that an attacker without trample assigns lethal damage
+// to each blocker in the chosen order before moving to the next
+func TestSetBlockerDamageOrder_LethalInOrder(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-blocker-damage-order", []string{"Alice", "Bob"})
+
+	attackerID := h.CreateAttacker("attacker", "Craw Wurm", "Alice", "5", "5")
+	bearID := h.CreateBlocker("bear", "Grizzly Bears", "Bob", "2", "2")
+	giantID := h.CreateBlocker("giant", "Hill Giant", "Bob", "3", "3")
+	squireID := h.CreateBlocker("squire", "Squire", "Bob", "1", "2")
+
+	h.SetupCombat("Alice")
+	h.DeclareAttacker(attackerID, "Bob", "Alice")
+	h.DeclareBlocker(bearID, attackerID, "Bob")
+	h.DeclareBlocker(giantID, attackerID, "Bob")
+	h.DeclareBlocker(squireID, attackerID, "Bob")
+
+	if err := h.engine.SetBlockerDamageOrder(h.gameID, attackerID, []string{giantID, bearID}); err == nil {
+		t.Error("expected an order missing a blocker to be rejected")
+	}
+	if err := h.engine.SetBlockerDamageOrder(h.gameID, attackerID, []string{giantID, giantID, bearID}); err == nil {
+		t.Error("expected an order repeating a blocker to be rejected")
+	}
+	if err := h.engine.SetBlockerDamageOrder(h.gameID, attackerID, []string{giantID, bearID, squireID}); err != nil {
+		t.Fatalf("failed to set blocker damage order: %v", err)
+	}
+
+	h.AcceptBlockers()
+	h.AssignDamage(false)
+	h.ApplyDamage()
+
+	// 5 damage: 3 to the giant, 2 to the bear, none left for the squire
+	h.AssertCreatureDead(giantID)
+	h.AssertCreatureDead(bearID)
+	h.AssertCreatureDamage(squireID, 0)
+	h.AssertPlayerLife("Bob", 20)
+}
+
+// TestSetBlockerDamageOrder_SkipsBlockerKilledByFirstStrike verifies that a blocker killed in the first
+// strike damage step is skipped, and with trample its share of the damage goes through
+func TestSetBlockerDamageOrder_SkipsBlockerKilledByFirstStrike(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-blocker-damage-order-first-strike", []string{"Alice", "Bob"})
+
+	attackerID := h.CreateCreature(CreatureSpec{
+		ID:         "attacker",
+		Name:       "Double Striker",
+		Power:      "3",
+		Toughness:  "3",
+		Controller: "Alice",
+		Abilities:  []string{abilityDoubleStrike, abilityTrample},
+	})
+	bearID := h.CreateBlocker("bear", "Grizzly Bears", "Bob", "2", "2")
+	giantID := h.CreateBlocker("giant", "Hill Giant", "Bob", "3", "3")
+
+	h.SetupCombat("Alice")
+	h.DeclareAttacker(attackerID, "Bob", "Alice")
+	h.DeclareBlocker(giantID, attackerID, "Bob")
+	h.DeclareBlocker(bearID, attackerID, "Bob")
+	if err := h.engine.SetBlockerDamageOrder(h.gameID, attackerID, []string{bearID, giantID}); err != nil {
+		t.Fatalf("failed to set blocker damage order: %v", err)
+	}
+	h.AcceptBlockers()
+
+	// First strike: 2 to the bear (lethal), 1 to the giant
+	h.AssignDamage(true)
+	h.ApplyDamage()
+	h.AssertCreatureDead(bearID)
+	h.AssertCreatureDamage(giantID, 1)
+	h.AssertPlayerLife("Bob", 20)
+
+	// Regular damage: the dead bear is skipped, 2 to the giant (lethal), 1 tramples over
+	h.AssignDamage(false)
+	h.ApplyDamage()
+	h.AssertCreatureDead(giantID)
+	h.AssertPlayerLife("Bob", 19)
+}
+
+// TestSetAttackerDamageOrder_BlockerAndBandDealDamage verifies that a blocker blocking several attackers
+// assigns lethal damage to each in the order the defending player chose, and that every creature of a
+// band deals its damage to the blocker
+func TestSetAttackerDamageOrder_BlockerAndBandDealDamage(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-attacker-damage-order", []string{"Alice", "Bob"})
+
+	bear1 := h.CreateAttacker("bear1", "Grizzly Bears", "Alice", "2", "2")
+	bear2 := h.CreateAttacker("bear2", "Grizzly Bears", "Alice", "2", "2")
+	knight := h.CreateAttacker("knight", "Knight Errant", "Alice", "3", "3")
+	giant := h.CreateBlocker("giant", "Giant Spider", "Bob", "5", "8")
+
+	h.SetupCombat("Alice")
+	h.DeclareAttacker(bear1, "Bob", "Alice")
+	h.DeclareAttacker(bear2, "Bob", "Alice")
+	h.DeclareAttacker(knight, "Bob", "Alice")
+
+	// The bears attack as a band: one combat group with both of them
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	groups := gameState.combat.groups
+	groups[0].attackers = append(groups[0].attackers, bear2)
+	gameState.combat.groups = append(groups[:1], groups[2:]...)
+	gameState.mu.Unlock()
+
+	h.DeclareBlocker(giant, bear1, "Bob")
+	h.DeclareBlocker(giant, knight, "Bob")
+
+	if err := h.engine.SetAttackerDamageOrder(h.gameID, giant, []string{knight, bear1}); err == nil {
+		t.Error("expected an order missing an attacker to be rejected")
+	}
+	if err := h.engine.SetAttackerDamageOrder(h.gameID, bear1, []string{knight}); err == nil {
+		t.Error("expected ordering for a creature that isn't blocking to be rejected")
+	}
+	if err := h.engine.SetAttackerDamageOrder(h.gameID, giant, []string{knight, bear2, bear1}); err != nil {
+		t.Fatalf("failed to set attacker damage order: %v", err)
+	}
+
+	h.AcceptBlockers()
+	h.AssignDamage(false)
+	h.ApplyDamage()
+
+	// 5 damage: 3 to the knight, 2 to the second bear, none left for the first bear
+	h.AssertCreatureDead(knight)
+	h.AssertCreatureDead(bear2)
+	h.AssertCreatureDamage(bear1, 0)
+	// Both bears of the band and the knight deal damage to the spider
+	h.AssertCreatureDamage(giant, 7)
+	h.AssertPlayerLife("Bob", 20)
+}
@@ -388,7 +388,7 @@ func TestCombatDeathTriggerMultiple(t *testing.T) {
 				creatureID = event.SourceID
 			}
 			return &triggeredAbilityQueueItem{
-				ID:          fmt.Sprintf("%s-death-trigger-%d", observerID, triggerCount),
+				ID:          fmt.Sprintf("%s-death-trigger-%s", observerID, creatureID),
 				SourceID:    observerID,
 				Controller:  "Alice",
 				Description: "Whenever a creature dies, put a +1/+1 counter on Death Counter",
@@ -435,9 +435,14 @@ func TestCombatDeathTriggerMultiple(t *testing.T) {
 
 	t.Logf("Dead creatures: %d", deadCount)
 
-	// Process and resolve triggers
+	// Process and resolve triggers; Alice puts her simultaneous triggers on the stack in queue order
 	gameState.mu.Lock()
 	engine.processTriggeredAbilities(gameState)
+	if decision, pending := gameState.decisions[gameState.triggerDecisionID]; pending {
+		if err := engine.respondToDecision(gameState, "Alice", decision.ID, Response{Choices: decision.Choices}); err != nil {
+			t.Fatalf("failed to order triggers: %v", err)
+		}
+	}
 	for !gameState.stack.IsEmpty() {
 		item, err := gameState.stack.Pop()
 		if err != nil {
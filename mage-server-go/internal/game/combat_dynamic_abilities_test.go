@@ -337,3 +337,52 @@ func TestCombatDynamicAbilityCleanup(t *testing.T) {
 		t.Error("Creature should not have flying after combat ends")
 	}
 }
+
+// TestCombatGrantAbility_ReachUntilEndOfTurn verifies that an ability granted with GrantAbility counts
+// for block legality and expires at the cleanup matching its duration
+func TestCombatGrantAbility_ReachUntilEndOfTurn(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-grant-ability", []string{"Alice", "Bob"})
+	bird := h.CreateCreature(CreatureSpec{ID: "bird", Name: "Suntail Hawk", Power: "1", Toughness: "1", Controller: "Alice", Abilities: []string{abilityFlying}})
+	bears := h.CreateCreature(CreatureSpec{ID: "bears", Name: "Grizzly Bears", Power: "2", Toughness: "2", Controller: "Bob"})
+	gameState := h.GetGameState()
+	h.SetupCombat("Alice")
+	h.DeclareAttacker(bird, "Bob", "Alice")
+
+	canBlock := func() bool {
+		gameState.mu.RLock()
+		defer gameState.mu.RUnlock()
+		ok, err := h.engine.canBlockInternal(gameState, bears, bird)
+		return ok && err == nil
+	}
+	if canBlock() {
+		t.Fatal("expected Grizzly Bears to be unable to block a flyer")
+	}
+
+	if _, err := h.engine.GrantAbility(h.gameID, bears, abilityReach, effects.Duration("Sometimes")); err == nil {
+		t.Error("expected an unsupported duration to be rejected")
+	}
+	if _, err := h.engine.GrantAbility(h.gameID, bears, abilityReach, effects.DurationUntilEndOfTurn); err != nil {
+		t.Fatalf("failed to grant reach: %v", err)
+	}
+	if _, err := h.engine.GrantAbility(h.gameID, bird, abilityFirstStrike, effects.DurationEndOfCombat); err != nil {
+		t.Fatalf("failed to grant first strike: %v", err)
+	}
+	if !canBlock() {
+		t.Fatal("expected Grizzly Bears with granted reach to block a flyer")
+	}
+
+	gameState.mu.Lock()
+	if !h.engine.hasFirstStrike(gameState, gameState.cards[bird]) {
+		t.Error("expected the granted first strike to count in combat")
+	}
+	effects.CleanupEndOfCombatEffects(gameState.layerSystem)
+	if h.engine.hasFirstStrike(gameState, gameState.cards[bird]) {
+		t.Error("expected first strike granted until end of combat to expire")
+	}
+	effects.CleanupEndOfTurnEffects(gameState.layerSystem)
+	gameState.mu.Unlock()
+
+	if canBlock() {
+		t.Error("expected reach granted until end of turn to expire at cleanup")
+	}
+}
@@ -432,3 +432,35 @@ func TestCombatNoFirstStrike(t *testing.T) {
 	}
 	gameState.mu.RUnlock()
 }
+
+// TestCombatResolveCombatDamage_FirstStrikerKillsBlockerFirst verifies that resolving combat damage runs the
+// first strike step before the normal one, so a first striker kills a vanilla blocker without being dealt damage
+func TestCombatResolveCombatDamage_FirstStrikerKillsBlockerFirst(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-resolve-first-strike", []string{"Alice", "Bob"})
+	attackerID := h.CreateCreature(CreatureSpec{ID: "alice-knight", Name: "White Knight", Power: "2", Toughness: "2", Controller: "Alice", Abilities: []string{abilityFirstStrike}})
+	blockerID := h.CreateCreature(CreatureSpec{ID: "bob-bear", Name: "Grizzly Bears", Power: "2", Toughness: "2", Controller: "Bob"})
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.battlefield = append(gameState.battlefield, gameState.cards[attackerID], gameState.cards[blockerID])
+	gameState.mu.Unlock()
+
+	h.SetupCombat("Alice")
+	h.DeclareAttacker(attackerID, "Bob", "Alice")
+	h.DeclareBlocker(blockerID, attackerID, "Bob")
+	h.AcceptBlockers()
+
+	if err := h.engine.resolveCombatDamage(h.gameID); err != nil {
+		t.Fatalf("failed to resolve combat damage: %v", err)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if blocker := gameState.cards[blockerID]; blocker.Zone != zoneGraveyard {
+		t.Errorf("expected the blocker to die to first strike damage, got zone %v", blocker.Zone)
+	}
+	if attacker := gameState.cards[attackerID]; attacker.Zone != zoneBattlefield || attacker.Damage != 0 {
+		t.Errorf("expected the first striker to survive undamaged, got zone %v damage %d", attacker.Zone, attacker.Damage)
+	}
+	h.AssertPlayerLife("Bob", 20)
+}
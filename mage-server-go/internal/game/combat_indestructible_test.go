@@ -0,0 +1,55 @@
+package game
+
+import (
+	"testing"
+)
+
+// TestIndestructible_SurvivesLethalDeathtouchAndDestroyButNotZeroToughness verifies that an indestructible
+// creature survives lethal and deathtouch combat damage and destroy effects, but still dies at 0 toughness
+func TestIndestructible_SurvivesLethalDeathtouchAndDestroyButNotZeroToughness(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-indestructible", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	attacker := h.CreateCreature(CreatureSpec{
+		ID:         "deathtouch-giant",
+		Name:       "Deathtouch Giant",
+		Power:      "5",
+		Toughness:  "5",
+		Controller: "Alice",
+		Abilities:  []string{abilityDeathtouch},
+	})
+	blocker := h.CreateCreature(CreatureSpec{
+		ID:         "darksteel-myr",
+		Name:       "Darksteel Myr",
+		Power:      "0",
+		Toughness:  "1",
+		Controller: "Bob",
+		Abilities:  []string{abilityIndestructible},
+	})
+
+	h.SetupCombat("Alice")
+	h.DeclareAttacker(attacker, "Bob", "Alice")
+	h.DeclareBlocker(blocker, attacker, "Bob")
+	h.AcceptBlockers()
+	h.AssignDamage(false)
+	h.ApplyDamage()
+
+	h.AssertCreatureAlive(blocker)
+	h.AssertCreatureDamage(blocker, 5)
+	h.EndCombat()
+
+	if err := h.engine.DestroyPermanent(h.gameID, blocker, "wrath"); err != nil {
+		t.Fatalf("failed to destroy: %v", err)
+	}
+	h.AssertCreatureAlive(blocker)
+
+	// 704.5f still applies: an indestructible creature with 0 toughness is put into the graveyard
+	gameState.mu.Lock()
+	myr := gameState.cards[blocker]
+	gameState.battlefield = append(gameState.battlefield, myr)
+	myr.Toughness = "0"
+	h.engine.checkStateBasedActions(gameState)
+	gameState.mu.Unlock()
+
+	h.AssertCreatureDead(blocker)
+}
@@ -0,0 +1,57 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/counters"
+)
+
+// TestCombatInfect_PoisonsPlayerAndWeakensCreatures tests that an infect creature gives an unblocked
+// defending player poison counters instead of life loss, puts -1/-1 counters on its blockers, and that
+// 10 poison counters make a player lose
+func TestCombatInfect_PoisonsPlayerAndWeakensCreatures(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-infect", []string{"Alice", "Bob"})
+	infect := func(id string) string {
+		return h.CreateCreature(CreatureSpec{ID: id, Name: "Plague Stinger", Power: "3", Toughness: "3", Controller: "Alice", Abilities: []string{abilityInfect}})
+	}
+	stinger := infect("stinger")
+
+	h.SetupCombat("Alice")
+	h.DeclareAttacker(stinger, "Bob", "Alice")
+	h.AcceptBlockers()
+	h.AssignDamage(false)
+	h.ApplyDamage()
+
+	h.AssertPlayerLife("Bob", 20)
+	gameState := h.GetGameState()
+	gameState.mu.RLock()
+	poison := gameState.players["Bob"].Poison
+	gameState.mu.RUnlock()
+	if poison != 3 {
+		t.Fatalf("expected Bob to have 3 poison counters, got %d", poison)
+	}
+	h.EndCombat()
+
+	blight := infect("blight")
+	ogre := h.CreateBlocker("ogre", "Gray Ogre", "Bob", "2", "4")
+	h.SetupCombat("Alice")
+	h.DeclareAttacker(blight, "Bob", "Alice")
+	h.DeclareBlocker(ogre, blight, "Bob")
+	h.AcceptBlockers()
+	h.AssignDamage(false)
+	h.ApplyDamage()
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	blocker := gameState.cards[ogre]
+	if blocker.Damage != 0 || blocker.Counters.GetCount(string(counters.CounterTypeM1M1)) != 3 {
+		t.Errorf("expected the blocker to get three -1/-1 counters and no damage, got %d counters and %d damage",
+			blocker.Counters.GetCount(string(counters.CounterTypeM1M1)), blocker.Damage)
+	}
+
+	gameState.players["Bob"].Poison = 10
+	h.engine.checkStateBasedActions(gameState)
+	if !gameState.players["Bob"].Lost {
+		t.Error("expected Bob to lose with 10 poison counters")
+	}
+}
@@ -3,6 +3,7 @@ package game
 import (
 	"testing"
 
+	"github.com/magefree/mage-server-go/internal/game/rules"
 	"go.uber.org/zap/zaptest"
 )
 
@@ -401,3 +402,77 @@ func TestLifelinkMultipleBlockers(t *testing.T) {
 
 	engine.EndCombat(gameID)
 }
+
+// TestLifelinkSplitDamageGainsTotalOnce verifies that a lifelink attacker splitting its damage between
+// blockers and trampling over gains the full total as a single life gain
+func TestLifelinkSplitDamageGainsTotalOnce(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-lifelink-split", []string{"Alice", "Bob"})
+
+	attacker := h.CreateCreature(CreatureSpec{
+		ID:         "lifelink-trampler",
+		Name:       "Lifelink Trampler",
+		Power:      "8",
+		Toughness:  "8",
+		Controller: "Alice",
+		Abilities:  []string{abilityLifelink, abilityTrample},
+	})
+	blocker1 := h.CreateBlocker("blocker1", "Grizzly Bears", "Bob", "2", "2")
+	blocker2 := h.CreateBlocker("blocker2", "Runeclaw Bear", "Bob", "2", "2")
+
+	gains := make([]rules.Event, 0)
+	h.GetGameState().eventBus.SubscribeTyped(rules.EventGainedLife, func(evt rules.Event) {
+		gains = append(gains, evt)
+	})
+
+	h.SetupCombat("Alice")
+	h.DeclareAttacker(attacker, "Bob", "Alice")
+	h.DeclareBlocker(blocker1, attacker, "Bob")
+	h.DeclareBlocker(blocker2, attacker, "Bob")
+	h.AcceptBlockers()
+	h.AssignDamage(false)
+	h.ApplyDamage()
+
+	// 2 + 2 to the blockers, 4 tramples over to Bob
+	h.AssertPlayerLife("Bob", 16)
+	h.AssertPlayerLife("Alice", 28)
+
+	if len(gains) != 1 {
+		t.Fatalf("expected a single life gain for the combat damage, got %d", len(gains))
+	}
+	if gains[0].PlayerID != "Alice" || gains[0].SourceID != attacker || gains[0].Amount != 8 {
+		t.Errorf("unexpected life gain event: %+v", gains[0])
+	}
+}
+
+// TestLifelinkNonCombatDamage verifies that non-combat damage from a lifelink source gains life
+func TestLifelinkNonCombatDamage(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-lifelink-noncombat", []string{"Alice", "Bob"})
+	h.engine.SetDebugOperationsEnabled(true)
+
+	source := h.CreateCreature(CreatureSpec{
+		ID:         "lifelink-pinger",
+		Name:       "Lifelink Pinger",
+		Power:      "1",
+		Toughness:  "1",
+		Controller: "Alice",
+		Abilities:  []string{abilityLifelink},
+	})
+	target := h.CreateBlocker("target", "Wall of Wood", "Bob", "0", "4")
+
+	gains := make([]rules.Event, 0)
+	h.GetGameState().eventBus.SubscribeTyped(rules.EventGainedLife, func(evt rules.Event) {
+		gains = append(gains, evt)
+	})
+
+	if err := h.engine.DealDamage(h.gameID, source, "Bob", 3, false); err != nil {
+		t.Fatalf("failed to deal damage: %v", err)
+	}
+	if err := h.engine.DealDamage(h.gameID, source, target, 2, false); err != nil {
+		t.Fatalf("failed to deal damage: %v", err)
+	}
+
+	h.AssertPlayerLife("Alice", 25)
+	if len(gains) != 2 || gains[0].Amount != 3 || gains[1].Amount != 2 {
+		t.Errorf("expected life gains of 3 and 2, got %+v", gains)
+	}
+}
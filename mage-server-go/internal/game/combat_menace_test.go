@@ -1,6 +1,7 @@
 package game
 
 import (
+	"strings"
 	"testing"
 
 	"go.uber.org/zap/zaptest"
@@ -62,32 +63,38 @@ func TestMenaceBlockedByOne(t *testing.T) {
 	// Try to block with single creature
 	engine.DeclareBlocker(gameID, blockerID, attackerID, "Bob")
 
-	// Accept blockers - should remove the single blocker due to menace
-	engine.AcceptBlockers(gameID)
-
-	// Verify blocker was removed
-	gameState.mu.RLock()
-	blocker, exists := gameState.cards[blockerID]
-	if !exists {
-		t.Fatal("Blocker card not found")
+	// Accept blockers - the block is illegal and must be redeclared
+	err := engine.AcceptBlockers(gameID)
+	if err == nil {
+		t.Fatal("Expected AcceptBlockers to reject a single blocker on a menace attacker")
+	}
+	if !strings.Contains(err.Error(), "Menace Creature") || !strings.Contains(err.Error(), "2 or more") {
+		t.Errorf("Expected the error to describe the illegal block, got %v", err)
 	}
 
-	if blocker.Blocking {
-		t.Error("Expected blocker to be removed due to menace")
+	if minBlockers, err := engine.CanBeBlockedBy(gameID, attackerID); err != nil || minBlockers != 2 {
+		t.Errorf("Expected a menace attacker to require 2 blockers, got %d (%v)", minBlockers, err)
 	}
 
-	// Verify combat group shows no blockers
-	if len(gameState.combat.groups) != 1 {
-		t.Fatalf("Expected 1 combat group, got %d", len(gameState.combat.groups))
+	// Verify nothing was accepted, so the blocker can be redeclared
+	gameState.mu.RLock()
+	if !gameState.cards[blockerID].Blocking {
+		t.Error("Expected the declared block to stay in place")
 	}
+	gameState.mu.RUnlock()
 
-	group := gameState.combat.groups[0]
-	if len(group.blockers) != 0 {
-		t.Errorf("Expected 0 blockers in group, got %d", len(group.blockers))
+	// Redeclare without the illegal block
+	if err := engine.RemoveBlocker(gameID, blockerID); err != nil {
+		t.Fatalf("Failed to remove blocker: %v", err)
+	}
+	if err := engine.AcceptBlockers(gameID); err != nil {
+		t.Fatalf("Expected an unblocked menace attacker to be accepted: %v", err)
 	}
 
-	if group.blocked {
-		t.Error("Expected group to be unblocked")
+	gameState.mu.RLock()
+	group := gameState.combat.groups[0]
+	if len(group.blockers) != 0 || group.blocked {
+		t.Errorf("Expected the menace attacker to be unblocked, got %d blockers", len(group.blockers))
 	}
 	gameState.mu.RUnlock()
 
@@ -251,3 +251,45 @@ func TestMustAttackIfAble_Integration(t *testing.T) {
 	assert.Equal(t, 3, gameState.cards[blockerID].Damage, "blocker should take 3 damage")
 	assert.Equal(t, 2, gameState.cards[attackerID].Damage, "attacker should take 2 damage")
 }
+
+// TestCombatRestrictions_RequirementsAreEnforced verifies that restrictions added with AddCombatRestriction
+// keep creatures from attacking or blocking, and that requirements added with AddCombatRequirement are
+// checked when attackers and blockers are finished
+func TestCombatRestrictions_RequirementsAreEnforced(t *testing.T) {
+	h := NewCombatTestHarness(t, "game-combat-restrictions", []string{"Alice", "Bob"})
+
+	berserker := h.CreateAttacker("alice-berserker", "Raging Berserker", "Alice", "3", "2")
+	pacifist := h.CreateAttacker("alice-pacifist", "Pacified Bear", "Alice", "2", "2")
+	guard := h.CreateBlocker("bob-guard", "Loyal Guard", "Bob", "2", "3")
+	coward := h.CreateBlocker("bob-coward", "Craven Goblin", "Bob", "1", "1")
+
+	_, err := h.engine.AddCombatRequirement(h.gameID, berserker, CombatRequirementMustAttack, "", effects.DurationEndOfTurn)
+	require.NoError(t, err)
+	_, err = h.engine.AddCombatRestriction(h.gameID, pacifist, CombatRestrictionCantAttack, effects.DurationEndOfTurn)
+	require.NoError(t, err)
+	_, err = h.engine.AddCombatRequirement(h.gameID, guard, CombatRequirementMustBlock, berserker, effects.DurationEndOfTurn)
+	require.NoError(t, err)
+	_, err = h.engine.AddCombatRestriction(h.gameID, coward, CombatRestrictionCantBlock, effects.DurationEndOfTurn)
+	require.NoError(t, err)
+	_, err = h.engine.AddCombatRestriction(h.gameID, coward, CombatRestrictionCantBlock, effects.DurationWhileControlled)
+	assert.Error(t, err, "only until end of turn, end of combat and permanent durations are supported")
+
+	restrictions, err := h.engine.GetCombatRestrictions(h.gameID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{pacifist}, restrictions.CantAttack)
+	assert.Equal(t, []string{coward}, restrictions.CantBlock)
+	assert.Equal(t, []string{berserker}, restrictions.MustAttack)
+	assert.Equal(t, map[string][]string{berserker: {guard}}, restrictions.MustBlock)
+
+	require.NoError(t, h.engine.SetAttacker(h.gameID, "Alice"))
+	require.NoError(t, h.engine.SetDefenders(h.gameID))
+	assert.Error(t, h.engine.DeclareAttacker(h.gameID, pacifist, "Bob", "Alice"), "a creature that can't attack can't be declared")
+	assert.ErrorContains(t, h.engine.FinishDeclaringAttackers(h.gameID), "Raging Berserker attacks each combat if able")
+	require.NoError(t, h.engine.DeclareAttacker(h.gameID, berserker, "Bob", "Alice"))
+	require.NoError(t, h.engine.FinishDeclaringAttackers(h.gameID))
+
+	assert.Error(t, h.engine.DeclareBlocker(h.gameID, coward, berserker, "Bob"), "a creature that can't block can't be declared")
+	assert.ErrorContains(t, h.engine.AcceptBlockers(h.gameID), "Loyal Guard must block Raging Berserker if able")
+	require.NoError(t, h.engine.DeclareBlocker(h.gameID, guard, berserker, "Bob"))
+	require.NoError(t, h.engine.AcceptBlockers(h.gameID))
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/magefree/mage-server-go/internal/game/counters"
+	"github.com/magefree/mage-server-go/internal/game/rules"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -670,3 +671,198 @@ func TestPlaneswalkerCombat_TrampleOverWithDeathtouch(t *testing.T) {
 	// Verify Bob took 2 excess damage (3 power - 1 lethal = 2 trample over)
 	assert.Equal(t, 18, gameState.players["Bob"].Life, "Bob should have 18 life (20-2 from trample over)")
 }
+
+// setupBlockedTramplerAttackingPlaneswalker has a 6/6 trampler attack Bob's 3-loyalty planeswalker and get
+// blocked by a 2/2, then deals combat damage
+func setupBlockedTramplerAttackingPlaneswalker(t *testing.T, gameID string, abilities []string) (*CombatTestHarness, *internalCard) {
+	h := NewCombatTestHarness(t, gameID, []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	planeswalkerID := "nissa"
+	gameState.mu.Lock()
+	planeswalker := &internalCard{
+		ID:           planeswalkerID,
+		Name:         "Nissa, Who Shakes the World",
+		Type:         "Planeswalker",
+		Zone:         zoneBattlefield,
+		OwnerID:      "Bob",
+		ControllerID: "Bob",
+		Loyalty:      "3",
+		Counters:     counters.NewCounters(),
+	}
+	planeswalker.Counters.AddCounter(counters.NewCounter("loyalty", 3))
+	gameState.cards[planeswalkerID] = planeswalker
+	gameState.players["Bob"].Life = 20
+	gameState.mu.Unlock()
+
+	attackerID := h.CreateCreature(CreatureSpec{
+		ID:         "attacker",
+		Name:       "Trampler",
+		Power:      "6",
+		Toughness:  "6",
+		Controller: "Alice",
+		Abilities:  abilities,
+	})
+	blockerID := h.CreateBlocker("blocker", "Chump Blocker", "Bob", "2", "2")
+
+	require.NoError(t, h.engine.SetAttacker(h.gameID, "Alice"))
+	require.NoError(t, h.engine.SetDefenders(h.gameID))
+	require.NoError(t, h.engine.DeclareAttacker(h.gameID, attackerID, planeswalkerID, "Alice"))
+	require.NoError(t, h.engine.DeclareBlocker(h.gameID, blockerID, attackerID, "Bob"))
+	require.NoError(t, h.engine.AssignCombatDamage(h.gameID, false))
+	require.NoError(t, h.engine.ApplyCombatDamage(h.gameID))
+
+	assert.Equal(t, 2, gameState.cards[blockerID].Damage, "blocker should be assigned lethal damage")
+	return h, planeswalker
+}
+
+// TestPlaneswalkerCombat_BlockedTramplerExcessStaysOnPlaneswalker tests that damage trampling over a blocker
+// is all dealt to the planeswalker, and the excess beyond its loyalty is wasted
+func TestPlaneswalkerCombat_BlockedTramplerExcessStaysOnPlaneswalker(t *testing.T) {
+	h, planeswalker := setupBlockedTramplerAttackingPlaneswalker(t, "game-13", []string{abilityTrample})
+
+	assert.Equal(t, 0, planeswalker.Counters.GetCount("loyalty"), "planeswalker should be dealt lethal damage")
+	assert.Equal(t, 20, h.GetGameState().players["Bob"].Life, "excess damage shouldn't reach Bob without trample over planeswalkers")
+}
+
+// TestPlaneswalkerCombat_BlockedTrampleOverSpillsToController tests that with trample over planeswalkers,
+// damage beyond lethal to the blocker and the planeswalker is dealt to the planeswalker's controller
+func TestPlaneswalkerCombat_BlockedTrampleOverSpillsToController(t *testing.T) {
+	h, planeswalker := setupBlockedTramplerAttackingPlaneswalker(t, "game-14", []string{abilityTrample, abilityTrampleOverPlaneswalkers})
+
+	assert.Equal(t, 0, planeswalker.Counters.GetCount("loyalty"), "planeswalker should be dealt lethal damage")
+	assert.Equal(t, 19, h.GetGameState().players["Bob"].Life, "Bob should be dealt the 1 excess damage (6 - 2 - 3)")
+}
+
+// TestPlaneswalkerCombat_TrampleOverPlaneswalkerLeftBattlefield tests that an unblocked attacker deals no
+// damage once the planeswalker it's attacking has left the battlefield, even with trample over planeswalkers
+func TestPlaneswalkerCombat_TrampleOverPlaneswalkerLeftBattlefield(t *testing.T) {
+	h := NewCombatTestHarness(t, "game-15", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	planeswalkerID := "vraska"
+	gameState.mu.Lock()
+	planeswalker := &internalCard{
+		ID:           planeswalkerID,
+		Name:         "Vraska, Golgari Queen",
+		Type:         "Planeswalker",
+		Zone:         zoneBattlefield,
+		OwnerID:      "Bob",
+		ControllerID: "Bob",
+		Loyalty:      "4",
+		Counters:     counters.NewCounters(),
+	}
+	planeswalker.Counters.AddCounter(counters.NewCounter("loyalty", 4))
+	gameState.cards[planeswalkerID] = planeswalker
+	gameState.players["Bob"].Life = 20
+	gameState.mu.Unlock()
+
+	attackerID := h.CreateCreature(CreatureSpec{
+		ID:         "attacker",
+		Name:       "Thrasta, Tempest's Roar",
+		Power:      "7",
+		Toughness:  "7",
+		Controller: "Alice",
+		Abilities:  []string{abilityTrample, abilityTrampleOverPlaneswalkers},
+	})
+
+	require.NoError(t, h.engine.SetAttacker(h.gameID, "Alice"))
+	require.NoError(t, h.engine.SetDefenders(h.gameID))
+	require.NoError(t, h.engine.DeclareAttacker(h.gameID, attackerID, planeswalkerID, "Alice"))
+
+	// The planeswalker is bounced before combat damage
+	gameState.mu.Lock()
+	planeswalker.Zone = zoneHand
+	gameState.mu.Unlock()
+
+	require.NoError(t, h.engine.AssignCombatDamage(h.gameID, false))
+	require.NoError(t, h.engine.ApplyCombatDamage(h.gameID))
+
+	assert.Equal(t, 4, planeswalker.Counters.GetCount("loyalty"), "planeswalker off the battlefield shouldn't be dealt damage")
+	assert.Equal(t, 20, gameState.players["Bob"].Life, "Bob shouldn't be dealt damage")
+}
+
+// TestPlaneswalkerCombat_BeginCombatDefenders verifies that the beginning of combat step makes opponents'
+// planeswalkers and battles attackable, that damage to them removes loyalty or defense counters without
+// touching their controller's life, and that damage to the controller leaves the planeswalker alone
+func TestPlaneswalkerCombat_BeginCombatDefenders(t *testing.T) {
+	h := NewCombatTestHarness(t, "game-begin-combat-defenders", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	newPermanent := func(id, name, cardType, counterType string, count int) *internalCard {
+		card := &internalCard{ID: id, Name: name, Type: cardType, Zone: zoneBattlefield, OwnerID: "Bob", ControllerID: "Bob", Counters: counters.NewCounters()}
+		card.Counters.AddCounter(counters.NewCounter(counterType, count))
+		gameState.cards[id] = card
+		gameState.battlefield = append(gameState.battlefield, card)
+		return card
+	}
+	planeswalker := newPermanent("bob-jace", "Jace Beleren", "Planeswalker", "loyalty", 3)
+	battle := newPermanent("bob-siege", "Invasion of Zendikar", "Battle", "defense", 3)
+	gameState.mu.Unlock()
+
+	bear := h.CreateAttacker("alice-bear", "Grizzly Bears", "Alice", "2", "2")
+	ogre := h.CreateAttacker("alice-ogre", "Gray Ogre", "Alice", "2", "2")
+	giant := h.CreateAttacker("alice-giant", "Hill Giant", "Alice", "3", "3")
+
+	gameState.mu.Lock()
+	h.engine.handleCombatStepBegin(gameState, rules.StepBeginCombat, "Alice")
+	defenders := gameState.combat.defenders
+	gameState.mu.Unlock()
+	for _, defenderID := range []string{"Bob", planeswalker.ID, battle.ID} {
+		assert.True(t, defenders[defenderID], "expected %s to be a defender", defenderID)
+	}
+	assert.False(t, defenders["Alice"], "the attacking player can't be attacked")
+
+	require.NoError(t, h.engine.DeclareAttacker(h.gameID, bear, planeswalker.ID, "Alice"))
+	require.NoError(t, h.engine.DeclareAttacker(h.gameID, ogre, "Bob", "Alice"))
+	require.NoError(t, h.engine.DeclareAttacker(h.gameID, giant, battle.ID, "Alice"))
+	require.NoError(t, h.engine.AssignCombatDamage(h.gameID, false))
+	require.NoError(t, h.engine.ApplyCombatDamage(h.gameID))
+
+	assert.Equal(t, 1, planeswalker.Counters.GetCount("loyalty"), "the planeswalker should take only the bear's damage")
+	h.AssertPlayerLife("Bob", 18)
+	assert.Equal(t, 0, battle.Counters.GetCount("defense"), "the battle should lose defense counters")
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	h.engine.checkStateBasedActions(gameState)
+	assert.Equal(t, zoneGraveyard, battle.Zone, "a battle with no defense should be put into the graveyard")
+	assert.Equal(t, zoneBattlefield, planeswalker.Zone)
+}
+
+// TestPlaneswalkerCombat_ControllerDefendsOwnPlaneswalker tests that the planeswalker's controller is the
+// defending player of an attack on it: their creatures block it, another opponent's can't
+func TestPlaneswalkerCombat_ControllerDefendsOwnPlaneswalker(t *testing.T) {
+	h := NewCombatTestHarness(t, "game-defend-own-planeswalker", []string{"Alice", "Bob", "Charlie"})
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	planeswalker := &internalCard{ID: "bob-jace", Name: "Jace Beleren", Type: "Planeswalker", Zone: zoneBattlefield, OwnerID: "Bob", ControllerID: "Bob", Counters: counters.NewCounters()}
+	planeswalker.Counters.AddCounter(counters.NewCounter("loyalty", 3))
+	gameState.cards[planeswalker.ID] = planeswalker
+	gameState.battlefield = append(gameState.battlefield, planeswalker)
+	gameState.mu.Unlock()
+
+	attackerID := h.CreateAttacker("attacker", "Hill Giant", "Alice", "3", "3")
+	bobBlocker := h.CreateBlocker("bob-wall", "Wall of Stone", "Bob", "0", "8")
+	charlieBlocker := h.CreateBlocker("charlie-bear", "Grizzly Bears", "Charlie", "2", "2")
+
+	require.NoError(t, h.engine.SetAttacker(h.gameID, "Alice"))
+	require.NoError(t, h.engine.SetDefenders(h.gameID))
+	require.NoError(t, h.engine.DeclareAttacker(h.gameID, attackerID, planeswalker.ID, "Alice"))
+
+	view, err := h.engine.GetCombatView(h.gameID)
+	require.NoError(t, err)
+	require.Len(t, view.Groups, 1)
+	assert.Equal(t, planeswalker.ID, view.Groups[0].DefenderID)
+	assert.Equal(t, "Bob", view.Groups[0].DefendingPlayerID, "the planeswalker's controller should be the defending player")
+
+	assert.Error(t, h.engine.DeclareBlocker(h.gameID, charlieBlocker, attackerID, "Charlie"), "another opponent can't block an attack on Bob's planeswalker")
+	require.NoError(t, h.engine.DeclareBlocker(h.gameID, bobBlocker, attackerID, "Bob"))
+	require.NoError(t, h.engine.AssignCombatDamage(h.gameID, false))
+	require.NoError(t, h.engine.ApplyCombatDamage(h.gameID))
+
+	assert.Equal(t, 3, planeswalker.Counters.GetCount("loyalty"), "the blocked attacker shouldn't damage the planeswalker")
+	h.AssertCreatureDamage(bobBlocker, 3)
+}
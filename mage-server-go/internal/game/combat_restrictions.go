@@ -0,0 +1,240 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/magefree/mage-server-go/internal/game/effects"
+)
+
+// CombatRestriction is a restriction on how a creature may attack or block (rule 508.1c, 509.1b)
+type CombatRestriction string
+
+const (
+	CombatRestrictionCantAttack CombatRestriction = "CANT_ATTACK" // The creature can't attack
+	CombatRestrictionCantBlock  CombatRestriction = "CANT_BLOCK"  // The creature can't block
+)
+
+// CombatRequirement is a requirement on how a creature attacks or blocks if able (rule 508.1d, 509.1c)
+type CombatRequirement string
+
+const (
+	CombatRequirementMustAttack CombatRequirement = "MUST_ATTACK" // The creature attacks each combat if able
+	CombatRequirementMustBlock  CombatRequirement = "MUST_BLOCK"  // The creature blocks a given attacker if able
+)
+
+// CombatRestrictions lists the creatures of a game under combat restrictions and requirements
+type CombatRestrictions struct {
+	CantAttack []string
+	CantBlock  []string
+	MustAttack []string
+	MustBlock  map[string][]string // Attacker ID -> creatures that must block it if able (empty = every creature able to)
+}
+
+// AddCombatRestriction keeps a creature on the battlefield from attacking or blocking for the given
+// duration: until end of turn, until end of combat, or permanently. The restriction is a continuous
+// effect and can be ended early with RemoveContinuousEffect.
+// Per Java CantAttackTargetEffect and CantBlockTargetEffect
+func (e *MageEngine) AddCombatRestriction(gameID, creatureID string, restriction CombatRestriction, duration effects.Duration) (_ string, err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return "", fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "AddCombatRestriction", creatureID, restriction, duration)
+
+	if err := checkCombatEffectTarget(gameState, creatureID, duration); err != nil {
+		return "", err
+	}
+
+	var effect effects.ContinuousEffect
+	switch restriction {
+	case CombatRestrictionCantAttack:
+		effect = effects.NewCantAttackEffect("", []string{creatureID}, duration)
+	case CombatRestrictionCantBlock:
+		effect = effects.NewCantBlockEffect("", []string{creatureID}, duration)
+	default:
+		return "", fmt.Errorf("unknown combat restriction %s", restriction)
+	}
+	return gameState.layerSystem.AddEffect(effect), nil
+}
+
+// AddCombatRequirement makes a creature on the battlefield attack each combat if able, or block the
+// given attacker if able, for the given duration. attackerID is only used by CombatRequirementMustBlock.
+// Requirements are checked by FinishDeclaringAttackers and AcceptBlockers.
+// Per Java AttacksIfAbleTargetEffect and MustBeBlockedByTargetSourceEffect
+func (e *MageEngine) AddCombatRequirement(gameID, creatureID string, requirement CombatRequirement, attackerID string, duration effects.Duration) (_ string, err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return "", fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "AddCombatRequirement", creatureID, requirement, attackerID, duration)
+
+	if err := checkCombatEffectTarget(gameState, creatureID, duration); err != nil {
+		return "", err
+	}
+
+	var effect effects.ContinuousEffect
+	switch requirement {
+	case CombatRequirementMustAttack:
+		if attackerID != "" {
+			return "", fmt.Errorf("an attack requirement doesn't take an attacker")
+		}
+		effect = effects.NewMustAttackEffect("", []string{creatureID}, duration)
+	case CombatRequirementMustBlock:
+		if _, exists := gameState.cards[attackerID]; !exists {
+			return "", fmt.Errorf("attacker %s not found", attackerID)
+		}
+		effect = effects.NewMustBeBlockedEffect("", attackerID, []string{creatureID}, duration)
+	default:
+		return "", fmt.Errorf("unknown combat requirement %s", requirement)
+	}
+	return gameState.layerSystem.AddEffect(effect), nil
+}
+
+// checkCombatEffectTarget checks that a combat restriction or requirement can be put on a creature
+func checkCombatEffectTarget(gameState *engineGameState, creatureID string, duration effects.Duration) error {
+	card, exists := gameState.cards[creatureID]
+	if !exists {
+		return fmt.Errorf("creature %s not found", creatureID)
+	}
+	if card.Zone != zoneBattlefield {
+		return fmt.Errorf("%s is not on the battlefield", card.Name)
+	}
+	switch duration {
+	case effects.DurationEndOfTurn, effects.DurationUntilEndOfTurn, effects.DurationEndOfCombat, effects.DurationPermanent:
+		return nil
+	default:
+		return fmt.Errorf("unsupported duration %s for a combat restriction", duration)
+	}
+}
+
+// GetCombatRestrictions returns the creatures under combat restrictions and requirements
+func (e *MageEngine) GetCombatRestrictions(gameID string) (CombatRestrictions, error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return CombatRestrictions{}, fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+
+	restrictions := CombatRestrictions{
+		CantAttack: make([]string, 0),
+		CantBlock:  make([]string, 0),
+		MustAttack: make([]string, 0),
+		MustBlock:  make(map[string][]string),
+	}
+	add := func(ids, more []string) []string {
+		for _, id := range more {
+			ids = appendUnique(ids, id)
+		}
+		return ids
+	}
+	for _, effect := range gameState.layerSystem.AllEffects() {
+		switch effect := effect.(type) {
+		case *effects.CantAttackEffect:
+			restrictions.CantAttack = add(restrictions.CantAttack, effect.GetTargetIDs())
+		case *effects.CantBlockEffect:
+			restrictions.CantBlock = add(restrictions.CantBlock, effect.GetTargetIDs())
+		case *effects.MustAttackEffect:
+			restrictions.MustAttack = add(restrictions.MustAttack, effect.GetTargetIDs())
+		case *effects.MustBeBlockedEffect:
+			attackerID := effect.GetAttackerID()
+			restrictions.MustBlock[attackerID] = add(restrictions.MustBlock[attackerID], effect.GetTargetIDs())
+		}
+	}
+	for _, ids := range [][]string{restrictions.CantAttack, restrictions.CantBlock, restrictions.MustAttack} {
+		sort.Strings(ids)
+	}
+	for _, ids := range restrictions.MustBlock {
+		sort.Strings(ids)
+	}
+	return restrictions, nil
+}
+
+// mustBeBlockedBy reports whether any of an attacker's "must be blocked" effects requires the blocker to
+// block it; an effect that names no blockers requires every creature able to block it
+func mustBeBlockedBy(mbEffects []*effects.MustBeBlockedEffect, blockerID string) bool {
+	for _, effect := range mbEffects {
+		targets := effect.GetTargetIDs()
+		if len(targets) == 0 || containsString(targets, blockerID) {
+			return true
+		}
+	}
+	return false
+}
+
+// mustAttackViolations lists the attacking player's creatures that must attack if able but weren't
+// declared as attackers although they could have been; creatures in declaring are about to be declared
+// and count as attacking
+// Per rule 508.1d and Java Combat.checkAttackRequirements()
+func (e *MageEngine) mustAttackViolations(gameState *engineGameState, declaring map[string]bool) []string {
+	violations := make([]string, 0)
+	for _, card := range gameState.cards {
+		if card.Zone != zoneBattlefield || card.ControllerID != gameState.combat.attackingPlayerID || !e.isCreature(card) {
+			continue
+		}
+		if card.Attacking || declaring[card.ID] || !e.hasMustAttackEffect(gameState, card.ID) || !e.canAttackInternal(gameState, card) {
+			continue
+		}
+		violations = append(violations, fmt.Sprintf("%s attacks each combat if able", card.Name))
+	}
+	sort.Strings(violations)
+	return violations
+}
+
+// mustBlockViolations lists the creatures that must block an attacker if able but aren't blocking it, or
+// another attacker they're required to block, although they could be. Only playerID's creatures are
+// checked ("" = every player's); declaring maps blockers about to be declared to the attackers they'll
+// block, which count as blocked.
+// Per rule 509.1c and Java Combat.checkBlockRequirementsAfter()
+func (e *MageEngine) mustBlockViolations(gameState *engineGameState, playerID string, declaring map[string][]string) []string {
+	required := make(map[string][]string) // Blocker ID -> attackers it could block and is required to
+	for attackerID := range gameState.combat.attackers {
+		mbEffects := e.getMustBeBlockedEffects(gameState, attackerID)
+		if len(mbEffects) == 0 {
+			continue
+		}
+		for _, blocker := range gameState.cards {
+			if blocker.Zone != zoneBattlefield || (playerID != "" && blocker.ControllerID != playerID) || !mustBeBlockedBy(mbEffects, blocker.ID) {
+				continue
+			}
+			if canBlock, _ := e.canBlockInternal(gameState, blocker.ID, attackerID); canBlock {
+				required[blocker.ID] = append(required[blocker.ID], attackerID)
+			}
+		}
+	}
+
+	violations := make([]string, 0)
+	for blockerID, attackerIDs := range required {
+		blocker := gameState.cards[blockerID]
+		obeyed := false
+		for _, attackerID := range attackerIDs {
+			if containsString(blocker.BlockingWhat, attackerID) || containsString(declaring[blockerID], attackerID) {
+				obeyed = true
+				break
+			}
+		}
+		if !obeyed {
+			attacker := gameState.cards[attackerIDs[0]]
+			violations = append(violations, fmt.Sprintf("%s must block %s if able", blocker.Name, attacker.Name))
+		}
+	}
+	sort.Strings(violations)
+	return violations
+}
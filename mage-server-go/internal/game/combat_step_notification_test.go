@@ -0,0 +1,46 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestCombatStepNotification_DeclareBlockersNamesDefender verifies that the declare blockers step
+// announces a COMBAT_STEP notification naming the defending player as the one who must declare
+func TestCombatStepNotification_DeclareBlockersNamesDefender(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-combat-step-notification", []string{"Alice", "Bob"})
+	attacker := h.CreateAttacker("alice-bears", "Grizzly Bears", "Alice", "2", "2")
+	h.SetupCombat("Alice")
+	h.DeclareAttacker(attacker, "Bob", "Alice")
+
+	notifications := make(chan GameNotification, 10)
+	h.engine.SetNotificationHandler(func(notification GameNotification) {
+		if notification.Type == "COMBAT_STEP" {
+			notifications <- notification
+		}
+	})
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	h.engine.handleCombatStepBegin(gameState, rules.StepDeclareBlockers, "Alice")
+	gameState.mu.Unlock()
+
+	select {
+	case notification := <-notifications:
+		data := notification.Data
+		if data["step"] != rules.StepDeclareBlockers.String() || data["decision"] != "declare_blockers" {
+			t.Errorf("expected a declare blockers notification, got %+v", data)
+		}
+		acting, _ := data["acting_players"].([]string)
+		if len(acting) != 1 || acting[0] != "Bob" {
+			t.Errorf("expected Bob to be the one to declare blockers, got %v", data["acting_players"])
+		}
+		if data["active_player"] != "Alice" {
+			t.Errorf("expected Alice as the active player, got %v", data["active_player"])
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected a COMBAT_STEP notification")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/magefree/mage-server-go/internal/game/counters"
 	"go.uber.org/zap/zaptest"
 )
 
@@ -74,6 +75,7 @@ func (h *CombatTestHarness) CreateCreature(spec CreatureSpec) string {
 		Toughness:    spec.Toughness,
 		Tapped:       spec.Tapped,
 		Abilities:    abilities,
+		Counters:     counters.NewCounters(),
 	}
 
 	gameState.cards[spec.ID] = card
@@ -3,6 +3,7 @@ package game
 import (
 	"testing"
 
+	"github.com/magefree/mage-server-go/internal/game/effects"
 	"go.uber.org/zap/zaptest"
 )
 
@@ -609,3 +610,65 @@ func TestCombatTrampleInsufficientPower(t *testing.T) {
 
 	engine.EndCombat(gameID)
 }
+
+// TestCombatAssignTrampleDamage verifies that the attacking player can choose how trample damage is split,
+// that each blocker must be assigned lethal damage (counting marked damage and deathtouch) before any
+// damage tramples over, and that all of the attacker's power must be assigned
+func TestCombatAssignTrampleDamage(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-assign-trample", []string{"Alice", "Bob"})
+	dreadmaw := h.CreateCreature(CreatureSpec{ID: "dreadmaw", Name: "Colossal Dreadmaw", Power: "6", Toughness: "6", Controller: "Alice", Abilities: []string{abilityTrample}})
+	bears := h.CreateCreature(CreatureSpec{ID: "bears", Name: "Grizzly Bears", Power: "2", Toughness: "2", Controller: "Bob"})
+	giant := h.CreateCreature(CreatureSpec{ID: "giant", Name: "Hill Giant", Power: "3", Toughness: "3", Controller: "Bob"})
+	grunt := h.CreateCreature(CreatureSpec{ID: "grunt", Name: "Goblin Grunt", Power: "3", Toughness: "1", Controller: "Alice"})
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.cards[giant].Damage = 1
+	gameState.mu.Unlock()
+
+	h.SetupCombat("Alice")
+	h.DeclareAttacker(dreadmaw, "Bob", "Alice")
+	h.DeclareAttacker(grunt, "Bob", "Alice")
+	h.DeclareBlocker(bears, dreadmaw, "Bob")
+	h.DeclareBlocker(giant, dreadmaw, "Bob")
+	h.AcceptBlockers()
+
+	for _, tc := range []struct {
+		name     string
+		blockers map[string]int
+		defender int
+	}{
+		{"blocker below lethal", map[string]int{bears: 1, giant: 3}, 2},
+		{"damaged blocker below lethal", map[string]int{bears: 2, giant: 1}, 3},
+		{"less than its power", map[string]int{bears: 2, giant: 2}, 1},
+		{"more than its power", map[string]int{bears: 2, giant: 2}, 3},
+		{"non-blocker", map[string]int{bears: 2, giant: 2, grunt: 1}, 1},
+	} {
+		if err := h.engine.AssignTrampleDamage(h.gameID, dreadmaw, tc.blockers, tc.defender); err == nil {
+			t.Errorf("%s: expected the assignment to be rejected", tc.name)
+		}
+	}
+	if err := h.engine.AssignTrampleDamage(h.gameID, grunt, map[string]int{}, 3); err == nil {
+		t.Error("expected an attacker without trample to be rejected")
+	}
+
+	// With deathtouch, 1 damage is lethal to each blocker
+	if _, err := h.engine.GrantAbility(h.gameID, dreadmaw, abilityDeathtouch, effects.DurationEndOfTurn); err != nil {
+		t.Fatalf("failed to grant deathtouch: %v", err)
+	}
+	if err := h.engine.AssignTrampleDamage(h.gameID, dreadmaw, map[string]int{bears: 1, giant: 1}, 4); err != nil {
+		t.Fatalf("failed to assign trample damage: %v", err)
+	}
+
+	h.AssignDamage(false)
+	h.ApplyDamage()
+	h.AssertPlayerLife("Bob", 13)
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	for _, blockerID := range []string{bears, giant} {
+		if card := gameState.cards[blockerID]; card.Zone != zoneGraveyard {
+			t.Errorf("expected %s to die, got zone %d", card.Name, card.Zone)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+)
+
+// SetCommander makes a card its owner's commander (rule 903.3). Combat damage the commander deals to a
+// player is tracked for the rest of the game, and with the CommanderDamage rules option a player dealt
+// that much by one commander loses (rule 704.6c).
+func (e *MageEngine) SetCommander(gameID, cardID string) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "SetCommander", cardID)
+
+	card, exists := gameState.cards[cardID]
+	if !exists {
+		return fmt.Errorf("card %s not found", cardID)
+	}
+	if card.CopyOf != "" {
+		return fmt.Errorf("a copy of a spell can't be a commander")
+	}
+	card.Commander = true
+	return nil
+}
+
+// addCommanderDamage records combat damage a commander dealt to the player
+func (p *internalPlayer) addCommanderDamage(commanderID string, amount int) {
+	if p.CommanderDamage == nil {
+		p.CommanderDamage = make(map[string]int)
+	}
+	p.CommanderDamage[commanderID] += amount
+}
+
+// lethalCommanderDamage returns a commander that has dealt the player at least threshold combat damage
+// and how much, or "" if none has (or threshold is 0, commander damage not being tracked)
+func (p *internalPlayer) lethalCommanderDamage(threshold int) (string, int) {
+	if threshold <= 0 {
+		return "", 0
+	}
+	ids := make([]string, 0, len(p.CommanderDamage))
+	for id := range p.CommanderDamage {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if damage := p.CommanderDamage[id]; damage >= threshold {
+			return id, damage
+		}
+	}
+	return "", 0
+}
@@ -0,0 +1,227 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestMultiplayerConcede_GameContinuesUntilOneRemains verifies that conceding in a 4-player game
+// keeps the game going and attributes the win once a single player remains
+func TestMultiplayerConcede_GameContinuesUntilOneRemains(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	engine := NewMageEngine(logger)
+	engine.SetDebugOperationsEnabled(true)
+
+	gameID := "test-multiplayer-concede"
+	players := []string{"Alice", "Bob", "Carol", "Dave"}
+	if err := engine.StartGame(gameID, players, "Free For All"); err != nil {
+		t.Fatalf("failed to start game: %v", err)
+	}
+
+	engine.mu.RLock()
+	gameState := engine.games[gameID]
+	engine.mu.RUnlock()
+
+	winEvents := 0
+	gameState.eventBus.SubscribeTyped(rules.EventWins, func(evt rules.Event) {
+		winEvents++
+	})
+
+	if err := engine.PlayerConcede(gameID, "Bob"); err != nil {
+		t.Fatalf("Bob failed to concede: %v", err)
+	}
+	if err := engine.PlayerConcede(gameID, "Dave"); err != nil {
+		t.Fatalf("Dave failed to concede: %v", err)
+	}
+
+	gameState.mu.RLock()
+	if gameState.state == GameStateFinished {
+		t.Fatal("expected game to continue with two players remaining")
+	}
+	if !gameState.players["Bob"].Left || !gameState.players["Dave"].Left {
+		t.Error("expected conceding players to have left the game")
+	}
+	if gameState.players["Alice"].Wins != 0 || gameState.players["Carol"].Wins != 0 {
+		t.Error("expected no winner while two players remain")
+	}
+	gameState.mu.RUnlock()
+
+	// Turn order now skips the players who left
+	gameState.mu.RLock()
+	nextPlayer := engine.getNextPlayer(gameState)
+	gameState.mu.RUnlock()
+	if nextPlayer != "Carol" {
+		t.Errorf("expected next turn to go to Carol, got %s", nextPlayer)
+	}
+
+	// Carol is dealt lethal damage, leaving Alice as the sole survivor
+	if err := engine.DealDamage(gameID, "", "Carol", 20, false); err != nil {
+		t.Fatalf("failed to deal damage: %v", err)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+
+	if gameState.state != GameStateFinished {
+		t.Fatal("expected game to be finished")
+	}
+	if gameState.players["Alice"].Wins != 1 {
+		t.Errorf("expected Alice to be credited with the win, got %d wins", gameState.players["Alice"].Wins)
+	}
+	if gameState.players["Carol"].Wins != 0 {
+		t.Errorf("expected Carol to have no wins, got %d", gameState.players["Carol"].Wins)
+	}
+	if winEvents != 1 {
+		t.Errorf("expected 1 EventWins, got %d", winEvents)
+	}
+}
+
+// TestMultiplayerConcede_LeaveEffects verifies monarch transfer, exile of controlled objects and
+// leave triggers when a player concedes
+func TestMultiplayerConcede_LeaveEffects(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-concede-leave-effects", []string{"Alice", "Bob", "Carol"})
+	gameState := h.GetGameState()
+
+	// Bob has gained control of one of Carol's creatures
+	stolen := h.CreateCreature(CreatureSpec{
+		ID:         "stolen",
+		Name:       "Serra Angel",
+		Controller: "Bob",
+		Power:      "4",
+		Toughness:  "4",
+	})
+	gameState.mu.Lock()
+	gameState.cards[stolen].OwnerID = "Carol"
+	gameState.mu.Unlock()
+
+	if err := h.engine.SetMonarch(h.gameID, "Bob"); err != nil {
+		t.Fatalf("failed to set monarch: %v", err)
+	}
+
+	// "When a player leaves the game" trigger on one of Alice's permanents
+	watcher := h.CreateAttacker("watcher", "Curator", "Alice", "1", "1")
+	leaveTriggers := 0
+	if err := h.engine.RegisterCombatTrigger(h.gameID, &combatTrigger{
+		SourceID:    watcher,
+		TriggerType: "player_leaves",
+		Condition: func(gs *engineGameState, event rules.Event) bool {
+			return event.Type == rules.EventLost
+		},
+		CreateAbility: func(gs *engineGameState, event rules.Event) *triggeredAbilityQueueItem {
+			leaveTriggers++
+			return nil
+		},
+	}); err != nil {
+		t.Fatalf("failed to register trigger: %v", err)
+	}
+
+	if err := h.engine.PlayerConcede(h.gameID, "Bob"); err != nil {
+		t.Fatalf("Bob failed to concede: %v", err)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+
+	if gameState.state == GameStateFinished {
+		t.Fatal("expected game to continue")
+	}
+	if gameState.monarchID != "Alice" {
+		t.Errorf("expected active player Alice to become the monarch, got %q", gameState.monarchID)
+	}
+	if gameState.cards[stolen].Zone != zoneExile {
+		t.Errorf("expected object controlled by leaving player to be exiled, got zone %d", gameState.cards[stolen].Zone)
+	}
+	if leaveTriggers != 1 {
+		t.Errorf("expected leave trigger to fire once, got %d", leaveTriggers)
+	}
+}
+
+// TestMultiplayerConcede_AttackingPlayerLeavesMidCombat verifies that combat holds no references to the
+// leaving player's creatures after the attacking player concedes
+func TestMultiplayerConcede_AttackingPlayerLeavesMidCombat(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-concede-mid-combat", []string{"Alice", "Bob", "Carol"})
+
+	bears := h.CreateAttacker("bears", "Grizzly Bears", "Alice", "2", "2")
+	giant := h.CreateAttacker("giant", "Hill Giant", "Alice", "3", "3")
+	wall := h.CreateBlocker("wall", "Wall of Wood", "Bob", "0", "4")
+
+	h.SetupCombat("Alice")
+	h.DeclareAttacker(bears, "Bob", "Alice")
+	h.DeclareAttacker(giant, "Carol", "Alice")
+	h.DeclareBlocker(wall, bears, "Bob")
+	h.AcceptBlockers()
+
+	if err := h.engine.PlayerConcede(h.gameID, "Alice"); err != nil {
+		t.Fatalf("Alice failed to concede: %v", err)
+	}
+
+	gameState := h.GetGameState()
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+
+	if gameState.state == GameStateFinished {
+		t.Fatal("expected game to continue for Bob and Carol")
+	}
+
+	combat := gameState.combat
+	if combat.attackingPlayerID != "" {
+		t.Errorf("expected attacking player to be reset, got %q", combat.attackingPlayerID)
+	}
+	if len(combat.groups) != 0 {
+		t.Errorf("expected no combat groups, got %d", len(combat.groups))
+	}
+	if len(combat.attackers) != 0 || len(combat.blockers) != 0 || len(combat.blockingGroups) != 0 {
+		t.Errorf("expected no attackers or blockers, got attackers=%v blockers=%v blockingGroups=%d",
+			combat.attackers, combat.blockers, len(combat.blockingGroups))
+	}
+
+	blocker := gameState.cards[wall]
+	if blocker.Blocking || len(blocker.BlockingWhat) != 0 {
+		t.Errorf("expected Bob's blocker to no longer be blocking, got blocking=%v what=%v", blocker.Blocking, blocker.BlockingWhat)
+	}
+	for _, id := range []string{bears, giant} {
+		if gameState.cards[id].Attacking {
+			t.Errorf("expected %s to no longer be attacking", id)
+		}
+	}
+}
+
+// TestMultiplayerConcede_LeaverOwnedOnlyBlocker verifies that an attacker stays blocked when the only
+// creature blocking it leaves combat with the player who owned it (rule 506.4)
+func TestMultiplayerConcede_LeaverOwnedOnlyBlocker(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-concede-only-blocker", []string{"Alice", "Bob", "Carol"})
+
+	bears := h.CreateAttacker("bears", "Grizzly Bears", "Alice", "2", "2")
+	wall := h.CreateBlocker("wall", "Wall of Wood", "Bob", "0", "4")
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.cards[wall].OwnerID = "Carol" // Bob gained control of Carol's wall
+	gameState.mu.Unlock()
+
+	h.SetupCombat("Alice")
+	h.DeclareAttacker(bears, "Bob", "Alice")
+	h.DeclareBlocker(wall, bears, "Bob")
+	h.AcceptBlockers()
+
+	if err := h.engine.PlayerConcede(h.gameID, "Carol"); err != nil {
+		t.Fatalf("Carol failed to concede: %v", err)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+
+	combat := gameState.combat
+	if len(combat.groups) != 1 {
+		t.Fatalf("expected Alice's attack on Bob to remain, got %d combat groups", len(combat.groups))
+	}
+	group := combat.groups[0]
+	if len(group.blockers) != 0 {
+		t.Errorf("expected the wall to be removed from combat, got blockers %v", group.blockers)
+	}
+	if !group.blocked {
+		t.Error("expected the attacker to stay blocked after its only blocker was removed")
+	}
+}
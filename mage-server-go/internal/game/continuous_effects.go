@@ -0,0 +1,290 @@
+package game
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/magefree/mage-server-go/internal/game/counters"
+	"github.com/magefree/mage-server-go/internal/game/effects"
+)
+
+// staticAbility is a static ability whose continuous effects exist only while its source is on
+// the battlefield (e.g. Glorious Anthem, lords), or in the command zone for an emblem.
+// Per Java StaticAbility / ContinuousEffects.removeInactiveEffects()
+type staticAbility struct {
+	SourceID      string
+	Description   string
+	CreateEffects func(gameState *engineGameState, source *internalCard) []effects.ContinuousEffect
+
+	effectIDs    []string // Effects currently registered in the layer system
+	controllerID string   // Controller of the source when the effects were created
+}
+
+// anthemAbility creates a static ability giving creatures controlled by the source's controller +X/+X,
+// optionally limited to a subtype ("Other Elf creatures you control get +1/+1")
+func anthemAbility(sourceID string, powerDelta, toughDelta int, subType string, includeSelf bool) *staticAbility {
+	return &staticAbility{
+		SourceID:    sourceID,
+		Description: fmt.Sprintf("creatures you control get %+d/%+d", powerDelta, toughDelta),
+		CreateEffects: func(gameState *engineGameState, source *internalCard) []effects.ContinuousEffect {
+			return []effects.ContinuousEffect{
+				effects.NewAnthemEffect(source.ID, source.ControllerID, subType, powerDelta, toughDelta, includeSelf),
+			}
+		},
+	}
+}
+
+// RegisterStaticAbility registers a static ability for a permanent.
+// Its effects are added to the layer system while the source is on the battlefield.
+func (e *MageEngine) RegisterStaticAbility(gameID string, ability *staticAbility) error {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+
+	if _, exists := gameState.cards[ability.SourceID]; !exists {
+		return fmt.Errorf("source %s not found", ability.SourceID)
+	}
+
+	gameState.staticAbilities = append(gameState.staticAbilities, ability)
+	e.recomputeContinuousEffects(gameState)
+	gameState.markUnreplayable("RegisterStaticAbility")
+	return nil
+}
+
+// AddContinuousEffect adds a continuous effect (e.g. from a resolving spell) and reapplies effects
+func (e *MageEngine) AddContinuousEffect(gameID string, effect effects.ContinuousEffect) (string, error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return "", fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+
+	effectID := gameState.layerSystem.AddEffect(effect)
+	e.recomputeContinuousEffects(gameState)
+	gameState.markUnreplayable("AddContinuousEffect")
+	return effectID, nil
+}
+
+// RemoveContinuousEffect ends a continuous effect and reapplies the remaining effects
+func (e *MageEngine) RemoveContinuousEffect(gameID, effectID string) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "RemoveContinuousEffect", effectID)
+
+	gameState.layerSystem.RemoveEffect(effectID)
+	e.recomputeContinuousEffects(gameState)
+	return nil
+}
+
+// GrantAbility gives a permanent an ability (e.g. "target creature gains flying until end of turn")
+// for the given duration: until end of turn, until end of combat, or permanently. The grant ends at
+// the matching cleanup and can be ended early with RemoveContinuousEffect.
+// Per Java GainAbilityTargetEffect
+func (e *MageEngine) GrantAbility(gameID, cardID, abilityID string, duration effects.Duration) (_ string, err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return "", fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "GrantAbility", cardID, abilityID, duration)
+
+	card, exists := gameState.cards[cardID]
+	if !exists {
+		return "", fmt.Errorf("card %s not found", cardID)
+	}
+	if card.Zone != zoneBattlefield {
+		return "", fmt.Errorf("%s is not on the battlefield", card.Name)
+	}
+	switch duration {
+	case effects.DurationEndOfTurn, effects.DurationUntilEndOfTurn, effects.DurationEndOfCombat, effects.DurationPermanent:
+	default:
+		return "", fmt.Errorf("unsupported duration %s for a granted ability", duration)
+	}
+
+	effectID := gameState.layerSystem.AddEffect(effects.NewGrantAbilityEffect("", abilityID, []string{cardID}, duration))
+	e.recomputeContinuousEffects(gameState)
+	return effectID, nil
+}
+
+// syncStaticAbilities adds the effects of static abilities whose source is on the battlefield (or is
+// an emblem) and removes those whose source has left (or changed controller, so the effects are recreated)
+func (e *MageEngine) syncStaticAbilities(gameState *engineGameState) {
+	for _, ability := range gameState.staticAbilities {
+		source, exists := gameState.cards[ability.SourceID]
+		active := exists && (source.Zone == zoneBattlefield || (source.Zone == zoneCommand && isEmblem(source)))
+
+		if len(ability.effectIDs) > 0 && (!active || source.ControllerID != ability.controllerID) {
+			for _, effectID := range ability.effectIDs {
+				gameState.layerSystem.RemoveEffect(effectID)
+			}
+			ability.effectIDs = nil
+		}
+
+		if active && len(ability.effectIDs) == 0 && ability.CreateEffects != nil {
+			for _, effect := range ability.CreateEffects(gameState, source) {
+				ability.effectIDs = append(ability.effectIDs, gameState.layerSystem.AddEffect(effect))
+			}
+			ability.controllerID = source.ControllerID
+		}
+	}
+}
+
+// recomputeContinuousEffects reapplies all continuous effects to permanents on the battlefield
+// Per Java GameState.applyEffects(): characteristics are recalculated from printed values through
+// the layer system (control in layer 2, types in layer 4, then P/T in layer 7), so effects that end
+// simply stop contributing.
+// Characteristics set outside the layer system (e.g. by tests or one-shot effects) become the new base values.
+func (e *MageEngine) recomputeContinuousEffects(gameState *engineGameState) {
+	if gameState.layerSystem == nil {
+		return
+	}
+
+	e.syncStaticAbilities(gameState)
+	controlChanged := false
+
+	for _, card := range gameState.cards {
+		if card.Zone != zoneBattlefield {
+			// Objects that left the battlefield return to their printed characteristics
+			if card.layered {
+				card.Power = card.BasePower
+				card.Toughness = card.BaseToughness
+				card.Type = card.BaseType
+				card.SubTypes = append([]string(nil), card.BaseSubTypes...)
+				card.layered = false
+			}
+			continue
+		}
+
+		if !card.layered || card.Power != card.LayeredPower {
+			card.BasePower = card.Power
+		}
+		if !card.layered || card.Toughness != card.LayeredToughness {
+			card.BaseToughness = card.Toughness
+		}
+		if !card.layered || card.Type != card.LayeredType {
+			card.BaseType = card.Type
+		}
+		if !card.layered || !equalStrings(card.SubTypes, card.LayeredSubTypes) {
+			card.BaseSubTypes = append([]string(nil), card.SubTypes...)
+		}
+		if !card.layered || card.ControllerID != card.LayeredControllerID {
+			card.BaseControllerID = card.ControllerID
+		}
+
+		power, powerErr := e.parsePowerToughness(xStat(card, card.BasePower))
+		toughness, toughErr := e.parsePowerToughness(xStat(card, card.BaseToughness))
+		baseTypes := cardTypes(card.BaseType)
+
+		snapshot := effects.NewSnapshot(card.ID, card.BaseControllerID, baseTypes, power, toughness, powerErr == nil, toughErr == nil)
+		snapshot.SubTypes = append([]string(nil), card.BaseSubTypes...)
+		gameState.layerSystem.Apply(snapshot)
+
+		// Layer 2: control-changing effects
+		// Per rule 800.4a: control effects giving control to a player who left the game end
+		controllerID := snapshot.ControllerID
+		if player, exists := gameState.players[controllerID]; !exists || player.Lost || player.Left {
+			controllerID = card.BaseControllerID
+		}
+		if controllerID != card.ControllerID {
+			e.changeController(gameState, card, controllerID)
+			controlChanged = true
+		}
+
+		// Layer 4: type-changing effects
+		if equalStrings(snapshot.Types, baseTypes) {
+			card.Type = card.BaseType
+		} else {
+			card.Type = strings.Join(snapshot.Types, " ")
+		}
+		card.SubTypes = snapshot.SubTypes
+
+		// Layer 7: power/toughness (set, then modified by effects and by +1/+1 and -1/-1 counters)
+		// Per rule 613.4c
+		boost := boostCounters(card)
+		card.Power = card.BasePower
+		if snapshot.HasBasePower {
+			card.Power = strconv.Itoa(snapshot.Power + boost)
+		}
+		card.Toughness = card.BaseToughness
+		if snapshot.HasBaseTough {
+			card.Toughness = strconv.Itoa(snapshot.Toughness + boost)
+		}
+
+		card.LayeredPower = card.Power
+		card.LayeredToughness = card.Toughness
+		card.LayeredType = card.Type
+		card.LayeredSubTypes = append([]string(nil), card.SubTypes...)
+		card.LayeredControllerID = card.ControllerID
+		card.layered = true
+	}
+
+	// Static abilities of permanents that changed controller now apply for their new controller
+	if controlChanged {
+		e.recomputeContinuousEffects(gameState)
+	}
+}
+
+// boostCounters returns the net +N/+N from a permanent's +1/+1 and -1/-1 counters
+func boostCounters(card *internalCard) int {
+	if card.Counters == nil {
+		return 0
+	}
+	return card.Counters.GetCount(string(counters.CounterTypeP1P1)) - card.Counters.GetCount(string(counters.CounterTypeM1M1))
+}
+
+// printedPowerToughness returns a card's power and toughness before continuous effects and counters
+func printedPowerToughness(card *internalCard) (string, string) {
+	if card.layered {
+		return card.BasePower, card.BaseToughness
+	}
+	return card.Power, card.Toughness
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// cardTypes splits a type line into card types ("Artifact Creature — Golem" -> [Artifact Creature])
+func cardTypes(typeLine string) []string {
+	if idx := strings.Index(typeLine, "—"); idx >= 0 {
+		typeLine = typeLine[:idx]
+	}
+	if idx := strings.Index(typeLine, " - "); idx >= 0 {
+		typeLine = typeLine[:idx]
+	}
+	return strings.Fields(typeLine)
+}
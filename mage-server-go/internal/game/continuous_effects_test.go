@@ -0,0 +1,295 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/counters"
+	"github.com/magefree/mage-server-go/internal/game/effects"
+)
+
+func assertPT(t *testing.T, gameState *engineGameState, cardID, power, toughness string) {
+	t.Helper()
+	card := gameState.cards[cardID]
+	if card.Power != power || card.Toughness != toughness {
+		t.Errorf("expected %s to be %s/%s, got %s/%s", card.Name, power, toughness, card.Power, card.Toughness)
+	}
+}
+
+// TestAnthem_BoostsControllersCreaturesWhileOnBattlefield verifies that an anthem gives the controller's
+// creatures +1/+1 and reverts when the anthem leaves the battlefield
+func TestAnthem_BoostsControllersCreaturesWhileOnBattlefield(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-anthem", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	bears := h.CreateCreature(CreatureSpec{ID: "bears", Name: "Grizzly Bears", Controller: "Alice", Power: "2", Toughness: "2"})
+	elf := h.CreateCreature(CreatureSpec{ID: "elf", Name: "Llanowar Elves", Controller: "Alice", Power: "1", Toughness: "1"})
+	opponent := h.CreateCreature(CreatureSpec{ID: "opponent", Name: "Hill Giant", Controller: "Bob", Power: "3", Toughness: "3"})
+
+	gameState.mu.Lock()
+	anthem := &internalCard{
+		ID:           "anthem",
+		Name:         "Glorious Anthem",
+		Type:         "Enchantment",
+		Zone:         zoneBattlefield,
+		OwnerID:      "Alice",
+		ControllerID: "Alice",
+	}
+	gameState.cards[anthem.ID] = anthem
+	gameState.battlefield = append(gameState.battlefield, anthem)
+	gameState.mu.Unlock()
+
+	if err := h.engine.RegisterStaticAbility(h.gameID, anthemAbility(anthem.ID, 1, 1, "", false)); err != nil {
+		t.Fatalf("failed to register anthem: %v", err)
+	}
+
+	gameState.mu.Lock()
+	assertPT(t, gameState, bears, "3", "3")
+	assertPT(t, gameState, elf, "2", "2")
+	assertPT(t, gameState, opponent, "3", "3")
+
+	// 2 damage no longer kills the boosted 2/2
+	gameState.cards[bears].Damage = 2
+	h.engine.checkStateBasedActions(gameState)
+	if gameState.cards[bears].Zone != zoneBattlefield {
+		t.Fatal("expected boosted Grizzly Bears to survive 2 damage")
+	}
+	gameState.cards[bears].Damage = 0
+
+	// The anthem leaves the battlefield: creatures return to their printed P/T
+	if err := h.engine.moveCard(gameState, anthem, zoneGraveyard, ""); err != nil {
+		t.Fatalf("failed to move anthem: %v", err)
+	}
+	assertPT(t, gameState, bears, "2", "2")
+	assertPT(t, gameState, elf, "1", "1")
+	assertPT(t, gameState, opponent, "3", "3")
+
+	// Stays reverted on later recomputation
+	h.engine.checkStateBasedActions(gameState)
+	assertPT(t, gameState, bears, "2", "2")
+	gameState.mu.Unlock()
+}
+
+// TestAnthem_LordOnlyBoostsSubtype verifies that a lord's anthem only applies to its subtype
+func TestAnthem_LordOnlyBoostsSubtype(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-anthem-lord", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	lord := h.CreateCreature(CreatureSpec{ID: "lord", Name: "Elvish Archdruid", Controller: "Alice", Power: "2", Toughness: "2"})
+	elf := h.CreateCreature(CreatureSpec{ID: "elf", Name: "Llanowar Elves", Controller: "Alice", Power: "1", Toughness: "1"})
+	bears := h.CreateCreature(CreatureSpec{ID: "bears", Name: "Grizzly Bears", Controller: "Alice", Power: "2", Toughness: "2"})
+
+	gameState.mu.Lock()
+	gameState.cards[lord].SubTypes = []string{"Elf", "Druid"}
+	gameState.cards[elf].SubTypes = []string{"Elf", "Druid"}
+	gameState.cards[bears].SubTypes = []string{"Bear"}
+	gameState.mu.Unlock()
+
+	if err := h.engine.RegisterStaticAbility(h.gameID, anthemAbility(lord, 1, 1, "Elf", false)); err != nil {
+		t.Fatalf("failed to register lord: %v", err)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	assertPT(t, gameState, lord, "2", "2")
+	assertPT(t, gameState, elf, "2", "2")
+	assertPT(t, gameState, bears, "2", "2")
+}
+
+// TestTypeChanging_LandsBecomeCreatures verifies that an effect turning lands into 2/2 creatures lets a
+// land attack and die to damage, and that the lands revert when the effect ends
+func TestTypeChanging_LandsBecomeCreatures(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-animate-lands", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	for _, id := range []string{"forest-1", "forest-2"} {
+		gameState.cards[id] = &internalCard{
+			ID:           id,
+			Name:         "Forest",
+			Type:         "Basic Land",
+			SubTypes:     []string{"Forest"},
+			Zone:         zoneBattlefield,
+			OwnerID:      "Alice",
+			ControllerID: "Alice",
+		}
+	}
+	gameState.mu.Unlock()
+	blocker := h.CreateBlocker("giant", "Hill Giant", "Bob", "3", "3")
+
+	// A land can't attack before it is animated
+	h.SetupCombat("Alice")
+	if err := h.engine.DeclareAttacker(h.gameID, "forest-1", "Bob", "Alice"); err == nil {
+		t.Fatal("expected a non-creature land to be unable to attack")
+	}
+
+	typeEffectID, err := h.engine.AddContinuousEffect(h.gameID,
+		effects.NewTypeChangingEffect("nature-revolt", nil, "Land", []string{"Creature"}, nil, effects.DurationEndOfTurn))
+	if err != nil {
+		t.Fatalf("failed to add type-changing effect: %v", err)
+	}
+	ptEffectID, err := h.engine.AddContinuousEffect(h.gameID,
+		effects.NewSetPowerToughnessEffect("nature-revolt", nil, "Land", 2, 2, effects.DurationEndOfTurn))
+	if err != nil {
+		t.Fatalf("failed to add P/T-setting effect: %v", err)
+	}
+
+	gameState.mu.RLock()
+	if !h.engine.isCreature(gameState.cards["forest-1"]) {
+		t.Fatalf("expected Forest to be a creature, got type %q", gameState.cards["forest-1"].Type)
+	}
+	assertPT(t, gameState, "forest-1", "2", "2")
+	gameState.mu.RUnlock()
+
+	// The animated land attacks and is blocked by a 3/3
+	h.SetupCombat("Alice")
+	h.DeclareAttacker("forest-1", "Bob", "Alice")
+	h.DeclareBlocker(blocker, "forest-1", "Bob")
+	h.AcceptBlockers()
+	h.AssignDamage(false)
+	h.ApplyDamage()
+
+	h.AssertCreatureDead("forest-1")
+	h.AssertCreatureDamage(blocker, 2)
+
+	// The effect ends: the surviving Forest is a land again with no P/T
+	if err := h.engine.RemoveContinuousEffect(h.gameID, typeEffectID); err != nil {
+		t.Fatalf("failed to remove effect: %v", err)
+	}
+	if err := h.engine.RemoveContinuousEffect(h.gameID, ptEffectID); err != nil {
+		t.Fatalf("failed to remove effect: %v", err)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	forest := gameState.cards["forest-2"]
+	if forest.Type != "Basic Land" || forest.Power != "" || forest.Toughness != "" {
+		t.Errorf("expected Forest to revert to a land, got %q %s/%s", forest.Type, forest.Power, forest.Toughness)
+	}
+	if len(forest.SubTypes) != 1 || forest.SubTypes[0] != "Forest" {
+		t.Errorf("expected Forest subtype to be kept, got %v", forest.SubTypes)
+	}
+}
+
+// TestTypeChanging_AnthemAppliesAfterSetPT verifies that P/T modifications (layer 7c) apply on top of
+// P/T-setting effects (layer 7b) from type-changing animation
+func TestTypeChanging_AnthemAppliesAfterSetPT(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-animate-anthem", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	gameState.cards["forest"] = &internalCard{ID: "forest", Name: "Forest", Type: "Land", Zone: zoneBattlefield, OwnerID: "Alice", ControllerID: "Alice"}
+	gameState.cards["anthem"] = &internalCard{ID: "anthem", Name: "Glorious Anthem", Type: "Enchantment", Zone: zoneBattlefield, OwnerID: "Alice", ControllerID: "Alice"}
+	gameState.mu.Unlock()
+
+	if err := h.engine.RegisterStaticAbility(h.gameID, anthemAbility("anthem", 1, 1, "", false)); err != nil {
+		t.Fatalf("failed to register anthem: %v", err)
+	}
+	if _, err := h.engine.AddContinuousEffect(h.gameID,
+		effects.NewSetPowerToughnessEffect("animate", []string{"forest"}, "", 2, 2, effects.DurationEndOfTurn)); err != nil {
+		t.Fatalf("failed to add P/T-setting effect: %v", err)
+	}
+	if _, err := h.engine.AddContinuousEffect(h.gameID,
+		effects.NewTypeChangingEffect("animate", []string{"forest"}, "", []string{"Creature"}, []string{"Elemental"}, effects.DurationEndOfTurn)); err != nil {
+		t.Fatalf("failed to add type-changing effect: %v", err)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	assertPT(t, gameState, "forest", "3", "3")
+}
+
+// TestControlChanging_GainControlUntilEndOfTurn verifies that a creature stolen until end of turn
+// (Threaten) can attack its owner with granted haste and returns to its owner at cleanup
+func TestControlChanging_GainControlUntilEndOfTurn(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-threaten", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	giant := h.CreateCreature(CreatureSpec{ID: "giant", Name: "Hill Giant", Controller: "Bob", Power: "3", Toughness: "3"})
+
+	if _, err := h.engine.AddContinuousEffect(h.gameID,
+		effects.NewControlChangingEffect("threaten", "Alice", []string{giant}, effects.DurationEndOfTurn)); err != nil {
+		t.Fatalf("failed to add control-changing effect: %v", err)
+	}
+
+	gameState.mu.RLock()
+	if controller := gameState.cards[giant].ControllerID; controller != "Alice" {
+		t.Fatalf("expected Alice to control Hill Giant, got %s", controller)
+	}
+	if !gameState.cards[giant].SummoningSickness {
+		t.Error("expected Hill Giant to be summoning sick under its new controller")
+	}
+	gameState.mu.RUnlock()
+
+	// Without haste the stolen creature can't attack this turn
+	h.SetupCombat("Alice")
+	if err := h.engine.DeclareAttacker(h.gameID, giant, "Bob", "Alice"); err == nil {
+		t.Fatal("expected a summoning sick creature to be unable to attack")
+	}
+
+	if _, err := h.engine.AddContinuousEffect(h.gameID,
+		effects.NewGrantAbilityEffect("threaten", abilityHaste, []string{giant}, effects.DurationEndOfTurn)); err != nil {
+		t.Fatalf("failed to grant haste: %v", err)
+	}
+
+	h.SetupCombat("Alice")
+	h.DeclareAttacker(giant, "Bob", "Alice")
+	h.AcceptBlockers()
+	h.AssignDamage(false)
+	h.ApplyDamage()
+	h.AssertPlayerLife("Bob", 17)
+	h.EndCombat()
+
+	// Cleanup step: the effect ends and Hill Giant returns to Bob
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	effects.CleanupEndOfTurnEffects(gameState.layerSystem)
+	h.engine.recomputeContinuousEffects(gameState)
+
+	card := gameState.cards[giant]
+	if card.ControllerID != "Bob" {
+		t.Fatalf("expected Hill Giant to return to Bob, got %s", card.ControllerID)
+	}
+
+	// Bob hasn't controlled it continuously since their turn began until their next turn starts
+	if !h.engine.isSummoningSick(gameState, card) {
+		t.Error("expected Hill Giant to be summoning sick after returning to Bob")
+	}
+	h.engine.clearSummoningSickness(gameState, "Bob")
+	if h.engine.isSummoningSick(gameState, card) {
+		t.Error("expected Hill Giant to lose summoning sickness at the start of Bob's turn")
+	}
+}
+
+// TestLayers_CountersAndShrinkEffects verifies that +1/+1 counters count toward a creature's effective
+// P/T alongside continuous effects, that views keep the printed P/T apart, and that a -3/-3 effect kills
+// a creature whose toughness it reduces to 0
+func TestLayers_CountersAndShrinkEffects(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-layers-counters", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+	bears := h.CreateCreature(CreatureSpec{ID: "bears", Name: "Grizzly Bears", Controller: "Alice", Power: "2", Toughness: "2"})
+
+	gameState.mu.Lock()
+	card := gameState.cards[bears]
+	gameState.battlefield = append(gameState.battlefield, card)
+	card.Counters.AddCounter(counters.CounterTypeP1P1.CreateInstance(1))
+	h.engine.checkStateBasedActions(gameState)
+	assertPT(t, gameState, bears, "3", "3")
+	view := h.engine.buildCardViews([]*internalCard{card})[0]
+	if view.Power != "3" || view.Toughness != "3" || view.PrintedPower != "2" || view.PrintedToughness != "2" {
+		t.Errorf("expected a 3/3 view printed as 2/2, got %s/%s printed %s/%s", view.Power, view.Toughness, view.PrintedPower, view.PrintedToughness)
+	}
+	gameState.mu.Unlock()
+
+	if _, err := h.engine.AddContinuousEffect(h.gameID, effects.NewEffectBuilder(bears).Targeting(bears).UntilEndOfTurn().Boost(-3, -3)); err != nil {
+		t.Fatalf("failed to add effect: %v", err)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	assertPT(t, gameState, bears, "0", "0")
+	h.engine.checkStateBasedActions(gameState)
+	if card.Zone != zoneGraveyard {
+		t.Fatalf("expected the 0/0 Grizzly Bears to die, got zone %d", card.Zone)
+	}
+	assertPT(t, gameState, bears, "2", "2")
+}
@@ -0,0 +1,45 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/counters"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestCounterAnnihilation_RemovesMatchingPairs verifies rule 704.5q: a permanent with 3 +1/+1 and
+// 2 -1/-1 counters ends up with a single +1/+1 counter, and the removals are announced
+func TestCounterAnnihilation_RemovesMatchingPairs(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-counter-annihilation", []string{"Alice", "Bob"})
+	bear := h.CreateCreature(CreatureSpec{ID: "bear", Name: "Grizzly Bears", Power: "2", Toughness: "2", Controller: "Alice"})
+
+	gameState := h.GetGameState()
+	removed := make(map[string]int)
+	gameState.eventBus.SubscribeTyped(rules.EventCounterRemoved, func(event rules.Event) {
+		removed[event.Metadata["counter_name"]] += event.Amount
+	})
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	card := gameState.cards[bear]
+	card.Counters.AddCounter(counters.CounterTypeP1P1.CreateInstance(3))
+	card.Counters.AddCounter(counters.CounterTypeM1M1.CreateInstance(2))
+	gameState.battlefield = append(gameState.battlefield, card)
+
+	if !h.engine.checkStateBasedActions(gameState) {
+		t.Fatal("expected the counters to be annihilated")
+	}
+
+	if plus, minus := card.Counters.GetCount("+1/+1"), card.Counters.GetCount("-1/-1"); plus != 1 || minus != 0 {
+		t.Errorf("expected 1 +1/+1 and no -1/-1 counters, got %d and %d", plus, minus)
+	}
+	if removed["+1/+1"] != 2 || removed["-1/-1"] != 2 {
+		t.Errorf("expected 2 counters of each kind removed, got %v", removed)
+	}
+	if card.Zone != zoneBattlefield {
+		t.Errorf("expected the creature to stay on the battlefield, got zone %d", card.Zone)
+	}
+	if h.engine.checkStateBasedActions(gameState) {
+		t.Error("expected nothing more to annihilate")
+	}
+}
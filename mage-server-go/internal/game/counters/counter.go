@@ -82,7 +82,7 @@ func formatBoost(value int) string {
 	if value > 0 {
 		return "+" + formatInt(value)
 	} else if value < 0 {
-		return formatInt(value)
+		return "-" + formatInt(-value)
 	}
 	return "±0"
 }
@@ -255,8 +255,11 @@ func parseBoostValue(s string) (int, bool) {
 	return value, true
 }
 
-// Copy creates a deep copy of the Counters collection.
+// Copy creates a deep copy of the Counters collection; a nil collection copies to nil.
 func (cs *Counters) Copy() *Counters {
+	if cs == nil {
+		return nil
+	}
 	copy := NewCounters()
 	for name, counter := range cs.Counters {
 		copy.Counters[name] = counter.Copy()
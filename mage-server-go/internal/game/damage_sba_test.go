@@ -0,0 +1,58 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestLethalDamageSBA_DestroysOutsideCombat verifies rule 704.5g: a creature with damage marked
+// greater than or equal to its toughness dies when state-based actions are checked, unless it's
+// indestructible, and marked damage is removed in the cleanup step
+func TestLethalDamageSBA_DestroysOutsideCombat(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-lethal-damage-sba", []string{"Alice", "Bob"})
+	bears := h.CreateCreature(CreatureSpec{ID: "bears", Name: "Grizzly Bears", Power: "2", Toughness: "2", Controller: "Bob"})
+	giant := h.CreateCreature(CreatureSpec{ID: "giant", Name: "Hill Giant", Power: "3", Toughness: "3", Controller: "Bob"})
+	golem := h.CreateCreature(CreatureSpec{
+		ID: "golem", Name: "Darksteel Myr", Power: "0", Toughness: "1", Controller: "Bob",
+		Abilities: []string{abilityIndestructible},
+	})
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	for id, damage := range map[string]int{bears: 2, giant: 2, golem: 3} {
+		card := gameState.cards[id]
+		card.Damage = damage
+		card.DamageSources = map[string]int{"shock": damage}
+		gameState.battlefield = append(gameState.battlefield, card)
+	}
+	h.engine.checkStateAndTriggered(gameState)
+	gameState.mu.Unlock()
+
+	h.AssertCreatureDead(bears)
+	h.AssertCreatureAlive(giant)
+	h.AssertCreatureAlive(golem)
+
+	// Damage wears off in the cleanup step
+	gameState.mu.Lock()
+	for gameState.turnManager.CurrentStep() != rules.StepEnd {
+		gameState.turnManager.AdvanceStep("Alice")
+	}
+	gameState.turnManager.SetPriority("Alice")
+	gameState.mu.Unlock()
+
+	for _, playerID := range []string{"Alice", "Bob"} {
+		if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: playerID, ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+			t.Fatalf("failed to pass priority: %v", err)
+		}
+	}
+
+	gameState.mu.RLock()
+	if step := gameState.turnManager.CurrentStep(); step != rules.StepCleanup {
+		t.Fatalf("expected the cleanup step, got %s", step)
+	}
+	gameState.mu.RUnlock()
+	h.AssertCreatureDamage(giant, 0)
+	h.AssertCreatureDamage(golem, 0)
+	h.AssertCreatureAlive(golem)
+}
@@ -0,0 +1,145 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestDealDamage_Player verifies that non-combat damage reduces a player's life and fires damage events
+func TestDealDamage_Player(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-deal-damage-player", []string{"Alice", "Bob"})
+	h.engine.SetDebugOperationsEnabled(true)
+
+	source := h.CreateAttacker("shock-source", "Prodigal Sorcerer", "Alice", "1", "1")
+
+	events := make([]rules.Event, 0)
+	h.GetGameState().eventBus.SubscribeTyped(rules.EventDamagedPlayer, func(evt rules.Event) {
+		events = append(events, evt)
+	})
+
+	if err := h.engine.DealDamage(h.gameID, source, "Bob", 3, false); err != nil {
+		t.Fatalf("failed to deal damage: %v", err)
+	}
+
+	h.AssertPlayerLife("Bob", 17)
+
+	if len(events) != 1 {
+		t.Fatalf("expected 1 EventDamagedPlayer, got %d", len(events))
+	}
+	if events[0].TargetID != "Bob" || events[0].SourceID != source || events[0].Amount != 3 {
+		t.Errorf("unexpected damage event: %+v", events[0])
+	}
+	if events[0].Flag {
+		t.Error("expected non-combat damage event (flag=false)")
+	}
+}
+
+// TestDealDamage_Creature verifies that damage is marked on creatures and lethal damage destroys them
+func TestDealDamage_Creature(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-deal-damage-creature", []string{"Alice", "Bob"})
+	h.engine.SetDebugOperationsEnabled(true)
+
+	source := h.CreateAttacker("shock-source", "Prodigal Sorcerer", "Alice", "1", "1")
+	survivor := h.CreateBlocker("survivor", "Wall of Wood", "Bob", "0", "4")
+	victim := h.CreateBlocker("victim", "Centaur Courser", "Bob", "3", "3")
+
+	events := make([]rules.Event, 0)
+	h.GetGameState().eventBus.SubscribeTyped(rules.EventDamagedPermanent, func(evt rules.Event) {
+		events = append(events, evt)
+	})
+
+	// 3 damage to a 0/4 is marked but not lethal
+	if err := h.engine.DealDamage(h.gameID, source, survivor, 3, false); err != nil {
+		t.Fatalf("failed to deal damage: %v", err)
+	}
+	h.AssertCreatureDamage(survivor, 3)
+	h.AssertCreatureAlive(survivor)
+
+	// 3 damage to a 3/3 is lethal
+	if err := h.engine.DealDamage(h.gameID, source, victim, 3, false); err != nil {
+		t.Fatalf("failed to deal damage: %v", err)
+	}
+	h.AssertCreatureDead(victim)
+
+	if len(events) != 2 {
+		t.Fatalf("expected 2 EventDamagedPermanent, got %d", len(events))
+	}
+	for _, evt := range events {
+		if evt.Amount != 3 || evt.SourceID != source {
+			t.Errorf("unexpected damage event: %+v", evt)
+		}
+	}
+}
+
+// TestDealDamage_RequiresDebugOperations verifies that DealDamage is gated behind debug operations
+func TestDealDamage_RequiresDebugOperations(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-deal-damage-gated", []string{"Alice", "Bob"})
+
+	if err := h.engine.DealDamage(h.gameID, "", "Bob", 3, false); err == nil {
+		t.Fatal("expected DealDamage to fail when debug operations are disabled")
+	}
+	h.AssertPlayerLife("Bob", 20)
+
+	h.engine.SetDebugOperationsEnabled(true)
+	if err := h.engine.DealDamage(h.gameID, "", "Bob", 0, false); err == nil {
+		t.Error("expected DealDamage to reject non-positive amounts")
+	}
+	if err := h.engine.DealDamage(h.gameID, "", "missing-target", 3, false); err == nil {
+		t.Error("expected DealDamage to reject unknown targets")
+	}
+}
+
+// TestDealDamage_LightningBoltResolves verifies that Lightning Bolt deals 3 damage to its target as it
+// resolves, to a player or to a creature
+func TestDealDamage_LightningBoltResolves(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-deal-damage-bolt", []string{"Alice", "Bob"})
+	ogre := h.CreateBlocker("bob-ogre", "Hill Giant", "Bob", "3", "3")
+	castTestSetup(t, h, "Alice", "bolt-1", "Lightning Bolt", "Instant", rules.StepMain1)
+	castTestSetup(t, h, "Alice", "bolt-2", "Lightning Bolt", "Instant", rules.StepMain1)
+
+	gameState := h.GetGameState()
+	bolts := make([]string, 0)
+	gameState.mu.Lock()
+	gameState.battlefield = append(gameState.battlefield, gameState.cards[ogre])
+	// The starting hand has Lightning Bolts of its own; whichever is cast needs its printed rules text
+	for _, card := range gameState.players["Alice"].Hand {
+		if card.Name == "Lightning Bolt" {
+			card.RulesText = "Lightning Bolt deals 3 damage to any target."
+			bolts = append(bolts, card.ID)
+		}
+	}
+	gameState.mu.Unlock()
+	for _, bolt := range bolts {
+		if err := h.engine.RegisterSpellEffect(h.gameID, bolt, h.engine.damageTargetEffect(3)); err != nil {
+			t.Fatalf("failed to register Lightning Bolt's effect: %v", err)
+		}
+	}
+
+	resolve := func(target string) {
+		t.Helper()
+		if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Alice", ActionType: "SEND_STRING", Data: "Lightning Bolt", Targets: []string{target}}); err != nil {
+			t.Fatalf("failed to cast Lightning Bolt: %v", err)
+		}
+		for i := 0; i < 10; i++ {
+			gameState.mu.RLock()
+			empty := gameState.stack.IsEmpty()
+			priority := gameState.turnManager.PriorityPlayer()
+			gameState.mu.RUnlock()
+			if empty {
+				return
+			}
+			if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: priority, ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+				t.Fatalf("failed to pass priority: %v", err)
+			}
+		}
+		t.Fatal("expected Lightning Bolt to resolve")
+	}
+
+	resolve("Bob")
+	h.AssertPlayerLife("Bob", 17)
+
+	resolve(ogre)
+	h.AssertCreatureDead(ogre)
+	h.AssertPlayerLife("Bob", 17)
+}
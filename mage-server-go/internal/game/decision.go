@@ -0,0 +1,418 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/magefree/mage-server-go/internal/game/targeting"
+	"go.uber.org/zap"
+)
+
+// DecisionKind identifies what kind of answer a decision expects
+type DecisionKind string
+
+const (
+	// DecisionChooseTarget asks a player to choose targets from a set of legal target IDs
+	DecisionChooseTarget DecisionKind = "choose_target"
+	// DecisionChooseNumber asks a player to choose a number within a range (e.g. X)
+	DecisionChooseNumber DecisionKind = "choose_number"
+	// DecisionYesNo asks a player a yes/no question (e.g. "may" abilities)
+	DecisionYesNo DecisionKind = "yes_no"
+	// DecisionOrderList asks a player to put a list of items in order (e.g. triggers, blockers)
+	DecisionOrderList DecisionKind = "order_list"
+	// DecisionChooseCards asks a player to choose cards from a set of card IDs
+	DecisionChooseCards DecisionKind = "choose_cards"
+	// DecisionChooseColor asks a player to choose a color (e.g. for "add one mana of any color")
+	DecisionChooseColor DecisionKind = "choose_color"
+	// DecisionPriority tells a player they have priority; answering it passes (rule 117.3d). Casting
+	// spells and activating abilities are actions of their own.
+	DecisionPriority DecisionKind = "priority"
+	// DecisionDeclareAttackers asks the attacking player which creatures attack (rule 508.1a)
+	DecisionDeclareAttackers DecisionKind = "declare_attackers"
+	// DecisionDeclareBlockers asks a defending player which creatures block (rule 509.1a)
+	DecisionDeclareBlockers DecisionKind = "declare_blockers"
+)
+
+// Decision is a typed request for player input.
+// Clients should answer it with RespondToDecision; Text is kept as a human-readable fallback.
+type Decision struct {
+	ID       string
+	PlayerID string
+	Kind     DecisionKind
+	Text     string
+	// Choices holds the legal answers: target/card IDs for choose_target and choose_cards,
+	// the colors for choose_color, the items to order for order_list, "creatureID:defenderID" attacks
+	// for declare_attackers and "blockerID:attackerID" blocks for declare_blockers. Empty for
+	// choose_number, yes_no and priority.
+	Choices []string
+	// Min and Max constrain the answer: number of choices for choose_target/choose_cards/choose_color,
+	// the allowed range for choose_number. Unused for the other kinds.
+	Min       int
+	Max       int
+	Timestamp time.Time
+
+	// resolve is called with the validated response while the game lock is held
+	resolve func(gameState *engineGameState, response Response) error
+}
+
+// Response is a player's answer to a Decision
+type Response struct {
+	// Chosen IDs (choose_target, choose_cards), color (choose_color), the full ordering (order_list) or
+	// the attacks or blocks declared (declare_attackers, declare_blockers; none = no attackers or blockers)
+	Choices []string
+	Number  int  // Chosen number (choose_number)
+	Yes     bool // Answer to a yes_no decision
+}
+
+// validate checks that a response satisfies the decision's constraints
+func (d *Decision) validate(response Response) error {
+	switch d.Kind {
+	case DecisionChooseTarget, DecisionChooseCards, DecisionChooseColor:
+		if len(response.Choices) < d.Min || len(response.Choices) > d.Max {
+			return fmt.Errorf("expected between %d and %d choices, got %d", d.Min, d.Max, len(response.Choices))
+		}
+		seen := make(map[string]bool, len(response.Choices))
+		for _, choice := range response.Choices {
+			if seen[choice] {
+				return fmt.Errorf("%s chosen more than once", choice)
+			}
+			seen[choice] = true
+			if !containsString(d.Choices, choice) {
+				return fmt.Errorf("%s is not a legal choice", choice)
+			}
+		}
+	case DecisionChooseNumber:
+		if response.Number < d.Min || response.Number > d.Max {
+			return fmt.Errorf("number %d is outside the allowed range %d-%d", response.Number, d.Min, d.Max)
+		}
+	case DecisionOrderList:
+		if len(response.Choices) != len(d.Choices) {
+			return fmt.Errorf("expected all %d items to be ordered, got %d", len(d.Choices), len(response.Choices))
+		}
+		seen := make(map[string]bool, len(response.Choices))
+		for _, item := range response.Choices {
+			if seen[item] || !containsString(d.Choices, item) {
+				return fmt.Errorf("invalid ordering: %s", strings.Join(response.Choices, ", "))
+			}
+			seen[item] = true
+		}
+	case DecisionDeclareAttackers, DecisionDeclareBlockers:
+		seen := make(map[string]bool, len(response.Choices))
+		attackers := make(map[string]bool, len(response.Choices))
+		for _, choice := range response.Choices {
+			if seen[choice] {
+				return fmt.Errorf("%s chosen more than once", choice)
+			}
+			seen[choice] = true
+			if !containsString(d.Choices, choice) {
+				return fmt.Errorf("%s is not a legal choice", choice)
+			}
+			// Per rule 508.1b: each attacking creature attacks one player, planeswalker or battle
+			if d.Kind == DecisionDeclareAttackers {
+				creatureID, _, _ := strings.Cut(choice, ":")
+				if attackers[creatureID] {
+					return fmt.Errorf("%s can attack only one defender", creatureID)
+				}
+				attackers[creatureID] = true
+			}
+		}
+	case DecisionYesNo, DecisionPriority:
+		// Any response answers the decision
+	default:
+		return fmt.Errorf("unknown decision kind %q", d.Kind)
+	}
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// addDecision registers a pending decision and adds a text prompt as fallback for clients
+// that don't understand typed decisions
+func (s *engineGameState) addDecision(decision *Decision) *Decision {
+	if decision.ID == "" {
+		decision.ID = s.newDecisionID(func() string { return uuid.New().String() })
+	}
+	decision.Timestamp = time.Now()
+	s.decisions[decision.ID] = decision
+
+	options := decision.Choices
+	switch decision.Kind {
+	case DecisionYesNo:
+		options = []string{"YES", "NO"}
+	case DecisionPriority:
+		options = []string{"PASS"}
+	}
+	s.prompts = append(s.prompts, EnginePrompt{
+		PlayerID:   decision.PlayerID,
+		DecisionID: decision.ID,
+		Kind:       decision.Kind,
+		Text:       decision.Text,
+		Options:    options,
+		Timestamp:  decision.Timestamp,
+	})
+	return decision
+}
+
+// removeDecision drops a pending decision and its fallback prompt
+func (s *engineGameState) removeDecision(decisionID string) {
+	if decisionID == "" {
+		return
+	}
+	delete(s.decisions, decisionID)
+	prompts := s.prompts[:0]
+	for _, prompt := range s.prompts {
+		if prompt.DecisionID != decisionID {
+			prompts = append(prompts, prompt)
+		}
+	}
+	s.prompts = prompts
+}
+
+// syncDecisions reconciles pending decisions with restored prompts.
+// Decisions carry callbacks and are not snapshotted, so after a restore only decisions whose
+// prompt survived are kept, and prompts for decisions that were already resolved are dropped.
+func (s *engineGameState) syncDecisions() {
+	restored := make(map[string]bool)
+	prompts := make([]EnginePrompt, 0, len(s.prompts))
+	for _, prompt := range s.prompts {
+		if prompt.DecisionID != "" {
+			if _, ok := s.decisions[prompt.DecisionID]; !ok {
+				continue
+			}
+			restored[prompt.DecisionID] = true
+		}
+		prompts = append(prompts, prompt)
+	}
+	s.prompts = prompts
+
+	for decisionID := range s.decisions {
+		if !restored[decisionID] {
+			delete(s.decisions, decisionID)
+		}
+	}
+}
+
+// pendingDecisionsFor returns copies of the pending decisions for a player, oldest first
+func (s *engineGameState) pendingDecisionsFor(playerID string) []Decision {
+	result := make([]Decision, 0)
+	for _, prompt := range s.prompts {
+		if prompt.DecisionID == "" || prompt.PlayerID != playerID {
+			continue
+		}
+		if decision, ok := s.decisions[prompt.DecisionID]; ok {
+			view := *decision
+			view.Choices = append([]string(nil), decision.Choices...)
+			view.resolve = nil
+			result = append(result, view)
+		}
+	}
+	return result
+}
+
+// requestTargetDecision asks a player to choose targets for a requirement.
+// The decision carries the IDs of every legal target at the time it is created.
+func (e *MageEngine) requestTargetDecision(gameState *engineGameState, playerID, text string, requirement targeting.TargetRequirement, onChosen func(gameState *engineGameState, targets []string) error) *Decision {
+	return gameState.addDecision(&Decision{
+		PlayerID: playerID,
+		Kind:     DecisionChooseTarget,
+		Text:     text,
+		Choices:  e.legalTargets(gameState, playerID, requirement),
+		Min:      requirement.MinTargets,
+		Max:      requirement.MaxTargets,
+		resolve: func(gameState *engineGameState, response Response) error {
+			if onChosen == nil {
+				return nil
+			}
+			return onChosen(gameState, response.Choices)
+		},
+	})
+}
+
+// requestNumberDecision asks a player to choose a number in [min, max]
+func (e *MageEngine) requestNumberDecision(gameState *engineGameState, playerID, text string, min, max int, onChosen func(gameState *engineGameState, value int) error) *Decision {
+	return gameState.addDecision(&Decision{
+		PlayerID: playerID,
+		Kind:     DecisionChooseNumber,
+		Text:     fmt.Sprintf("%s (%d-%d)", text, min, max),
+		Min:      min,
+		Max:      max,
+		resolve: func(gameState *engineGameState, response Response) error {
+			if onChosen == nil {
+				return nil
+			}
+			return onChosen(gameState, response.Number)
+		},
+	})
+}
+
+// requestPriorityDecision tells a player they have priority, replacing the priority decision of whoever
+// had it before; answering it passes priority
+func (e *MageEngine) requestPriorityDecision(gameState *engineGameState, playerID, text string) {
+	gameState.removeDecision(gameState.priorityDecisionID)
+	decision := gameState.addDecision(&Decision{
+		PlayerID: playerID,
+		Kind:     DecisionPriority,
+		Text:     text,
+		resolve: func(gameState *engineGameState, response Response) error {
+			return e.handlePass(gameState, playerID)
+		},
+	})
+	gameState.priorityDecisionID = decision.ID
+}
+
+// addDeclaration adds a declare attackers or blockers decision; declarations not answered by the end
+// of their step are dropped (see clearDeclarations)
+func (s *engineGameState) addDeclaration(decision *Decision) {
+	s.addDecision(decision)
+	s.declarations = append(s.declarations, decision.ID)
+}
+
+// pendingDeclarations reports whether a declare attackers or blockers decision is still unanswered
+func (s *engineGameState) pendingDeclarations() bool {
+	for _, decisionID := range s.declarations {
+		if _, pending := s.decisions[decisionID]; pending {
+			return true
+		}
+	}
+	return false
+}
+
+// clearDeclarations drops the unanswered declare attackers and blockers decisions
+func (s *engineGameState) clearDeclarations() {
+	for _, decisionID := range s.declarations {
+		s.removeDecision(decisionID)
+	}
+	s.declarations = nil
+}
+
+// legalTargets returns the IDs of all players and objects within a player's range of influence that
+// satisfy a target requirement
+func (e *MageEngine) legalTargets(gameState *engineGameState, playerID string, requirement targeting.TargetRequirement) []string {
+	targets := make([]string, 0)
+	if gameState.targetValidator == nil {
+		return targets
+	}
+
+	if requirement.Type == targeting.TargetTypePlayer || requirement.Type == targeting.TargetTypeAny {
+		for _, targetID := range gameState.playerOrder {
+			if gameState.inRangeOf(playerID, targetID) && gameState.targetValidator.ValidateTarget(targetID, requirement) == nil {
+				targets = append(targets, targetID)
+			}
+		}
+		if requirement.Type == targeting.TargetTypePlayer {
+			return targets
+		}
+	}
+	players := len(targets)
+
+	zone := zoneBattlefield
+	if requirement.Type == targeting.TargetTypeSpell {
+		zone = zoneStack
+	}
+	for _, card := range gameState.cards {
+		if card.Zone != zone || !gameState.objectInRangeOf(playerID, card.ID) {
+			continue
+		}
+		if gameState.targetValidator.ValidateTarget(card.ID, requirement) == nil {
+			targets = append(targets, card.ID)
+		}
+	}
+	sort.Strings(targets[players:])
+	return targets
+}
+
+// RespondToDecision answers a pending decision.
+// The response is validated against the decision's kind and constraints before it is applied.
+func (e *MageEngine) RespondToDecision(gameID, playerID, decisionID string, response Response) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "RespondToDecision", playerID, decisionID, response)
+
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+
+	if err := e.respondToDecision(gameState, playerID, decisionID, response); err != nil {
+		return err
+	}
+
+	e.notifyPlayerAction(gameID, playerID, map[string]interface{}{
+		"type":        "decision_response",
+		"decision_id": decisionID,
+	})
+	return nil
+}
+
+// respondToDecision validates and resolves a decision; caller must hold the game lock. If resolving it
+// fails, the game is restored to a bookmark taken before and the decision stays pending, like a failed
+// action in ProcessAction, so a failed response leaves nothing behind.
+func (e *MageEngine) respondToDecision(gameState *engineGameState, playerID, decisionID string, response Response) error {
+	decision, ok := gameState.decisions[decisionID]
+	if !ok {
+		return fmt.Errorf("decision %s not found", decisionID)
+	}
+	if decision.PlayerID != playerID {
+		return fmt.Errorf("decision %s belongs to player %s", decisionID, decision.PlayerID)
+	}
+	if err := decision.validate(response); err != nil {
+		return fmt.Errorf("invalid response to decision %s: %w", decisionID, err)
+	}
+
+	if decision.resolve == nil {
+		gameState.removeDecision(decisionID)
+		return nil
+	}
+
+	bookmarkID := e.bookmarkState(gameState)
+	gameState.removeDecision(decisionID)
+	if err := decision.resolve(gameState, response); err != nil {
+		// Decisions aren't part of a bookmark: the restore drops the ones resolving asked, and this
+		// one is asked again
+		if restoreErr := e.restoreState(gameState, bookmarkID, fmt.Sprintf("Error recovery: %v", err)); restoreErr != nil && e.logger != nil {
+			e.logger.Error("failed to restore state after decision error",
+				zap.String("game_id", gameState.gameID),
+				zap.String("decision_id", decisionID),
+				zap.Error(err),
+				zap.Error(restoreErr),
+			)
+		}
+		gameState.addDecision(decision)
+		return err
+	}
+	e.RemoveBookmark(gameState.gameID, bookmarkID)
+	return nil
+}
+
+// GetPendingDecisions returns the decisions a player still has to answer
+func (e *MageEngine) GetPendingDecisions(gameID, playerID string) ([]Decision, error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+
+	return gameState.pendingDecisionsFor(playerID), nil
+}
@@ -0,0 +1,371 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+	"github.com/magefree/mage-server-go/internal/game/targeting"
+)
+
+// TestDecision_TargetChoiceCarriesLegalTargets verifies that a choose_target decision lists
+// only legal target IDs and shows up in the player's view
+func TestDecision_TargetChoiceCarriesLegalTargets(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-decision-targets", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	bear := h.CreateCreature(CreatureSpec{ID: "bear", Name: "Grizzly Bears", Controller: "Bob", Power: "2", Toughness: "2"})
+	elf := h.CreateCreature(CreatureSpec{ID: "elf", Name: "Llanowar Elves", Controller: "Alice", Power: "1", Toughness: "1"})
+	// A creature card in the graveyard is not a legal target
+	dead := h.CreateCreature(CreatureSpec{ID: "dead", Name: "Hill Giant", Controller: "Bob", Power: "3", Toughness: "3"})
+
+	gameState.mu.Lock()
+	gameState.cards[dead].Zone = zoneGraveyard
+	decision := h.engine.requestTargetDecision(gameState, "Alice", "Choose target creature", targeting.TargetRequirement{
+		Type:       targeting.TargetTypeCreature,
+		MinTargets: 1,
+		MaxTargets: 1,
+	}, nil)
+	gameState.mu.Unlock()
+
+	if decision.Kind != DecisionChooseTarget {
+		t.Fatalf("expected choose_target decision, got %s", decision.Kind)
+	}
+	if len(decision.Choices) != 2 || !containsString(decision.Choices, bear) || !containsString(decision.Choices, elf) {
+		t.Errorf("expected legal targets [%s %s], got %v", bear, elf, decision.Choices)
+	}
+	if decision.Min != 1 || decision.Max != 1 {
+		t.Errorf("expected 1-1 targets, got %d-%d", decision.Min, decision.Max)
+	}
+
+	viewAny, err := h.engine.GetGameView(h.gameID, "Alice")
+	if err != nil {
+		t.Fatalf("failed to get game view: %v", err)
+	}
+	view := viewAny.(*EngineGameView)
+	if len(view.Decisions) != 1 || view.Decisions[0].ID != decision.ID {
+		t.Fatalf("expected pending decision %s in Alice's view, got %+v", decision.ID, view.Decisions)
+	}
+
+	// The text prompt is kept as a fallback and references the decision
+	found := false
+	for _, prompt := range view.Prompts {
+		if prompt.DecisionID == decision.ID && prompt.Text == "Choose target creature" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("expected fallback text prompt for the decision")
+	}
+
+	bobView, err := h.engine.GetGameView(h.gameID, "Bob")
+	if err != nil {
+		t.Fatalf("failed to get game view: %v", err)
+	}
+	if len(bobView.(*EngineGameView).Decisions) != 0 {
+		t.Error("expected Alice's decision to be hidden from Bob's decisions")
+	}
+}
+
+// TestDecision_RespondResolvesDecision verifies that responding validates the answer,
+// runs the decision's callback and removes it from the pending decisions
+func TestDecision_RespondResolvesDecision(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-decision-respond", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	bear := h.CreateCreature(CreatureSpec{ID: "bear", Name: "Grizzly Bears", Controller: "Bob", Power: "2", Toughness: "2"})
+
+	var chosen []string
+	gameState.mu.Lock()
+	decision := h.engine.requestTargetDecision(gameState, "Alice", "Choose target creature", targeting.TargetRequirement{
+		Type:       targeting.TargetTypeCreature,
+		MinTargets: 1,
+		MaxTargets: 1,
+	}, func(gs *engineGameState, targets []string) error {
+		chosen = targets
+		return nil
+	})
+	gameState.mu.Unlock()
+
+	if err := h.engine.RespondToDecision(h.gameID, "Bob", decision.ID, Response{Choices: []string{bear}}); err == nil {
+		t.Error("expected response from another player to be rejected")
+	}
+	if err := h.engine.RespondToDecision(h.gameID, "Alice", decision.ID, Response{Choices: []string{"Bob"}}); err == nil {
+		t.Error("expected illegal target to be rejected")
+	}
+	if err := h.engine.RespondToDecision(h.gameID, "Alice", decision.ID, Response{}); err == nil {
+		t.Error("expected response with too few targets to be rejected")
+	}
+	if chosen != nil {
+		t.Fatal("expected callback not to run for rejected responses")
+	}
+
+	if err := h.engine.RespondToDecision(h.gameID, "Alice", decision.ID, Response{Choices: []string{bear}}); err != nil {
+		t.Fatalf("failed to respond to decision: %v", err)
+	}
+	if len(chosen) != 1 || chosen[0] != bear {
+		t.Errorf("expected callback to receive [%s], got %v", bear, chosen)
+	}
+
+	pending, err := h.engine.GetPendingDecisions(h.gameID, "Alice")
+	if err != nil {
+		t.Fatalf("failed to get pending decisions: %v", err)
+	}
+	if len(pending) != 0 {
+		t.Errorf("expected no pending decisions, got %d", len(pending))
+	}
+	if err := h.engine.RespondToDecision(h.gameID, "Alice", decision.ID, Response{Choices: []string{bear}}); err == nil {
+		t.Error("expected resolved decision to reject further responses")
+	}
+}
+
+// TestDecision_IntegerAnswersNumberDecision verifies that SEND_INTEGER answers a pending choose_number decision
+func TestDecision_IntegerAnswersNumberDecision(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-decision-number", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	chosen := -1
+	gameState.mu.Lock()
+	h.engine.requestNumberDecision(gameState, "Alice", "Choose X", 0, 5, func(gs *engineGameState, value int) error {
+		chosen = value
+		return nil
+	})
+	gameState.mu.Unlock()
+
+	if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Alice", ActionType: "SEND_INTEGER", Data: 7}); err == nil {
+		t.Error("expected out-of-range number to be rejected")
+	}
+	if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Alice", ActionType: "SEND_INTEGER", Data: 3}); err != nil {
+		t.Fatalf("failed to send integer: %v", err)
+	}
+	if chosen != 3 {
+		t.Errorf("expected decision to resolve with 3, got %d", chosen)
+	}
+}
+
+// pendingDecisionOfKind returns a player's pending decision of a kind, failing the test if there isn't one
+func pendingDecisionOfKind(t *testing.T, h *CombatTestHarness, playerID string, kind DecisionKind) Decision {
+	t.Helper()
+	decisions, err := h.engine.GetPendingDecisions(h.gameID, playerID)
+	if err != nil {
+		t.Fatalf("failed to get pending decisions: %v", err)
+	}
+	for _, decision := range decisions {
+		if decision.Kind == kind {
+			return decision
+		}
+	}
+	t.Fatalf("expected %s to have a pending %s decision, got %+v", playerID, kind, decisions)
+	return Decision{}
+}
+
+// TestDecision_FailedResolveChangesNothing verifies that a decision whose resolution fails leaves the
+// game as it was and stays pending
+func TestDecision_FailedResolveChangesNothing(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-decision-failed", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	decision := h.engine.requestNumberDecision(gameState, "Alice", "Lose life", 0, 5, func(gs *engineGameState, value int) error {
+		gs.players["Alice"].Life -= value
+		gs.addDecision(&Decision{PlayerID: "Bob", Kind: DecisionYesNo, Text: "Follow-up"})
+		if value > 2 {
+			return fmt.Errorf("too much life lost")
+		}
+		return nil
+	})
+	life := gameState.players["Alice"].Life
+	gameState.mu.Unlock()
+
+	if err := h.engine.RespondToDecision(h.gameID, "Alice", decision.ID, Response{Number: 4}); err == nil {
+		t.Fatal("expected the failed resolution to be returned")
+	}
+	if got := h.GetPlayerLife("Alice"); got != life {
+		t.Errorf("expected Alice's life to be restored to %d, got %d", life, got)
+	}
+	pendingDecisionOfKind(t, h, "Alice", DecisionChooseNumber)
+	if decisions, _ := h.engine.GetPendingDecisions(h.gameID, "Bob"); len(decisions) != 0 {
+		t.Errorf("expected the decision asked while resolving to be dropped, got %+v", decisions)
+	}
+
+	if err := h.engine.RespondToDecision(h.gameID, "Alice", decision.ID, Response{Number: 1}); err != nil {
+		t.Fatalf("failed to answer the decision again: %v", err)
+	}
+	if got := h.GetPlayerLife("Alice"); got != life-1 {
+		t.Errorf("expected Alice at %d life, got %d", life-1, got)
+	}
+}
+
+// TestDecision_PriorityAnsweredByPassing verifies that the player given priority gets a priority decision,
+// that answering it passes priority, and that passing with an action drops it
+func TestDecision_PriorityAnsweredByPassing(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-decision-priority", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+	gameState.mu.RLock()
+	step := gameState.turnManager.CurrentStep()
+	gameState.mu.RUnlock()
+
+	if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Alice", ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+		t.Fatalf("failed to pass priority: %v", err)
+	}
+	decision := pendingDecisionOfKind(t, h, "Bob", DecisionPriority)
+	if err := h.engine.RespondToDecision(h.gameID, "Bob", decision.ID, Response{}); err != nil {
+		t.Fatalf("failed to answer the priority decision: %v", err)
+	}
+
+	gameState.mu.RLock()
+	if gameState.turnManager.CurrentStep() == step {
+		t.Errorf("expected both players passing to end %s", step)
+	}
+	if _, pending := gameState.decisions[decision.ID]; pending {
+		t.Error("expected the answered priority decision to be gone")
+	}
+	gameState.mu.RUnlock()
+
+	if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Alice", ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+		t.Fatalf("failed to pass priority: %v", err)
+	}
+	decision = pendingDecisionOfKind(t, h, "Bob", DecisionPriority)
+	if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Bob", ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+		t.Fatalf("failed to pass priority: %v", err)
+	}
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if _, pending := gameState.decisions[decision.ID]; pending {
+		t.Error("expected passing with an action to drop the priority decision")
+	}
+}
+
+// TestDecision_DeclareAttackersAndBlockers verifies that attackers and blockers are declared by answering
+// the declare attackers and declare blockers decisions
+func TestDecision_DeclareAttackersAndBlockers(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-decision-combat", []string{"Alice", "Bob"})
+	attacker := h.CreateAttacker("bears", "Grizzly Bears", "Alice", "2", "2")
+	blocker := h.CreateBlocker("wall", "Wall of Stone", "Bob", "0", "8")
+	h.SetupCombat("Alice")
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	h.engine.handleCombatStepBegin(gameState, rules.StepDeclareAttackers, "Alice")
+	gameState.mu.Unlock()
+
+	attack := pendingDecisionOfKind(t, h, "Alice", DecisionDeclareAttackers)
+	if !equalStrings(attack.Choices, []string{attacker + ":Bob"}) {
+		t.Fatalf("expected Grizzly Bears to be able to attack Bob, got %v", attack.Choices)
+	}
+	if err := h.engine.RespondToDecision(h.gameID, "Alice", attack.ID, Response{Choices: []string{blocker + ":Alice"}}); err == nil {
+		t.Error("expected an attack that isn't a choice to be rejected")
+	}
+	if err := h.engine.RespondToDecision(h.gameID, "Alice", attack.ID, Response{Choices: attack.Choices}); err != nil {
+		t.Fatalf("failed to declare attackers: %v", err)
+	}
+	if !h.IsCreatureAttacking(attacker) {
+		t.Fatal("expected Grizzly Bears to be attacking")
+	}
+
+	gameState.mu.Lock()
+	h.engine.handleCombatStepBegin(gameState, rules.StepDeclareBlockers, "Alice")
+	gameState.mu.Unlock()
+
+	block := pendingDecisionOfKind(t, h, "Bob", DecisionDeclareBlockers)
+	if !equalStrings(block.Choices, []string{blocker + ":" + attacker}) {
+		t.Fatalf("expected Wall of Stone to be able to block Grizzly Bears, got %v", block.Choices)
+	}
+	if err := h.engine.RespondToDecision(h.gameID, "Bob", block.ID, Response{Choices: block.Choices}); err != nil {
+		t.Fatalf("failed to declare blockers: %v", err)
+	}
+	if !h.IsCreatureBlocking(blocker) {
+		t.Fatal("expected Wall of Stone to be blocking")
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if gameState.pendingDeclarations() {
+		t.Error("expected no declarations to be pending once blockers are accepted")
+	}
+	if group := gameState.combat.blockingGroups[blocker]; group == nil || !group.blocked {
+		t.Error("expected Grizzly Bears to be blocked")
+	}
+}
+
+// TestDecision_IllegalBlockIsDeclaredAgain verifies that a single block of a menace attacker answered
+// through the declare blockers decision is refused without declaring anything, and that the same
+// decision then takes a corrected block
+func TestDecision_IllegalBlockIsDeclaredAgain(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-decision-menace", []string{"Alice", "Bob"})
+	attacker := h.CreateCreature(CreatureSpec{
+		ID:         "menace",
+		Name:       "Menace Creature",
+		Power:      "3",
+		Toughness:  "3",
+		Controller: "Alice",
+		Abilities:  []string{abilityMenace},
+	})
+	bears := h.CreateBlocker("bears", "Grizzly Bears", "Bob", "2", "2")
+	wall := h.CreateBlocker("wall", "Wall of Stone", "Bob", "0", "8")
+	h.SetupCombat("Alice")
+	h.DeclareAttacker(attacker, "Bob", "Alice")
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	h.engine.handleCombatStepBegin(gameState, rules.StepDeclareBlockers, "Alice")
+	gameState.mu.Unlock()
+
+	block := pendingDecisionOfKind(t, h, "Bob", DecisionDeclareBlockers)
+	err := h.engine.RespondToDecision(h.gameID, "Bob", block.ID, Response{Choices: []string{bears + ":" + attacker}})
+	if err == nil || !strings.Contains(err.Error(), "illegal block") {
+		t.Fatalf("expected a single block of a menace attacker to be refused, got %v", err)
+	}
+	if h.IsCreatureBlocking(bears) {
+		t.Error("expected the refused block not to be declared")
+	}
+
+	retry := pendingDecisionOfKind(t, h, "Bob", DecisionDeclareBlockers)
+	if retry.ID != block.ID || !equalStrings(retry.Choices, block.Choices) {
+		t.Fatalf("expected the same decision with the same choices to be pending, got %+v", retry)
+	}
+	decisions, err := h.engine.GetPendingDecisions(h.gameID, "Bob")
+	if err != nil {
+		t.Fatalf("failed to get pending decisions: %v", err)
+	}
+	if len(decisions) != 1 {
+		t.Errorf("expected only the declare blockers decision to be pending, got %+v", decisions)
+	}
+
+	corrected := []string{bears + ":" + attacker, wall + ":" + attacker}
+	if err := h.engine.RespondToDecision(h.gameID, "Bob", block.ID, Response{Choices: corrected}); err != nil {
+		t.Fatalf("failed to declare two blockers: %v", err)
+	}
+	if !h.IsCreatureBlocking(bears) || !h.IsCreatureBlocking(wall) {
+		t.Error("expected both creatures to be blocking")
+	}
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if gameState.pendingDeclarations() {
+		t.Error("expected no declarations to be pending once blockers are accepted")
+	}
+}
+
+// TestDecision_UnansweredDeclarationEndsWithItsStep verifies that a declare attackers decision isn't left
+// pending once its step is over
+func TestDecision_UnansweredDeclarationEndsWithItsStep(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-decision-declaration-step", []string{"Alice", "Bob"})
+	h.CreateAttacker("bears", "Grizzly Bears", "Alice", "2", "2")
+	h.SetupCombat("Alice")
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	h.engine.handleCombatStepBegin(gameState, rules.StepDeclareAttackers, "Alice")
+	gameState.mu.Unlock()
+	attack := pendingDecisionOfKind(t, h, "Alice", DecisionDeclareAttackers)
+
+	for _, playerID := range []string{"Alice", "Bob"} {
+		if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: playerID, ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+			t.Fatalf("failed to pass priority: %v", err)
+		}
+	}
+	if err := h.engine.RespondToDecision(h.gameID, "Alice", attack.ID, Response{}); err == nil {
+		t.Error("expected the declare attackers decision to end with its step")
+	}
+}
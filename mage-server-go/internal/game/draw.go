@@ -0,0 +1,88 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// DrawCard has a player draw count cards from the top of their library.
+// A player who attempts to draw from an empty library doesn't lose right away: the attempt is
+// recorded and they lose the next time state-based actions are checked (rule 704.5b), so players
+// who draw from empty libraries at the same time lose simultaneously.
+// Per Java PlayerImpl.drawCards()
+func (e *MageEngine) DrawCard(gameID, playerID string, count int) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "DrawCard", playerID, count)
+
+	if count < 0 {
+		return fmt.Errorf("can't draw a negative number of cards, got %d", count)
+	}
+	player, exists := gameState.players[playerID]
+	if !exists {
+		return fmt.Errorf("player %s not found", playerID)
+	}
+	if player.Lost || player.Left {
+		return fmt.Errorf("player %s is no longer in the game", playerID)
+	}
+
+	e.drawCards(gameState, player, count)
+	return nil
+}
+
+// drawCards moves cards from the top of a player's library to their hand one at a time and returns
+// the number drawn; caller must hold the game lock
+// Per rule 121.2: cards are drawn one at a time
+func (e *MageEngine) drawCards(gameState *engineGameState, player *internalPlayer, count int) int {
+	drawn := 0
+	for i := 0; i < count; i++ {
+		if len(player.Library) == 0 {
+			// Per rule 121.4: the draw fails and the player loses when state-based actions are checked
+			player.DrawFromEmptyLibrary = true
+			gameState.addMessage(fmt.Sprintf("%s attempts to draw from an empty library", player.Name), "action")
+			break
+		}
+
+		card := player.Library[0]
+		player.Library = player.Library[1:]
+		card.Zone = zoneHand
+		player.Hand = append(player.Hand, card)
+		drawn++
+
+		gameState.eventBus.Publish(rules.NewEvent(rules.EventDrewCard, card.ID, card.ID, player.PlayerID))
+	}
+
+	if drawn > 0 {
+		gameState.addMessage(fmt.Sprintf("%s draws %d card(s)", player.Name, drawn), "action")
+	}
+	return drawn
+}
+
+// handleDrawStepBegin has the active player draw a card as the draw step begins, unless it's the
+// starting player's first turn and the game skips that draw (rule 103.8a)
+// Per Java DrawStep.beginStep()
+func (e *MageEngine) handleDrawStepBegin(gameState *engineGameState, step rules.Step, activePlayerID string) {
+	if step != rules.StepDraw {
+		return
+	}
+	player, exists := gameState.players[activePlayerID]
+	if !exists || player.Lost || player.Left {
+		return
+	}
+
+	gameState.eventBus.Publish(rules.NewEvent(rules.EventDrawStep, "", "", activePlayerID))
+	if gameState.rulesOptions.SkipFirstDraw && gameState.turnManager.TurnNumber() == 1 {
+		gameState.addMessage(fmt.Sprintf("%s skips the draw on the first turn", player.Name), "action")
+		return
+	}
+	e.drawCards(gameState, player, 1)
+}
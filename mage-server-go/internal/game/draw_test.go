@@ -0,0 +1,98 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestDrawCard_EmptyLibraryLosesAsStateBasedAction verifies that drawing moves cards from the top of the
+// library to the hand, and that players who draw from empty libraries lose together when state-based
+// actions are next checked rather than at the moment of the draw
+func TestDrawCard_EmptyLibraryLosesAsStateBasedAction(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-draw-empty-library", []string{"Alice", "Bob"})
+
+	topID := h.CreateCreature(CreatureSpec{ID: "top", Name: "Grizzly Bears", Power: "2", Toughness: "2", Controller: "Alice"})
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	alice, bob := gameState.players["Alice"], gameState.players["Bob"]
+	top := gameState.cards[topID]
+	top.Zone = zoneLibrary
+	alice.Library = []*internalCard{top}
+	bob.Library = nil
+	handSize := len(alice.Hand)
+	gameState.mu.Unlock()
+
+	if err := h.engine.DrawCard(h.gameID, "Alice", 2); err != nil {
+		t.Fatalf("failed to draw: %v", err)
+	}
+	if err := h.engine.DrawCard(h.gameID, "Bob", 1); err != nil {
+		t.Fatalf("failed to draw: %v", err)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	if len(alice.Hand) != handSize+1 || top.Zone != zoneHand {
+		t.Errorf("expected Alice to draw the top card of their library, hand size %d -> %d", handSize, len(alice.Hand))
+	}
+	if alice.Lost || bob.Lost {
+		t.Fatal("expected drawing from an empty library not to lose the game until state-based actions are checked")
+	}
+
+	h.engine.checkStateBasedActions(gameState)
+	if !alice.Lost || !bob.Lost {
+		t.Fatalf("expected both players to lose, got Alice lost=%v Bob lost=%v", alice.Lost, bob.Lost)
+	}
+	if !h.engine.checkIfGameIsOver(gameState) || gameState.state != GameStateFinished {
+		t.Error("expected the game to end in a draw")
+	}
+}
+
+// TestDrawStep_ActivePlayerDraws verifies that the active player draws a card as the draw step begins,
+// and that the starting player skips that draw on the first turn unless the game is set not to
+func TestDrawStep_ActivePlayerDraws(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		skipFirstDraw bool
+		wantDrawn     int
+	}{
+		{name: "two-player game skips the first draw", skipFirstDraw: true, wantDrawn: 0},
+		{name: "multiplayer game draws on the first turn", skipFirstDraw: false, wantDrawn: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewCombatTestHarness(t, "test-draw-step", []string{"Alice", "Bob"})
+			topID := h.CreateCreature(CreatureSpec{ID: "top", Name: "Grizzly Bears", Power: "2", Toughness: "2", Controller: "Alice"})
+
+			gameState := h.GetGameState()
+			gameState.mu.Lock()
+			gameState.rulesOptions.SkipFirstDraw = tc.skipFirstDraw
+			for gameState.turnManager.CurrentStep() != rules.StepUpkeep {
+				gameState.turnManager.AdvanceStep("Alice")
+			}
+			gameState.turnManager.SetPriority("Alice")
+			alice := gameState.players["Alice"]
+			top := gameState.cards[topID]
+			top.Zone = zoneLibrary
+			alice.Library = []*internalCard{top}
+			handSize := len(alice.Hand)
+			gameState.mu.Unlock()
+
+			for _, playerID := range []string{"Alice", "Bob"} {
+				if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: playerID, ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+					t.Fatalf("failed to pass priority: %v", err)
+				}
+			}
+
+			gameState.mu.RLock()
+			defer gameState.mu.RUnlock()
+			if step := gameState.turnManager.CurrentStep(); step != rules.StepDraw {
+				t.Fatalf("expected the draw step, got %s", step)
+			}
+			alice = gameState.players["Alice"]
+			if drawn := len(alice.Hand) - handSize; drawn != tc.wantDrawn {
+				t.Errorf("expected Alice to draw %d card(s), drew %d", tc.wantDrawn, drawn)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package game
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// drawProposalTimeout is how long players have to accept a draw before the proposal is declined
+const drawProposalTimeout = 2 * time.Minute
+
+// drawProposal is a draw the players in a game are voting on; the game ends in a draw once every
+// player still in it accepts (e.g. an intentional draw in a tournament)
+type drawProposal struct {
+	proposedBy string
+	accepted   map[string]bool // Player ID -> accepted
+	timer      *time.Timer
+	generation int // Number of the proposal in the game, so the timer of an earlier one does nothing
+}
+
+// ProposeDraw proposes that a game end in a draw. The proposing player accepts it; the other players
+// answer with RespondToDraw, and the proposal is declined if they don't all accept within
+// drawProposalTimeout.
+func (e *MageEngine) ProposeDraw(gameID, playerID string) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "ProposeDraw", playerID)
+
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+	player, err := gameState.drawVoter(playerID)
+	if err != nil {
+		return err
+	}
+	if gameState.drawProposal != nil {
+		return fmt.Errorf("a draw has already been proposed by %s", gameState.drawProposal.proposedBy)
+	}
+
+	gameState.drawProposals++
+	generation := gameState.drawProposals
+	proposal := &drawProposal{
+		proposedBy: playerID,
+		accepted:   map[string]bool{playerID: true},
+		generation: generation,
+	}
+	proposal.timer = time.AfterFunc(drawProposalTimeout, func() {
+		e.drawProposalExpired(gameState, generation)
+	})
+	gameState.drawProposal = proposal
+	gameState.addMessage(fmt.Sprintf("%s proposes a draw", player.Name), "system")
+	e.notifyGameStateChange(gameID, map[string]interface{}{
+		"type":        "draw_proposed",
+		"player_id":   playerID,
+		"deadline_ms": drawProposalTimeout.Milliseconds(),
+	})
+
+	e.resolveDrawProposal(gameState)
+	return nil
+}
+
+// RespondToDraw accepts or declines the draw proposed in a game; declining cancels the proposal
+func (e *MageEngine) RespondToDraw(gameID, playerID string, accept bool) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "RespondToDraw", playerID, accept)
+
+	proposal := gameState.drawProposal
+	if proposal == nil {
+		return fmt.Errorf("no draw has been proposed in game %s", gameID)
+	}
+	player, err := gameState.drawVoter(playerID)
+	if err != nil {
+		return err
+	}
+
+	if !accept {
+		e.cancelDrawProposal(gameState, fmt.Sprintf("%s declines the draw", player.Name))
+		return nil
+	}
+	proposal.accepted[playerID] = true
+	gameState.addMessage(fmt.Sprintf("%s accepts the draw", player.Name), "system")
+	e.resolveDrawProposal(gameState)
+	return nil
+}
+
+// drawVoter returns a player who can vote on a draw: one still in the game
+func (s *engineGameState) drawVoter(playerID string) (*internalPlayer, error) {
+	player, exists := s.players[playerID]
+	if !exists {
+		return nil, fmt.Errorf("player %s not found", playerID)
+	}
+	if !player.canRespond() {
+		return nil, fmt.Errorf("player %s is no longer in the game", playerID)
+	}
+	return player, nil
+}
+
+// resolveDrawProposal ends the game in a draw if every player still in it has accepted the proposal. It
+// runs whenever a vote is cast and whenever a player leaves or loses, since the players left may all
+// have accepted; once only one player is left the proposal is cancelled, and that player wins.
+func (e *MageEngine) resolveDrawProposal(gameState *engineGameState) {
+	proposal := gameState.drawProposal
+	if proposal == nil {
+		return
+	}
+	remaining, accepted := 0, 0
+	for _, pid := range gameState.playerOrder {
+		if player := gameState.players[pid]; player.canRespond() {
+			remaining++
+			if proposal.accepted[pid] {
+				accepted++
+			}
+		}
+	}
+	if remaining < 2 {
+		e.cancelDrawProposal(gameState, "The draw proposal is cancelled: only one player is left")
+		return
+	}
+	if accepted < remaining {
+		return
+	}
+
+	proposal.timer.Stop()
+	gameState.drawProposal = nil
+	for _, pid := range gameState.playerOrder {
+		if player := gameState.players[pid]; player.canRespond() {
+			player.Drew = true
+		}
+	}
+	gameState.addMessage("The players agree to a draw", "system")
+	if e.logger != nil {
+		e.logger.Info("players agreed to a draw",
+			zap.String("game_id", gameState.gameID),
+			zap.String("proposed_by", proposal.proposedBy),
+		)
+	}
+	e.checkIfGameIsOver(gameState)
+}
+
+// cancelDrawProposal withdraws the outstanding draw proposal
+func (e *MageEngine) cancelDrawProposal(gameState *engineGameState, reason string) {
+	proposal := gameState.drawProposal
+	if proposal == nil {
+		return
+	}
+	proposal.timer.Stop()
+	gameState.drawProposal = nil
+	gameState.addMessage(reason, "system")
+	e.notifyGameStateChange(gameState.gameID, map[string]interface{}{
+		"type":   "draw_declined",
+		"reason": reason,
+	})
+}
+
+// drawProposalExpired declines a draw proposal the players didn't all accept in time
+func (e *MageEngine) drawProposalExpired(gameState *engineGameState, generation int) {
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+
+	if proposal := gameState.drawProposal; proposal == nil || proposal.generation != generation {
+		return
+	}
+	e.cancelDrawProposal(gameState, "The draw proposal expired")
+	e.logCall(gameState, nil, "drawProposalExpired", generation)
+}
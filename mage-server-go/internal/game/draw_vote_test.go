@@ -0,0 +1,131 @@
+package game
+
+import "testing"
+
+// TestProposeDraw_DuelEndsDrawn verifies that a declined or expired draw proposal leaves the game going,
+// and that the game ends in a draw without a winner once both players agree
+func TestProposeDraw_DuelEndsDrawn(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-draw-vote", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	if err := h.engine.RespondToDraw(h.gameID, "Bob", true); err == nil {
+		t.Error("expected accepting a draw nobody proposed to fail")
+	}
+	if err := h.engine.ProposeDraw(h.gameID, "Alice"); err != nil {
+		t.Fatalf("failed to propose a draw: %v", err)
+	}
+	if err := h.engine.ProposeDraw(h.gameID, "Bob"); err == nil {
+		t.Error("expected a second proposal to be rejected while one is outstanding")
+	}
+	if err := h.engine.RespondToDraw(h.gameID, "Bob", false); err != nil {
+		t.Fatalf("failed to decline the draw: %v", err)
+	}
+
+	// A proposal nobody answers expires
+	if err := h.engine.ProposeDraw(h.gameID, "Bob"); err != nil {
+		t.Fatalf("failed to propose a draw: %v", err)
+	}
+	gameState.mu.RLock()
+	generation := gameState.drawProposal.generation
+	gameState.mu.RUnlock()
+	h.engine.drawProposalExpired(gameState, generation)
+
+	gameState.mu.RLock()
+	outstanding, state := gameState.drawProposal != nil, gameState.state
+	gameState.mu.RUnlock()
+	if outstanding || state == GameStateFinished {
+		t.Fatalf("expected the game to go on after the proposals were declined, state %v", state)
+	}
+
+	if err := h.engine.ProposeDraw(h.gameID, "Alice"); err != nil {
+		t.Fatalf("failed to propose a draw: %v", err)
+	}
+	if err := h.engine.RespondToDraw(h.gameID, "Bob", true); err != nil {
+		t.Fatalf("failed to accept the draw: %v", err)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if gameState.state != GameStateFinished {
+		t.Fatalf("expected the game to end once both players agreed, state %v", gameState.state)
+	}
+	for _, player := range gameState.players {
+		if player.Wins != 0 || player.Lost {
+			t.Errorf("expected a draw without a winner, %s has %d wins (lost: %v)", player.PlayerID, player.Wins, player.Lost)
+		}
+		if !player.Drew {
+			t.Errorf("expected %s to have drawn", player.PlayerID)
+		}
+	}
+}
+
+// TestProposeDraw_ResolvedWhenHoldoutLeaves verifies that in a three-player game a draw accepted by two
+// players ends the game once the third, who hadn't answered, concedes or loses
+func TestProposeDraw_ResolvedWhenHoldoutLeaves(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		leave func(t *testing.T, h *CombatTestHarness)
+	}{
+		{"concede", func(t *testing.T, h *CombatTestHarness) {
+			if err := h.engine.PlayerConcede(h.gameID, "Carol"); err != nil {
+				t.Fatalf("failed to concede: %v", err)
+			}
+		}},
+		{"lose", func(t *testing.T, h *CombatTestHarness) {
+			gameState := h.GetGameState()
+			gameState.mu.Lock()
+			defer gameState.mu.Unlock()
+			gameState.players["Carol"].Life = 0
+			h.engine.checkStateBasedActions(gameState)
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewCombatTestHarness(t, "test-draw-vote-"+tc.name, []string{"Alice", "Bob", "Carol"})
+			if err := h.engine.ProposeDraw(h.gameID, "Alice"); err != nil {
+				t.Fatalf("failed to propose a draw: %v", err)
+			}
+			if err := h.engine.RespondToDraw(h.gameID, "Bob", true); err != nil {
+				t.Fatalf("failed to accept the draw: %v", err)
+			}
+
+			tc.leave(t, h)
+
+			gameState := h.GetGameState()
+			gameState.mu.RLock()
+			defer gameState.mu.RUnlock()
+			if gameState.state != GameStateFinished || gameState.drawProposal != nil {
+				t.Fatalf("expected the draw to end the game once Carol was out, state %v", gameState.state)
+			}
+			for _, pid := range []string{"Alice", "Bob"} {
+				if player := gameState.players[pid]; !player.Drew || player.Wins != 0 {
+					t.Errorf("expected %s to have drawn, drew %v with %d wins", pid, player.Drew, player.Wins)
+				}
+			}
+			if carol := gameState.players["Carol"]; !carol.Lost || carol.Drew {
+				t.Errorf("expected Carol to have lost, lost %v drew %v", carol.Lost, carol.Drew)
+			}
+		})
+	}
+}
+
+// TestProposeDraw_CancelledWhenOnePlayerLeft verifies that a proposal isn't turned into a draw when the
+// opponent concedes: the last player wins
+func TestProposeDraw_CancelledWhenOnePlayerLeft(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-draw-vote-last", []string{"Alice", "Bob"})
+	if err := h.engine.ProposeDraw(h.gameID, "Alice"); err != nil {
+		t.Fatalf("failed to propose a draw: %v", err)
+	}
+	if err := h.engine.PlayerConcede(h.gameID, "Bob"); err != nil {
+		t.Fatalf("failed to concede: %v", err)
+	}
+
+	gameState := h.GetGameState()
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if gameState.drawProposal != nil {
+		t.Error("expected the proposal to be cancelled")
+	}
+	if alice := gameState.players["Alice"]; alice.Wins != 1 || alice.Drew {
+		t.Errorf("expected Alice to win, %d wins (drew: %v)", alice.Wins, alice.Drew)
+	}
+}
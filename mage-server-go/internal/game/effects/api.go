@@ -52,6 +52,11 @@ func (b *EffectBuilder) GrantAbility(abilityID string) *GrantAbilityEffect {
 	return NewGrantAbilityEffect(b.sourceID, abilityID, b.targetIDs, b.duration)
 }
 
+// Boost creates a BoostTargetEffect
+func (b *EffectBuilder) Boost(powerDelta, toughDelta int) *BoostTargetEffect {
+	return NewBoostTargetEffect(b.sourceID, b.targetIDs, powerDelta, toughDelta, b.duration)
+}
+
 // CantAttack creates a CantAttackEffect
 func (b *EffectBuilder) CantAttack() *CantAttackEffect {
 	return NewCantAttackEffect(b.sourceID, b.targetIDs, b.duration)
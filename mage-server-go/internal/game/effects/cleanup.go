@@ -92,7 +92,7 @@ func CleanupEndOfTurnEffects(system *LayerSystem) {
 		for id, effect := range effectMap {
 			// Check if effect has duration
 			if durationEffect, ok := effect.(EffectWithDuration); ok {
-				if durationEffect.GetDuration() == DurationEndOfTurn {
+				if duration := durationEffect.GetDuration(); duration == DurationEndOfTurn || duration == DurationUntilEndOfTurn {
 					toRemove = append(toRemove, id)
 				}
 			}
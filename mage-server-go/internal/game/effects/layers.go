@@ -18,6 +18,9 @@ const (
 	LayerColor
 	LayerAbility
 	LayerPowerToughness
+	// LayerPowerToughnessSet is sublayer 7b (effects that set P/T), applied before the
+	// modifications in LayerPowerToughness (7c)
+	LayerPowerToughnessSet
 )
 
 var layerOrder = []Layer{
@@ -27,6 +30,7 @@ var layerOrder = []Layer{
 	LayerType,
 	LayerColor,
 	LayerAbility,
+	LayerPowerToughnessSet,
 	LayerPowerToughness,
 }
 
@@ -35,6 +39,7 @@ type Snapshot struct {
 	CardID        string
 	ControllerID  string
 	Types         []string
+	SubTypes      []string
 	BasePower     int
 	BaseToughness int
 	HasBasePower  bool
@@ -79,6 +84,17 @@ func (s *Snapshot) HasType(typeName string) bool {
 	return false
 }
 
+// HasSubType returns true if the snapshot includes the provided subtype.
+func (s *Snapshot) HasSubType(subType string) bool {
+	subType = strings.ToLower(strings.TrimSpace(subType))
+	for _, t := range s.SubTypes {
+		if strings.ToLower(strings.TrimSpace(t)) == subType {
+			return true
+		}
+	}
+	return false
+}
+
 // ContinuousEffect defines behaviour for modifying card characteristics.
 type ContinuousEffect interface {
 	ID() string
@@ -148,6 +164,22 @@ func (ls *LayerSystem) RemoveEffect(id string) {
 	}
 }
 
+// AllEffects returns every registered effect, in no particular order.
+func (ls *LayerSystem) AllEffects() []ContinuousEffect {
+	if ls == nil {
+		return nil
+	}
+	ls.mu.RLock()
+	defer ls.mu.RUnlock()
+	result := make([]ContinuousEffect, 0, len(ls.index))
+	for _, layerMap := range ls.effects {
+		for _, effect := range layerMap {
+			result = append(result, effect)
+		}
+	}
+	return result
+}
+
 // Apply executes all relevant continuous effects across layers against the snapshot.
 func (ls *LayerSystem) Apply(snapshot *Snapshot) {
 	if snapshot == nil {
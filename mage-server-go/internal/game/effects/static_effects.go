@@ -73,3 +73,354 @@ func (e *SimplePTBoostEffect) Apply(snapshot *Snapshot) {
 		snapshot.Toughness += e.toughDelta
 	}
 }
+
+// AnthemEffect is a static "creatures you control get +X/+X" effect (Glorious Anthem, lords).
+// It lasts while its source is on the battlefield and can be limited to a creature subtype.
+type AnthemEffect struct {
+	id           string
+	sourceID     string
+	controllerID string
+	subType      string
+	powerDelta   int
+	toughDelta   int
+	includeSelf  bool
+}
+
+// NewAnthemEffect creates an anthem for creatures controlled by controllerID ("" = all creatures),
+// optionally limited to a subtype ("" = any creature).
+func NewAnthemEffect(sourceID, controllerID, subType string, powerDelta, toughDelta int, includeSelf bool) *AnthemEffect {
+	return &AnthemEffect{
+		id:           uuid.NewString(),
+		sourceID:     strings.TrimSpace(sourceID),
+		controllerID: strings.TrimSpace(controllerID),
+		subType:      strings.TrimSpace(subType),
+		powerDelta:   powerDelta,
+		toughDelta:   toughDelta,
+		includeSelf:  includeSelf,
+	}
+}
+
+// ID returns the unique identifier.
+func (e *AnthemEffect) ID() string {
+	return e.id
+}
+
+// Layer identifies the layer in which the effect applies (7c, P/T modifications).
+func (e *AnthemEffect) Layer() Layer {
+	return LayerPowerToughness
+}
+
+// GetDuration returns the duration of the effect.
+func (e *AnthemEffect) GetDuration() Duration {
+	return DurationWhileOnBattlefield
+}
+
+// GetSourceID returns the source of the effect.
+func (e *AnthemEffect) GetSourceID() string {
+	return e.sourceID
+}
+
+// AppliesTo determines whether the snapshot should receive the modification.
+func (e *AnthemEffect) AppliesTo(snapshot *Snapshot) bool {
+	if snapshot == nil {
+		return false
+	}
+	if e.controllerID != "" && snapshot.ControllerID != e.controllerID {
+		return false
+	}
+	if !snapshot.HasType("creature") {
+		return false
+	}
+	if e.subType != "" && !snapshot.HasSubType(e.subType) {
+		return false
+	}
+	if !e.includeSelf && snapshot.CardID == e.sourceID {
+		return false
+	}
+	return snapshot.HasBasePower && snapshot.HasBaseTough
+}
+
+// Apply mutates the snapshot.
+func (e *AnthemEffect) Apply(snapshot *Snapshot) {
+	if snapshot == nil {
+		return
+	}
+	snapshot.Power += e.powerDelta
+	snapshot.Toughness += e.toughDelta
+}
+
+// matchesCharacteristics reports whether a snapshot is one of targetIDs (when given) and has
+// matchType (when given).
+func matchesCharacteristics(snapshot *Snapshot, targetIDs []string, matchType string) bool {
+	if len(targetIDs) > 0 {
+		found := false
+		for _, id := range targetIDs {
+			if snapshot.CardID == id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return matchType == "" || snapshot.HasType(matchType)
+}
+
+// ControlChangingEffect gives control of permanents to a player in layer 2
+// (e.g. "gain control of target creature until end of turn").
+// Per Java GainControlTargetEffect: when the effect ends, control reverts to the previous controller.
+type ControlChangingEffect struct {
+	id           string
+	sourceID     string
+	controllerID string
+	targetIDs    []string
+	duration     Duration
+}
+
+// NewControlChangingEffect creates an effect giving controllerID control of targetIDs.
+func NewControlChangingEffect(sourceID, controllerID string, targetIDs []string, duration Duration) *ControlChangingEffect {
+	return &ControlChangingEffect{
+		id:           uuid.NewString(),
+		sourceID:     sourceID,
+		controllerID: controllerID,
+		targetIDs:    append([]string(nil), targetIDs...),
+		duration:     duration,
+	}
+}
+
+// ID returns the unique identifier.
+func (e *ControlChangingEffect) ID() string {
+	return e.id
+}
+
+// Layer identifies the layer in which the effect applies.
+func (e *ControlChangingEffect) Layer() Layer {
+	return LayerControl
+}
+
+// GetDuration returns the duration of the effect.
+func (e *ControlChangingEffect) GetDuration() Duration {
+	return e.duration
+}
+
+// GetSourceID returns the source of the effect.
+func (e *ControlChangingEffect) GetSourceID() string {
+	return e.sourceID
+}
+
+// GetControllerID returns the player gaining control.
+func (e *ControlChangingEffect) GetControllerID() string {
+	return e.controllerID
+}
+
+// AppliesTo determines whether the snapshot changes controller.
+func (e *ControlChangingEffect) AppliesTo(snapshot *Snapshot) bool {
+	if snapshot == nil || len(e.targetIDs) == 0 {
+		return false
+	}
+	return matchesCharacteristics(snapshot, e.targetIDs, "")
+}
+
+// Apply sets the snapshot's controller.
+func (e *ControlChangingEffect) Apply(snapshot *Snapshot) {
+	if snapshot == nil {
+		return
+	}
+	snapshot.ControllerID = e.controllerID
+}
+
+// TypeChangingEffect adds card types and subtypes in layer 4 (e.g. "all lands are creatures").
+type TypeChangingEffect struct {
+	id          string
+	sourceID    string
+	targetIDs   []string
+	matchType   string
+	addTypes    []string
+	addSubTypes []string
+	duration    Duration
+}
+
+// NewTypeChangingEffect creates an effect adding types/subtypes to permanents that are one of
+// targetIDs (empty = any) and have matchType ("" = any).
+func NewTypeChangingEffect(sourceID string, targetIDs []string, matchType string, addTypes, addSubTypes []string, duration Duration) *TypeChangingEffect {
+	return &TypeChangingEffect{
+		id:          uuid.NewString(),
+		sourceID:    sourceID,
+		targetIDs:   append([]string(nil), targetIDs...),
+		matchType:   matchType,
+		addTypes:    append([]string(nil), addTypes...),
+		addSubTypes: append([]string(nil), addSubTypes...),
+		duration:    duration,
+	}
+}
+
+// ID returns the unique identifier.
+func (e *TypeChangingEffect) ID() string {
+	return e.id
+}
+
+// Layer identifies the layer in which the effect applies.
+func (e *TypeChangingEffect) Layer() Layer {
+	return LayerType
+}
+
+// GetDuration returns the duration of the effect.
+func (e *TypeChangingEffect) GetDuration() Duration {
+	return e.duration
+}
+
+// GetSourceID returns the source of the effect.
+func (e *TypeChangingEffect) GetSourceID() string {
+	return e.sourceID
+}
+
+// AppliesTo determines whether the snapshot should receive the new types.
+func (e *TypeChangingEffect) AppliesTo(snapshot *Snapshot) bool {
+	if snapshot == nil {
+		return false
+	}
+	return matchesCharacteristics(snapshot, e.targetIDs, e.matchType)
+}
+
+// Apply adds the types and subtypes the snapshot doesn't already have.
+func (e *TypeChangingEffect) Apply(snapshot *Snapshot) {
+	if snapshot == nil {
+		return
+	}
+	for _, t := range e.addTypes {
+		if !snapshot.HasType(t) {
+			snapshot.Types = append(snapshot.Types, t)
+		}
+	}
+	for _, t := range e.addSubTypes {
+		if !snapshot.HasSubType(t) {
+			snapshot.SubTypes = append(snapshot.SubTypes, t)
+		}
+	}
+}
+
+// SetPowerToughnessEffect sets base power and toughness in layer 7b (e.g. "... are 2/2 creatures").
+// Modifications such as anthems (layer 7c) apply on top of the set values.
+type SetPowerToughnessEffect struct {
+	id        string
+	sourceID  string
+	targetIDs []string
+	matchType string
+	power     int
+	toughness int
+	duration  Duration
+}
+
+// NewSetPowerToughnessEffect creates an effect setting P/T of permanents that are one of targetIDs
+// (empty = any) and have matchType ("" = any).
+func NewSetPowerToughnessEffect(sourceID string, targetIDs []string, matchType string, power, toughness int, duration Duration) *SetPowerToughnessEffect {
+	return &SetPowerToughnessEffect{
+		id:        uuid.NewString(),
+		sourceID:  sourceID,
+		targetIDs: append([]string(nil), targetIDs...),
+		matchType: matchType,
+		power:     power,
+		toughness: toughness,
+		duration:  duration,
+	}
+}
+
+// ID returns the unique identifier.
+func (e *SetPowerToughnessEffect) ID() string {
+	return e.id
+}
+
+// Layer identifies the layer in which the effect applies.
+func (e *SetPowerToughnessEffect) Layer() Layer {
+	return LayerPowerToughnessSet
+}
+
+// GetDuration returns the duration of the effect.
+func (e *SetPowerToughnessEffect) GetDuration() Duration {
+	return e.duration
+}
+
+// GetSourceID returns the source of the effect.
+func (e *SetPowerToughnessEffect) GetSourceID() string {
+	return e.sourceID
+}
+
+// AppliesTo determines whether the snapshot's P/T should be set.
+func (e *SetPowerToughnessEffect) AppliesTo(snapshot *Snapshot) bool {
+	if snapshot == nil {
+		return false
+	}
+	return matchesCharacteristics(snapshot, e.targetIDs, e.matchType)
+}
+
+// Apply sets power and toughness, giving the snapshot P/T if it had none (e.g. an animated land).
+func (e *SetPowerToughnessEffect) Apply(snapshot *Snapshot) {
+	if snapshot == nil {
+		return
+	}
+	snapshot.Power = e.power
+	snapshot.Toughness = e.toughness
+	snapshot.HasBasePower = true
+	snapshot.HasBaseTough = true
+}
+
+// BoostTargetEffect modifies the power and toughness of specific creatures in layer 7c
+// (e.g. "target creature gets +1/+1 until end of turn", prowess).
+type BoostTargetEffect struct {
+	id         string
+	sourceID   string
+	targetIDs  []string
+	powerDelta int
+	toughDelta int
+	duration   Duration
+}
+
+// NewBoostTargetEffect creates an effect giving each of targetIDs +powerDelta/+toughDelta.
+func NewBoostTargetEffect(sourceID string, targetIDs []string, powerDelta, toughDelta int, duration Duration) *BoostTargetEffect {
+	return &BoostTargetEffect{
+		id:         uuid.NewString(),
+		sourceID:   sourceID,
+		targetIDs:  append([]string(nil), targetIDs...),
+		powerDelta: powerDelta,
+		toughDelta: toughDelta,
+		duration:   duration,
+	}
+}
+
+// ID returns the unique identifier.
+func (e *BoostTargetEffect) ID() string {
+	return e.id
+}
+
+// Layer identifies the layer in which the effect applies.
+func (e *BoostTargetEffect) Layer() Layer {
+	return LayerPowerToughness
+}
+
+// GetDuration returns the duration of the effect.
+func (e *BoostTargetEffect) GetDuration() Duration {
+	return e.duration
+}
+
+// GetSourceID returns the source of the effect.
+func (e *BoostTargetEffect) GetSourceID() string {
+	return e.sourceID
+}
+
+// AppliesTo determines whether the snapshot is one of the boosted creatures.
+func (e *BoostTargetEffect) AppliesTo(snapshot *Snapshot) bool {
+	if snapshot == nil || len(e.targetIDs) == 0 {
+		return false
+	}
+	return matchesCharacteristics(snapshot, e.targetIDs, "creature") && snapshot.HasBasePower && snapshot.HasBaseTough
+}
+
+// Apply mutates the snapshot.
+func (e *BoostTargetEffect) Apply(snapshot *Snapshot) {
+	if snapshot == nil {
+		return
+	}
+	snapshot.Power += e.powerDelta
+	snapshot.Toughness += e.toughDelta
+}
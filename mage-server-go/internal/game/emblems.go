@@ -0,0 +1,93 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/magefree/mage-server-go/internal/game/counters"
+	"github.com/magefree/mage-server-go/internal/game/effects"
+)
+
+// emblemType is the type line shown for emblems
+const emblemType = "Emblem"
+
+// EmblemSpec describes an emblem, e.g. one created by a planeswalker's ultimate ability.
+// Per rule 114: an emblem is an object in the command zone with abilities and no other characteristics.
+type EmblemSpec struct {
+	Name string
+	Text string
+	// CreateEffects returns the continuous effects of the emblem's static abilities
+	CreateEffects func(gameState *engineGameState, emblem *internalCard) []effects.ContinuousEffect
+}
+
+// anthemEmblem describes an emblem with "Creatures you control get +X/+X."
+func anthemEmblem(name string, powerDelta, toughDelta int) EmblemSpec {
+	return EmblemSpec{
+		Name: name,
+		Text: fmt.Sprintf("Creatures you control get %+d/%+d.", powerDelta, toughDelta),
+		CreateEffects: func(gameState *engineGameState, emblem *internalCard) []effects.ContinuousEffect {
+			return []effects.ContinuousEffect{
+				effects.NewAnthemEffect(emblem.ID, emblem.ControllerID, "", powerDelta, toughDelta, false),
+			}
+		},
+	}
+}
+
+// isEmblem reports whether an object is an emblem
+func isEmblem(card *internalCard) bool {
+	return card != nil && card.Type == emblemType
+}
+
+// CreateEmblem puts an emblem controlled by a player into the command zone and returns its ID.
+// Its static abilities function from the command zone (rule 114.4) through the layer system for the
+// rest of the game; emblems can't be removed.
+// Per Java Emblem / GameState.addCommandObject()
+func (e *MageEngine) CreateEmblem(gameID, controllerID string, spec EmblemSpec) (string, error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return "", fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+
+	if _, exists := gameState.players[controllerID]; !exists {
+		return "", fmt.Errorf("player %s not found", controllerID)
+	}
+
+	name := spec.Name
+	if name == "" {
+		name = emblemType
+	}
+	emblem := &internalCard{
+		ID:           uuid.New().String(),
+		Name:         name,
+		DisplayName:  name,
+		Type:         emblemType,
+		SubTypes:     []string{},
+		SuperTypes:   []string{},
+		RulesText:    spec.Text,
+		Zone:         zoneCommand,
+		ControllerID: controllerID,
+		OwnerID:      controllerID,
+		Counters:     counters.NewCounters(),
+	}
+	gameState.cards[emblem.ID] = emblem
+	gameState.command = append(gameState.command, emblem)
+
+	if spec.CreateEffects != nil {
+		gameState.staticAbilities = append(gameState.staticAbilities, &staticAbility{
+			SourceID:      emblem.ID,
+			Description:   spec.Text,
+			CreateEffects: spec.CreateEffects,
+		})
+	}
+	e.recomputeContinuousEffects(gameState)
+
+	gameState.addMessage(fmt.Sprintf("%s gets an emblem: %s", controllerID, spec.Text), "action")
+	gameState.markUnreplayable("CreateEmblem")
+	return emblem.ID, nil
+}
@@ -0,0 +1,55 @@
+package game
+
+import "testing"
+
+// TestEmblem_AnthemPersistsThroughBoardWipe verifies that an anthem emblem buffs its controller's
+// creatures only, stays in the command zone when every permanent is destroyed, and still buffs
+// creatures that enter afterwards
+func TestEmblem_AnthemPersistsThroughBoardWipe(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-emblem-anthem", []string{"Alice", "Bob"})
+	aliceBear := h.CreateCreature(CreatureSpec{ID: "alice-bear", Name: "Grizzly Bears", Power: "2", Toughness: "2", Controller: "Alice"})
+	bobBear := h.CreateCreature(CreatureSpec{ID: "bob-bear", Name: "Grizzly Bears", Power: "2", Toughness: "2", Controller: "Bob"})
+	latecomer := h.CreateCreature(CreatureSpec{ID: "alice-elf", Name: "Llanowar Elves", Power: "1", Toughness: "1", Controller: "Alice"})
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.battlefield = append(gameState.battlefield, gameState.cards[aliceBear], gameState.cards[bobBear])
+	gameState.mu.Unlock()
+
+	emblemID, err := h.engine.CreateEmblem(h.gameID, "Alice", anthemEmblem("Elspeth, Knight-Errant Emblem", 1, 1))
+	if err != nil {
+		t.Fatalf("failed to create emblem: %v", err)
+	}
+
+	assertPT := func(cardID, power, toughness string) {
+		t.Helper()
+		gameState.mu.RLock()
+		defer gameState.mu.RUnlock()
+		if card := gameState.cards[cardID]; card.Power != power || card.Toughness != toughness {
+			t.Errorf("expected %s to be %s/%s, got %s/%s", cardID, power, toughness, card.Power, card.Toughness)
+		}
+	}
+	assertPT(aliceBear, "3", "3")
+	assertPT(bobBear, "2", "2")
+
+	for _, cardID := range []string{aliceBear, bobBear} {
+		if err := h.engine.DestroyPermanent(h.gameID, cardID, "wrath"); err != nil {
+			t.Fatalf("failed to destroy %s: %v", cardID, err)
+		}
+	}
+
+	gameState.mu.Lock()
+	emblem := gameState.cards[emblemID]
+	if err := h.engine.moveCard(gameState, emblem, zoneGraveyard, ""); err == nil {
+		t.Error("expected the emblem to be impossible to remove")
+	}
+	if emblem.Zone != zoneCommand || len(gameState.command) != 1 {
+		t.Errorf("expected the emblem to stay in the command zone, got zone %v", emblem.Zone)
+	}
+	if err := h.engine.moveCard(gameState, gameState.cards[latecomer], zoneBattlefield, "Alice"); err != nil {
+		t.Fatalf("failed to put a creature onto the battlefield: %v", err)
+	}
+	gameState.mu.Unlock()
+
+	assertPT(latecomer, "2", "2")
+}
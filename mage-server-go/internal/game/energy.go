@@ -0,0 +1,60 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/magefree/mage-server-go/internal/game/counters"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// AddEnergy gives a player energy counters ("you get {E}{E}"). Energy is spent to pay {E} in the
+// costs of spells and abilities.
+// Per rule 122.1 and Java AddCountersControllerEffect with CounterType.ENERGY
+func (e *MageEngine) AddEnergy(gameID, playerID string, amount int) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "AddEnergy", playerID, amount)
+
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+	player, exists := gameState.players[playerID]
+	if !exists {
+		return fmt.Errorf("player %s not found", playerID)
+	}
+	if amount <= 0 {
+		return fmt.Errorf("energy amount must be positive, got %d", amount)
+	}
+
+	player.Energy += amount
+	event := rules.NewEventWithAmount(rules.EventCounterAdded, playerID, playerID, playerID, amount)
+	event.Data = string(counters.CounterTypeEnergy)
+	event.Metadata["counter_name"] = string(counters.CounterTypeEnergy)
+	gameState.eventBus.Publish(event)
+	gameState.addMessage(fmt.Sprintf("%s gets %d energy (now %d)", player.Name, amount, player.Energy), "action")
+
+	e.notifyPlayerAction(gameID, playerID, map[string]interface{}{
+		"type":   "add_energy",
+		"amount": amount,
+	})
+	return nil
+}
+
+// payEnergy removes energy counters from a player to pay {E} symbols; the caller checks the player
+// has enough
+func (e *MageEngine) payEnergy(gameState *engineGameState, player *internalPlayer, amount int) {
+	player.Energy -= amount
+	event := rules.NewEventWithAmount(rules.EventCounterRemoved, player.PlayerID, player.PlayerID, player.PlayerID, amount)
+	event.Data = string(counters.CounterTypeEnergy)
+	event.Metadata["counter_name"] = string(counters.CounterTypeEnergy)
+	gameState.eventBus.Publish(event)
+	gameState.addMessage(fmt.Sprintf("%s pays %d energy", player.Name, amount), "action")
+}
@@ -0,0 +1,92 @@
+package game
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// CastFromZone casts a player's card from a zone other than their hand: from their graveyard for its
+// flashback cost (rule 702.34a), after which it is exiled instead of going anywhere else as it leaves
+// the stack. Casting from zoneHand is casting the card normally. It is a CAST_SPELL action, so spells
+// with targets or X are cast with a CAST_SPELL action naming CardID and FromZone instead.
+// Per Java FlashbackAbility
+func (e *MageEngine) CastFromZone(gameID, playerID, cardID string, fromZone int) error {
+	return e.ProcessAction(gameID, PlayerAction{
+		PlayerID:   playerID,
+		ActionType: "CAST_SPELL",
+		Data:       CastSpellData{CardID: cardID, FromZone: fromZone},
+	})
+}
+
+// castableCard finds the card a player casts and the mana cost they pay for it: the card named
+// spellName in their hand, or the card named by choices.CardID in choices.FromZone
+func (e *MageEngine) castableCard(gameState *engineGameState, player *internalPlayer, spellName string, choices *CastSpellData) (*internalCard, string, error) {
+	if choices == nil || choices.CardID == "" {
+		for _, card := range player.Hand {
+			if strings.EqualFold(card.Name, spellName) {
+				return card, card.ManaCost, nil
+			}
+		}
+		return nil, "", fmt.Errorf("card %s not found in hand", spellName)
+	}
+
+	var zone []*internalCard
+	switch choices.FromZone {
+	case zoneHand:
+		zone = player.Hand
+	case zoneGraveyard:
+		zone = player.Graveyard
+	default:
+		return nil, "", fmt.Errorf("spells can't be cast from %s", strings.ToLower(zoneToString(choices.FromZone)))
+	}
+	var card *internalCard
+	for _, candidate := range zone {
+		if candidate.ID == choices.CardID {
+			card = candidate
+			break
+		}
+	}
+	if card == nil {
+		return nil, "", fmt.Errorf("card %s not found in %s", choices.CardID, strings.ToLower(zoneToString(choices.FromZone)))
+	}
+
+	if choices.FromZone == zoneHand {
+		return card, card.ManaCost, nil
+	}
+	cost, hasFlashback := flashbackCost(card)
+	if !hasFlashback {
+		return nil, "", fmt.Errorf("%s can't be cast from the graveyard", card.Name)
+	}
+	return card, cost, nil
+}
+
+// flashbackCostPattern matches "Flashback {1}{R}" in rules text or a flashback ability's text
+var flashbackCostPattern = regexp.MustCompile(`(?i)flashback\W*((?:\{[^}]+\})+)`)
+
+// flashbackCost returns a card's flashback cost, from its flashback ability or its rules text, and
+// whether it has flashback
+func flashbackCost(card *internalCard) (string, bool) {
+	for _, ability := range card.Abilities {
+		if ability.ID != abilityFlashback {
+			continue
+		}
+		if match := flashbackCostPattern.FindStringSubmatch(ability.Text); match != nil {
+			return match[1], true
+		}
+		return "", true
+	}
+	if match := flashbackCostPattern.FindStringSubmatch(card.RulesText); match != nil {
+		return match[1], true
+	}
+	return "", false
+}
+
+// exileFlashbackSpell exiles a spell cast with flashback that leaves the stack without resolving, and
+// reports whether it was one
+func (e *MageEngine) exileFlashbackSpell(gameState *engineGameState, card *internalCard) bool {
+	if !card.ExileFromStack {
+		return false
+	}
+	return e.moveCard(gameState, card, zoneExile, "") == nil
+}
@@ -0,0 +1,88 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/mana"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestCastFromZone_FlashbackExilesSpell verifies that an instant with flashback is cast from the
+// graveyard for its flashback cost and is exiled as it resolves, and that cards without flashback
+// can't be cast from there
+func TestCastFromZone_FlashbackExilesSpell(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-flashback", []string{"Alice", "Bob"})
+	castTestSetup(t, h, "Alice", "think-twice", "Think Twice", "Instant", rules.StepMain1)
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	alice := gameState.players["Alice"]
+	var card, bolt *internalCard
+	for _, c := range alice.Hand {
+		switch {
+		case c.ID == "think-twice":
+			card = c
+		case c.Name == "Lightning Bolt" && bolt == nil:
+			bolt = c
+		}
+	}
+	card.ManaCost = "{1}{U}"
+	card.RulesText = "Draw a card.\nFlashback {2}{U}"
+	for _, c := range []*internalCard{card, bolt} {
+		alice.Hand = h.engine.removeCardFromSlice(alice.Hand, c.ID)
+		c.Zone = zoneGraveyard
+		alice.Graveyard = append(alice.Graveyard, c)
+	}
+	alice.ManaPool.Add(mana.ManaBlue, 1) // With the red from castTestSetup
+	gameState.mu.Unlock()
+
+	if err := h.engine.CastFromZone(h.gameID, "Alice", bolt.ID, zoneGraveyard); err == nil {
+		t.Error("expected a card without flashback not to be castable from the graveyard")
+	}
+	if err := h.engine.CastFromZone(h.gameID, "Alice", card.ID, zoneGraveyard); err == nil {
+		t.Fatal("expected the flashback cost {2}{U} not to be payable with two mana")
+	}
+
+	// Failed actions are rolled back, which replaces the game's players and cards
+	gameState.mu.Lock()
+	alice = gameState.players["Alice"]
+	alice.ManaPool.Add(mana.ManaColorless, 1)
+	gameState.mu.Unlock()
+	if err := h.engine.CastFromZone(h.gameID, "Alice", card.ID, zoneGraveyard); err != nil {
+		t.Fatalf("failed to cast Think Twice with flashback: %v", err)
+	}
+
+	gameState.mu.RLock()
+	for _, c := range alice.Graveyard {
+		if c.ID == card.ID {
+			t.Error("expected Think Twice to leave the graveyard as it is cast")
+		}
+	}
+	gameState.mu.RUnlock()
+
+	for i := 0; i < 10; i++ {
+		gameState.mu.RLock()
+		empty := gameState.stack.IsEmpty()
+		priority := gameState.turnManager.PriorityPlayer()
+		gameState.mu.RUnlock()
+		if empty {
+			break
+		}
+		if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: priority, ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+			t.Fatalf("failed to pass priority: %v", err)
+		}
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	alice = gameState.players["Alice"]
+	card = gameState.cards[card.ID]
+	if card.Zone != zoneExile {
+		t.Errorf("expected Think Twice to be exiled as it resolves, got zone %s", zoneToString(card.Zone))
+	}
+	for _, c := range alice.Graveyard {
+		if c.ID == card.ID {
+			t.Error("expected Think Twice not to return to the graveyard")
+		}
+	}
+}
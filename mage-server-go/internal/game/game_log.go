@@ -0,0 +1,134 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	// maxLogMessages is the number of messages a game log keeps; older messages are dropped
+	maxLogMessages = 1000
+
+	// LogGapColor is the category of the marker GetLogSince puts first when messages a client hasn't
+	// seen were dropped from the log
+	LogGapColor = "gap"
+)
+
+// GetGameLog returns the game log, optionally filtered by message category (action, life, combat,
+// mulligan, system; none = all).
+// With playerVisibleOnly, messages revealing a player's hidden information are redacted to what every
+// player may see, so the log can be shown to spectators or opponents.
+func (e *MageEngine) GetGameLog(gameID string, categories []string, playerVisibleOnly bool) ([]EngineMessage, error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+
+	var wanted map[string]bool
+	if len(categories) > 0 {
+		wanted = make(map[string]bool, len(categories))
+		for _, category := range categories {
+			wanted[category] = true
+		}
+	}
+
+	if !playerVisibleOnly {
+		log := make([]EngineMessage, 0, len(gameState.messages))
+		for _, message := range gameState.messages {
+			if wanted == nil || wanted[message.Color] {
+				log = append(log, message)
+			}
+		}
+		return log, nil
+	}
+
+	return visibleMessages(gameState.messages, "", wanted), nil
+}
+
+// GetLogSince returns the messages a player may see from log index sinceIndex on, and the index to
+// pass next time, so clients can tail the log instead of fetching it whole.
+// The log index counts every message since the game started. If some of the requested messages were
+// dropped from the log, the result starts with a LogGapColor marker saying how many.
+// An index past the end (e.g. after the game was rolled back) returns the whole log.
+func (e *MageEngine) GetLogSince(gameID, playerID string, sinceIndex int) ([]EngineMessage, int, error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return nil, 0, fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+
+	first := gameState.droppedMessages
+	next := first + len(gameState.messages)
+	if sinceIndex < 0 || sinceIndex > next {
+		sinceIndex = 0
+	}
+
+	messages := make([]EngineMessage, 0)
+	if sinceIndex < first {
+		messages = append(messages, EngineMessage{
+			Text:      fmt.Sprintf("%d earlier message(s) are no longer available", first-sinceIndex),
+			Color:     LogGapColor,
+			Timestamp: time.Now(),
+		})
+		sinceIndex = first
+	}
+	messages = append(messages, visibleMessages(gameState.messages[sinceIndex-first:], playerID, nil)...)
+
+	return messages, next, nil
+}
+
+// GetGameLogSince returns the messages a player may see that were logged after since, so a client
+// reconnecting to a game can catch up on what happened while it was away. Use GetLogSince to tail the
+// log of a connected client.
+func (e *MageEngine) GetGameLogSince(gameID, playerID string, since time.Time) ([]EngineMessage, error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+
+	// Messages are logged in time order, so the missed ones are at the end
+	first := sort.Search(len(gameState.messages), func(i int) bool {
+		return gameState.messages[i].Timestamp.After(since)
+	})
+	return visibleMessages(gameState.messages[first:], playerID, nil), nil
+}
+
+// visibleMessages returns the messages a player may see, with other players' hidden information
+// redacted; an empty viewerID sees only public information
+// If categories is non-nil, only messages of those categories are returned.
+func visibleMessages(messages []EngineMessage, viewerID string, categories map[string]bool) []EngineMessage {
+	visible := make([]EngineMessage, 0, len(messages))
+	for _, message := range messages {
+		if categories != nil && !categories[message.Color] {
+			continue
+		}
+		if message.OwnerID != "" && message.OwnerID != viewerID {
+			if message.PublicText == "" {
+				continue
+			}
+			message.Text = message.PublicText
+			message.OwnerID = ""
+			message.PublicText = ""
+		}
+		visible = append(visible, message)
+	}
+	return visible
+}
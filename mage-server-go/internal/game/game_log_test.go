@@ -0,0 +1,177 @@
+package game
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestGameLog_FiltersByCategory verifies that a log filtered for combat messages excludes life messages
+func TestGameLog_FiltersByCategory(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-game-log-filter", []string{"Alice", "Bob"})
+	h.engine.SetDebugOperationsEnabled(true)
+
+	attackerID := h.CreateAttacker("attacker", "Grizzly Bears", "Alice", "2", "2")
+	h.SetupCombat("Alice")
+	h.DeclareAttacker(attackerID, "Bob", "Alice")
+	if err := h.engine.DealDamage(h.gameID, attackerID, "Bob", 2, false); err != nil {
+		t.Fatalf("failed to deal damage: %v", err)
+	}
+
+	all, err := h.engine.GetGameLog(h.gameID, nil, false)
+	if err != nil {
+		t.Fatalf("failed to get game log: %v", err)
+	}
+	if !hasMessageColor(all, "life") {
+		t.Fatal("expected the unfiltered log to contain a life message")
+	}
+
+	combat, err := h.engine.GetGameLog(h.gameID, []string{"combat"}, false)
+	if err != nil {
+		t.Fatalf("failed to get game log: %v", err)
+	}
+	if len(combat) == 0 {
+		t.Fatal("expected combat messages")
+	}
+	for _, message := range combat {
+		if message.Color != "combat" {
+			t.Errorf("expected only combat messages, got %q (%s)", message.Text, message.Color)
+		}
+	}
+	if hasMessageColor(combat, "life") {
+		t.Error("expected life messages to be excluded")
+	}
+}
+
+// TestGameLog_RedactsHiddenInformation verifies that a player-visible log shows the public version of
+// messages revealing a player's hidden information, while the owner's game view shows the details
+func TestGameLog_RedactsHiddenInformation(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-game-log-redact", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	gameState.addPrivateMessage("Alice", "Alice searches their library and finds Forest", "Alice searches their library and finds 1 card(s)", "action")
+	gameState.addPrivateMessage("Alice", "Alice looks at Island", "", "action")
+	gameState.mu.Unlock()
+
+	log, err := h.engine.GetGameLog(h.gameID, []string{"action"}, true)
+	if err != nil {
+		t.Fatalf("failed to get game log: %v", err)
+	}
+	for _, message := range log {
+		if strings.Contains(message.Text, "Forest") || strings.Contains(message.Text, "Island") {
+			t.Errorf("expected hidden information to be redacted, got %q", message.Text)
+		}
+	}
+	if !hasMessageText(log, "Alice searches their library and finds 1 card(s)") {
+		t.Error("expected the public version of the search message")
+	}
+
+	for playerID, wantDetails := range map[string]bool{"Alice": true, "Bob": false} {
+		viewRaw, err := h.engine.GetGameView(h.gameID, playerID)
+		if err != nil {
+			t.Fatalf("failed to get game view: %v", err)
+		}
+		if got := hasMessageText(viewRaw.(*EngineGameView).Messages, "Alice looks at Island"); got != wantDetails {
+			t.Errorf("expected %s to see the private message: %v, got %v", playerID, wantDetails, got)
+		}
+	}
+}
+
+// TestGameLog_LogSinceReturnsOnlyNewMessages verifies tailing the log from a previous index, and the gap
+// marker once unseen messages were dropped
+func TestGameLog_LogSinceReturnsOnlyNewMessages(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-game-log-since", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	gameState.addMessage("first", "action")
+	gameState.mu.Unlock()
+
+	_, index, err := h.engine.GetLogSince(h.gameID, "Alice", 0)
+	if err != nil {
+		t.Fatalf("failed to get log: %v", err)
+	}
+
+	gameState.mu.Lock()
+	gameState.addMessage("second", "action")
+	gameState.addMessage("third", "life")
+	gameState.mu.Unlock()
+
+	messages, next, err := h.engine.GetLogSince(h.gameID, "Alice", index)
+	if err != nil {
+		t.Fatalf("failed to get log: %v", err)
+	}
+	if len(messages) != 2 || messages[0].Text != "second" || messages[1].Text != "third" {
+		t.Fatalf("expected only the 2 new messages, got %+v", messages)
+	}
+	if next != index+2 {
+		t.Errorf("expected next index %d, got %d", index+2, next)
+	}
+
+	if messages, _, _ := h.engine.GetLogSince(h.gameID, "Alice", next); len(messages) != 0 {
+		t.Errorf("expected no messages past the end, got %d", len(messages))
+	}
+
+	// Overflow the log so the messages after index are partly dropped
+	gameState.mu.Lock()
+	for i := 0; i < maxLogMessages+5; i++ {
+		gameState.addMessage("filler", "action")
+	}
+	gameState.mu.Unlock()
+
+	messages, next, err = h.engine.GetLogSince(h.gameID, "Alice", index)
+	if err != nil {
+		t.Fatalf("failed to get log: %v", err)
+	}
+	if len(messages) != maxLogMessages+1 || messages[0].Color != LogGapColor {
+		t.Fatalf("expected a gap marker followed by the kept log, got %d messages starting with %+v", len(messages), messages[0])
+	}
+	if next != index+2+maxLogMessages+5 {
+		t.Errorf("expected the index to keep counting dropped messages, got %d", next)
+	}
+}
+
+func hasMessageColor(messages []EngineMessage, color string) bool {
+	for _, message := range messages {
+		if message.Color == color {
+			return true
+		}
+	}
+	return false
+}
+
+func hasMessageText(messages []EngineMessage, text string) bool {
+	for _, message := range messages {
+		if message.Text == text {
+			return true
+		}
+	}
+	return false
+}
+
+// TestGameLog_SinceTimeReturnsMissedMessages verifies that a reconnecting player gets the messages logged
+// after they left, with other players' hidden information redacted
+func TestGameLog_SinceTimeReturnsMissedMessages(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-game-log-since-time", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	left := time.Now().Add(time.Hour)
+	gameState.mu.Lock()
+	gameState.appendMessage(EngineMessage{Text: "before", Color: "action", Timestamp: left.Add(-time.Second)})
+	gameState.appendMessage(EngineMessage{Text: "after", Color: "action", Timestamp: left.Add(time.Second)})
+	gameState.appendMessage(EngineMessage{Text: "Bob searches for Forest", Color: "action", Timestamp: left.Add(2 * time.Second), OwnerID: "Bob", PublicText: "Bob searches their library"})
+	gameState.mu.Unlock()
+
+	messages, err := h.engine.GetGameLogSince(h.gameID, "Alice", left)
+	if err != nil {
+		t.Fatalf("failed to get log: %v", err)
+	}
+	if len(messages) != 2 || messages[0].Text != "after" || messages[1].Text != "Bob searches their library" {
+		t.Errorf("expected the 2 missed messages with Bob's search redacted, got %+v", messages)
+	}
+
+	if _, err := h.engine.GetGameLogSince("missing", "Alice", left); err == nil {
+		t.Error("expected an error for an unknown game")
+	}
+}
@@ -0,0 +1,109 @@
+package game
+
+import (
+	cryptorand "crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math/rand/v2"
+)
+
+// RandomSeed is the seed of a game's random source. It is a full ChaCha8 key, so every ordering of a
+// library can come out of a shuffle; a smaller seed would leave most of them unreachable.
+type RandomSeed [32]byte
+
+// String returns the seed in hex
+func (s RandomSeed) String() string {
+	return hex.EncodeToString(s[:])
+}
+
+// MarshalText saves the seed in hex, for action logs and saved games
+func (s RandomSeed) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText reads a seed saved by MarshalText
+func (s *RandomSeed) UnmarshalText(text []byte) error {
+	decoded, err := hex.DecodeString(string(text))
+	if err != nil {
+		return fmt.Errorf("invalid random seed: %w", err)
+	}
+	if len(decoded) != len(s) {
+		return fmt.Errorf("invalid random seed: %d bytes, want %d", len(decoded), len(s))
+	}
+	copy(s[:], decoded)
+	return nil
+}
+
+// gameRandom is the random source of a game. Everything a game does at random goes through it, so a
+// game started from the same seed and given the same actions plays out the same way (see ReplayGame).
+type gameRandom struct {
+	seed   RandomSeed
+	source *rand.ChaCha8
+	rand   *rand.Rand
+}
+
+// newGameRandom returns a game random source seeded with seed
+func newGameRandom(seed RandomSeed) *gameRandom {
+	source := rand.NewChaCha8(seed)
+	return &gameRandom{seed: seed, source: source, rand: rand.New(source)}
+}
+
+// restoreGameRandom returns the random source of a saved game: seeded with seed and continuing from
+// state, the state it was saved in
+func restoreGameRandom(seed RandomSeed, state []byte) (*gameRandom, error) {
+	random := newGameRandom(seed)
+	if err := random.source.UnmarshalBinary(state); err != nil {
+		return nil, fmt.Errorf("failed to restore random source: %w", err)
+	}
+	return random, nil
+}
+
+// state returns where the random source is in its sequence, for restoreGameRandom
+func (r *gameRandom) state() ([]byte, error) {
+	return r.source.MarshalBinary()
+}
+
+// Intn returns a random number in [0, n)
+func (r *gameRandom) Intn(n int) int {
+	return r.rand.IntN(n)
+}
+
+// WithSeed makes every game this engine starts use seed for its random source, so its shuffles are
+// the same each time; meant for tests. It returns the engine, for use with NewMageEngine.
+func (e *MageEngine) WithSeed(seed RandomSeed) *MageEngine {
+	e.seedMu.Lock()
+	defer e.seedMu.Unlock()
+	e.fixedSeed = &seed
+	return e
+}
+
+// GameSeed returns the seed of a game's random source, which replays it with the same shuffles. It must
+// not be shown to players while the game is in progress, since it tells the order of every library.
+func (e *MageEngine) GameSeed(gameID string) (RandomSeed, error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return RandomSeed{}, fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	return gameState.random.seed, nil
+}
+
+// newGameSeed returns the seed for a new game: the one set with WithSeed, or otherwise one from
+// crypto/rand so players can't predict the order of a library (rule 103.2)
+func (e *MageEngine) newGameSeed() RandomSeed {
+	e.seedMu.Lock()
+	defer e.seedMu.Unlock()
+
+	if e.fixedSeed != nil {
+		return *e.fixedSeed
+	}
+	var seed RandomSeed
+	// crypto/rand.Read never returns an error and panics if the system's source fails
+	_, _ = cryptorand.Read(seed[:])
+	return seed
+}
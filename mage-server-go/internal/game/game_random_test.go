@@ -0,0 +1,80 @@
+package game
+
+import (
+	"testing"
+
+	"go.uber.org/zap/zaptest"
+)
+
+// TestGameRandom_SeedReproducesShuffles verifies that games started with the same seed shuffle the same
+// way, and that a saved game continues its random sequence where it left off when loaded
+func TestGameRandom_SeedReproducesShuffles(t *testing.T) {
+	deck := make([]string, 0, 60)
+	for i := 0; i < 30; i++ {
+		deck = append(deck, "Forest", "Grizzly Bears")
+	}
+	decks := map[string][]string{"Alice": deck}
+
+	libraries := make([][]string, 0, 2)
+	engines := make([]*MageEngine, 0, 2)
+	for i := 0; i < 2; i++ {
+		engine := NewMageEngine(zaptest.NewLogger(t)).WithSeed(RandomSeed{42})
+		if err := engine.StartGameWithDecks("test-seeded", []string{"Alice", "Bob"}, "Duel", decks); err != nil {
+			t.Fatalf("failed to start game: %v", err)
+		}
+		if seed, err := engine.GameSeed("test-seeded"); err != nil || seed != (RandomSeed{42}) {
+			t.Fatalf("expected the game to be seeded with the engine's seed, got %s (%v)", seed, err)
+		}
+		libraries = append(libraries, cardIDs(engine.games["test-seeded"].players["Alice"].Library))
+		engines = append(engines, engine)
+	}
+	if !equalStrings(libraries[0], libraries[1]) {
+		t.Fatal("expected games with the same seed to shuffle the same way")
+	}
+
+	// One game is saved and loaded in a new engine; both then shuffle again and must agree
+	data, err := engines[1].SerializeGame("test-seeded")
+	if err != nil {
+		t.Fatalf("failed to serialize game: %v", err)
+	}
+	loader := NewMageEngine(zaptest.NewLogger(t))
+	if _, err := loader.LoadGame(data); err != nil {
+		t.Fatalf("failed to load game: %v", err)
+	}
+	shuffled := func(engine *MageEngine) []string {
+		gameState := engine.games["test-seeded"]
+		gameState.mu.Lock()
+		defer gameState.mu.Unlock()
+		player := gameState.players["Alice"]
+		engine.shuffleLibrary(gameState, player)
+		return cardIDs(player.Library)
+	}
+	if !equalStrings(shuffled(engines[0]), shuffled(loader)) {
+		t.Error("expected the loaded game to continue the random sequence of the saved one")
+	}
+}
+
+// TestRandomSeed_FullSeedFromCryptoRand verifies that new games get a full 32-byte seed from crypto/rand,
+// and that a seed survives being saved as text
+func TestRandomSeed_FullSeedFromCryptoRand(t *testing.T) {
+	engine := NewMageEngine(zaptest.NewLogger(t))
+	first, second := engine.newGameSeed(), engine.newGameSeed()
+	if first == second {
+		t.Fatal("expected each game to get its own seed")
+	}
+	if [8]byte(first[24:]) == [8]byte{} {
+		t.Error("expected the whole seed to be random, its last 8 bytes are zero")
+	}
+
+	text, err := first.MarshalText()
+	if err != nil {
+		t.Fatalf("failed to save seed: %v", err)
+	}
+	var loaded RandomSeed
+	if err := loaded.UnmarshalText(text); err != nil || loaded != first {
+		t.Errorf("expected the seed to load as saved, got %s (%v)", loaded, err)
+	}
+	if err := loaded.UnmarshalText([]byte("42")); err == nil {
+		t.Error("expected a short seed to be rejected")
+	}
+}
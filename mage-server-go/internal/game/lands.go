@@ -0,0 +1,91 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// landsPerTurn is the number of lands a player may play each turn (rule 305.2)
+const landsPerTurn = 1
+
+// isLand reports whether a card is a land
+func isLand(card *internalCard) bool {
+	return card != nil && strings.Contains(card.Type, "Land")
+}
+
+// PlayLand plays a land from a player's hand. Playing a land is a special action: the land doesn't
+// use the stack and is put directly onto the battlefield (rule 305.1). A player may only play a land
+// while they have priority during their own main phase with an empty stack, and only once each turn.
+// Per Java PlayerImpl.playLand()
+func (e *MageEngine) PlayLand(gameID, cardID, playerID string) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "PlayLand", cardID, playerID)
+
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+	if err := e.playLand(gameState, playerID, cardID); err != nil {
+		return err
+	}
+
+	e.notifyPlayerAction(gameID, playerID, map[string]interface{}{
+		"type":    "play_land",
+		"card_id": cardID,
+	})
+	return nil
+}
+
+// playLand checks that a player may play a land from their hand and puts it onto the battlefield;
+// caller must hold the game lock
+func (e *MageEngine) playLand(gameState *engineGameState, playerID, cardID string) error {
+	player, exists := gameState.players[playerID]
+	if !exists {
+		return fmt.Errorf("player %s not found", playerID)
+	}
+
+	var card *internalCard
+	for _, c := range player.Hand {
+		if c.ID == cardID {
+			card = c
+			break
+		}
+	}
+	if card == nil {
+		return fmt.Errorf("card %s not found in hand", cardID)
+	}
+	if !isLand(card) {
+		return fmt.Errorf("%s is not a land and can't be played as one", card.Name)
+	}
+
+	// Per rule 305.1 and 116.2a: priority, main phase of the player's own turn, empty stack
+	if gameState.turnManager.PriorityPlayer() != playerID {
+		return fmt.Errorf("player %s does not have priority", playerID)
+	}
+	if !sorceryTiming(gameState, playerID) {
+		return fmt.Errorf("lands can only be played during your main phase while the stack is empty")
+	}
+	if player.LandsPlayedThisTurn >= landsPerTurn {
+		return fmt.Errorf("player %s has already played a land this turn", playerID)
+	}
+
+	if err := e.moveCard(gameState, card, zoneBattlefield, playerID); err != nil {
+		return err
+	}
+	player.LandsPlayedThisTurn++
+
+	gameState.eventBus.Publish(rules.NewEvent(rules.EventLandPlayed, card.ID, card.ID, playerID))
+	gameState.trackAction()
+	gameState.addMessage(fmt.Sprintf("%s plays %s", playerID, card.Name), "action")
+	return nil
+}
@@ -0,0 +1,90 @@
+package game
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestPlayLand_OnePerTurn verifies that a land is put directly onto the battlefield, that a second
+// land in the same turn and non-land cards are rejected, and that the limit resets the next turn
+func TestPlayLand_OnePerTurn(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-play-land", []string{"Alice", "Bob"})
+	castTestSetup(t, h, "Alice", "forest-1", "Forest", "Basic Land - Forest", rules.StepMain1)
+	castTestSetup(t, h, "Alice", "forest-2", "Forest", "Basic Land - Forest", rules.StepMain1)
+	castTestSetup(t, h, "Alice", "bears", "Grizzly Bears", "Creature - Bear", rules.StepMain1)
+
+	gameState := h.GetGameState()
+	entered := 0
+	gameState.eventBus.SubscribeTyped(rules.EventEntersTheBattlefield, func(evt rules.Event) {
+		if evt.TargetID == "forest-1" {
+			entered++
+		}
+	})
+
+	if err := h.engine.PlayLand(h.gameID, "bears", "Alice"); err == nil || !strings.Contains(err.Error(), "not a land") {
+		t.Errorf("expected playing Grizzly Bears as a land to be rejected, got %v", err)
+	}
+	if err := h.engine.PlayLand(h.gameID, "forest-1", "Alice"); err != nil {
+		t.Fatalf("failed to play a land: %v", err)
+	}
+	if err := h.engine.PlayLand(h.gameID, "forest-2", "Alice"); err == nil {
+		t.Error("expected a second land in the same turn to be rejected")
+	}
+
+	gameState.mu.Lock()
+	if card := gameState.cards["forest-1"]; card.Zone != zoneBattlefield || card.ControllerID != "Alice" {
+		t.Errorf("expected the Forest on the battlefield under Alice's control, got zone %v", card.Zone)
+	}
+	if !gameState.stack.IsEmpty() {
+		t.Error("expected playing a land not to use the stack")
+	}
+	if entered != 1 {
+		t.Errorf("expected one enters-the-battlefield event, got %d", entered)
+	}
+	h.engine.beginTurn(gameState, "Alice")
+	gameState.mu.Unlock()
+
+	if err := h.engine.PlayLand(h.gameID, "forest-2", "Alice"); err != nil {
+		t.Errorf("expected a land to be playable again on the next turn: %v", err)
+	}
+}
+
+// TestPlayLand_TimingRestrictions verifies that lands can't be played on an opponent's turn, outside a
+// main phase, or while the stack isn't empty
+func TestPlayLand_TimingRestrictions(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-play-land-timing", []string{"Alice", "Bob"})
+	castTestSetup(t, h, "Bob", "bob-forest", "Forest", "Basic Land - Forest", rules.StepMain1)
+	castTestSetup(t, h, "Alice", "dart", "Lightning Dart", "Instant", rules.StepMain1)
+	castTestSetup(t, h, "Alice", "forest", "Forest", "Basic Land - Forest", rules.StepMain1)
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.turnManager.SetPriority("Bob")
+	gameState.mu.Unlock()
+	if err := h.engine.PlayLand(h.gameID, "bob-forest", "Bob"); err == nil {
+		t.Error("expected Bob not to be able to play a land on Alice's turn")
+	}
+
+	gameState.mu.Lock()
+	gameState.turnManager.SetPriority("Alice")
+	gameState.mu.Unlock()
+	if err := cast(h, "Alice", "Lightning Dart"); err != nil {
+		t.Fatalf("failed to cast Lightning Dart: %v", err)
+	}
+	gameState.mu.Lock()
+	gameState.turnManager.SetPriority("Alice")
+	gameState.mu.Unlock()
+	if err := h.engine.PlayLand(h.gameID, "forest", "Alice"); err == nil {
+		t.Error("expected a land not to be playable while the stack isn't empty")
+	}
+
+	gameState.mu.Lock()
+	gameState.stack = rules.NewStackManager()
+	gameState.turnManager.AdvanceStep("Alice")
+	gameState.mu.Unlock()
+	if err := h.engine.PlayLand(h.gameID, "forest", "Alice"); err == nil {
+		t.Error("expected a land not to be playable outside a main phase")
+	}
+}
@@ -0,0 +1,103 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// isLegendary reports whether a card has the legendary supertype
+func isLegendary(card *internalCard) bool {
+	return containsFold(card.SuperTypes, "Legendary") || containsFold(strings.Fields(card.Type), "Legendary")
+}
+
+// checkLegendRule asks each player who controls two or more legendary permanents with the same name
+// to choose one of them; the rest are put into their owners' graveyards once the player answers.
+// Every conflicting group gets its decision in the same check. Returns true if a decision was added.
+// Per rule 704.5j and Java GameImpl.checkStateBasedActions() legend rule handling
+func (e *MageEngine) checkLegendRule(gameState *engineGameState) bool {
+	groups := make(map[string][]*internalCard)
+	keys := make([]string, 0)
+	for _, card := range gameState.battlefield {
+		if card.Zone != zoneBattlefield || !isLegendary(card) {
+			continue
+		}
+		key := card.ControllerID + "|" + card.Name
+		if _, exists := groups[key]; !exists {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], card)
+	}
+
+	if gameState.legendDecisions == nil {
+		gameState.legendDecisions = make(map[string]string)
+	}
+
+	added := false
+	for _, key := range keys {
+		group := groups[key]
+		if len(group) < 2 {
+			continue
+		}
+		// The controller is already choosing for this group; the choice covers permanents that joined since
+		if decisionID, pending := gameState.legendDecisions[key]; pending {
+			if _, exists := gameState.decisions[decisionID]; exists {
+				continue
+			}
+		}
+
+		controllerID, name := group[0].ControllerID, group[0].Name
+		choices := make([]string, 0, len(group))
+		for _, card := range group {
+			choices = append(choices, card.ID)
+		}
+
+		groupKey := key
+		decision := gameState.addDecision(&Decision{
+			PlayerID: controllerID,
+			Kind:     DecisionChooseCards,
+			Text:     fmt.Sprintf("Legend rule: choose the %s to keep", name),
+			Choices:  choices,
+			Min:      1,
+			Max:      1,
+			resolve: func(gameState *engineGameState, response Response) error {
+				delete(gameState.legendDecisions, groupKey)
+				e.applyLegendRule(gameState, controllerID, name, response.Choices[0])
+				e.checkStateAndTriggered(gameState)
+				return nil
+			},
+		})
+		gameState.legendDecisions[key] = decision.ID
+		added = true
+
+		if e.logger != nil {
+			e.logger.Debug("legend rule applies",
+				zap.String("game_id", gameState.gameID),
+				zap.String("player_id", controllerID),
+				zap.String("card_name", name),
+				zap.Int("count", len(group)),
+			)
+		}
+	}
+	return added
+}
+
+// applyLegendRule puts every legendary permanent named name that controllerID controls, other than
+// the one they chose to keep, into its owner's graveyard
+func (e *MageEngine) applyLegendRule(gameState *engineGameState, controllerID, name, keepID string) {
+	if kept, exists := gameState.cards[keepID]; !exists || kept.Zone != zoneBattlefield {
+		return // The chosen permanent left; the next check asks again
+	}
+
+	toRemove := make([]*internalCard, 0)
+	for _, card := range gameState.battlefield {
+		if card.ID != keepID && card.Zone == zoneBattlefield && card.ControllerID == controllerID && card.Name == name && isLegendary(card) {
+			toRemove = append(toRemove, card)
+		}
+	}
+	for _, card := range toRemove {
+		e.moveCardToGraveyard(gameState, card)
+		gameState.addMessage(fmt.Sprintf("%s is put into the graveyard (legend rule)", card.Name), "action")
+	}
+}
@@ -0,0 +1,65 @@
+package game
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestLegendRule_ControllerChoosesWhichToKeep verifies rule 704.5j: a player controlling legendary
+// permanents with the same name chooses one to keep and the rest go to the graveyard; every group
+// gets its decision in the same check, and other players' copies are unaffected
+func TestLegendRule_ControllerChoosesWhichToKeep(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-legend-rule", []string{"Alice", "Bob"})
+	for _, spec := range []CreatureSpec{
+		{ID: "isamaru-1", Name: "Isamaru, Hound of Konda", Controller: "Alice"},
+		{ID: "isamaru-2", Name: "Isamaru, Hound of Konda", Controller: "Alice"},
+		{ID: "isamaru-bob", Name: "Isamaru, Hound of Konda", Controller: "Bob"},
+		{ID: "tetsuo-1", Name: "Tetsuo Umezawa", Controller: "Alice"},
+		{ID: "tetsuo-2", Name: "Tetsuo Umezawa", Controller: "Alice"},
+		{ID: "bears-1", Name: "Grizzly Bears", Controller: "Alice"},
+		{ID: "bears-2", Name: "Grizzly Bears", Controller: "Alice"},
+	} {
+		spec.Power, spec.Toughness = "2", "2"
+		h.CreateCreature(spec)
+	}
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	for _, id := range []string{"isamaru-1", "isamaru-2", "isamaru-bob", "tetsuo-1", "tetsuo-2", "bears-1", "bears-2"} {
+		card := gameState.cards[id]
+		if !strings.HasPrefix(id, "bears") {
+			card.SuperTypes = []string{"Legendary"}
+		}
+		gameState.battlefield = append(gameState.battlefield, card)
+	}
+	h.engine.checkStateAndTriggered(gameState)
+	h.engine.checkStateAndTriggered(gameState)
+	gameState.mu.Unlock()
+
+	if decisions, _ := h.engine.GetPendingDecisions(h.gameID, "Bob"); len(decisions) != 0 {
+		t.Errorf("expected Bob to have nothing to choose, got %d decisions", len(decisions))
+	}
+	decisions, err := h.engine.GetPendingDecisions(h.gameID, "Alice")
+	if err != nil || len(decisions) != 2 {
+		t.Fatalf("expected one legend rule decision per group for Alice, got %+v (%v)", decisions, err)
+	}
+
+	keep := map[string]string{"isamaru-1": "isamaru-2", "tetsuo-1": "tetsuo-1"}
+	for _, decision := range decisions {
+		if decision.Kind != DecisionChooseCards || decision.Min != 1 || decision.Max != 1 || len(decision.Choices) != 2 {
+			t.Fatalf("expected a choice of one of two permanents, got %+v", decision)
+		}
+		if err := h.engine.RespondToDecision(h.gameID, "Alice", decision.ID, Response{Choices: []string{keep[decision.Choices[0]]}}); err != nil {
+			t.Fatalf("failed to choose the legend to keep: %v", err)
+		}
+	}
+
+	h.AssertCreatureDead("isamaru-1")
+	h.AssertCreatureDead("tetsuo-2")
+	for _, id := range []string{"isamaru-2", "tetsuo-1", "isamaru-bob", "bears-1", "bears-2"} {
+		h.AssertCreatureAlive(id)
+	}
+	if decisions, _ := h.engine.GetPendingDecisions(h.gameID, "Alice"); len(decisions) != 0 {
+		t.Errorf("expected no further legend rule decisions, got %+v", decisions)
+	}
+}
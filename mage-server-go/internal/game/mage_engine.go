@@ -2,9 +2,12 @@ package game
 
 import (
 	"fmt"
+	"maps"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,6 +16,7 @@ import (
 	"github.com/magefree/mage-server-go/internal/game/mana"
 	"github.com/magefree/mage-server-go/internal/game/rules"
 	"github.com/magefree/mage-server-go/internal/game/targeting"
+	"github.com/magefree/mage-server-go/internal/game/watchers"
 	"go.uber.org/zap"
 )
 
@@ -64,6 +68,22 @@ const (
 	abilityMenace                   = "MenaceAbility"
 	abilityUnblockable              = "CantBeBlockedSourceAbility"
 	abilityBanding                  = "BandingAbility"
+	abilityFlash                    = "FlashAbility"
+	abilityHaste                    = "HasteAbility"
+	abilityIndestructible           = "IndestructibleAbility"
+	abilityProwess                  = "ProwessAbility"
+	abilityCantBeCountered          = "CantBeCounteredSourceAbility"
+	abilitySplitSecond              = "SplitSecondAbility"
+	abilityInfect                   = "InfectAbility"
+	abilityDoesntUntap              = "DontUntapInControllersUntapStepSourceAbility"
+	abilityFlashback                = "FlashbackAbility"
+)
+
+// Tap reasons reported on card views
+const (
+	tapReasonAttack = "attack" // Tapped by attacking (rule 508.1f)
+	tapReasonCost   = "cost"   // Tapped to pay an ability's {T} cost
+	tapReasonEffect = "effect" // Tapped by a spell or ability's effect
 )
 
 // EngineGameView represents the complete game state view for a player
@@ -83,9 +103,17 @@ type EngineGameView struct {
 	Revealed       []EngineRevealedView
 	LookedAt       []EngineLookedAtView
 	Combat         EngineCombatView
+	Monarch        string
 	StartedAt      time.Time
 	Messages       []EngineMessage
 	Prompts        []EnginePrompt
+	Decisions      []Decision // Pending decisions for the requesting player
+	// PriorityTimeLeft is the time the priority player has left to act before passing automatically
+	// (0 = no priority clock; see SetPriorityTimeout)
+	PriorityTimeLeft time.Duration
+	// Version is the number of times the game has changed; clients pass it to GetGameViewDelta to get only
+	// what changed since
+	Version int
 }
 
 // EnginePlayerView represents a player's view in the game
@@ -106,35 +134,39 @@ type EnginePlayerView struct {
 	Lost         bool
 	Left         bool
 	Wins         int
+	TimeBank     time.Duration // Time left on the player's match clock (0 = no match clock)
 }
 
 // EngineCardView represents a card in any zone
 type EngineCardView struct {
-	ID             string
-	Name           string
-	DisplayName    string
-	ManaCost       string
-	Type           string
-	SubTypes       []string
-	SuperTypes     []string
-	Color          string
-	Power          string
-	Toughness      string
-	Loyalty        string
-	CardNumber     int
-	ExpansionSet   string
-	Rarity         string
-	RulesText      string
-	Tapped         bool
-	Flipped        bool
-	Transformed    bool
-	FaceDown       bool
-	Zone           int
-	ControllerID   string
-	OwnerID        string
-	AttachedToCard []string
-	Abilities      []EngineAbilityView
-	Counters       []EngineCounterView
+	ID               string
+	Name             string
+	DisplayName      string
+	ManaCost         string
+	Type             string
+	SubTypes         []string
+	SuperTypes       []string
+	Color            string
+	Power            string // Effective power, after continuous effects and counters
+	Toughness        string // Effective toughness, after continuous effects and counters
+	PrintedPower     string
+	PrintedToughness string
+	Loyalty          string
+	CardNumber       int
+	ExpansionSet     string
+	Rarity           string
+	RulesText        string
+	Tapped           bool
+	TapReason        string // Why the permanent is tapped (tapReasonAttack, tapReasonCost, tapReasonEffect)
+	Flipped          bool
+	Transformed      bool
+	FaceDown         bool
+	Zone             int
+	ControllerID     string
+	OwnerID          string
+	AttachedToCard   []string
+	Abilities        []EngineAbilityView
+	Counters         []EngineCounterView
 }
 
 // EngineAbilityView represents an ability on a card
@@ -199,6 +231,9 @@ type combatState struct {
 	blockers          map[string]bool         // all blocking creatures
 	attackersTapped   map[string]bool         // creatures tapped by attack
 	firstStrikers     map[string]bool         // creatures that dealt damage in first strike step
+	lifelinkDamage    map[string]int          // lifelink sourceID -> combat damage dealt this step
+	// Damage assignment order of the attackers each blocker blocks, chosen by the defending player (rule 509.3)
+	attackerDamageOrders map[string][]string // blockerID -> attacker IDs
 	// Combat requirements/restrictions tracking (Java: Combat lines 70-74)
 	creaturesForcedToAttack    map[string]map[string]bool // creatureID -> set of defenderIDs it must attack (empty = any)
 	creatureMustBlockAttackers map[string]map[string]bool // blockerID -> set of attackerIDs it must block
@@ -221,7 +256,7 @@ type combatGroup struct {
 	blockers            []string       // blocking creature IDs
 	blocked             bool           // is this group blocked
 	attackerOrder       map[string]int // damage assignment order for attackers (deprecated - kept for compatibility)
-	blockerOrder        map[string]int // damage assignment order for blockers (deprecated - kept for compatibility)
+	blockerOrder        map[string]int // damage assignment order for blockers chosen by the attacking player (rule 509.2)
 	// Modern damage division (Rule 510.1c-d: players divide damage as they choose, no ordering required)
 	attackerDamageAssignments map[string]map[string]int // attackerID -> (blockerID -> damage)
 	blockerDamageAssignments  map[string]map[string]int // blockerID -> (attackerID -> damage)
@@ -238,6 +273,7 @@ func newCombatState() *combatState {
 		blockers:                                make(map[string]bool),
 		attackersTapped:                         make(map[string]bool),
 		firstStrikers:                           make(map[string]bool),
+		attackerDamageOrders:                    make(map[string][]string),
 		creaturesForcedToAttack:                 make(map[string]map[string]bool),
 		creatureMustBlockAttackers:              make(map[string]map[string]bool),
 		maxAttackers:                            -1, // no limit by default
@@ -266,18 +302,24 @@ func newCombatGroup(defenderID string, defenderIsPermanent bool, defendingPlayer
 }
 
 // EngineMessage represents a game log message
+// Color is the message category: action, life, combat, mulligan or system.
 type EngineMessage struct {
-	Text      string
-	Color     string
-	Timestamp time.Time
+	Text       string
+	Color      string
+	Timestamp  time.Time
+	OwnerID    string // Player whose hidden information Text reveals ("" = public)
+	PublicText string // What other players see instead of Text ("" = nothing)
 }
 
-// EnginePrompt represents a prompt for player input
+// EnginePrompt represents a prompt for player input.
+// Prompts backed by a typed Decision carry its ID and kind; Text and Options are the text fallback.
 type EnginePrompt struct {
-	PlayerID  string
-	Text      string
-	Options   []string
-	Timestamp time.Time
+	PlayerID   string
+	DecisionID string
+	Kind       DecisionKind
+	Text       string
+	Options    []string
+	Timestamp  time.Time
 }
 
 // internalCard represents a card in the game state
@@ -298,6 +340,7 @@ type internalCard struct {
 	Rarity         string
 	RulesText      string
 	Tapped         bool
+	TapReason      string // Why the permanent is tapped; "" when untapped
 	Flipped        bool
 	Transformed    bool
 	FaceDown       bool
@@ -318,7 +361,26 @@ type internalCard struct {
 	Damage        int            // Damage marked on this creature
 	DamageSources map[string]int // Damage by source ID
 	// Status fields
-	SummoningSickness bool // Does this creature have summoning sickness
+	SummoningSickness   bool           // Does this creature have summoning sickness
+	AbilityUses         map[string]int // Activations this turn by ability ID (rules 602.5b, 606.3)
+	RegenerationShields int            // Regeneration shields until end of turn (rule 701.19)
+	XValue              int            // Value chosen for X when this card was cast (rule 107.3); kept by the permanent it becomes
+	Targets             []string       // Targets chosen when this card was cast as a spell (rule 601.2c)
+	CopyOf              string         // Card this is a copy of a spell of, which isn't a card itself (rule 707.10); "" = a card
+	ExileFromStack      bool           // Exiled instead of going anywhere else as it leaves the stack (flashback, rule 702.34a)
+	Commander           bool           // Its owner's commander (rule 903.3); combat damage it deals to players is tracked
+	// Continuous effects (layer system): printed values and the values last computed from them
+	BasePower           string
+	BaseToughness       string
+	BaseType            string
+	BaseSubTypes        []string
+	BaseControllerID    string // Controller without layer 2 effects
+	LayeredPower        string
+	LayeredToughness    string
+	LayeredType         string
+	LayeredSubTypes     []string
+	LayeredControllerID string
+	layered             bool // Characteristics were last written by recomputeContinuousEffects
 }
 
 // internalPlayer represents a player in the game state
@@ -342,9 +404,20 @@ type internalPlayer struct {
 	TimerTimeout   bool // Player lost due to timer timeout
 	IdleTimeout    bool // Player lost due to idle timeout
 	Conceded       bool // Player conceded
+	Drew           bool // Player agreed to a draw
 	StoredBookmark int  // Bookmark ID for player undo (-1 = no undo available)
 	MulliganCount  int  // Number of times player has mulliganed
 	KeptHand       bool // Whether player has kept their hand
+	// LandsPlayedThisTurn counts the lands the player has played this turn (rule 305.2)
+	LandsPlayedThisTurn int
+	// DrawFromEmptyLibrary records an attempt to draw from an empty library since state-based actions
+	// were last checked
+	DrawFromEmptyLibrary bool
+	// CommanderDamage is the combat damage dealt to the player by each commander, by card ID (rule 903.10a)
+	CommanderDamage map[string]int
+	// AlwaysPromptTriggers pauses for the player to acknowledge and order their triggered abilities,
+	// even when there's nothing to choose (default: put them on the stack automatically)
+	AlwaysPromptTriggers bool
 }
 
 // triggeredAbilityQueueItem represents a triggered ability waiting to be put on the stack
@@ -355,6 +428,10 @@ type triggeredAbilityQueueItem struct {
 	Description string
 	Resolve     func(*engineGameState) error
 	UsesStack   bool // If false, executes immediately without going on stack
+	Optional    bool // "You may ..." ability: its controller chooses whether Resolve runs as it resolves
+	// Condition is an intervening "if" clause (nil = none): checked when the ability would be put on the
+	// stack and again as it resolves; the ability does nothing if it doesn't hold
+	Condition func(*engineGameState) bool
 }
 
 // combatTrigger represents a combat-related trigger condition
@@ -383,6 +460,7 @@ type gameAnalytics struct {
 type engineGameState struct {
 	gameID             string
 	gameType           string
+	rulesOptions       RulesOptions // Format-dependent rules toggles
 	state              GameState
 	players            map[string]*internalPlayer
 	playerOrder        []string
@@ -395,20 +473,54 @@ type engineGameState struct {
 	combat             *combatState // Internal combat state
 	turnManager        *rules.TurnManager
 	stack              *rules.StackManager
+	splitSecondActive  bool // A spell with split second is on the stack
 	eventBus           *rules.EventBus
 	watchers           *rules.WatcherRegistry
 	legality           *rules.LegalityChecker
 	targetValidator    *targeting.TargetValidator
 	layerSystem        *effects.LayerSystem
 	triggeredQueue     []*triggeredAbilityQueueItem // Queue of triggered abilities waiting to be put on stack
+	triggerDecisionID  string                       // Pending decision putting a player's triggers on the stack ("" = none)
+	priorityDecisionID string                       // Pending decision of the player with priority ("" = none)
+	declarations       []string                     // Declare attackers and blockers decisions of the current step
+	legendDecisions    map[string]string            // Legend rule group (controller + name) -> pending decision ID
+	optionalTriggers   map[string]string            // Resolving optional trigger ID -> pending yes/no decision ID
+	pendingLooks       map[string]*pendingLook      // Player ID -> scry or surveil awaiting the player's choice
+	priorityClock      *priorityClock               // Time limit for acting with priority (nil = none)
+	drawProposal       *drawProposal                // Draw the players are voting on (nil = none)
+	drawProposals      int                          // Draws proposed so far, numbering the proposals
+	rollbackVote       *rollbackVote                // Turn rollback the players are voting on (nil = none)
+	rollbackVotes      int                          // Rollbacks requested so far, numbering the votes
+	matchClock         *matchClock                  // Players' time banks (nil = none)
+	random             *gameRandom                  // Source of everything the game does at random
+	actionLog          *ActionLog                   // Accepted actions, for ExportReplay
+	viewHistory        *viewHistory                 // Recent views sent to each player, for GetGameViewDelta
+	cardViews          *cardViewCache               // Last view built of each card
+	replaySource       *replaySource                // Random results of the game being replayed (nil = not a replay)
 	combatTriggers     []*combatTrigger             // Registered combat triggers (for cards with combat-related abilities)
+	castPermissions    []*castPermission            // Effects that change when spells can be cast
+	spellEffects       map[string]spellEffect       // Effects of spells applied as they resolve, by card ID
+	spellCosts         map[string][]abilityCost     // Additional costs of spells, by card ID (rule 601.2f)
+	staticAbilities    []*staticAbility             // Static abilities whose effects apply while their source is on the battlefield
+	manaAbilities      []*manaAbility               // Activated mana abilities of permanents
+	activatedAbilities []*activatedAbility          // Non-mana activated abilities of permanents
+	mulligan           *mulliganProcedure           // Mulligan rounds (nil outside the mulligan phase)
 	simultaneousEvents []rules.Event                // Queue of events that happened simultaneously
 	concedingPlayers   []string                     // Queue of players requesting concession
+	monarchID          string                       // Player who is currently the monarch (empty if none)
 	analytics          *gameAnalytics               // Game metrics and analytics
+	actionSequence     int                          // Number of player actions processed
+	lastActions        map[string]int               // playerID -> sequence number of their last action
+	actionNonces       map[string]bool              // Nonces of recently processed actions
+	actionNonceOrder   []string                     // actionNonces in the order they were processed, oldest first
 	messages           []EngineMessage
+	droppedMessages    int // Messages dropped from the start of the log; the log index of messages[0]
 	prompts            []EnginePrompt
+	decisions          map[string]*Decision // Pending typed decisions keyed by decision ID
 	startedAt          time.Time
-	mu                 sync.RWMutex
+	mu                 gameLock
+	published          atomic.Pointer[publishedViews] // Views built since the game last changed
+	publishMu          sync.Mutex                     // Serializes publishing views
 }
 
 // GameNotification represents a notification that can be sent to UI/websocket clients
@@ -430,6 +542,8 @@ type gameStateSnapshot struct {
 	GameType       string
 	State          GameState
 	TurnNumber     int
+	Phase          string
+	Step           string
 	ActivePlayer   string
 	PriorityPlayer string
 
@@ -446,10 +560,15 @@ type gameStateSnapshot struct {
 	// Stack state
 	StackItems []rules.StackItem
 
+	// Designations
+	Monarch string
+
 	// Other state
-	Messages  []EngineMessage
-	Prompts   []EnginePrompt
-	Timestamp time.Time
+	Messages        []EngineMessage
+	DroppedMessages int
+	Prompts         []EnginePrompt
+	ActionSequence  int // Player actions processed before the snapshot
+	Timestamp       time.Time
 }
 
 // MageEngine is the main game engine implementation
@@ -460,8 +579,10 @@ type MageEngine struct {
 	notificationHandler NotificationHandler // Optional handler for UI/websocket notifications
 
 	// State bookmarking for rollback/undo
-	// Maps gameID -> list of bookmarked states
-	bookmarks map[string][]*gameStateSnapshot
+	// Maps gameID -> list of bookmarked states. Guarded by its own mutex, which is taken after a game's
+	// lock, so bookmarks are taken and restored while the game stays locked.
+	bookmarksMu sync.Mutex
+	bookmarks   map[string][]*gameStateSnapshot
 
 	// Turn rollback system (separate from action bookmarks)
 	// Maps gameID -> map[turnNumber -> snapshot]
@@ -473,6 +594,32 @@ type MageEngine struct {
 	// Replay recording system
 	// Records step-by-step game state for replay and spectator synchronization
 	replayRecorder *ReplayRecorder
+
+	// Debug/admin operations (e.g. DealDamage) are disabled by default
+	// and must be explicitly enabled by tests or admin tooling
+	debugOperationsEnabled bool
+
+	// Cards that decklists are built from (nil = the card data bundled with the engine)
+	cardDatabase *CardDatabase
+
+	// Seed of every new game's random source (nil = one from crypto/rand); tests set it for
+	// determinism. Guarded by its own mutex because games are seeded while e.mu is held.
+	seedMu    sync.Mutex
+	fixedSeed *RandomSeed
+
+	// Triggered abilities of cards by lowercased card name (see RegisterTrigger). Guarded by its own
+	// mutex because triggers are collected while a game's lock is held.
+	triggersMu   sync.RWMutex
+	cardTriggers map[string][]TriggerDefinition
+
+	// Variant formats may let unused mana carry over between steps and phases; by default mana
+	// pools empty at the end of each (rule 500.4)
+	retainManaBetweenSteps bool
+
+	// Writes in-progress games saved at the start of each turn for crash recovery (nil = not saved).
+	// Guarded by its own mutex because games are saved while their lock is held.
+	storeMu    sync.Mutex
+	gameWriter *gameWriter
 }
 
 // NewMageEngine creates a new MageEngine instance
@@ -496,6 +643,21 @@ func (e *MageEngine) SetNotificationHandler(handler NotificationHandler) {
 	e.notificationHandler = handler
 }
 
+// SetDebugOperationsEnabled enables or disables admin/test-only operations such as DealDamage
+func (e *MageEngine) SetDebugOperationsEnabled(enabled bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.debugOperationsEnabled = enabled
+}
+
+// SetRetainManaBetweenSteps sets whether unused mana stays in mana pools from one step or phase to
+// the next instead of emptying (rule 500.4)
+func (e *MageEngine) SetRetainManaBetweenSteps(retain bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.retainManaBetweenSteps = retain
+}
+
 // emitNotification sends a notification to the registered handler
 // This method is safe to call while holding gameState locks because:
 //  1. It only briefly acquires e.mu.RLock() to read the handler
@@ -564,6 +726,17 @@ func (e *MageEngine) notifyPhaseChange(gameID string, data map[string]interface{
 	})
 }
 
+// notifyCombatStep notifies that a combat step has begun and who must act in it
+func (e *MageEngine) notifyCombatStep(gameID string, data map[string]interface{}) {
+	e.emitNotification(GameNotification{
+		Type:      "COMBAT_STEP",
+		GameID:    gameID,
+		PlayerID:  "", // Broadcast to all players
+		Timestamp: time.Now(),
+		Data:      data,
+	})
+}
+
 // notifyPlayerAction notifies about a player action
 func (e *MageEngine) notifyPlayerAction(gameID, playerID string, data map[string]interface{}) {
 	e.emitNotification(GameNotification{
@@ -586,14 +759,108 @@ func (e *MageEngine) notifyTrigger(gameID string, data map[string]interface{}) {
 	})
 }
 
+// newEngineGameState creates the state of a game in progress with no players or cards yet
+func newEngineGameState(gameID, gameType string, options RulesOptions) *engineGameState {
+	gameState := &engineGameState{
+		gameID:       gameID,
+		gameType:     gameType,
+		rulesOptions: options,
+		state:        GameStateInProgress,
+		players:      make(map[string]*internalPlayer),
+		playerOrder:  make([]string, 0),
+		cards:        make(map[string]*internalCard),
+		battlefield:  make([]*internalCard, 0),
+		exile:        make([]*internalCard, 0),
+		command:      make([]*internalCard, 0),
+		revealed:     make([]EngineRevealedView, 0),
+		lookedAt:     make([]EngineLookedAtView, 0),
+		combat:       newCombatState(),
+		analytics: &gameAnalytics{
+			actionsPerTurn: make(map[int]int),
+			turnStartTimes: make(map[int]time.Time),
+			gameStartTime:  time.Now(),
+		},
+		messages:    make([]EngineMessage, 0),
+		prompts:     make([]EnginePrompt, 0),
+		decisions:   make(map[string]*Decision),
+		startedAt:   time.Now(),
+		viewHistory: newViewHistory(),
+		cardViews:   newCardViewCache(),
+	}
+
+	// Initialize supporting systems
+	gameState.stack = rules.NewStackManager()
+	gameState.eventBus = rules.NewEventBus()
+	gameState.watchers = rules.NewWatcherRegistry()
+	gameState.layerSystem = effects.NewLayerSystem()
+	return gameState
+}
+
+// initGameSystems sets up a game's legality checker and target validator and wires its event bus to
+// watchers and triggered abilities; its players and turn manager must already exist
+func (e *MageEngine) initGameSystems(gameState *engineGameState) {
+	// Initialize legality checker and target validator
+	gameState.legality = rules.NewLegalityChecker(gameState)
+	gameState.targetValidator = targeting.NewTargetValidator(gameState)
+
+	// Wire up event bus to watchers
+	gameState.eventBus.Subscribe(func(event rules.Event) {
+		// Per-turn history ("died this turn", "spells cast this turn") starts over each turn
+		if event.Type == rules.EventBeginTurn {
+			gameState.watchers.ResetWatchers()
+		}
+		gameState.watchers.NotifyWatchers(event)
+		e.collectCardTriggers(gameState, event)
+	})
+	gameState.watchers.AddWatcher(watchers.NewSpellCastWatcher(func(event rules.Event) {
+		e.collectTriggers(gameState, event)
+	}))
+	gameState.watchers.AddWatcher(watchers.NewSpellsCastWatcher())
+	gameState.watchers.AddWatcher(watchers.NewDiedThisTurnWatcher())
+	gameState.watchers.AddWatcher(watchers.NewAttackedThisTurnWatcher())
+}
+
 // StartGame initializes a new game state
 func (e *MageEngine) StartGame(gameID string, players []string, gameType string) error {
+	return e.StartGameWithOptions(gameID, players, gameType, RulesOptionsForGameType(gameType))
+}
+
+// StartGameWithOptions starts a game with explicit rules options instead of the game type's defaults
+func (e *MageEngine) StartGameWithOptions(gameID string, players []string, gameType string, options RulesOptions) error {
+	return e.startGame(gameID, players, gameType, options, nil, e.newGameSeed(), nil)
+}
+
+// StartGameWithDecks starts a game where each player's library is built from their decklist of card
+// names, looked up in the card database. Each library is shuffled and the opening hand drawn from its top.
+// Players without a decklist get the placeholder deck that StartGame gives everyone, which is meant
+// for tests and development only.
+func (e *MageEngine) StartGameWithDecks(gameID string, players []string, gameType string, decks map[string][]string) error {
+	return e.startGame(gameID, players, gameType, RulesOptionsForGameType(gameType), decks, e.newGameSeed(), nil)
+}
+
+// SetCardDatabase sets the cards that decklists are built from
+func (e *MageEngine) SetCardDatabase(db *CardDatabase) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cardDatabase = db
+}
+
+// startGame starts a game whose random source is seeded with seed; replay feeds it the decision IDs
+// of a game it replays (nil = a new game)
+func (e *MageEngine) startGame(gameID string, players []string, gameType string, options RulesOptions, decks map[string][]string, seed RandomSeed, replay *replaySource) error {
+	if err := options.Validate(); err != nil {
+		return fmt.Errorf("invalid rules options: %w", err)
+	}
 	if gameID == "" {
 		return fmt.Errorf("gameID is required")
 	}
 	if len(players) < 2 {
 		return fmt.Errorf("at least 2 players required")
 	}
+	libraries, err := e.buildLibraries(players, decks, options.MinimumDeckSize)
+	if err != nil {
+		return err
+	}
 
 	e.mu.Lock()
 	// Note: We manually unlock before calling notifications to avoid deadlock
@@ -605,42 +872,18 @@ func (e *MageEngine) StartGame(gameID string, players []string, gameType string)
 	}
 
 	// Create game state
-	gameState := &engineGameState{
-		gameID:      gameID,
-		gameType:    gameType,
-		state:       GameStateInProgress,
-		players:     make(map[string]*internalPlayer),
-		playerOrder: make([]string, len(players)),
-		cards:       make(map[string]*internalCard),
-		battlefield: make([]*internalCard, 0),
-		exile:       make([]*internalCard, 0),
-		command:     make([]*internalCard, 0),
-		revealed:    make([]EngineRevealedView, 0),
-		lookedAt:    make([]EngineLookedAtView, 0),
-		combat:      newCombatState(),
-		analytics: &gameAnalytics{
-			actionsPerTurn: make(map[int]int),
-			turnStartTimes: make(map[int]time.Time),
-			gameStartTime:  time.Now(),
-		},
-		messages:  make([]EngineMessage, 0),
-		prompts:   make([]EnginePrompt, 0),
-		startedAt: time.Now(),
-	}
-
-	// Initialize supporting systems
-	gameState.stack = rules.NewStackManager()
-	gameState.eventBus = rules.NewEventBus()
-	gameState.watchers = rules.NewWatcherRegistry()
-	gameState.layerSystem = effects.NewLayerSystem()
+	gameState := newEngineGameState(gameID, gameType, options)
+	gameState.random = newGameRandom(seed)
+	gameState.actionLog = newActionLog(gameID, gameType, players, options, decks, seed)
+	gameState.replaySource = replay
 
 	// Create players
-	for i, playerID := range players {
-		gameState.playerOrder[i] = playerID
+	for _, playerID := range players {
+		gameState.playerOrder = append(gameState.playerOrder, playerID)
 		gameState.players[playerID] = &internalPlayer{
 			PlayerID:       playerID,
 			Name:           playerID,
-			Life:           20,
+			Life:           options.StartingLife,
 			Poison:         0,
 			Energy:         0,
 			Library:        make([]*internalCard, 0),
@@ -658,10 +901,16 @@ func (e *MageEngine) StartGame(gameID string, players []string, gameType string)
 			KeptHand:       false, // Haven't kept hand yet
 		}
 
-		// Create starting hand (7 cards)
+		if library, hasDeck := libraries[playerID]; hasDeck {
+			e.drawOpeningHand(gameState, gameState.players[playerID], library)
+			continue
+		}
+
+		// Placeholder deck for tests and development, when no decklist was given
+		// Create starting hand (7 cards by default)
 		// Mix of different card types for testing
 		cardNames := []string{"Lightning Bolt", "Lightning Bolt", "Lightning Bolt", "Counterspell", "Shock", "Lightning Bolt", "Lightning Bolt"}
-		for j := 0; j < 7; j++ {
+		for j := 0; j < options.StartingHandSize; j++ {
 			cardName := cardNames[j%len(cardNames)]
 			card := e.createStarterCard(fmt.Sprintf("%s-card-%d", playerID, j), playerID, cardName)
 			gameState.cards[card.ID] = card
@@ -669,10 +918,10 @@ func (e *MageEngine) StartGame(gameID string, players []string, gameType string)
 			card.Zone = zoneHand
 		}
 
-		// Create library (53 cards for a 60-card deck)
+		// Create library (rest of a 60-card deck)
 		// Mix card types
 		libraryCardNames := []string{"Lightning Bolt", "Counterspell", "Shock", "Lightning Bolt", "Counterspell"}
-		for j := 0; j < 53; j++ {
+		for j := 0; j < 60-options.StartingHandSize; j++ {
 			cardName := libraryCardNames[j%len(libraryCardNames)]
 			card := e.createStarterCard(fmt.Sprintf("%s-library-%d", playerID, j), playerID, cardName)
 			gameState.cards[card.ID] = card
@@ -685,17 +934,12 @@ func (e *MageEngine) StartGame(gameID string, players []string, gameType string)
 	gameState.turnManager = rules.NewTurnManager(players[0])
 	gameState.players[players[0]].HasPriority = true
 
-	// Initialize legality checker and target validator
-	gameState.legality = rules.NewLegalityChecker(gameState)
-	gameState.targetValidator = targeting.NewTargetValidator(gameState)
-
-	// Wire up event bus to watchers
-	gameState.eventBus.Subscribe(func(event rules.Event) {
-		gameState.watchers.NotifyWatchers(event)
-	})
+	// Initialize legality checker, target validator, watchers and triggers
+	e.initGameSystems(gameState)
 
 	// Add initial log message
 	gameState.addMessage("Game started", "action")
+	gameState.actionLog.finishSetup()
 
 	e.games[gameID] = gameState
 
@@ -727,6 +971,13 @@ func (e *MageEngine) StartGame(gameID string, players []string, gameType string)
 		"players":   players,
 	})
 
+	// End the game by its draw resolution once the time limit passes
+	if options.TimeLimit > 0 {
+		time.AfterFunc(options.TimeLimit, func() {
+			_ = e.EndGameAtLimit(gameID, "time limit reached")
+		})
+	}
+
 	if e.logger != nil {
 		e.logger.Info("mage engine started game",
 			zap.String("game_id", gameID),
@@ -785,42 +1036,42 @@ func (e *MageEngine) ProcessAction(gameID string, action PlayerAction) (err erro
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
 
-	if gameState.state == GameStateFinished {
-		return fmt.Errorf("game %s has ended", gameID)
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+
+	// Reject duplicate submissions before anything is changed
+	if gameState.actionNonces[action.Nonce] {
+		return fmt.Errorf("action %s has already been processed", action.Nonce)
 	}
 
 	// Create bookmark before processing action for error recovery
 	// Per Java GameImpl.playPriority() line 1728: rollbackBookmarkOnPriorityStart = bookmarkState()
-	var bookmarkID int
-	gameState.mu.Unlock() // Temporarily unlock to call BookmarkState
-	bookmarkID, bookmarkErr := e.BookmarkState(gameID)
-	gameState.mu.Lock() // Re-acquire lock
+	// The game stays locked, so no other action can change it between the bookmark and this action
+	bookmarkID := e.bookmarkState(gameState)
 
-	if bookmarkErr != nil {
-		if e.logger != nil {
-			e.logger.Warn("failed to create bookmark before action",
-				zap.String("game_id", gameID),
-				zap.Error(bookmarkErr),
-			)
-		}
-		// Continue without bookmark - error recovery won't be available
-		bookmarkID = 0
-	} else {
-		// Set player's stored bookmark for undo
-		// Per Java PlayerImpl.setStoredBookmark(): enables undo button
-		if player, exists := gameState.players[action.PlayerID]; exists {
-			player.StoredBookmark = bookmarkID
-		}
+	// Set player's stored bookmark for undo
+	// Per Java PlayerImpl.setStoredBookmark(): enables undo button
+	if player, exists := gameState.players[action.PlayerID]; exists {
+		player.StoredBookmark = bookmarkID
 	}
 
 	// Defer error recovery: if action fails and we have a bookmark, restore state
 	defer func() {
+		if err == nil {
+			gameState.recordAction(action.PlayerID)
+			gameState.recordActionNonce(action.Nonce)
+			e.logAction(gameState, action)
+			e.resetPriorityClock(gameState, action.PlayerID)
+			e.runMatchClock(gameState)
+		} else {
+			gameState.discardActionRandomness()
+		}
+
 		if err != nil && bookmarkID > 0 {
 			// Restore to bookmarked state on error
 			// Per Java GameImpl.playPriority() line 1800: restoreState(rollbackBookmarkOnPriorityStart, "Game error: " + e)
-			gameState.mu.Unlock() // Temporarily unlock to call RestoreState
-			restoreErr := e.RestoreState(gameID, bookmarkID, fmt.Sprintf("Error recovery: %v", err))
-			gameState.mu.Lock() // Re-acquire lock
+			restoreErr := e.restoreState(gameState, bookmarkID, fmt.Sprintf("Error recovery: %v", err))
 
 			if restoreErr != nil {
 				if e.logger != nil {
@@ -856,9 +1107,7 @@ func (e *MageEngine) ProcessAction(gameID string, action PlayerAction) (err erro
 
 			if !bookmarkInUse {
 				// Remove the bookmark since no player is using it
-				gameState.mu.Unlock() // Temporarily unlock to call RemoveBookmark
 				e.RemoveBookmark(gameID, bookmarkID)
-				gameState.mu.Lock() // Re-acquire lock
 			}
 		}
 	}()
@@ -869,6 +1118,10 @@ func (e *MageEngine) ProcessAction(gameID string, action PlayerAction) (err erro
 		return e.handlePlayerAction(gameState, action)
 	case "SEND_STRING":
 		return e.handleStringAction(gameState, action)
+	case "CAST_SPELL":
+		return e.handleCastSpellAction(gameState, action)
+	case "TAP_FOR_MANA":
+		return e.handleTapForManaAction(gameState, action)
 	case "SEND_INTEGER":
 		return e.handleIntegerAction(gameState, action)
 	case "SEND_UUID":
@@ -920,6 +1173,7 @@ func (e *MageEngine) handlePass(gameState *engineGameState, playerID string) err
 	// Per Java GameImpl.playPriority() line 1740: saveState(false) before each priority
 	e.recordReplayState(gameState)
 
+	gameState.removeDecision(gameState.priorityDecisionID)
 	player.Passed = true
 	gameState.trackPriorityPass()
 	gameState.trackAction()
@@ -939,29 +1193,48 @@ func (e *MageEngine) handlePass(gameState *engineGameState, playerID string) err
 		}
 
 		// Advance step/phase
+		e.emptyManaPools(gameState)
+		gameState.clearDeclarations()
 		nextPlayer := e.getNextPlayer(gameState)
 		oldTurn := gameState.turnManager.TurnNumber()
 		phase, step := gameState.turnManager.AdvanceStep(nextPlayer)
 		newTurn := gameState.turnManager.TurnNumber()
 		gameState.addMessage(fmt.Sprintf("Game advances to %s - %s", phase.String(), step.String()), "action")
 
+		// A game with a turn limit ends instead of starting the turn after the last one
+		if limit := gameState.rulesOptions.TurnLimit; limit > 0 && newTurn > limit {
+			e.endGameAtLimit(gameState, fmt.Sprintf("turn limit %d reached", limit))
+			return nil
+		}
+
+		if newTurn > oldTurn {
+			e.beginTurn(gameState, gameState.turnManager.ActivePlayer())
+		}
+
 		// Save turn snapshot if we advanced to a new turn
 		// Per Java GameImpl.saveRollBackGameState(): save at start of each turn
 		if newTurn > oldTurn {
 			gameState.mu.Unlock() // Temporarily unlock to call SaveTurnSnapshot
 			e.SaveTurnSnapshot(gameState.gameID, newTurn)
 			gameState.mu.Lock() // Re-acquire lock
-		}
-
-		// Cleanup continuous effects at end of turn
-		// Per Java: ContinuousEffects.removeEndOfTurnEffects() in cleanup step
-		if step == rules.StepCleanup && gameState.layerSystem != nil {
-			effects.CleanupEndOfTurnEffects(gameState.layerSystem)
+			e.saveGame(gameState, newTurn)
 		}
 
 		// Get active player
 		activePlayerID := gameState.turnManager.ActivePlayer()
 
+		// Per rule 502.3: the active player's permanents untap as the untap step begins
+		phase, step = e.handleUntapStepBegin(gameState, phase, step, activePlayerID)
+
+		// Per rule 503.1: "at the beginning of your upkeep" abilities trigger
+		e.handleUpkeepStepBegin(gameState, step, activePlayerID)
+
+		// Per rule 514.2: damage wears off and "until end of turn" effects end in the cleanup step
+		e.handleCleanupStepBegin(gameState, step, activePlayerID)
+
+		// Per rule 504.1: the active player draws a card as the draw step begins
+		e.handleDrawStepBegin(gameState, step, activePlayerID)
+
 		// Handle combat step initialization
 		// Per Java BeginCombatStep.beginStep() and DeclareAttackersStep.beginStep()
 		e.handleCombatStepBegin(gameState, step, activePlayerID)
@@ -988,11 +1261,9 @@ func (e *MageEngine) handlePass(gameState *engineGameState, playerID string) err
 			"step":          gameState.turnManager.CurrentStep().String(),
 		})
 
-		// Per rule 117.5: Check state-based actions before priority
-		// Repeat until no more state-based actions occur
-		for e.checkStateBasedActions(gameState) {
-			// Continue checking until stable
-		}
+		// Per rules 117.5 and 603.3: check state-based actions and put triggered abilities (e.g. upkeep
+		// triggers) on the stack before priority
+		e.checkStateAndTriggered(gameState)
 
 		// Emit phase/step change events
 		gameState.eventBus.Publish(rules.NewEvent(rules.EventChangePhase, "", "", activePlayerID))
@@ -1014,6 +1285,8 @@ func (e *MageEngine) handlePass(gameState *engineGameState, playerID string) err
 				}
 			}
 			// Advance step/phase
+			e.emptyManaPools(gameState)
+			gameState.clearDeclarations()
 			nextPlayer := e.getNextPlayer(gameState)
 			phase, step := gameState.turnManager.AdvanceStep(nextPlayer)
 			gameState.addMessage(fmt.Sprintf("Game advances to %s - %s", phase.String(), step.String()), "action")
@@ -1022,15 +1295,25 @@ func (e *MageEngine) handlePass(gameState *engineGameState, playerID string) err
 			// Set priority to active player
 			activePlayerID := gameState.turnManager.ActivePlayer()
 
+			// Per rule 502.3: the active player's permanents untap as the untap step begins
+			_, step = e.handleUntapStepBegin(gameState, phase, step, activePlayerID)
+
+			// Per rule 503.1: "at the beginning of your upkeep" abilities trigger
+			e.handleUpkeepStepBegin(gameState, step, activePlayerID)
+
+			// Per rule 514.2: damage wears off and "until end of turn" effects end in the cleanup step
+			e.handleCleanupStepBegin(gameState, step, activePlayerID)
+
+			// Per rule 504.1: the active player draws a card as the draw step begins
+			e.handleDrawStepBegin(gameState, step, activePlayerID)
+
 			// Handle combat step initialization
 			// Per Java BeginCombatStep.beginStep() and DeclareAttackersStep.beginStep()
 			e.handleCombatStepBegin(gameState, step, activePlayerID)
 
-			// Per rule 117.5: Check state-based actions before priority
-			// Repeat until no more state-based actions occur
-			for e.checkStateBasedActions(gameState) {
-				// Continue checking until stable
-			}
+			// Per rules 117.5 and 603.3: check state-based actions and put triggered abilities (e.g. upkeep
+			// triggers) on the stack before priority
+			e.checkStateAndTriggered(gameState)
 
 			gameState.turnManager.SetPriority(activePlayerID)
 			gameState.players[activePlayerID].HasPriority = true
@@ -1046,7 +1329,7 @@ func (e *MageEngine) handlePass(gameState *engineGameState, playerID string) err
 		gameState.turnManager.SetPriority(nextPlayerID)
 		gameState.players[nextPlayerID].HasPriority = true
 		gameState.players[nextPlayerID].Passed = false
-		gameState.addPrompt(nextPlayerID, "You have priority. Pass?", []string{"PASS", "CAST"})
+		e.requestPriorityDecision(gameState, nextPlayerID, "You have priority. Pass?")
 	}
 
 	return nil
@@ -1065,7 +1348,30 @@ func (e *MageEngine) handleStringAction(gameState *engineGameState, action Playe
 		return e.handlePass(gameState, action.PlayerID)
 	}
 
-	playerID := action.PlayerID
+	var choices *CastSpellData
+	if len(action.Targets) > 0 {
+		choices = &CastSpellData{Name: spellName, Targets: action.Targets}
+	}
+	return e.castSpell(gameState, action.PlayerID, spellName, choices)
+}
+
+// handleCastSpellAction handles CAST_SPELL actions, which cast a spell with choices for its cost
+// (the value of X, how phyrexian mana is paid)
+func (e *MageEngine) handleCastSpellAction(gameState *engineGameState, action PlayerAction) error {
+	data, ok := action.Data.(CastSpellData)
+	if !ok {
+		return fmt.Errorf("CAST_SPELL data must be CastSpellData")
+	}
+	if len(data.Targets) == 0 {
+		data.Targets = action.Targets
+	}
+	return e.castSpell(gameState, action.PlayerID, data.Name, &data)
+}
+
+// castSpell casts a spell, paying its cost from the player's mana pool. It is cast from the player's
+// hand by name, unless choices name a card to cast from another zone (see castableCard).
+// choices are the player's choices for the cost; without them X is 0 and phyrexian mana is paid with mana.
+func (e *MageEngine) castSpell(gameState *engineGameState, playerID, spellName string, choices *CastSpellData) error {
 	player, exists := gameState.players[playerID]
 	if !exists {
 		return fmt.Errorf("player %s not found", playerID)
@@ -1080,26 +1386,77 @@ func (e *MageEngine) handleStringAction(gameState *engineGameState, action Playe
 	// Repeat until stable (SBA → triggers → repeat)
 	e.checkStateAndTriggered(gameState)
 
-	// Find card in hand
-	var card *internalCard
-	for _, c := range player.Hand {
-		if strings.EqualFold(c.Name, spellName) {
-			card = c
-			break
-		}
+	fromZone := zoneHand
+	if choices != nil && choices.CardID != "" {
+		fromZone = choices.FromZone
+	}
+	card, cost, err := e.castableCard(gameState, player, spellName, choices)
+	if err != nil {
+		return err
 	}
 
-	if card == nil {
-		return fmt.Errorf("card %s not found in hand", spellName)
+	// Per rule 702.61a: no spells can be cast while a spell with split second is on the stack
+	if err := gameState.checkSplitSecond(); err != nil {
+		return err
+	}
+
+	// Per rule 307.1: check timing, including cast permissions (flash grants, "only during your turn")
+	if err := e.checkCastTiming(gameState, playerID, card); err != nil {
+		return err
+	}
+
+	// Per rule 601.2c: targets are chosen before the total cost is determined and paid
+	var targets []string
+	if choices != nil {
+		targets = choices.Targets
+	}
+	if err := e.checkSpellTargets(gameState, playerID, card, targets); err != nil {
+		return err
+	}
+
+	// Per rules 601.2b and 601.2f-h: X is announced as the spell is cast, then the total cost is paid
+	x := 0
+	var payWithLife []bool
+	if choices != nil {
+		if choices.X != 0 && !hasXCost(card) {
+			return fmt.Errorf("%s has no X in its mana cost", card.Name)
+		}
+		if choices.X < 0 {
+			return fmt.Errorf("X must not be negative, got %d", choices.X)
+		}
+		x = choices.X
+		payWithLife = choices.PayPhyrexian
+	}
+	var costChoices [][]string
+	if choices != nil {
+		costChoices = choices.CostChoices
+	}
+	payments, err := e.checkCostPayments(gameState, playerID, card.ID, gameState.spellCosts[card.ID], costChoices)
+	if err != nil {
+		return err
+	}
+	if cost != "" {
+		if err := e.payManaCostWithX(gameState, playerID, cost, x, payWithLife); err != nil {
+			return err
+		}
+	}
+	if err := e.payCosts(gameState, playerID, card.ID, gameState.spellCosts[card.ID], payments); err != nil {
+		return err
 	}
+	card.XValue = x
+	card.Targets = append([]string(nil), targets...)
 
 	// Move card to stack
-	player.Hand = e.removeCardFromSlice(player.Hand, card.ID)
+	switch fromZone {
+	case zoneHand:
+		player.Hand = e.removeCardFromSlice(player.Hand, card.ID)
+	case zoneGraveyard:
+		player.Graveyard = e.removeCardFromSlice(player.Graveyard, card.ID)
+	}
 	card.Zone = zoneStack
+	// Per rule 702.34a: a spell cast with flashback is exiled instead of going anywhere else
+	card.ExileFromStack = fromZone == zoneGraveyard
 
-	// Create stack item with resolve function that looks up card by ID
-	// This ensures we get the current card reference, not a stale closure
-	cardID := card.ID
 	stackItem := rules.StackItem{
 		ID:          card.ID,
 		Controller:  playerID,
@@ -1107,17 +1464,27 @@ func (e *MageEngine) handleStringAction(gameState *engineGameState, action Playe
 		Kind:        rules.StackItemKindSpell,
 		SourceID:    card.ID,
 		Metadata:    make(map[string]string),
-		Resolve: func() error {
-			// Look up card by ID to ensure we have the current reference
-			resolveCard, found := gameState.cards[cardID]
-			if !found {
-				return fmt.Errorf("card %s not found in game state", cardID)
-			}
-			return e.resolveSpell(gameState, resolveCard)
-		},
+		Resolve:     e.spellResolver(gameState, card.ID),
+	}
+
+	if card.ExileFromStack {
+		stackItem.Metadata["flashback"] = "true"
+	}
+	if hasXCost(card) {
+		stackItem.Metadata["x_value"] = strconv.Itoa(card.XValue)
+	}
+	if len(targets) > 0 {
+		stackItem.Metadata["targets"] = targeting.FormatTargets(targets)
+	}
+	if e.cantBeCountered(card) {
+		stackItem.Metadata["uncounterable"] = "true"
+	}
+	if e.hasSplitSecond(card) {
+		stackItem.Metadata["split_second"] = "true"
 	}
 
 	gameState.stack.Push(stackItem)
+	gameState.updateSplitSecond()
 	gameState.trackStackItem()
 	gameState.trackStackDepth()
 	gameState.trackSpellCast()
@@ -1129,7 +1496,7 @@ func (e *MageEngine) handleStringAction(gameState *engineGameState, action Playe
 		"action":      "spell_cast",
 		"player_id":   playerID,
 		"card_name":   card.Name,
-		"card_id":     cardID,
+		"card_id":     card.ID,
 		"stack_depth": len(gameState.stack.List()),
 	})
 
@@ -1145,13 +1512,9 @@ func (e *MageEngine) handleStringAction(gameState *engineGameState, action Playe
 		Metadata:    make(map[string]string),
 		Description: fmt.Sprintf("%s casts %s", playerID, card.Name),
 	}
+	spellCastEvent.Metadata["creature"] = strconv.FormatBool(e.isCreature(card))
 	gameState.eventBus.Publish(spellCastEvent)
 
-	// Check for triggered abilities (e.g., "whenever you cast a spell")
-	// Create a triggered ability for Lightning Bolt (for testing - simulates a "Sanctuary" effect)
-	// Triggered abilities go on top of the stack (LIFO - last in, first out)
-	e.createTriggeredAbilityForSpell(gameState, card, playerID)
-
 	// Per MTG rules 117.3c: After a player casts a spell, activates an ability, or takes a special action,
 	// that player retains priority and may take another action. Priority only passes when the player
 	// explicitly passes or when a spell/ability resolves.
@@ -1166,7 +1529,7 @@ func (e *MageEngine) handleStringAction(gameState *engineGameState, action Playe
 	player.HasPriority = true
 	player.Passed = false
 	gameState.turnManager.SetPriority(playerID)
-	gameState.addPrompt(playerID, "You have priority. Cast another spell or pass?", []string{"PASS", "CAST"})
+	e.requestPriorityDecision(gameState, playerID, "You have priority. Cast another spell or pass?")
 
 	return nil
 }
@@ -1190,41 +1553,30 @@ func (e *MageEngine) handleIntegerAction(gameState *engineGameState, action Play
 	}
 
 	playerID := action.PlayerID
-	player, exists := gameState.players[playerID]
-	if !exists {
+	if _, exists := gameState.players[playerID]; !exists {
 		return fmt.Errorf("player %s not found", playerID)
 	}
 
-	// For now, treat integer as life change (for testing)
-	oldLife := player.Life
-	player.Life += value
-	gameState.addMessage(fmt.Sprintf("%s's life changes by %d (now %d)", playerID, value, player.Life), "life")
-
-	// Emit life change event
-	if value < 0 {
-		gameState.eventBus.Publish(rules.Event{
-			Type:        rules.EventLostLife,
-			ID:          uuid.New().String(),
-			TargetID:    playerID,
-			PlayerID:    playerID,
-			Amount:      -value,
-			Timestamp:   time.Now(),
-			Metadata:    make(map[string]string),
-			Description: fmt.Sprintf("%s's life changes from %d to %d", playerID, oldLife, player.Life),
-		})
-	} else {
-		gameState.eventBus.Publish(rules.Event{
-			Type:        rules.EventGainedLife,
-			ID:          uuid.New().String(),
-			TargetID:    playerID,
-			PlayerID:    playerID,
-			Amount:      value,
-			Timestamp:   time.Now(),
-			Metadata:    make(map[string]string),
-			Description: fmt.Sprintf("%s's life changes from %d to %d", playerID, oldLife, player.Life),
-		})
+	// Integers answer numeric choices (e.g. choosing X or an amount); they never change life directly.
+	// Use DealDamage for damage and life loss.
+	// If the player has a pending choose_number decision, the integer answers the oldest one.
+	for _, decision := range gameState.pendingDecisionsFor(playerID) {
+		if decision.Kind != DecisionChooseNumber {
+			continue
+		}
+		if err := e.respondToDecision(gameState, playerID, decision.ID, Response{Number: value}); err != nil {
+			return err
+		}
+		break
 	}
 
+	gameState.addMessage(fmt.Sprintf("%s chooses %d", playerID, value), "action")
+
+	e.notifyPlayerAction(gameState.gameID, playerID, map[string]interface{}{
+		"type":  "integer_response",
+		"value": value,
+	})
+
 	return nil
 }
 
@@ -1250,17 +1602,28 @@ func (e *MageEngine) handleUUIDAction(gameState *engineGameState, action PlayerA
 	// Repeat until stable (SBA → triggers → repeat)
 	e.checkStateAndTriggered(gameState)
 
+	// Countering a spell is a response, which split second doesn't allow
+	if err := gameState.checkSplitSecond(); err != nil {
+		return err
+	}
+
 	// Check if UUID refers to a spell on the stack that can be countered
 	stackItems := gameState.stack.List()
 	for _, item := range stackItems {
 		if item.ID == uuidStr || item.SourceID == uuidStr {
+			// A spell that can't be countered stays on the stack, and the countering action fails
+			if item.Metadata["uncounterable"] == "true" {
+				return fmt.Errorf("%s can't be countered", item.Description)
+			}
+
 			// Counter the spell by removing it from stack
 			removedItem, found := gameState.stack.Remove(item.ID)
 			if found {
+				gameState.updateSplitSecond()
 				gameState.addMessage(fmt.Sprintf("%s counters %s", playerID, removedItem.Description), "action")
 
 				// Move countered spell to graveyard
-				if card, found := gameState.cards[removedItem.SourceID]; found {
+				if card, found := gameState.cards[removedItem.SourceID]; found && !e.removeSpellCopy(gameState, card) && !e.exileFlashbackSpell(gameState, card) {
 					card.Zone = zoneGraveyard
 					if controller, exists := gameState.players[removedItem.Controller]; exists {
 						controller.Graveyard = append(controller.Graveyard, card)
@@ -1301,6 +1664,18 @@ func (e *MageEngine) handleUUIDAction(gameState *engineGameState, action PlayerA
 	return fmt.Errorf("UUID %s not found on stack", uuidStr)
 }
 
+// cantBeCountered reports whether a spell can't be countered, from its ability or its rules text
+// ("This spell can't be countered.", or the card's name in older wordings)
+// Per Java CantBeCounteredSourceAbility
+func (e *MageEngine) cantBeCountered(card *internalCard) bool {
+	if e.hasAbility(card, abilityCantBeCountered) {
+		return true
+	}
+	text := strings.ToLower(strings.ReplaceAll(card.RulesText, "cannot", "can't"))
+	return strings.Contains(text, "this spell can't be countered") ||
+		strings.Contains(text, strings.ToLower(card.Name)+" can't be countered")
+}
+
 // resolveStack resolves all items on the stack
 func (e *MageEngine) resolveStack(gameState *engineGameState) error {
 	gameState.addMessage("Resolving stack", "action")
@@ -1328,6 +1703,7 @@ func (e *MageEngine) resolveStack(gameState *engineGameState) error {
 		if err != nil {
 			return fmt.Errorf("failed to pop from stack: %w", err)
 		}
+		gameState.updateSplitSecond()
 
 		if e.logger != nil {
 			e.logger.Debug("popped from stack",
@@ -1355,10 +1731,16 @@ func (e *MageEngine) resolveStack(gameState *engineGameState) error {
 					zap.String("reason", result.Reason),
 				)
 			}
+			// Per rule 608.2b: a spell whose targets are all illegal is countered by the game rules
+			if item.Kind == rules.StackItemKindSpell {
+				gameState.eventBus.Publish(rules.NewEvent(rules.EventCountered, item.SourceID, "", item.Controller))
+			}
 			// Remove illegal item from game state if it's a card
-			if card, found := gameState.cards[item.SourceID]; found && card.Zone == zoneStack {
+			if card, found := gameState.cards[item.SourceID]; found && card.Zone == zoneStack && !e.removeSpellCopy(gameState, card) && !e.exileFlashbackSpell(gameState, card) {
 				// Move to graveyard (or appropriate zone)
 				card.Zone = zoneGraveyard
+				card.XValue = 0
+				card.Targets = nil
 				if player, exists := gameState.players[item.Controller]; exists {
 					player.Graveyard = append(player.Graveyard, card)
 				}
@@ -1445,11 +1827,23 @@ func (e *MageEngine) resolveStack(gameState *engineGameState) error {
 
 	gameState.turnManager.SetPriority(activePlayerID)
 	gameState.players[activePlayerID].HasPriority = true
-	gameState.addPrompt(activePlayerID, "You have priority. Pass?", []string{"PASS", "CAST"})
+	e.requestPriorityDecision(gameState, activePlayerID, "You have priority. Pass?")
 
 	return nil
 }
 
+// spellResolver returns the resolve function of a spell on the stack. It looks up the card by ID as
+// the spell resolves, so it gets the current card reference rather than a stale one.
+func (e *MageEngine) spellResolver(gameState *engineGameState, cardID string) func() error {
+	return func() error {
+		card, found := gameState.cards[cardID]
+		if !found {
+			return fmt.Errorf("card %s not found in game state", cardID)
+		}
+		return e.resolveSpell(gameState, card)
+	}
+}
+
 // resolveSpell resolves a spell on the stack
 // Per Java Spell.resolve(): instant/sorcery goes to graveyard, permanents go to battlefield
 func (e *MageEngine) resolveSpell(gameState *engineGameState, card *internalCard) error {
@@ -1466,6 +1860,18 @@ func (e *MageEngine) resolveSpell(gameState *engineGameState, card *internalCard
 		)
 	}
 
+	// Apply the spell's registered effect, with the X chosen when it was cast
+	// A copy has the effect of the spell it copies (rule 707.10)
+	effect, exists := gameState.spellEffects[card.ID]
+	if !exists && card.CopyOf != "" {
+		effect, exists = gameState.spellEffects[card.CopyOf]
+	}
+	if exists {
+		if err := effect(gameState, card, card.XValue); err != nil {
+			return fmt.Errorf("failed to apply effect of %s: %w", card.Name, err)
+		}
+	}
+
 	// Determine where the card should go based on its type
 	// Per Java: instant/sorcery -> graveyard, permanents (creature, artifact, enchantment, planeswalker, land) -> battlefield
 	cardType := strings.ToLower(card.Type)
@@ -1484,15 +1890,7 @@ func (e *MageEngine) resolveSpell(gameState *engineGameState, card *internalCard
 			return fmt.Errorf("failed to move permanent to Battlefield: %w", err)
 		}
 
-		// Apply layer system for power/toughness if it's a creature
-		if strings.Contains(cardType, "creature") {
-			power, _ := e.parsePowerToughness(card.Power)
-			toughness, _ := e.parsePowerToughness(card.Toughness)
-			snapshot := effects.NewSnapshot(card.ID, card.ControllerID, []string{"Creature"}, power, toughness, true, true)
-			gameState.layerSystem.Apply(snapshot)
-			card.Power = fmt.Sprintf("%d", snapshot.Power)
-			card.Toughness = fmt.Sprintf("%d", snapshot.Toughness)
-		}
+		// Continuous effects (e.g. anthems) were applied by moveCard via recomputeContinuousEffects
 	} else {
 		// Move instant/sorcery to graveyard
 		// Per Java: controller.moveCards(card, Zone.GRAVEYARD, ability, game)
@@ -1506,108 +1904,66 @@ func (e *MageEngine) resolveSpell(gameState *engineGameState, card *internalCard
 	// This makes the spell resolution irreversible
 	if player, exists := gameState.players[card.ControllerID]; exists {
 		if player.StoredBookmark != -1 {
-			gameState.mu.Unlock() // Temporarily unlock to call ResetPlayerStoredBookmark
-			e.ResetPlayerStoredBookmark(gameState.gameID, card.ControllerID)
-			gameState.mu.Lock() // Re-acquire lock
+			e.resetStoredBookmark(gameState, player)
 		}
 	}
 
 	return nil
 }
 
-// createTriggeredAbilityForSpell creates a triggered ability when a spell is cast
-// This simulates effects like "Sanctuary" that trigger on spell casts
-// Per new implementation: adds to triggered queue instead of immediately to stack
-func (e *MageEngine) createTriggeredAbilityForSpell(gameState *engineGameState, card *internalCard, casterID string) {
-	// For Lightning Bolt, create a triggered ability that gains life
-	// This simulates a "Sanctuary" effect for testing
-	cardNameLower := strings.ToLower(card.Name)
-	if strings.Contains(cardNameLower, "lightning bolt") {
-		triggerID := uuid.New().String()
-
-		// Create triggered ability queue item
-		triggeredAbility := &triggeredAbilityQueueItem{
-			ID:          triggerID,
-			SourceID:    card.ID,
-			Controller:  casterID,
-			Description: fmt.Sprintf("Triggered ability: %s gains 1 life", casterID),
-			UsesStack:   true, // This ability uses the stack
-			Resolve: func(gs *engineGameState) error {
-				player, exists := gs.players[casterID]
-				if !exists {
-					return fmt.Errorf("player %s not found", casterID)
-				}
-				oldLife := player.Life
-				player.Life += 1
-				gs.addMessage(fmt.Sprintf("%s gains 1 life (now %d)", casterID, player.Life), "life")
-
-				// Emit life gain event
-				gs.eventBus.Publish(rules.Event{
-					Type:        rules.EventGainedLife,
-					ID:          uuid.New().String(),
-					TargetID:    casterID,
-					PlayerID:    casterID,
-					Amount:      1,
-					Timestamp:   time.Now(),
-					Description: fmt.Sprintf("%s gains 1 life (from %d to %d)", casterID, oldLife, player.Life),
-				})
-				return nil
-			},
-		}
-
-		// Add to triggered queue instead of directly to stack
-		// Per rule 603.3: triggered abilities are put on stack before priority
-		gameState.triggeredQueue = append(gameState.triggeredQueue, triggeredAbility)
-		gameState.addMessage(fmt.Sprintf("Triggered: %s gains 1 life (queued)", casterID), "action")
-
-		if e.logger != nil {
-			e.logger.Debug("queued triggered ability",
-				zap.String("trigger_id", triggerID),
-				zap.String("spell_id", card.ID),
-				zap.String("controller", casterID),
-			)
-		}
-	}
-}
-
-// GetGameView returns the current game view for a player
-func (e *MageEngine) GetGameView(gameID, playerID string) (interface{}, error) {
-	e.mu.RLock()
-	gameState, exists := e.games[gameID]
-	e.mu.RUnlock()
+// GetGameView returns the current game view for a player
+func (e *MageEngine) GetGameView(gameID, playerID string) (interface{}, error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
 
 	if !exists {
 		return nil, fmt.Errorf("game %s not found", gameID)
 	}
 
+	// Hand out the view built last if the game hasn't changed since, without waiting for the lock
+	if view := gameState.publishedView(playerID); view != nil {
+		return view, nil
+	}
+
 	gameState.mu.RLock()
 	defer gameState.mu.RUnlock()
 
+	view := e.buildGameView(gameState, playerID)
+	view.Version = gameState.recordView(playerID, view)
+	gameState.publishView(playerID, view)
+	return view, nil
+}
+
+// buildGameView builds the view of a game for a player
+func (e *MageEngine) buildGameView(gameState *engineGameState, playerID string) *EngineGameView {
 	view := &EngineGameView{
-		GameID:         gameID,
-		State:          gameState.state,
-		Phase:          gameState.turnManager.CurrentPhase().String(),
-		Step:           gameState.turnManager.CurrentStep().String(),
-		Turn:           gameState.turnManager.TurnNumber(),
-		ActivePlayerID: gameState.turnManager.ActivePlayer(),
-		PriorityPlayer: gameState.turnManager.PriorityPlayer(),
-		Players:        e.buildPlayerViews(gameState, playerID),
-		Battlefield:    e.buildCardViews(gameState.battlefield),
-		Stack:          e.buildStackViews(gameState),
-		Exile:          e.buildCardViews(gameState.exile),
-		Command:        e.buildCardViews(gameState.command),
-		Revealed:       gameState.revealed,
-		LookedAt:       gameState.lookedAt,
-		Combat:         e.buildCombatView(gameState),
-		StartedAt:      gameState.startedAt,
-		Messages:       make([]EngineMessage, len(gameState.messages)),
-		Prompts:        make([]EnginePrompt, len(gameState.prompts)),
+		GameID:           gameState.gameID,
+		State:            gameState.state,
+		Phase:            gameState.turnManager.CurrentPhase().String(),
+		Step:             gameState.turnManager.CurrentStep().String(),
+		Turn:             gameState.turnManager.TurnNumber(),
+		ActivePlayerID:   gameState.turnManager.ActivePlayer(),
+		PriorityPlayer:   gameState.turnManager.PriorityPlayer(),
+		Players:          e.buildPlayerViews(gameState, playerID),
+		Battlefield:      e.cachedCardViews(gameState, gameState.cardsInRangeOf(playerID, gameState.battlefield)),
+		Stack:            e.buildStackViews(gameState),
+		Exile:            e.cachedCardViews(gameState, gameState.exile),
+		Command:          e.cachedCardViews(gameState, gameState.command),
+		Revealed:         gameState.revealed,
+		LookedAt:         gameState.lookedAt,
+		Combat:           e.buildCombatView(gameState),
+		Monarch:          gameState.monarchID,
+		StartedAt:        gameState.startedAt,
+		Messages:         visibleMessages(gameState.messages, playerID, nil),
+		Prompts:          make([]EnginePrompt, len(gameState.prompts)),
+		Decisions:        gameState.pendingDecisionsFor(playerID),
+		PriorityTimeLeft: gameState.priorityTimeLeft(),
 	}
 
-	copy(view.Messages, gameState.messages)
 	copy(view.Prompts, gameState.prompts)
 
-	return view, nil
+	return view
 }
 
 // buildPlayerViews builds player views
@@ -1615,6 +1971,9 @@ func (e *MageEngine) buildPlayerViews(gameState *engineGameState, requestingPlay
 	views := make([]EnginePlayerView, 0, len(gameState.playerOrder))
 
 	for _, playerID := range gameState.playerOrder {
+		if !gameState.inRangeOf(requestingPlayerID, playerID) {
+			continue
+		}
 		player := gameState.players[playerID]
 		view := EnginePlayerView{
 			PlayerID:     player.PlayerID,
@@ -1624,7 +1983,7 @@ func (e *MageEngine) buildPlayerViews(gameState *engineGameState, requestingPlay
 			Energy:       player.Energy,
 			LibraryCount: len(player.Library),
 			HandCount:    len(player.Hand),
-			Graveyard:    e.buildCardViews(player.Graveyard),
+			Graveyard:    e.cachedCardViews(gameState, player.Graveyard),
 			ManaPool: EngineManaPoolView{
 				White:     player.ManaPool.GetTotal(mana.ManaWhite),
 				Blue:      player.ManaPool.GetTotal(mana.ManaBlue),
@@ -1639,11 +1998,12 @@ func (e *MageEngine) buildPlayerViews(gameState *engineGameState, requestingPlay
 			Lost:         player.Lost,
 			Left:         player.Left,
 			Wins:         player.Wins,
+			TimeBank:     gameState.timeBankLeft(playerID),
 		}
 
 		// Only show hand to the owning player
 		if playerID == requestingPlayerID {
-			view.Hand = e.buildCardViews(player.Hand)
+			view.Hand = e.cachedCardViews(gameState, player.Hand)
 		} else {
 			view.Hand = make([]EngineCardView, len(player.Hand))
 			for i := range player.Hand {
@@ -1665,37 +2025,46 @@ func (e *MageEngine) buildPlayerViews(gameState *engineGameState, requestingPlay
 func (e *MageEngine) buildCardViews(cards []*internalCard) []EngineCardView {
 	views := make([]EngineCardView, len(cards))
 	for i, card := range cards {
-		views[i] = EngineCardView{
-			ID:             card.ID,
-			Name:           card.Name,
-			DisplayName:    card.DisplayName,
-			ManaCost:       card.ManaCost,
-			Type:           card.Type,
-			SubTypes:       append([]string(nil), card.SubTypes...),
-			SuperTypes:     append([]string(nil), card.SuperTypes...),
-			Color:          card.Color,
-			Power:          card.Power,
-			Toughness:      card.Toughness,
-			Loyalty:        card.Loyalty,
-			CardNumber:     card.CardNumber,
-			ExpansionSet:   card.ExpansionSet,
-			Rarity:         card.Rarity,
-			RulesText:      card.RulesText,
-			Tapped:         card.Tapped,
-			Flipped:        card.Flipped,
-			Transformed:    card.Transformed,
-			FaceDown:       card.FaceDown,
-			Zone:           card.Zone,
-			ControllerID:   card.ControllerID,
-			OwnerID:        card.OwnerID,
-			AttachedToCard: append([]string(nil), card.AttachedToCard...),
-			Abilities:      append([]EngineAbilityView(nil), card.Abilities...),
-			Counters:       e.buildCounterViews(card.Counters),
-		}
+		views[i] = e.buildCardView(card)
 	}
 	return views
 }
 
+// buildCardView builds the view of a card
+func (e *MageEngine) buildCardView(card *internalCard) EngineCardView {
+	printedPower, printedToughness := printedPowerToughness(card)
+	return EngineCardView{
+		ID:               card.ID,
+		Name:             card.Name,
+		DisplayName:      card.DisplayName,
+		ManaCost:         card.ManaCost,
+		Type:             card.Type,
+		SubTypes:         append([]string(nil), card.SubTypes...),
+		SuperTypes:       append([]string(nil), card.SuperTypes...),
+		Color:            card.Color,
+		Power:            card.Power,
+		Toughness:        card.Toughness,
+		PrintedPower:     printedPower,
+		PrintedToughness: printedToughness,
+		Loyalty:          card.Loyalty,
+		CardNumber:       card.CardNumber,
+		ExpansionSet:     card.ExpansionSet,
+		Rarity:           card.Rarity,
+		RulesText:        card.RulesText,
+		Tapped:           card.Tapped,
+		TapReason:        card.TapReason,
+		Flipped:          card.Flipped,
+		Transformed:      card.Transformed,
+		FaceDown:         card.FaceDown,
+		Zone:             card.Zone,
+		ControllerID:     card.ControllerID,
+		OwnerID:          card.OwnerID,
+		AttachedToCard:   append([]string(nil), card.AttachedToCard...),
+		Abilities:        append([]EngineAbilityView(nil), card.Abilities...),
+		Counters:         e.buildCounterViews(card.Counters),
+	}
+}
+
 // buildStackViews builds stack item views
 // Stack.List() returns items bottom-to-top (topmost last), so last item is top of stack
 func (e *MageEngine) buildStackViews(gameState *engineGameState) []EngineCardView {
@@ -1727,7 +2096,7 @@ func (e *MageEngine) buildStackViews(gameState *engineGameState) []EngineCardVie
 					ControllerID: item.Controller,
 				})
 			} else {
-				cardView := e.buildCardViews([]*internalCard{card})[0]
+				cardView := e.cachedCardViews(gameState, []*internalCard{card})[0]
 				cardView.Zone = zoneStack
 				views = append(views, cardView)
 			}
@@ -1773,6 +2142,8 @@ func (e *MageEngine) buildCounterViews(counters *counters.Counters) []EngineCoun
 			Count: counter.Count,
 		})
 	}
+	// Sorted so the same counters always give the same view
+	sort.Slice(views, func(i, j int) bool { return views[i].Name < views[j].Name })
 	return views
 }
 
@@ -1791,7 +2162,7 @@ func (e *MageEngine) GetGameAnalytics(gameID string) (map[string]interface{}, er
 
 // PlayerConcede handles a player conceding the game
 // Per Java GameImpl.setConcedingPlayer() and PlayerImpl.concede()
-func (e *MageEngine) PlayerConcede(gameID, playerID string) error {
+func (e *MageEngine) PlayerConcede(gameID, playerID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -1802,22 +2173,28 @@ func (e *MageEngine) PlayerConcede(gameID, playerID string) error {
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "PlayerConcede", playerID)
 
 	player, exists := gameState.players[playerID]
 	if !exists {
 		return fmt.Errorf("player %s not found", playerID)
 	}
+	e.concede(gameState, player)
+	return nil
+}
 
+// concede makes a player concede; caller must hold the game lock
+func (e *MageEngine) concede(gameState *engineGameState, player *internalPlayer) {
 	// Add to conceding players queue if not already there
 	alreadyQueued := false
 	for _, pid := range gameState.concedingPlayers {
-		if pid == playerID {
+		if pid == player.PlayerID {
 			alreadyQueued = true
 			break
 		}
 	}
 	if !alreadyQueued {
-		gameState.concedingPlayers = append(gameState.concedingPlayers, playerID)
+		gameState.concedingPlayers = append(gameState.concedingPlayers, player.PlayerID)
 	}
 
 	// Mark player as conceded
@@ -1825,8 +2202,8 @@ func (e *MageEngine) PlayerConcede(gameID, playerID string) error {
 
 	if e.logger != nil {
 		e.logger.Info("player conceded",
-			zap.String("game_id", gameID),
-			zap.String("player_id", playerID),
+			zap.String("game_id", gameState.gameID),
+			zap.String("player_id", player.PlayerID),
 			zap.String("player_name", player.Name),
 		)
 	}
@@ -1834,13 +2211,11 @@ func (e *MageEngine) PlayerConcede(gameID, playerID string) error {
 	// Process concession immediately (in Java this is done on next priority check)
 	e.checkConcede(gameState)
 	e.checkIfGameIsOver(gameState)
-
-	return nil
 }
 
 // PlayerQuit handles a player quitting the match
 // Per Java PlayerImpl.quit()
-func (e *MageEngine) PlayerQuit(gameID, playerID string) error {
+func (e *MageEngine) PlayerQuit(gameID, playerID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -1850,15 +2225,16 @@ func (e *MageEngine) PlayerQuit(gameID, playerID string) error {
 	}
 
 	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "PlayerQuit", playerID)
+
 	player, exists := gameState.players[playerID]
 	if !exists {
-		gameState.mu.Unlock()
 		return fmt.Errorf("player %s not found", playerID)
 	}
 
 	player.Quit = true
 	gameState.addMessage(fmt.Sprintf("%s quits the match", player.Name), "system")
-	gameState.mu.Unlock()
 
 	if e.logger != nil {
 		e.logger.Info("player quit",
@@ -1869,12 +2245,13 @@ func (e *MageEngine) PlayerQuit(gameID, playerID string) error {
 	}
 
 	// Quitting also triggers concession
-	return e.PlayerConcede(gameID, playerID)
+	e.concede(gameState, player)
+	return nil
 }
 
 // PlayerTimerTimeout handles a player timing out
 // Per Java PlayerImpl.timerTimeout()
-func (e *MageEngine) PlayerTimerTimeout(gameID, playerID string) error {
+func (e *MageEngine) PlayerTimerTimeout(gameID, playerID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -1884,16 +2261,17 @@ func (e *MageEngine) PlayerTimerTimeout(gameID, playerID string) error {
 	}
 
 	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "PlayerTimerTimeout", playerID)
+
 	player, exists := gameState.players[playerID]
 	if !exists {
-		gameState.mu.Unlock()
 		return fmt.Errorf("player %s not found", playerID)
 	}
 
 	player.Quit = true
 	player.TimerTimeout = true
 	gameState.addMessage(fmt.Sprintf("%s loses due to timer timeout", player.Name), "system")
-	gameState.mu.Unlock()
 
 	if e.logger != nil {
 		e.logger.Info("player timer timeout",
@@ -1904,11 +2282,12 @@ func (e *MageEngine) PlayerTimerTimeout(gameID, playerID string) error {
 	}
 
 	// Timer timeout also triggers concession
-	return e.PlayerConcede(gameID, playerID)
+	e.concede(gameState, player)
+	return nil
 }
 
 // PlayerIdleTimeout handles a player idling out
-func (e *MageEngine) PlayerIdleTimeout(gameID, playerID string) error {
+func (e *MageEngine) PlayerIdleTimeout(gameID, playerID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -1918,16 +2297,17 @@ func (e *MageEngine) PlayerIdleTimeout(gameID, playerID string) error {
 	}
 
 	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "PlayerIdleTimeout", playerID)
+
 	player, exists := gameState.players[playerID]
 	if !exists {
-		gameState.mu.Unlock()
 		return fmt.Errorf("player %s not found", playerID)
 	}
 
 	player.Quit = true
 	player.IdleTimeout = true
 	gameState.addMessage(fmt.Sprintf("%s loses due to idle timeout", player.Name), "system")
-	gameState.mu.Unlock()
 
 	if e.logger != nil {
 		e.logger.Info("player idle timeout",
@@ -1938,7 +2318,8 @@ func (e *MageEngine) PlayerIdleTimeout(gameID, playerID string) error {
 	}
 
 	// Idle timeout also triggers concession
-	return e.PlayerConcede(gameID, playerID)
+	e.concede(gameState, player)
+	return nil
 }
 
 // checkConcede processes all players in the conceding queue
@@ -1958,14 +2339,17 @@ func (e *MageEngine) checkConcede(gameState *engineGameState) {
 // Per Java PlayerImpl.leave() and GameImpl.leave()
 func (e *MageEngine) playerLeave(gameState *engineGameState, playerID string) {
 	player, exists := gameState.players[playerID]
-	if !exists {
+	if !exists || player.Left {
 		return
 	}
 
+	hadPriority := gameState.turnManager.PriorityPlayer() == playerID
+
 	// Mark player as left and lost
 	player.Left = true
 	player.Lost = true
 	player.Passed = true
+	player.HasPriority = false
 
 	// Emit player lost event
 	lostEvent := rules.Event{
@@ -1977,6 +2361,8 @@ func (e *MageEngine) playerLeave(gameState *engineGameState, playerID string) {
 	gameState.eventBus.Publish(lostEvent)
 
 	gameState.addMessage(fmt.Sprintf("%s has lost the game", player.Name), "system")
+	// Rolling back would bring the player back into the game, so a vote on a rollback is called off
+	e.cancelRollbackVote(gameState, fmt.Sprintf("The rollback vote is cancelled: %s left the game", player.Name))
 
 	if e.logger != nil {
 		e.logger.Info("player left game",
@@ -1986,8 +2372,220 @@ func (e *MageEngine) playerLeave(gameState *engineGameState, playerID string) {
 		)
 	}
 
+	// Registered triggers that care about a player leaving (e.g. "when a player leaves the game")
+	// see the event while the leaving player's objects are still in the game
+	e.checkCombatTriggers(gameState, lostEvent)
+
+	// Combat must not keep referencing creatures that are about to leave the game
+	e.removePlayerFromCombat(gameState, playerID)
+
 	// Per rule 800.4a: When a player leaves the game, all objects owned by that player leave the game
 	e.removePlayerObjects(gameState, playerID)
+
+	// Per rule 800.4a: Any objects still controlled by that player are exiled
+	e.exileObjectsControlledByLeaver(gameState, playerID)
+
+	// Per rule 800.4i: If the monarch leaves, another player becomes the monarch
+	if gameState.monarchID == playerID {
+		e.transferMonarchOnLeave(gameState, playerID)
+	}
+
+	// Per rule 800.4: The game continues for the remaining players, so hand priority onward
+	if hadPriority {
+		nextPlayerID := e.getNextPlayerWithPriority(gameState, playerID)
+		if nextPlayerID != "" {
+			gameState.turnManager.SetPriority(nextPlayerID)
+			gameState.players[nextPlayerID].HasPriority = true
+			gameState.players[nextPlayerID].Passed = false
+		}
+	}
+
+	// The players left may all have accepted a draw the leaver hadn't
+	e.resolveDrawProposal(gameState)
+}
+
+// removePlayerFromCombat cleans up combat when a player leaves the game
+// Per Java Combat.removePlayer(): the leaver's attackers and blockers are removed from combat, creatures
+// attacking the leaver or their planeswalkers are removed from combat. Attackers the leaver blocked stay
+// blocked (rule 506.4).
+// If the leaver was the attacking player, the attacking player is reset.
+func (e *MageEngine) removePlayerFromCombat(gameState *engineGameState, playerID string) {
+	combat := gameState.combat
+	if combat == nil {
+		return
+	}
+
+	toRemove := make(map[string]bool)
+	for creatureID := range combat.attackers {
+		if card, exists := gameState.cards[creatureID]; !exists || card.ControllerID == playerID || card.OwnerID == playerID {
+			toRemove[creatureID] = true
+		}
+	}
+	for creatureID := range combat.blockers {
+		if card, exists := gameState.cards[creatureID]; !exists || card.ControllerID == playerID || card.OwnerID == playerID {
+			toRemove[creatureID] = true
+		}
+	}
+	for _, group := range combat.groups {
+		if group.defenderID == playerID || group.defendingPlayerID == playerID {
+			for _, attackerID := range group.attackers {
+				toRemove[attackerID] = true
+			}
+		}
+	}
+
+	// Per rule 506.4: an attacking creature that was blocked stays blocked even if the leaver controlled
+	// all its blockers
+	blocked := make(map[*combatGroup]bool, len(combat.groups))
+	for _, group := range combat.groups {
+		blocked[group] = group.blocked
+	}
+
+	for creatureID := range toRemove {
+		if card, exists := gameState.cards[creatureID]; exists {
+			e.removeFromCombat(gameState, card)
+		} else {
+			delete(combat.attackers, creatureID)
+			delete(combat.blockers, creatureID)
+			delete(combat.blockingGroups, creatureID)
+		}
+	}
+
+	// Blockers whose attackers are all gone no longer block anything
+	activeGroups := make(map[*combatGroup]bool, len(combat.groups))
+	for _, group := range combat.groups {
+		activeGroups[group] = true
+	}
+	for blockerID, group := range combat.blockingGroups {
+		if activeGroups[group] {
+			continue
+		}
+		if blocker, exists := gameState.cards[blockerID]; exists {
+			e.removeFromCombat(gameState, blocker)
+		}
+	}
+	for _, group := range combat.groups {
+		group.blocked = blocked[group]
+		for _, blockerID := range group.blockers {
+			blocker, exists := gameState.cards[blockerID]
+			if !exists {
+				continue
+			}
+			stillBlocking := make([]string, 0, len(blocker.BlockingWhat))
+			for _, attackerID := range blocker.BlockingWhat {
+				if combat.attackers[attackerID] {
+					stillBlocking = append(stillBlocking, attackerID)
+				}
+			}
+			blocker.BlockingWhat = stillBlocking
+		}
+	}
+
+	delete(combat.defenders, playerID)
+	if combat.attackingPlayerID == playerID {
+		combat.attackingPlayerID = ""
+	}
+}
+
+// exileObjectsControlledByLeaver exiles permanents controlled, but not owned, by a leaving player
+// Per rule 800.4a: "Then, if there are any objects still controlled by that player, those objects are exiled."
+func (e *MageEngine) exileObjectsControlledByLeaver(gameState *engineGameState, playerID string) {
+	toExile := make([]*internalCard, 0)
+	for _, card := range gameState.cards {
+		if card.Zone == zoneBattlefield && card.ControllerID == playerID && card.OwnerID != playerID {
+			toExile = append(toExile, card)
+		}
+	}
+
+	for _, card := range toExile {
+		if err := e.moveCard(gameState, card, zoneExile, card.OwnerID); err != nil {
+			if e.logger != nil {
+				e.logger.Warn("failed to exile object controlled by leaving player",
+					zap.String("card_id", card.ID),
+					zap.String("player_id", playerID),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+}
+
+// transferMonarchOnLeave passes the monarch designation when the monarch leaves the game
+// Per rule 800.4i: the active player becomes the monarch; if the monarch leaves during
+// their own turn, the next player in turn order becomes the monarch
+func (e *MageEngine) transferMonarchOnLeave(gameState *engineGameState, leavingPlayerID string) {
+	newMonarch := gameState.turnManager.ActivePlayer()
+	if newMonarch == leavingPlayerID {
+		newMonarch = e.getNextPlayerWithPriority(gameState, leavingPlayerID)
+	}
+
+	if player, exists := gameState.players[newMonarch]; !exists || !player.canRespond() {
+		gameState.monarchID = ""
+		return
+	}
+
+	e.setMonarch(gameState, newMonarch)
+}
+
+// setMonarch makes a player the monarch and fires BECOMES_MONARCH
+func (e *MageEngine) setMonarch(gameState *engineGameState, playerID string) {
+	if gameState.monarchID == playerID {
+		return
+	}
+
+	gameState.monarchID = playerID
+	gameState.addMessage(fmt.Sprintf("%s becomes the monarch", gameState.players[playerID].Name), "action")
+
+	gameState.eventBus.Publish(rules.Event{
+		Type:      rules.EventBecomesMonarch,
+		ID:        uuid.New().String(),
+		TargetID:  playerID,
+		PlayerID:  playerID,
+		Timestamp: time.Now(),
+	})
+}
+
+// SetMonarch makes a player the monarch
+// Per rule 724: only one player can be the monarch at a time
+func (e *MageEngine) SetMonarch(gameID, playerID string) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "SetMonarch", playerID)
+
+	player, exists := gameState.players[playerID]
+	if !exists {
+		return fmt.Errorf("player %s not found", playerID)
+	}
+	if !player.canRespond() {
+		return fmt.Errorf("player %s is no longer in the game", playerID)
+	}
+
+	e.setMonarch(gameState, playerID)
+	return nil
+}
+
+// GetMonarch returns the current monarch (empty if none)
+func (e *MageEngine) GetMonarch(gameID string) (string, error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return "", fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+
+	return gameState.monarchID, nil
 }
 
 // removePlayerObjects removes all objects owned by a player from the game
@@ -2034,6 +2632,7 @@ func (e *MageEngine) removePlayerObjects(gameState *engineGameState, playerID st
 			gameState.stack.Remove(item.ID)
 		}
 	}
+	gameState.updateSplitSecond()
 }
 
 // checkIfGameIsOver checks if the game should end
@@ -2048,9 +2647,10 @@ func (e *MageEngine) checkIfGameIsOver(gameState *engineGameState) bool {
 	numLosers := 0
 	var lastRemainingPlayer *internalPlayer
 
+	// Per Java GameImpl.checkIfGameIsOver(): players who lost, left or drew are no longer remaining
 	for _, pid := range gameState.playerOrder {
 		player := gameState.players[pid]
-		if !player.Left {
+		if !player.Left && !player.Lost && !player.Drew {
 			remainingPlayers++
 			lastRemainingPlayer = player
 		}
@@ -2063,39 +2663,10 @@ func (e *MageEngine) checkIfGameIsOver(gameState *engineGameState) bool {
 	if remainingPlayers <= 1 || numLosers == len(gameState.playerOrder) {
 		if remainingPlayers == 1 && lastRemainingPlayer != nil {
 			// Single winner
-			lastRemainingPlayer.Wins++
-			gameState.state = GameStateFinished
-			gameState.addMessage(fmt.Sprintf("%s wins the game!", lastRemainingPlayer.Name), "system")
-
-			// Notify game end
-			e.notifyGameStateChange(gameState.gameID, map[string]interface{}{
-				"state":     "finished",
-				"winner_id": lastRemainingPlayer.PlayerID,
-				"winner":    lastRemainingPlayer.Name,
-			})
-
-			if e.logger != nil {
-				e.logger.Info("game ended",
-					zap.String("game_id", gameState.gameID),
-					zap.String("winner", lastRemainingPlayer.Name),
-				)
-			}
+			e.declareWinner(gameState, lastRemainingPlayer)
 		} else {
 			// Draw or all players lost
-			gameState.state = GameStateFinished
-			gameState.addMessage("Game ended in a draw", "system")
-
-			// Notify game end
-			e.notifyGameStateChange(gameState.gameID, map[string]interface{}{
-				"state":  "finished",
-				"result": "draw",
-			})
-
-			if e.logger != nil {
-				e.logger.Info("game ended in draw",
-					zap.String("game_id", gameState.gameID),
-				)
-			}
+			e.declareDraw(gameState)
 		}
 		return true
 	}
@@ -2103,8 +2674,126 @@ func (e *MageEngine) checkIfGameIsOver(gameState *engineGameState) bool {
 	return false
 }
 
+// declareWinner finishes the game with a single winner
+func (e *MageEngine) declareWinner(gameState *engineGameState, winner *internalPlayer) {
+	winner.Wins++
+	gameState.state = GameStateFinished
+	e.deleteSavedGame(gameState.gameID)
+	gameState.addMessage(fmt.Sprintf("%s wins the game!", winner.Name), "system")
+
+	gameState.eventBus.Publish(rules.Event{
+		Type:      rules.EventWins,
+		ID:        uuid.New().String(),
+		PlayerID:  winner.PlayerID,
+		Timestamp: time.Now(),
+	})
+
+	// Notify game end
+	e.notifyGameStateChange(gameState.gameID, map[string]interface{}{
+		"state":     "finished",
+		"winner_id": winner.PlayerID,
+		"winner":    winner.Name,
+	})
+
+	if e.logger != nil {
+		e.logger.Info("game ended",
+			zap.String("game_id", gameState.gameID),
+			zap.String("winner", winner.Name),
+		)
+	}
+}
+
+// declareDraw finishes the game without a winner
+func (e *MageEngine) declareDraw(gameState *engineGameState) {
+	gameState.state = GameStateFinished
+	e.deleteSavedGame(gameState.gameID)
+	gameState.addMessage("Game ended in a draw", "system")
+
+	// Notify game end
+	e.notifyGameStateChange(gameState.gameID, map[string]interface{}{
+		"state":  "finished",
+		"result": "draw",
+	})
+
+	if e.logger != nil {
+		e.logger.Info("game ended in draw",
+			zap.String("game_id", gameState.gameID),
+		)
+	}
+}
+
+// EndGameAtLimit ends a game that ran out of time (e.g. a tournament round's time limit).
+// The result is decided by the game's DrawResolution policy.
+func (e *MageEngine) EndGameAtLimit(gameID, reason string) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "EndGameAtLimit", reason)
+
+	if gameState.state == GameStateFinished {
+		return fmt.Errorf("game %s is already finished", gameID)
+	}
+
+	e.endGameAtLimit(gameState, reason)
+	return nil
+}
+
+// endGameAtLimit ends a game at a turn or time limit, applying the DrawResolution policy
+func (e *MageEngine) endGameAtLimit(gameState *engineGameState, reason string) {
+	policy := gameState.rulesOptions.DrawResolution
+	gameState.addMessage(fmt.Sprintf("Game ends: %s", reason), "system")
+
+	if winner := e.limitWinner(gameState, policy); winner != nil {
+		gameState.addMessage(fmt.Sprintf("%s wins by %s", winner.Name, policy), "system")
+		e.declareWinner(gameState, winner)
+		return
+	}
+	e.declareDraw(gameState)
+}
+
+// limitWinner returns the remaining player the policy prefers, or nil for a draw
+// (TrueDraw, or a tie for the best value)
+func (e *MageEngine) limitWinner(gameState *engineGameState, policy DrawResolution) *internalPlayer {
+	var score func(player *internalPlayer) int
+	switch policy {
+	case DrawResolutionHighestLife:
+		score = func(player *internalPlayer) int { return player.Life }
+	case DrawResolutionFewestPoison:
+		score = func(player *internalPlayer) int { return -player.Poison }
+	default:
+		return nil
+	}
+
+	var best *internalPlayer
+	tied := false
+	for _, pid := range gameState.playerOrder {
+		player := gameState.players[pid]
+		if player.Lost || player.Left {
+			continue
+		}
+		switch {
+		case best == nil || score(player) > score(best):
+			best = player
+			tied = false
+		case score(player) == score(best):
+			tied = true
+		}
+	}
+	if tied {
+		return nil
+	}
+	return best
+}
+
 // EndGame ends a game
-func (e *MageEngine) EndGame(gameID string, winner string) error {
+func (e *MageEngine) EndGame(gameID string, winner string) (err error) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
@@ -2115,8 +2804,10 @@ func (e *MageEngine) EndGame(gameID string, winner string) error {
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "EndGame", winner)
 
 	gameState.state = GameStateFinished
+	e.deleteSavedGame(gameID)
 	gameState.addMessage(fmt.Sprintf("Game ended. Winner: %s", winner), "action")
 
 	if e.logger != nil {
@@ -2130,7 +2821,7 @@ func (e *MageEngine) EndGame(gameID string, winner string) error {
 }
 
 // PauseGame pauses a game
-func (e *MageEngine) PauseGame(gameID string) error {
+func (e *MageEngine) PauseGame(gameID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -2141,6 +2832,7 @@ func (e *MageEngine) PauseGame(gameID string) error {
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "PauseGame")
 
 	// Validate state
 	if gameState.state == GameStatePaused {
@@ -2151,8 +2843,15 @@ func (e *MageEngine) PauseGame(gameID string) error {
 	}
 
 	gameState.state = GameStatePaused
+	e.stopPriorityClock(gameState)
+	e.stopMatchClock(gameState)
 	gameState.addMessage("Game paused", "action")
 
+	// Clients disable input until the game is resumed
+	e.notifyGameStateChange(gameID, map[string]interface{}{
+		"state": "paused",
+	})
+
 	if e.logger != nil {
 		e.logger.Info("mage engine paused game", zap.String("game_id", gameID))
 	}
@@ -2161,7 +2860,7 @@ func (e *MageEngine) PauseGame(gameID string) error {
 }
 
 // ResumeGame resumes a paused game
-func (e *MageEngine) ResumeGame(gameID string) error {
+func (e *MageEngine) ResumeGame(gameID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -2172,14 +2871,21 @@ func (e *MageEngine) ResumeGame(gameID string) error {
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "ResumeGame")
 
 	if gameState.state != GameStatePaused {
 		return fmt.Errorf("game %s is not paused", gameID)
 	}
 
 	gameState.state = GameStateInProgress
+	e.startPriorityClock(gameState)
+	e.runMatchClock(gameState)
 	gameState.addMessage("Game resumed", "action")
 
+	e.notifyGameStateChange(gameID, map[string]interface{}{
+		"state": "in_progress",
+	})
+
 	if e.logger != nil {
 		e.logger.Info("mage engine resumed game", zap.String("game_id", gameID))
 	}
@@ -2240,6 +2946,11 @@ func (e *MageEngine) processTriggeredAbilities(gameState *engineGameState) bool
 		return false
 	}
 
+	// Waiting for a player to put their triggers on the stack; later players' triggers go on top
+	if gameState.triggerDecisionID != "" {
+		return false
+	}
+
 	played := false
 	activePlayerID := gameState.turnManager.ActivePlayer()
 
@@ -2262,7 +2973,20 @@ func (e *MageEngine) processTriggeredAbilities(gameState *engineGameState) bool
 				break
 			}
 
-			// Per Java lines 2339-2347: Process non-stack abilities first
+			// Per rule 603.4: an ability with an intervening "if" clause doesn't trigger unless its
+			// condition is true
+			for i := len(abilities) - 1; i >= 0; i-- {
+				ability := abilities[i]
+				if ability.Condition != nil && !ability.Condition(gameState) {
+					e.removeTriggeredAbility(gameState, ability.ID)
+					abilities = append(abilities[:i], abilities[i+1:]...)
+				}
+			}
+			if len(abilities) == 0 {
+				continue
+			}
+
+			// Per Java lines 2339-2347: Process non-stack abilities first
 			// (e.g., Banisher Priest return exiled creature)
 			for i := len(abilities) - 1; i >= 0; i-- {
 				ability := abilities[i]
@@ -2293,41 +3017,28 @@ func (e *MageEngine) processTriggeredAbilities(gameState *engineGameState) bool
 				break
 			}
 
+			// Per rule 603.3b: a player with several triggers chooses the order they're put on the stack in
+			// (see OrderTriggers), and players who asked to can acknowledge even a single trigger. Either way
+			// the player answers before the next player's triggers are processed.
+			if player.AlwaysPromptTriggers || len(abilities) > 1 {
+				e.promptTriggeredAbilities(gameState, playerID, abilities)
+				return played
+			}
+
 			// Per Java lines 2351-2360: If only one ability, put it on stack
-			// If multiple, player chooses order (for now, we process in queue order)
-			if len(abilities) == 1 {
-				ability := abilities[0]
-				e.removeTriggeredAbility(gameState, ability.ID)
+			ability := abilities[0]
+			e.removeTriggeredAbility(gameState, ability.ID)
 
-				// Put on stack
-				if err := e.putTriggeredAbilityOnStack(gameState, ability); err != nil {
-					if e.logger != nil {
-						e.logger.Error("failed to put triggered ability on stack",
-							zap.String("ability_id", ability.ID),
-							zap.Error(err),
-						)
-					}
-				} else {
-					played = true
+			// Put on stack
+			if err := e.putTriggeredAbilityOnStack(gameState, ability); err != nil {
+				if e.logger != nil {
+					e.logger.Error("failed to put triggered ability on stack",
+						zap.String("ability_id", ability.ID),
+						zap.Error(err),
+					)
 				}
 			} else {
-				// Multiple abilities - for now, process in queue order
-				// In full implementation, player would choose order
-				for _, ability := range abilities {
-					e.removeTriggeredAbility(gameState, ability.ID)
-
-					if err := e.putTriggeredAbilityOnStack(gameState, ability); err != nil {
-						if e.logger != nil {
-							e.logger.Error("failed to put triggered ability on stack",
-								zap.String("ability_id", ability.ID),
-								zap.Error(err),
-							)
-						}
-					} else {
-						played = true
-					}
-				}
-				break
+				played = true
 			}
 		}
 	}
@@ -2547,6 +3258,7 @@ func (e *MageEngine) RegisterCombatTrigger(gameID string, trigger *combatTrigger
 		)
 	}
 
+	gameState.markUnreplayable("RegisterCombatTrigger")
 	return nil
 }
 
@@ -2581,16 +3293,27 @@ func (e *MageEngine) checkCombatTriggers(gameState *engineGameState, event rules
 	}
 }
 
-// putTriggeredAbilityOnStack puts a triggered ability on the stack
-func (e *MageEngine) putTriggeredAbilityOnStack(gameState *engineGameState, ability *triggeredAbilityQueueItem) error {
-	// Wrap the resolve function to match StackItem signature
-	resolveFunc := func() error {
+// triggeredAbilityResolver wraps a triggered ability's resolve function to match the StackItem signature
+func (e *MageEngine) triggeredAbilityResolver(gameState *engineGameState, ability *triggeredAbilityQueueItem) func() error {
+	return func() error {
+		// Per rule 603.4: an intervening "if" clause is checked again on resolution
+		if ability.Condition != nil && !ability.Condition(gameState) {
+			gameState.addMessage(fmt.Sprintf("%s does nothing: its condition is no longer true", ability.Description), "action")
+			return nil
+		}
+		if ability.Optional {
+			e.promptOptionalTrigger(gameState, ability)
+			return nil
+		}
 		if ability.Resolve != nil {
 			return ability.Resolve(gameState)
 		}
 		return nil
 	}
+}
 
+// putTriggeredAbilityOnStack puts a triggered ability on the stack
+func (e *MageEngine) putTriggeredAbilityOnStack(gameState *engineGameState, ability *triggeredAbilityQueueItem) error {
 	// Create stack item for triggered ability
 	item := rules.StackItem{
 		ID:          ability.ID,
@@ -2598,7 +3321,7 @@ func (e *MageEngine) putTriggeredAbilityOnStack(gameState *engineGameState, abil
 		Controller:  ability.Controller,
 		Description: ability.Description,
 		Kind:        "TRIGGERED",
-		Resolve:     resolveFunc,
+		Resolve:     e.triggeredAbilityResolver(gameState, ability),
 	}
 
 	// Push to stack
@@ -2636,6 +3359,9 @@ func (e *MageEngine) putTriggeredAbilityOnStack(gameState *engineGameState, abil
 func (e *MageEngine) checkStateBasedActions(gameState *engineGameState) bool {
 	somethingHappened := false
 
+	// Per Java GameImpl.checkStateAndTriggered(): apply continuous effects before checking SBAs
+	e.recomputeContinuousEffects(gameState)
+
 	// Check player loss conditions (704.5a/704.5b/704.5c)
 	for _, player := range gameState.players {
 		if player.Lost || player.Left {
@@ -2656,10 +3382,11 @@ func (e *MageEngine) checkStateBasedActions(gameState *engineGameState) bool {
 			continue
 		}
 
-		// 704.5b: If a player has 10 or more poison counters, they lose the game
-		if player.Poison >= 10 {
+		// 704.5c: If a player has 10 or more poison counters, they lose the game
+		// (the threshold is a rules option for variants)
+		if player.Poison >= gameState.rulesOptions.PoisonThreshold {
 			player.Lost = true
-			gameState.addMessage(fmt.Sprintf("%s loses the game (poison >= 10)", player.PlayerID), "action")
+			gameState.addMessage(fmt.Sprintf("%s loses the game (poison >= %d)", player.PlayerID, gameState.rulesOptions.PoisonThreshold), "action")
 			somethingHappened = true
 			if e.logger != nil {
 				e.logger.Info("player lost due to poison",
@@ -2670,16 +3397,55 @@ func (e *MageEngine) checkStateBasedActions(gameState *engineGameState) bool {
 			continue
 		}
 
-		// 704.5c: If a player would draw a card from an empty library, they lose the game
-		// Note: This is typically handled when the draw would occur, but we check here too
-		if len(player.Library) == 0 {
-			// Only lose if they would draw (this is usually handled during draw step)
-			// For now, we'll skip this check as it's typically handled elsewhere
+		// 704.6c: In Commander, a player dealt combat damage of at least the threshold by a single
+		// commander over the game loses
+		if commanderID, damage := player.lethalCommanderDamage(gameState.rulesOptions.CommanderDamage); commanderID != "" {
+			name := commanderID
+			if commander, exists := gameState.cards[commanderID]; exists {
+				name = commander.Name
+			}
+			player.Lost = true
+			gameState.addMessage(fmt.Sprintf("%s loses the game (%d combat damage from %s)", player.PlayerID, damage, name), "action")
+			somethingHappened = true
+			if e.logger != nil {
+				e.logger.Info("player lost due to commander damage",
+					zap.String("player_id", player.PlayerID),
+					zap.String("commander_id", commanderID),
+					zap.Int("damage", damage),
+				)
+			}
+			continue
+		}
+
+		// 704.5b: If a player attempted to draw a card from an empty library since the last time
+		// state-based actions were checked, they lose the game
+		if player.DrawFromEmptyLibrary {
+			player.DrawFromEmptyLibrary = false
+			player.Lost = true
+			gameState.addMessage(fmt.Sprintf("%s loses the game (drew from an empty library)", player.PlayerID), "action")
+			somethingHappened = true
+			if e.logger != nil {
+				e.logger.Info("player lost due to drawing from an empty library",
+					zap.String("player_id", player.PlayerID),
+				)
+			}
+			continue
 		}
 	}
 
+	// The players left may all have accepted a draw the losers hadn't
+	if somethingHappened {
+		e.resolveDrawProposal(gameState)
+	}
+
+	// 704.5q: annihilate +1/+1 and -1/-1 counters before toughness is checked
+	if e.annihilateBoostCounters(gameState) {
+		somethingHappened = true
+	}
+
 	// Check permanents on battlefield
 	creaturesToRemove := make([]*internalCard, 0)
+	lethallyDamaged := make([]*internalCard, 0)
 	planeswalkersToRemove := make([]*internalCard, 0)
 
 	for _, card := range gameState.battlefield {
@@ -2705,8 +3471,13 @@ func (e *MageEngine) checkStateBasedActions(gameState *engineGameState) bool {
 			}
 
 			// 704.5g: If a creature has been dealt damage greater than or equal to its toughness,
-			// it's destroyed (dies). Note: We need to track damage on creatures for this.
-			// For now, we'll skip this as it requires damage tracking infrastructure.
+			// it's destroyed (dies), unless it's indestructible (rule 702.12b).
+			// 704.5h: the same applies to a creature dealt damage by a source with deathtouch.
+			if e.hasLethalDamage(gameState, card) && !e.hasAbilityWithEffects(gameState, card, abilityIndestructible) {
+				lethallyDamaged = append(lethallyDamaged, card)
+				somethingHappened = true
+				continue
+			}
 		}
 
 		// 704.5i: If a planeswalker has loyalty 0, it's put into its owner's graveyard
@@ -2729,6 +3500,13 @@ func (e *MageEngine) checkStateBasedActions(gameState *engineGameState) bool {
 				}
 			}
 		}
+
+		// 704.5v: If a battle has defense 0, it's put into its owner's graveyard
+		if e.isBattle(card) && !e.isCreature(card) && (card.Counters == nil || card.Counters.GetCount(string(counters.CounterTypeDefense)) <= 0) {
+			planeswalkersToRemove = append(planeswalkersToRemove, card)
+			gameState.addMessage(fmt.Sprintf("%s is defeated (defense <= 0)", card.Name), "action")
+			somethingHappened = true
+		}
 	}
 
 	// Remove creatures that died
@@ -2736,11 +3514,26 @@ func (e *MageEngine) checkStateBasedActions(gameState *engineGameState) bool {
 		e.moveCardToGraveyard(gameState, card)
 	}
 
+	// Destroy creatures with lethal damage (regeneration can replace the destruction)
+	for _, card := range lethallyDamaged {
+		if err := e.applyDamageToCreature(gameState, card.ID); err != nil && e.logger != nil {
+			e.logger.Error("failed to destroy creature with lethal damage",
+				zap.String("card_id", card.ID),
+				zap.Error(err),
+			)
+		}
+	}
+
 	// Remove planeswalkers that died
 	for _, card := range planeswalkersToRemove {
 		e.moveCardToGraveyard(gameState, card)
 	}
 
+	// 704.5j: legend rule
+	if e.checkLegendRule(gameState) {
+		somethingHappened = true
+	}
+
 	// Emit events for state-based actions
 	if somethingHappened {
 		gameState.eventBus.Publish(rules.Event{
@@ -2754,6 +3547,37 @@ func (e *MageEngine) checkStateBasedActions(gameState *engineGameState) bool {
 	return somethingHappened
 }
 
+// annihilateBoostCounters removes N +1/+1 and N -1/-1 counters from each permanent that has both,
+// where N is the smaller of the two counts. Returns true if any counters were removed.
+// Per rule 704.5q
+func (e *MageEngine) annihilateBoostCounters(gameState *engineGameState) bool {
+	removed := false
+	counterOps := counters.NewCounterOperations(gameState.eventBus)
+	for _, card := range gameState.battlefield {
+		if card.Zone != zoneBattlefield || card.Counters == nil {
+			continue
+		}
+		plus := card.Counters.GetCount(string(counters.CounterTypeP1P1))
+		minus := card.Counters.GetCount(string(counters.CounterTypeM1M1))
+		n := plus
+		if minus < n {
+			n = minus
+		}
+		if n == 0 {
+			continue
+		}
+
+		now := time.Now()
+		for _, name := range []string{string(counters.CounterTypeP1P1), string(counters.CounterTypeM1M1)} {
+			card.Counters.RemoveCounter(name, n)
+			counterOps.RemoveCounterFromCard(card.ID, name, n, card.ControllerID, now)
+		}
+		gameState.addMessage(fmt.Sprintf("%d +1/+1 and %d -1/-1 counter(s) on %s are removed", n, n, card.Name), "action")
+		removed = true
+	}
+	return removed
+}
+
 // parsePowerToughness parses a power/toughness string to an integer
 func (e *MageEngine) parsePowerToughness(value string) (int, error) {
 	if value == "" {
@@ -2779,6 +3603,9 @@ func (e *MageEngine) moveCard(gameState *engineGameState, card *internalCard, ta
 	if card == nil {
 		return fmt.Errorf("card is nil")
 	}
+	if isEmblem(card) {
+		return fmt.Errorf("emblem %s can't leave the command zone", card.Name)
+	}
 
 	sourceZone := card.Zone
 
@@ -2828,6 +3655,29 @@ func (e *MageEngine) moveCard(gameState *engineGameState, card *internalCard, ta
 		}
 	}
 
+	// Per rule 400.7: X is forgotten once the object leaves the stack for anywhere but the battlefield,
+	// or leaves the battlefield
+	if targetZone != zoneStack && (targetZone != zoneBattlefield || sourceZone != zoneStack) {
+		card.XValue = 0
+	}
+	if targetZone != zoneStack {
+		card.Targets = nil
+	}
+	// Per rule 702.34a: a spell cast with flashback is exiled instead of going anywhere else
+	if sourceZone == zoneStack && targetZone != zoneStack && card.ExileFromStack {
+		card.ExileFromStack = false
+		targetZone = zoneExile
+	}
+
+	// A copy of a spell that leaves the stack, other than a permanent spell resolving into a token,
+	// ceases to exist, as does that token when it leaves the battlefield
+	if targetZone != zoneBattlefield && e.removeSpellCopy(gameState, card) {
+		if sourceZone == zoneBattlefield {
+			e.recomputeContinuousEffects(gameState)
+		}
+		return nil
+	}
+
 	// Update card zone and controller
 	card.Zone = targetZone
 	if controllerID != "" {
@@ -2905,6 +3755,11 @@ func (e *MageEngine) moveCard(gameState *engineGameState, card *internalCard, ta
 		},
 	})
 
+	// Static abilities start or stop applying when a permanent enters or leaves the battlefield
+	if sourceZone == zoneBattlefield || targetZone == zoneBattlefield {
+		e.recomputeContinuousEffects(gameState)
+	}
+
 	if e.logger != nil {
 		e.logger.Debug("moved card",
 			zap.String("card_id", card.ID),
@@ -2931,31 +3786,152 @@ func (e *MageEngine) moveCardToGraveyard(gameState *engineGameState, card *inter
 
 // Helper methods for engineGameState
 
+// checkAcceptsActions returns an error if players can't act: the game has ended or is paused
+func (s *engineGameState) checkAcceptsActions() error {
+	switch s.state {
+	case GameStateFinished:
+		return fmt.Errorf("game %s has ended", s.gameID)
+	case GameStatePaused:
+		return fmt.Errorf("game %s is paused", s.gameID)
+	}
+	if s.rollbackVote != nil {
+		return fmt.Errorf("game %s is waiting for the players to vote on a rollback", s.gameID)
+	}
+	return nil
+}
+
+// maxActionNonces is the number of processed action nonces remembered per game
+const maxActionNonces = 256
+
+// recordActionNonce remembers the nonce of a processed action so a duplicate submission is rejected;
+// only the most recent nonces are kept
+func (s *engineGameState) recordActionNonce(nonce string) {
+	if nonce == "" {
+		return
+	}
+	if s.actionNonces == nil {
+		s.actionNonces = make(map[string]bool)
+	}
+	s.actionNonces[nonce] = true
+	s.actionNonceOrder = append(s.actionNonceOrder, nonce)
+	if len(s.actionNonceOrder) > maxActionNonces {
+		delete(s.actionNonces, s.actionNonceOrder[0])
+		s.actionNonceOrder = s.actionNonceOrder[1:]
+	}
+}
+
+// recordAction records that a player's action was processed
+func (s *engineGameState) recordAction(playerID string) {
+	s.actionSequence++
+	if s.lastActions == nil {
+		s.lastActions = make(map[string]int)
+	}
+	s.lastActions[playerID] = s.actionSequence
+}
+
+// actedSince returns another player who has acted after the given action sequence number ("" if none)
+func (s *engineGameState) actedSince(playerID string, sequence int) string {
+	for _, pid := range s.playerOrder {
+		if pid != playerID && s.lastActions[pid] > sequence {
+			return pid
+		}
+	}
+	return ""
+}
+
+// rollbackAllowed reports whether the game's rules options allow turn rollback; takes the read lock
+func (s *engineGameState) rollbackAllowed() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.rulesOptions.RollbackAllowed
+}
+
 func (s *engineGameState) addMessage(text, color string) {
-	s.messages = append(s.messages, EngineMessage{
+	s.appendMessage(EngineMessage{
 		Text:      text,
 		Color:     color,
 		Timestamp: time.Now(),
 	})
+}
+
+// addPrivateMessage adds a message revealing a player's hidden information (e.g. the cards they found
+// in their library); other players see publicText instead, or nothing if it's empty
+func (s *engineGameState) addPrivateMessage(ownerID, text, publicText, color string) {
+	s.appendMessage(EngineMessage{
+		Text:       text,
+		Color:      color,
+		Timestamp:  time.Now(),
+		OwnerID:    ownerID,
+		PublicText: publicText,
+	})
+}
+
+func (s *engineGameState) appendMessage(message EngineMessage) {
+	s.messages = append(s.messages, message)
 	// Keep only last 1000 messages
-	if len(s.messages) > 1000 {
-		s.messages = s.messages[len(s.messages)-1000:]
+	if len(s.messages) > maxLogMessages {
+		s.droppedMessages += len(s.messages) - maxLogMessages
+		s.messages = s.messages[len(s.messages)-maxLogMessages:]
+	}
+}
+
+// requestAttackersDecision asks the attacking player which of their creatures attack what. Answering it
+// declares the attackers and finishes the declaration (see FinishDeclaringAttackers); an illegal
+// declaration is refused and the decision stays pending.
+func (e *MageEngine) requestAttackersDecision(gameState *engineGameState, playerID string) *Decision {
+	choices := e.attackChoices(gameState)
+	text := "Declare attackers (select creatures to attack)"
+	if len(choices) == 0 {
+		text = "No creatures can attack"
+	}
+	decision := &Decision{
+		PlayerID: playerID,
+		Kind:     DecisionDeclareAttackers,
+		Text:     text,
+		Choices:  choices,
+		resolve: func(gameState *engineGameState, response Response) error {
+			// The whole declaration is checked before any creature attacks, so an illegal one changes
+			// nothing and the player answers the same decision again
+			if err := e.checkAttackDeclaration(gameState, playerID, response.Choices); err != nil {
+				return err
+			}
+			for _, choice := range response.Choices {
+				creatureID, defenderID, _ := strings.Cut(choice, ":")
+				if err := e.declareAttacker(gameState, creatureID, defenderID, playerID); err != nil {
+					return err
+				}
+			}
+			return e.finishDeclaringAttackers(gameState)
+		},
 	}
+	gameState.addDeclaration(decision)
+	return decision
 }
 
-func (s *engineGameState) addPrompt(playerID, text string, options []string) {
-	s.prompts = append(s.prompts, EnginePrompt{
-		PlayerID:  playerID,
-		Text:      text,
-		Options:   options,
-		Timestamp: time.Now(),
-	})
+// checkAttackDeclaration checks a declaration of attackers, as "creatureID:defenderID" choices, as a whole
+// without declaring any of them
+// Per rule 508.1: each attacker must be able to attack, and "attacks if able" requirements are obeyed
+func (e *MageEngine) checkAttackDeclaration(gameState *engineGameState, playerID string, choices []string) error {
+	declaring := make(map[string]bool, len(choices))
+	for _, choice := range choices {
+		creatureID, defenderID, _ := strings.Cut(choice, ":")
+		if declaring[creatureID] {
+			return fmt.Errorf("creature %s can only attack once", creatureID)
+		}
+		if err := e.checkAttacker(gameState, creatureID, defenderID, playerID); err != nil {
+			return err
+		}
+		declaring[creatureID] = true
+	}
+	if violations := e.mustAttackViolations(gameState, declaring); len(violations) > 0 {
+		return fmt.Errorf("illegal attack: %s", strings.Join(violations, "; "))
+	}
+	return nil
 }
 
-// buildAttackerPromptOptions builds prompt options for declaring attackers
-// Returns options in format: ["ATTACK:creatureID:defenderID", ..., "DONE_ATTACKING"]
-func (e *MageEngine) buildAttackerPromptOptions(gameState *engineGameState) []string {
-	options := make([]string, 0)
+// attackChoices lists the attacks the attacking player can declare, as "creatureID:defenderID"
+func (e *MageEngine) attackChoices(gameState *engineGameState) []string {
+	choices := make([]string, 0)
 	attackingPlayerID := gameState.combat.attackingPlayerID
 
 	// Find all creatures controlled by attacking player
@@ -2976,31 +3952,115 @@ func (e *MageEngine) buildAttackerPromptOptions(gameState *engineGameState) []st
 		}
 
 		// Check if creature can attack
-		canAttack, _ := e.CanAttack(gameState.gameID, card.ID)
-		if !canAttack {
+		if !e.canAttackInternal(gameState, card) {
 			continue
 		}
 
-		// For each valid defender, add an option
+		// For each valid defender, add a choice
 		for defenderID := range gameState.combat.defenders {
-			canAttackDefender, _ := e.CanAttackDefender(gameState.gameID, card.ID, defenderID)
+			canAttackDefender, _ := e.canAttackDefenderInternal(gameState, card, defenderID)
 			if canAttackDefender {
-				option := fmt.Sprintf("ATTACK:%s:%s", card.ID, defenderID)
-				options = append(options, option)
+				choices = append(choices, fmt.Sprintf("%s:%s", card.ID, defenderID))
 			}
 		}
 	}
+	sort.Strings(choices)
+	return choices
+}
+
+// requestBlockersDecisions asks each defending player (every opponent of the attacking player still in
+// the game) which of their creatures block what. Blocks are declared as each player answers and accepted
+// once they all have (see AcceptBlockers); an illegal declaration is refused and the decision stays
+// pending.
+func (e *MageEngine) requestBlockersDecisions(gameState *engineGameState) {
+	for _, playerID := range gameState.playerOrder {
+		if playerID == gameState.combat.attackingPlayerID || !gameState.players[playerID].canRespond() {
+			continue
+		}
+		e.requestBlockersDecision(gameState, playerID)
+	}
+}
+
+// requestBlockersDecision asks a defending player which of their creatures block what
+func (e *MageEngine) requestBlockersDecision(gameState *engineGameState, playerID string) {
+	choices := e.blockChoices(gameState, playerID)
+	text := "Declare blockers (select creatures to block)"
+	if len(choices) == 0 {
+		text = "No creatures can block or no attackers"
+	}
+	gameState.addDeclaration(&Decision{
+		PlayerID: playerID,
+		Kind:     DecisionDeclareBlockers,
+		Text:     text,
+		Choices:  choices,
+		resolve: func(gameState *engineGameState, response Response) error {
+			// Per rule 509.1b: an illegal block is declared again, so the whole declaration is checked
+			// before any creature blocks and an illegal one changes nothing
+			if err := e.checkBlockDeclaration(gameState, playerID, response.Choices); err != nil {
+				return err
+			}
+			declared := make([]string, 0, len(response.Choices))
+			undo := func() {
+				for _, blockerID := range declared {
+					_ = e.removeBlocker(gameState, blockerID)
+				}
+			}
+			for _, choice := range response.Choices {
+				blockerID, attackerID, _ := strings.Cut(choice, ":")
+				if err := e.declareBlocker(gameState, blockerID, attackerID, playerID); err != nil {
+					undo()
+					return err
+				}
+				declared = append(declared, blockerID)
+			}
+			if gameState.pendingDeclarations() {
+				return nil
+			}
+			// Blocks declared outside this decision can still make the whole declaration illegal
+			if err := e.acceptBlockers(gameState); err != nil {
+				undo()
+				return err
+			}
+			return nil
+		},
+	})
+}
 
-	// Always add option to finish declaring attackers
-	options = append(options, "DONE_ATTACKING")
+// checkBlockDeclaration checks a defending player's declaration of blockers, as "blockerID:attackerID"
+// choices, as a whole without declaring any of them: each block must be possible, attackers must be
+// blocked by enough creatures (e.g. menace) and the player's "blocks if able" requirements obeyed
+func (e *MageEngine) checkBlockDeclaration(gameState *engineGameState, playerID string, choices []string) error {
+	declaring := make(map[string][]string)
+	added := make(map[*combatGroup]int)
+	for _, choice := range choices {
+		blockerID, attackerID, _ := strings.Cut(choice, ":")
+		if containsString(declaring[blockerID], attackerID) {
+			return fmt.Errorf("creature %s already blocks attacker %s", blockerID, attackerID)
+		}
+		group, err := e.checkBlocker(gameState, blockerID, attackerID, playerID)
+		if err != nil {
+			return err
+		}
+		declaring[blockerID] = append(declaring[blockerID], attackerID)
+		added[group]++
+	}
 
-	return options
+	violations := make([]string, 0)
+	for _, group := range gameState.combat.groups {
+		if added[group] > 0 {
+			violations = append(violations, e.minBlockersViolations(gameState, group, len(group.blockers)+added[group])...)
+		}
+	}
+	violations = append(violations, e.mustBlockViolations(gameState, playerID, declaring)...)
+	if len(violations) > 0 {
+		return fmt.Errorf("illegal block: %s", strings.Join(violations, "; "))
+	}
+	return nil
 }
 
-// buildBlockerPromptOptions builds prompt options for declaring blockers
-// Returns options in format: ["BLOCK:blockerID:attackerID", ..., "DONE_BLOCKING"]
-func (e *MageEngine) buildBlockerPromptOptions(gameState *engineGameState, defendingPlayerID string) []string {
-	options := make([]string, 0)
+// blockChoices lists the blocks a defending player can declare, as "blockerID:attackerID"
+func (e *MageEngine) blockChoices(gameState *engineGameState, defendingPlayerID string) []string {
+	choices := make([]string, 0)
 
 	// Find all creatures controlled by defending player
 	for _, card := range gameState.cards {
@@ -3021,18 +4081,13 @@ func (e *MageEngine) buildBlockerPromptOptions(gameState *engineGameState, defen
 
 		// For each attacker, check if this creature can block it
 		for attackerID := range gameState.combat.attackers {
-			canBlock, _ := e.CanBlock(gameState.gameID, card.ID, attackerID)
-			if canBlock {
-				option := fmt.Sprintf("BLOCK:%s:%s", card.ID, attackerID)
-				options = append(options, option)
+			if canBlock, _ := e.canBlockInternal(gameState, card.ID, attackerID); canBlock {
+				choices = append(choices, fmt.Sprintf("%s:%s", card.ID, attackerID))
 			}
 		}
 	}
-
-	// Always add option to finish declaring blockers
-	options = append(options, "DONE_BLOCKING")
-
-	return options
+	sort.Strings(choices)
+	return choices
 }
 
 func (e *MageEngine) getNextPlayer(gameState *engineGameState) string {
@@ -3049,8 +4104,16 @@ func (e *MageEngine) getNextPlayer(gameState *engineGameState) string {
 	if activeIndex == -1 {
 		return gameState.playerOrder[0]
 	}
-	nextIndex := (activeIndex + 1) % len(gameState.playerOrder)
-	return gameState.playerOrder[nextIndex]
+
+	// Per rule 800.4: players who have left the game don't take turns
+	for i := 1; i <= len(gameState.playerOrder); i++ {
+		nextIndex := (activeIndex + i) % len(gameState.playerOrder)
+		nextPlayerID := gameState.playerOrder[nextIndex]
+		if gameState.players[nextPlayerID].canRespond() {
+			return nextPlayerID
+		}
+	}
+	return gameState.playerOrder[(activeIndex+1)%len(gameState.playerOrder)]
 }
 
 func (e *MageEngine) getNextPlayerWithPriority(gameState *engineGameState, currentPlayerID string) string {
@@ -3127,7 +4190,7 @@ func (e *MageEngine) removeCardFromSlice(cards []*internalCard, cardID string) [
 // ChangeControl changes the controller of a permanent on the battlefield
 // Returns true if control was successfully changed, false otherwise
 // Per Java PermanentImpl.changeControllerId(): emits GAIN_CONTROL and LOSE_CONTROL events
-func (e *MageEngine) ChangeControl(gameID, cardID, newControllerID string) error {
+func (e *MageEngine) ChangeControl(gameID, cardID, newControllerID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -3138,6 +4201,7 @@ func (e *MageEngine) ChangeControl(gameID, cardID, newControllerID string) error
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "ChangeControl", cardID, newControllerID)
 
 	// Find the card
 	card, found := gameState.cards[cardID]
@@ -3159,61 +4223,72 @@ func (e *MageEngine) ChangeControl(gameID, cardID, newControllerID string) error
 		return fmt.Errorf("player %s is not in game", newControllerID)
 	}
 
+	if card.ControllerID != newControllerID {
+		e.changeController(gameState, card, newControllerID)
+		// The new controller becomes the base controller, with any control effects reapplied on top
+		e.recomputeContinuousEffects(gameState)
+	}
+
+	return nil
+}
+
+// changeController moves control of a permanent to a new controller (caller must hold the lock)
+// Per rule 302.6 the creature can't attack or {T} until its new controller has controlled it
+// continuously since their most recent turn began, and per rule 506.4 it is removed from combat.
+func (e *MageEngine) changeController(gameState *engineGameState, card *internalCard, newControllerID string) {
 	oldControllerID := card.ControllerID
 
-	// Only emit events if control is actually changing
-	if oldControllerID != newControllerID {
-		// Emit LOSE_CONTROL event for old controller
-		loseControlEvent := rules.Event{
-			Type:        rules.EventLoseControl,
-			ID:          uuid.New().String(),
-			TargetID:    cardID,
-			SourceID:    cardID,
-			Controller:  oldControllerID,
-			PlayerID:    oldControllerID,
-			Timestamp:   time.Now(),
-			Description: fmt.Sprintf("%s loses control of %s", oldControllerID, card.Name),
-			Metadata: map[string]string{
-				"old_controller": oldControllerID,
-				"new_controller": newControllerID,
-			},
-		}
-		gameState.eventBus.Publish(loseControlEvent)
+	// Emit LOSE_CONTROL event for old controller
+	loseControlEvent := rules.Event{
+		Type:        rules.EventLoseControl,
+		ID:          uuid.New().String(),
+		TargetID:    card.ID,
+		SourceID:    card.ID,
+		Controller:  oldControllerID,
+		PlayerID:    oldControllerID,
+		Timestamp:   time.Now(),
+		Description: fmt.Sprintf("%s loses control of %s", oldControllerID, card.Name),
+		Metadata: map[string]string{
+			"old_controller": oldControllerID,
+			"new_controller": newControllerID,
+		},
+	}
+	gameState.eventBus.Publish(loseControlEvent)
 
-		// Change the controller
-		card.ControllerID = newControllerID
+	// Change the controller
+	card.ControllerID = newControllerID
 
-		// Emit GAIN_CONTROL event for new controller
-		gainControlEvent := rules.Event{
-			Type:        rules.EventGainControl,
-			ID:          uuid.New().String(),
-			TargetID:    cardID,
-			SourceID:    cardID,
-			Controller:  newControllerID,
-			PlayerID:    newControllerID,
-			Timestamp:   time.Now(),
-			Description: fmt.Sprintf("%s gains control of %s", newControllerID, card.Name),
-			Metadata: map[string]string{
-				"old_controller": oldControllerID,
-				"new_controller": newControllerID,
-			},
-		}
-		gameState.eventBus.Publish(gainControlEvent)
+	// Emit GAIN_CONTROL event for new controller
+	gainControlEvent := rules.Event{
+		Type:        rules.EventGainControl,
+		ID:          uuid.New().String(),
+		TargetID:    card.ID,
+		SourceID:    card.ID,
+		Controller:  newControllerID,
+		PlayerID:    newControllerID,
+		Timestamp:   time.Now(),
+		Description: fmt.Sprintf("%s gains control of %s", newControllerID, card.Name),
+		Metadata: map[string]string{
+			"old_controller": oldControllerID,
+			"new_controller": newControllerID,
+		},
+	}
+	gameState.eventBus.Publish(gainControlEvent)
 
-		gameState.addMessage(fmt.Sprintf("%s gains control of %s", newControllerID, card.Name), "action")
+	gameState.addMessage(fmt.Sprintf("%s gains control of %s", newControllerID, card.Name), "action")
 
-		if e.logger != nil {
-			e.logger.Info("control changed",
-				zap.String("game_id", gameID),
-				zap.String("card_id", cardID),
-				zap.String("card_name", card.Name),
-				zap.String("old_controller", oldControllerID),
-				zap.String("new_controller", newControllerID),
-			)
-		}
+	if e.logger != nil {
+		e.logger.Info("control changed",
+			zap.String("game_id", gameState.gameID),
+			zap.String("card_id", card.ID),
+			zap.String("card_name", card.Name),
+			zap.String("old_controller", oldControllerID),
+			zap.String("new_controller", newControllerID),
+		)
 	}
 
-	return nil
+	card.SummoningSickness = true
+	e.removeFromCombat(gameState, card)
 }
 
 // createSnapshot creates a deep copy snapshot of the current game state
@@ -3224,6 +4299,8 @@ func (e *MageEngine) createSnapshot(gameState *engineGameState) *gameStateSnapsh
 		GameType:       gameState.gameType,
 		State:          gameState.state,
 		TurnNumber:     gameState.turnManager.TurnNumber(),
+		Phase:          gameState.turnManager.CurrentPhase().String(),
+		Step:           gameState.turnManager.CurrentStep().String(),
 		ActivePlayer:   gameState.turnManager.ActivePlayer(),
 		PriorityPlayer: gameState.turnManager.PriorityPlayer(),
 		PlayerOrder:    make([]string, len(gameState.playerOrder)),
@@ -3233,8 +4310,10 @@ func (e *MageEngine) createSnapshot(gameState *engineGameState) *gameStateSnapsh
 		Exile:          make([]*internalCard, 0, len(gameState.exile)),
 		Command:        make([]*internalCard, 0, len(gameState.command)),
 		StackItems:     make([]rules.StackItem, 0),
+		Monarch:        gameState.monarchID,
 		Messages:       make([]EngineMessage, len(gameState.messages)),
 		Prompts:        make([]EnginePrompt, len(gameState.prompts)),
+		ActionSequence: gameState.actionSequence,
 		Timestamp:      time.Now(),
 	}
 
@@ -3263,9 +4342,17 @@ func (e *MageEngine) createSnapshot(gameState *engineGameState) *gameStateSnapsh
 			TimerTimeout:   player.TimerTimeout,
 			IdleTimeout:    player.IdleTimeout,
 			Conceded:       player.Conceded,
+			Drew:           player.Drew,
 			StoredBookmark: player.StoredBookmark,
 			MulliganCount:  player.MulliganCount,
 			KeptHand:       player.KeptHand,
+			// Turn tracking
+			LandsPlayedThisTurn: player.LandsPlayedThisTurn,
+			// Pending state-based actions
+			DrawFromEmptyLibrary: player.DrawFromEmptyLibrary,
+			CommanderDamage:      maps.Clone(player.CommanderDamage),
+			// Settings
+			AlwaysPromptTriggers: player.AlwaysPromptTriggers,
 		}
 		snapshot.Players[id] = playerCopy
 	}
@@ -3316,6 +4403,7 @@ func (e *MageEngine) createSnapshot(gameState *engineGameState) *gameStateSnapsh
 
 	// Copy messages and prompts
 	copy(snapshot.Messages, gameState.messages)
+	snapshot.DroppedMessages = gameState.droppedMessages
 	copy(snapshot.Prompts, gameState.prompts)
 
 	return snapshot
@@ -3344,6 +4432,7 @@ func (e *MageEngine) copyCard(card *internalCard) *internalCard {
 		Rarity:         card.Rarity,
 		RulesText:      card.RulesText,
 		Tapped:         card.Tapped,
+		TapReason:      card.TapReason,
 		Flipped:        card.Flipped,
 		Transformed:    card.Transformed,
 		FaceDown:       card.FaceDown,
@@ -3353,64 +4442,106 @@ func (e *MageEngine) copyCard(card *internalCard) *internalCard {
 		AttachedToCard: append([]string(nil), card.AttachedToCard...),
 		Abilities:      append([]EngineAbilityView(nil), card.Abilities...),
 		Counters:       card.Counters.Copy(),
+		// Status
+		SummoningSickness:   card.SummoningSickness,
+		AbilityUses:         copyAbilityUses(card.AbilityUses),
+		RegenerationShields: card.RegenerationShields,
+		XValue:              card.XValue,
+		Targets:             append([]string(nil), card.Targets...),
+		CopyOf:              card.CopyOf,
+		ExileFromStack:      card.ExileFromStack,
+		Commander:           card.Commander,
+		// Layer bookkeeping, so restored permanents aren't boosted twice
+		BasePower:           card.BasePower,
+		BaseToughness:       card.BaseToughness,
+		BaseType:            card.BaseType,
+		BaseSubTypes:        append([]string(nil), card.BaseSubTypes...),
+		BaseControllerID:    card.BaseControllerID,
+		LayeredPower:        card.LayeredPower,
+		LayeredToughness:    card.LayeredToughness,
+		LayeredType:         card.LayeredType,
+		LayeredSubTypes:     append([]string(nil), card.LayeredSubTypes...),
+		LayeredControllerID: card.LayeredControllerID,
+		layered:             card.layered,
 	}
 }
 
 // BookmarkState creates a bookmark of the current game state and returns the bookmark ID
 // The bookmark can be used later to restore the game to this state
 // Per Java GameImpl.bookmarkState(): saves state and returns index for later restoration
-func (e *MageEngine) BookmarkState(gameID string) (int, error) {
-	e.mu.Lock()
-	defer e.mu.Unlock()
-
+func (e *MageEngine) BookmarkState(gameID string) (_ int, err error) {
+	e.mu.RLock()
 	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
 	if !exists {
 		return 0, fmt.Errorf("game %s not found", gameID)
 	}
 
-	gameState.mu.RLock()
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	// Logged so a replay has the bookmark for RestoreState
+	defer e.logCall(gameState, &err, "BookmarkState")
+	return e.bookmarkState(gameState), nil
+}
+
+// bookmarkState bookmarks a game's current state and returns the bookmark ID; the caller holds the
+// game's lock, so the snapshot is consistent
+func (e *MageEngine) bookmarkState(gameState *engineGameState) int {
 	snapshot := e.createSnapshot(gameState)
-	gameState.mu.RUnlock()
 
-	// Add snapshot to bookmarks
-	if e.bookmarks[gameID] == nil {
-		e.bookmarks[gameID] = make([]*gameStateSnapshot, 0)
+	e.bookmarksMu.Lock()
+	if e.bookmarks[gameState.gameID] == nil {
+		e.bookmarks[gameState.gameID] = make([]*gameStateSnapshot, 0)
 	}
-	e.bookmarks[gameID] = append(e.bookmarks[gameID], snapshot)
-	bookmarkID := len(e.bookmarks[gameID])
+	e.bookmarks[gameState.gameID] = append(e.bookmarks[gameState.gameID], snapshot)
+	bookmarkID := len(e.bookmarks[gameState.gameID])
+	e.bookmarksMu.Unlock()
 
 	if e.logger != nil {
 		e.logger.Debug("bookmarked game state",
-			zap.String("game_id", gameID),
+			zap.String("game_id", gameState.gameID),
 			zap.Int("bookmark_id", bookmarkID),
 			zap.Int("turn", snapshot.TurnNumber),
 		)
 	}
 
-	return bookmarkID, nil
+	return bookmarkID
 }
 
 // RestoreState restores the game to a previously bookmarked state
 // Returns error if bookmark doesn't exist or restoration fails
 // Per Java GameImpl.restoreState(): rolls back to saved state and removes newer bookmarks
-func (e *MageEngine) RestoreState(gameID string, bookmarkID int, context string) error {
-	e.mu.Lock()
-	defer e.mu.Unlock()
-
+func (e *MageEngine) RestoreState(gameID string, bookmarkID int, context string) (err error) {
+	e.mu.RLock()
 	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
 	if !exists {
 		return fmt.Errorf("game %s not found", gameID)
 	}
 
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "RestoreState", bookmarkID, context)
+	return e.restoreState(gameState, bookmarkID, context)
+}
+
+// restoreState restores a game to a bookmarked state and removes that bookmark and all newer ones;
+// the caller holds the game's lock
+func (e *MageEngine) restoreState(gameState *engineGameState, bookmarkID int, context string) error {
+	gameID := gameState.gameID
+
+	e.bookmarksMu.Lock()
 	bookmarks := e.bookmarks[gameID]
 	if bookmarks == nil || bookmarkID < 1 || bookmarkID > len(bookmarks) {
+		e.bookmarksMu.Unlock()
 		return fmt.Errorf("bookmark %d not found for game %s", bookmarkID, gameID)
 	}
-
 	snapshot := bookmarks[bookmarkID-1]
-
-	gameState.mu.Lock()
-	defer gameState.mu.Unlock()
+	// Remove this bookmark and all newer bookmarks
+	e.bookmarks[gameID] = bookmarks[:bookmarkID-1]
+	e.bookmarksMu.Unlock()
 
 	// Restore game state from snapshot
 	gameState.state = snapshot.State
@@ -3428,24 +4559,26 @@ func (e *MageEngine) RestoreState(gameID string, bookmarkID int, context string)
 	for id, card := range snapshot.Cards {
 		gameState.cards[id] = card
 	}
+	gameState.cardViews.prune(gameState.cards)
 
 	// Restore zones
 	gameState.battlefield = append([]*internalCard(nil), snapshot.Battlefield...)
 	gameState.exile = append([]*internalCard(nil), snapshot.Exile...)
 	gameState.command = append([]*internalCard(nil), snapshot.Command...)
+	gameState.monarchID = snapshot.Monarch
 
 	// Restore stack
 	gameState.stack = rules.NewStackManager()
 	for _, item := range snapshot.StackItems {
 		gameState.stack.Push(item)
 	}
+	gameState.updateSplitSecond()
 
 	// Restore messages and prompts
 	gameState.messages = append([]EngineMessage(nil), snapshot.Messages...)
+	gameState.droppedMessages = snapshot.DroppedMessages
 	gameState.prompts = append([]EnginePrompt(nil), snapshot.Prompts...)
-
-	// Remove this bookmark and all newer bookmarks
-	e.bookmarks[gameID] = bookmarks[:bookmarkID-1]
+	gameState.syncDecisions()
 
 	gameState.addMessage(fmt.Sprintf("Game restored to turn %d (%s)", snapshot.TurnNumber, context), "system")
 
@@ -3464,8 +4597,8 @@ func (e *MageEngine) RestoreState(gameID string, bookmarkID int, context string)
 // RemoveBookmark removes a bookmark and all newer bookmarks
 // Per Java GameImpl.removeBookmark(): cleanup after restoration
 func (e *MageEngine) RemoveBookmark(gameID string, bookmarkID int) error {
-	e.mu.Lock()
-	defer e.mu.Unlock()
+	e.bookmarksMu.Lock()
+	defer e.bookmarksMu.Unlock()
 
 	bookmarks := e.bookmarks[gameID]
 	if bookmarks == nil || bookmarkID < 1 || bookmarkID > len(bookmarks) {
@@ -3488,8 +4621,8 @@ func (e *MageEngine) RemoveBookmark(gameID string, bookmarkID int) error {
 // ClearBookmarks removes all bookmarks for a game
 // Used when game ends or for cleanup
 func (e *MageEngine) ClearBookmarks(gameID string) {
-	e.mu.Lock()
-	defer e.mu.Unlock()
+	e.bookmarksMu.Lock()
+	defer e.bookmarksMu.Unlock()
 
 	delete(e.bookmarks, gameID)
 
@@ -3502,7 +4635,7 @@ func (e *MageEngine) ClearBookmarks(gameID string) {
 
 // SetPlayerStoredBookmark sets a player's stored bookmark for undo
 // Per Java PlayerImpl.setStoredBookmark(): enables undo button for player
-func (e *MageEngine) SetPlayerStoredBookmark(gameID, playerID string, bookmarkID int) error {
+func (e *MageEngine) SetPlayerStoredBookmark(gameID, playerID string, bookmarkID int) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -3513,6 +4646,7 @@ func (e *MageEngine) SetPlayerStoredBookmark(gameID, playerID string, bookmarkID
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "SetPlayerStoredBookmark", playerID, bookmarkID)
 
 	player, exists := gameState.players[playerID]
 	if !exists {
@@ -3534,7 +4668,7 @@ func (e *MageEngine) SetPlayerStoredBookmark(gameID, playerID string, bookmarkID
 
 // ResetPlayerStoredBookmark clears a player's stored bookmark and removes it from the bookmark list
 // Per Java PlayerImpl.resetStoredBookmark(): disables undo button for player
-func (e *MageEngine) ResetPlayerStoredBookmark(gameID, playerID string) error {
+func (e *MageEngine) ResetPlayerStoredBookmark(gameID, playerID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -3544,35 +4678,40 @@ func (e *MageEngine) ResetPlayerStoredBookmark(gameID, playerID string) error {
 	}
 
 	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "ResetPlayerStoredBookmark", playerID)
+
 	player, exists := gameState.players[playerID]
 	if !exists {
-		gameState.mu.Unlock()
 		return fmt.Errorf("player %s not found", playerID)
 	}
+	e.resetStoredBookmark(gameState, player)
+	return nil
+}
 
+// resetStoredBookmark clears a player's stored bookmark, removing the bookmark, so they can't undo
+// past this point; the caller holds the game's lock
+func (e *MageEngine) resetStoredBookmark(gameState *engineGameState, player *internalPlayer) {
 	bookmarkID := player.StoredBookmark
 	player.StoredBookmark = -1
-	gameState.mu.Unlock()
 
 	// Remove the bookmark if it exists
 	if bookmarkID != -1 {
-		e.RemoveBookmark(gameID, bookmarkID)
+		e.RemoveBookmark(gameState.gameID, bookmarkID)
 	}
 
 	if e.logger != nil {
 		e.logger.Debug("reset player stored bookmark",
-			zap.String("game_id", gameID),
-			zap.String("player_id", playerID),
+			zap.String("game_id", gameState.gameID),
+			zap.String("player_id", player.PlayerID),
 			zap.Int("old_bookmark_id", bookmarkID),
 		)
 	}
-
-	return nil
 }
 
 // Undo performs a player-initiated undo operation
 // Per Java GameImpl.undo(): restores to player's stored bookmark if available
-func (e *MageEngine) Undo(gameID, playerID string) error {
+func (e *MageEngine) Undo(gameID, playerID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -3595,15 +4734,40 @@ func (e *MageEngine) Undo(gameID, playerID string) error {
 		return fmt.Errorf("no undo available for player %s", playerID)
 	}
 
+	// In multiplayer, undo must not rewind past another player's action
+	e.bookmarksMu.Lock()
+	bookmarks := e.bookmarks[gameID]
+	var snapshot *gameStateSnapshot
+	if bookmarkID >= 1 && bookmarkID <= len(bookmarks) {
+		snapshot = bookmarks[bookmarkID-1]
+	}
+	e.bookmarksMu.Unlock()
+
+	if snapshot != nil {
+		gameState.mu.RLock()
+		otherPlayerID := gameState.actedSince(playerID, snapshot.ActionSequence)
+		gameState.mu.RUnlock()
+
+		if otherPlayerID != "" {
+			return fmt.Errorf("cannot undo: player %s has acted since player %s's last action", otherPlayerID, playerID)
+		}
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "Undo", playerID)
+
 	// Restore to the stored bookmark
-	if err := e.RestoreState(gameID, bookmarkID, fmt.Sprintf("player %s undo", playerID)); err != nil {
+	if err := e.restoreState(gameState, bookmarkID, fmt.Sprintf("player %s undo", playerID)); err != nil {
 		return fmt.Errorf("failed to undo: %w", err)
 	}
 
 	// Clear the stored bookmark
-	if err := e.SetPlayerStoredBookmark(gameID, playerID, -1); err != nil {
-		return fmt.Errorf("failed to clear stored bookmark: %w", err)
+	player, exists = gameState.players[playerID]
+	if !exists {
+		return fmt.Errorf("failed to clear stored bookmark: player %s not found", playerID)
 	}
+	player.StoredBookmark = -1
 
 	if e.logger != nil {
 		e.logger.Info("player undo",
@@ -3637,6 +4801,10 @@ func (e *MageEngine) SaveTurnSnapshot(gameID string, turnNumber int) error {
 	}
 	e.mu.Unlock()
 
+	if !gameState.rollbackAllowed() {
+		return nil // Turn rollback disabled for this game
+	}
+
 	gameState.mu.RLock()
 	snapshot := e.createSnapshot(gameState)
 	gameState.mu.RUnlock()
@@ -3683,6 +4851,9 @@ func (e *MageEngine) CanRollbackTurns(gameID string, turnsToRollback int) (bool,
 	if !exists {
 		return false, fmt.Errorf("game %s not found", gameID)
 	}
+	if !gameState.rollbackAllowed() {
+		return false, fmt.Errorf("turn rollback is disabled for this game")
+	}
 
 	currentTurn := gameState.turnManager.TurnNumber()
 	targetTurn := currentTurn - turnsToRollback
@@ -3703,9 +4874,11 @@ func (e *MageEngine) CanRollbackTurns(gameID string, turnsToRollback int) (bool,
 	return exists, nil
 }
 
-// RollbackTurns rolls back the game to N turns ago
-// Per Java GameImpl.rollbackTurns(): requires all players to agree (not implemented yet)
-func (e *MageEngine) RollbackTurns(gameID string, turnsToRollback int) error {
+// RollbackTurns rolls back the game to N turns ago right away, without a vote, for the server (e.g. a
+// judge's ruling); it is refused while the players vote on a rollback. Players ask for a rollback with
+// RequestRollback, which runs it once they all agree.
+// Per Java GameImpl.rollbackTurns()
+func (e *MageEngine) RollbackTurns(gameID string, turnsToRollback int) (err error) {
 	if !e.rollbackAllowed {
 		return fmt.Errorf("turn rollback is disabled")
 	}
@@ -3717,6 +4890,9 @@ func (e *MageEngine) RollbackTurns(gameID string, turnsToRollback int) error {
 	if !exists {
 		return fmt.Errorf("game %s not found", gameID)
 	}
+	if !gameState.rollbackAllowed() {
+		return fmt.Errorf("turn rollback is disabled for this game")
+	}
 
 	currentTurn := gameState.turnManager.TurnNumber()
 	targetTurn := currentTurn - turnsToRollback
@@ -3737,6 +4913,19 @@ func (e *MageEngine) RollbackTurns(gameID string, turnsToRollback int) error {
 	// Restore game state from turn snapshot
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "RollbackTurns", turnsToRollback)
+
+	if gameState.rollbackVote != nil {
+		return fmt.Errorf("a rollback vote is in progress")
+	}
+	e.restoreTurnSnapshot(gameState, snapshot, currentTurn, targetTurn)
+	return nil
+}
+
+// restoreTurnSnapshot rolls a game back to the snapshot taken at the start of targetTurn
+func (e *MageEngine) restoreTurnSnapshot(gameState *engineGameState, snapshot *gameStateSnapshot, currentTurn, targetTurn int) {
+	gameID := gameState.gameID
+	turnsToRollback := currentTurn - targetTurn
 
 	// Restore game state from snapshot
 	gameState.state = snapshot.State
@@ -3757,28 +4946,33 @@ func (e *MageEngine) RollbackTurns(gameID string, turnsToRollback int) error {
 	for id, card := range snapshot.Cards {
 		gameState.cards[id] = card
 	}
+	gameState.cardViews.prune(gameState.cards)
 
 	// Restore zones
 	gameState.battlefield = append([]*internalCard(nil), snapshot.Battlefield...)
 	gameState.exile = append([]*internalCard(nil), snapshot.Exile...)
 	gameState.command = append([]*internalCard(nil), snapshot.Command...)
+	gameState.monarchID = snapshot.Monarch
 
 	// Restore stack
 	gameState.stack = rules.NewStackManager()
 	for _, item := range snapshot.StackItems {
 		gameState.stack.Push(item)
 	}
+	gameState.updateSplitSecond()
 
 	// Restore messages and prompts
 	gameState.messages = append([]EngineMessage(nil), snapshot.Messages...)
+	gameState.droppedMessages = snapshot.DroppedMessages
 	gameState.prompts = append([]EnginePrompt(nil), snapshot.Prompts...)
+	gameState.syncDecisions()
 
 	// Clear all action bookmarks (they're invalid after turn rollback)
 	// Per Java: savedStates.clear() and gameStates.clear()
-	e.mu.Lock()
+	e.bookmarksMu.Lock()
 	delete(e.bookmarks, gameID)
 	e.bookmarks[gameID] = make([]*gameStateSnapshot, 0)
-	e.mu.Unlock()
+	e.bookmarksMu.Unlock()
 
 	gameState.addMessage(fmt.Sprintf("Game rolled back to start of turn %d", targetTurn), "system")
 
@@ -3798,8 +4992,6 @@ func (e *MageEngine) RollbackTurns(gameID string, turnsToRollback int) error {
 		"to_turn":           targetTurn,
 		"turns_rolled_back": turnsToRollback,
 	})
-
-	return nil
 }
 
 // CleanupGame removes a game and frees all associated resources
@@ -3815,7 +5007,9 @@ func (e *MageEngine) CleanupGame(gameID string) error {
 	gameState.mu.Lock()
 
 	// Clear all bookmarks
+	e.bookmarksMu.Lock()
 	delete(e.bookmarks, gameID)
+	e.bookmarksMu.Unlock()
 
 	// Clear turn snapshots
 	delete(e.turnSnapshots, gameID)
@@ -3850,7 +5044,7 @@ func (e *MageEngine) CleanupGame(gameID string) error {
 
 // StartMulligan transitions game to mulligan phase
 // Per Java GameImpl.start(): mulligan phase before main game
-func (e *MageEngine) StartMulligan(gameID string) error {
+func (e *MageEngine) StartMulligan(gameID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -3861,8 +5055,10 @@ func (e *MageEngine) StartMulligan(gameID string) error {
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "StartMulligan")
 
 	gameState.state = GameStateMulligan
+	gameState.mulligan = newMulliganProcedure()
 
 	if e.logger != nil {
 		e.logger.Info("started mulligan phase",
@@ -3878,9 +5074,10 @@ func (e *MageEngine) StartMulligan(gameID string) error {
 	return nil
 }
 
-// PlayerMulligan performs a mulligan for a player (London mulligan)
-// Per Java LondonMulligan.mulligan(): shuffle hand into library, draw N-1 cards
-func (e *MageEngine) PlayerMulligan(gameID, playerID string) error {
+// PlayerMulligan records a player's decision to mulligan this round (London mulligan)
+// Per Java LondonMulligan.mulligan(): shuffle hand into library, draw a new hand; this happens once
+// every player who hasn't kept has decided
+func (e *MageEngine) PlayerMulligan(gameID, playerID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -3891,70 +5088,14 @@ func (e *MageEngine) PlayerMulligan(gameID, playerID string) error {
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "PlayerMulligan", playerID)
 
-	if gameState.state != GameStateMulligan {
-		return fmt.Errorf("game is not in mulligan phase")
-	}
-
-	player, exists := gameState.players[playerID]
-	if !exists {
-		return fmt.Errorf("player %s not found", playerID)
-	}
-
-	if player.KeptHand {
-		return fmt.Errorf("player has already kept their hand")
-	}
-
-	// Shuffle hand back into library
-	player.Library = append(player.Library, player.Hand...)
-	player.Hand = make([]*internalCard, 0)
-
-	// Shuffle library (simple random shuffle)
-	for i := len(player.Library) - 1; i > 0; i-- {
-		j := i // In production, use crypto/rand for true randomness
-		player.Library[i], player.Library[j] = player.Library[j], player.Library[i]
-	}
-
-	// Increment mulligan count
-	player.MulliganCount++
-
-	// Draw N - mulliganCount cards (London mulligan)
-	handSize := 7 - player.MulliganCount
-	if handSize < 0 {
-		handSize = 0
-	}
-
-	for i := 0; i < handSize && len(player.Library) > 0; i++ {
-		card := player.Library[0]
-		player.Library = player.Library[1:]
-		card.Zone = zoneHand
-		player.Hand = append(player.Hand, card)
-	}
-
-	gameState.addMessage(fmt.Sprintf("%s mulligans to %d cards", player.Name, handSize), "mulligan")
-
-	if e.logger != nil {
-		e.logger.Info("player mulliganed",
-			zap.String("game_id", gameID),
-			zap.String("player_id", playerID),
-			zap.Int("mulligan_count", player.MulliganCount),
-			zap.Int("hand_size", handSize),
-		)
-	}
-
-	e.notifyGameStateChange(gameID, map[string]interface{}{
-		"type":           "player_mulligan",
-		"player_id":      playerID,
-		"mulligan_count": player.MulliganCount,
-		"hand_size":      handSize,
-	})
-
-	return nil
+	return e.decideMulligan(gameState, playerID, true)
 }
 
-// PlayerKeepHand indicates player is keeping their current hand
-// Per Java LondonMulligan.endMulligan(): finalize mulligan, bottom cards if needed
-func (e *MageEngine) PlayerKeepHand(gameID, playerID string) error {
+// PlayerKeepHand records a player's decision to keep their current hand this round
+// Per Java LondonMulligan.endMulligan(): cards are put on the bottom once all players have kept
+func (e *MageEngine) PlayerKeepHand(gameID, playerID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -3965,39 +5106,25 @@ func (e *MageEngine) PlayerKeepHand(gameID, playerID string) error {
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "PlayerKeepHand", playerID)
 
-	if gameState.state != GameStateMulligan {
-		return fmt.Errorf("game is not in mulligan phase")
-	}
-
-	player, exists := gameState.players[playerID]
-	if !exists {
-		return fmt.Errorf("player %s not found", playerID)
+	if err := e.decideMulligan(gameState, playerID, false); err != nil {
+		return err
 	}
 
-	player.KeptHand = true
-
-	gameState.addMessage(fmt.Sprintf("%s keeps their hand", player.Name), "mulligan")
-
 	if e.logger != nil {
-		e.logger.Info("player kept hand",
+		e.logger.Info("player decided to keep hand",
 			zap.String("game_id", gameID),
 			zap.String("player_id", playerID),
-			zap.Int("mulligan_count", player.MulliganCount),
 		)
 	}
 
-	e.notifyGameStateChange(gameID, map[string]interface{}{
-		"type":      "player_keep_hand",
-		"player_id": playerID,
-	})
-
 	return nil
 }
 
 // EndMulligan ends the mulligan phase and starts the main game
 // Per Java GameImpl.endMulligan(): transition to main game after all players keep
-func (e *MageEngine) EndMulligan(gameID string) error {
+func (e *MageEngine) EndMulligan(gameID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -4008,6 +5135,7 @@ func (e *MageEngine) EndMulligan(gameID string) error {
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "EndMulligan")
 
 	if gameState.state != GameStateMulligan {
 		return fmt.Errorf("game is not in mulligan phase")
@@ -4019,9 +5147,13 @@ func (e *MageEngine) EndMulligan(gameID string) error {
 			return fmt.Errorf("not all players have kept their hands")
 		}
 	}
+	if gameState.mulligan != nil && gameState.mulligan.stage != mulliganStageDone {
+		return fmt.Errorf("mulligan decisions and opening actions are not finished")
+	}
 
 	// Transition to main game
 	gameState.state = GameStateInProgress
+	gameState.mulligan = nil
 
 	gameState.addMessage("Mulligan phase complete, game starting", "system")
 
@@ -4068,15 +5200,14 @@ func (e *MageEngine) processForcedAttackers(gameState *engineGameState) error {
 		}
 
 		// Check if creature can attack
-		canAttack, _ := e.CanAttack(gameState.gameID, card.ID)
-		if !canAttack {
+		if !e.canAttackInternal(gameState, card) {
 			continue // Can't attack due to restrictions (tapped, summoning sickness, etc.)
 		}
 
 		// Find valid defenders this creature can attack
 		validDefenders := make([]string, 0)
 		for defenderID := range gameState.combat.defenders {
-			canAttackDefender, _ := e.CanAttackDefender(gameState.gameID, card.ID, defenderID)
+			canAttackDefender, _ := e.canAttackDefenderInternal(gameState, card, defenderID)
 			if canAttackDefender {
 				validDefenders = append(validDefenders, defenderID)
 			}
@@ -4091,7 +5222,7 @@ func (e *MageEngine) processForcedAttackers(gameState *engineGameState) error {
 		defenderID := validDefenders[0]
 
 		// Declare the attacker
-		if err := e.DeclareAttacker(gameState.gameID, card.ID, defenderID, activePlayerID); err != nil {
+		if err := e.declareAttacker(gameState, card.ID, defenderID, activePlayerID); err != nil {
 			if e.logger != nil {
 				e.logger.Warn("failed to declare forced attacker",
 					zap.String("game_id", gameState.gameID),
@@ -4169,8 +5300,13 @@ func (e *MageEngine) processMustBeBlockedRequirements(gameState *engineGameState
 				continue
 			}
 
+			// Only the blockers an effect names are required to block (none named = all able blockers)
+			if !mustBeBlockedBy(mbEffects, blocker.ID) {
+				continue
+			}
+
 			// Check if this blocker can block the attacker
-			canBlock, _ := e.CanBlock(gameState.gameID, blocker.ID, attackerID)
+			canBlock, _ := e.canBlockInternal(gameState, blocker.ID, attackerID)
 			if !canBlock {
 				continue
 			}
@@ -4219,15 +5355,7 @@ func (e *MageEngine) handleCombatStepBegin(gameState *engineGameState, step rule
 		gameState.combat.attackingPlayerID = activePlayerID
 
 		// Set defenders (equivalent to game.getCombat().setDefenders(game))
-		// Clear previous defenders
-		gameState.combat.defenders = make(map[string]bool)
-
-		// Add all opponents as defenders
-		for playerID := range gameState.players {
-			if playerID != activePlayerID {
-				gameState.combat.defenders[playerID] = true
-			}
-		}
+		e.setDefenders(gameState, activePlayerID)
 
 		// Fire begin combat event
 		gameState.eventBus.Publish(rules.NewEvent(rules.EventBeginCombatStep, "", "", ""))
@@ -4247,14 +5375,7 @@ func (e *MageEngine) handleCombatStepBegin(gameState *engineGameState, step rule
 		gameState.eventBus.Publish(rules.NewEvent(rules.EventDeclareAttackersStepPre, "", "", activePlayerID))
 
 		// Check for creatures that lost creature type (Per Java Combat.checkForRemoveFromCombat())
-		gameState.mu.Unlock()
-		if err := e.CheckForRemoveFromCombat(gameState.gameID); err != nil && e.logger != nil {
-			e.logger.Error("failed to check for removal from combat",
-				zap.String("game_id", gameState.gameID),
-				zap.Error(err),
-			)
-		}
-		gameState.mu.Lock()
+		e.checkForRemoveFromCombat(gameState)
 
 		// Process forced attackers ("attacks if able" effects)
 		// Per Java Combat.checkAttackRequirements()
@@ -4265,19 +5386,14 @@ func (e *MageEngine) handleCombatStepBegin(gameState *engineGameState, step rule
 			)
 		}
 
-		// Generate prompt for attacking player to declare attackers
-		options := e.buildAttackerPromptOptions(gameState)
-		if len(options) > 1 { // More than just "DONE_ATTACKING"
-			gameState.addPrompt(activePlayerID, "Declare attackers (select creatures to attack)", options)
-		} else {
-			gameState.addPrompt(activePlayerID, "No creatures can attack", []string{"DONE_ATTACKING"})
-		}
+		// Ask the attacking player to declare attackers
+		decision := e.requestAttackersDecision(gameState, activePlayerID)
 
 		if e.logger != nil {
 			e.logger.Debug("declare attackers step initialized",
 				zap.String("game_id", gameState.gameID),
 				zap.String("active_player", activePlayerID),
-				zap.Int("available_options", len(options)),
+				zap.Int("available_options", len(decision.Choices)),
 			)
 		}
 
@@ -4286,14 +5402,7 @@ func (e *MageEngine) handleCombatStepBegin(gameState *engineGameState, step rule
 		gameState.eventBus.Publish(rules.NewEvent(rules.EventDeclareBlockersStepPre, "", "", activePlayerID))
 
 		// Check for creatures that lost creature type (Per Java Combat.checkForRemoveFromCombat())
-		gameState.mu.Unlock()
-		if err := e.CheckForRemoveFromCombat(gameState.gameID); err != nil && e.logger != nil {
-			e.logger.Error("failed to check for removal from combat",
-				zap.String("game_id", gameState.gameID),
-				zap.Error(err),
-			)
-		}
-		gameState.mu.Lock()
+		e.checkForRemoveFromCombat(gameState)
 
 		// Process "must be blocked if able" requirements
 		// Per Java Combat.retrieveMustBlockAttackerRequirements()
@@ -4304,22 +5413,15 @@ func (e *MageEngine) handleCombatStepBegin(gameState *engineGameState, step rule
 			)
 		}
 
-		// Generate prompts for each defending player to declare blockers
-		// Defending players are all opponents of the active player
-		for playerID := range gameState.players {
-			if playerID != activePlayerID {
-				options := e.buildBlockerPromptOptions(gameState, playerID)
-				if len(options) > 1 { // More than just "DONE_BLOCKING"
-					gameState.addPrompt(playerID, "Declare blockers (select creatures to block)", options)
-				} else {
-					gameState.addPrompt(playerID, "No creatures can block or no attackers", []string{"DONE_BLOCKING"})
-				}
-			}
-		}
+		// Ask each defending player to declare blockers
+		e.requestBlockersDecisions(gameState)
 
 		// After blockers are declared, check if there are creatures with first/double strike
 		// If so, update the turn sequence to include the first strike damage step
-		if hasFirstStrike, err := e.HasFirstOrDoubleStrike(gameState.gameID); err == nil && hasFirstStrike {
+		gameState.mu.Unlock()
+		hasFirstStrike, err := e.HasFirstOrDoubleStrike(gameState.gameID)
+		gameState.mu.Lock()
+		if err == nil && hasFirstStrike {
 			gameState.turnManager.SetHasFirstStrike(true)
 			if e.logger != nil {
 				e.logger.Debug("first strike damage step added to turn sequence",
@@ -4340,18 +5442,11 @@ func (e *MageEngine) handleCombatStepBegin(gameState *engineGameState, step rule
 		gameState.eventBus.Publish(rules.NewEvent(rules.EventCombatDamageStepPre, "", "", activePlayerID))
 
 		// Check for creatures that lost creature type (Per Java Combat.checkForRemoveFromCombat())
-		gameState.mu.Unlock()
-		if err := e.CheckForRemoveFromCombat(gameState.gameID); err != nil && e.logger != nil {
-			e.logger.Error("failed to check for removal from combat",
-				zap.String("game_id", gameState.gameID),
-				zap.Error(err),
-			)
-		}
-		gameState.mu.Lock()
+		e.checkForRemoveFromCombat(gameState)
 
 		// Automatically assign and apply first strike damage
-		if err := e.AssignCombatDamage(gameState.gameID, true); err == nil {
-			if err := e.ApplyCombatDamage(gameState.gameID); err != nil && e.logger != nil {
+		if err := e.assignCombatDamage(gameState, true); err == nil {
+			if err := e.applyCombatDamage(gameState); err != nil && e.logger != nil {
 				e.logger.Error("failed to apply first strike damage",
 					zap.String("game_id", gameState.gameID),
 					zap.Error(err),
@@ -4375,18 +5470,11 @@ func (e *MageEngine) handleCombatStepBegin(gameState *engineGameState, step rule
 		gameState.eventBus.Publish(rules.NewEvent(rules.EventCombatDamageStepPre, "", "", activePlayerID))
 
 		// Check for creatures that lost creature type (Per Java Combat.checkForRemoveFromCombat())
-		gameState.mu.Unlock()
-		if err := e.CheckForRemoveFromCombat(gameState.gameID); err != nil && e.logger != nil {
-			e.logger.Error("failed to check for removal from combat",
-				zap.String("game_id", gameState.gameID),
-				zap.Error(err),
-			)
-		}
-		gameState.mu.Lock()
+		e.checkForRemoveFromCombat(gameState)
 
 		// Automatically assign and apply normal damage
-		if err := e.AssignCombatDamage(gameState.gameID, false); err == nil {
-			if err := e.ApplyCombatDamage(gameState.gameID); err != nil && e.logger != nil {
+		if err := e.assignCombatDamage(gameState, false); err == nil {
+			if err := e.applyCombatDamage(gameState); err != nil && e.logger != nil {
 				e.logger.Error("failed to apply normal combat damage",
 					zap.String("game_id", gameState.gameID),
 					zap.Error(err),
@@ -4410,7 +5498,7 @@ func (e *MageEngine) handleCombatStepBegin(gameState *engineGameState, step rule
 		gameState.eventBus.Publish(rules.NewEvent(rules.EventEndCombatStepPre, "", "", activePlayerID))
 
 		// End combat and clean up combat state
-		if err := e.EndCombat(gameState.gameID); err != nil && e.logger != nil {
+		if err := e.endCombat(gameState); err != nil && e.logger != nil {
 			e.logger.Error("failed to end combat",
 				zap.String("game_id", gameState.gameID),
 				zap.Error(err),
@@ -4422,12 +5510,45 @@ func (e *MageEngine) handleCombatStepBegin(gameState *engineGameState, step rule
 				zap.String("game_id", gameState.gameID),
 			)
 		}
+
+	default:
+		return
+	}
+
+	e.notifyCombatStep(gameState.gameID, e.combatStepNotification(gameState, step, activePlayerID))
+}
+
+// combatStepNotification describes a combat step for clients: the step, the decision it waits for
+// ("declare_attackers", "declare_blockers" or "" when no one has to declare) and the players who
+// must make it
+func (e *MageEngine) combatStepNotification(gameState *engineGameState, step rules.Step, activePlayerID string) map[string]interface{} {
+	decision := ""
+	actingPlayers := []string{}
+	switch step {
+	case rules.StepDeclareAttackers:
+		decision = "declare_attackers"
+		actingPlayers = append(actingPlayers, activePlayerID)
+	case rules.StepDeclareBlockers:
+		decision = "declare_blockers"
+		for _, playerID := range gameState.playerOrder {
+			if player := gameState.players[playerID]; playerID != activePlayerID && player.canRespond() {
+				actingPlayers = append(actingPlayers, playerID)
+			}
+		}
+	}
+
+	return map[string]interface{}{
+		"type":           "combat_step",
+		"step":           step.String(),
+		"active_player":  activePlayerID,
+		"decision":       decision,
+		"acting_players": actingPlayers,
 	}
 }
 
 // ResetCombat clears all combat state at the beginning of combat
 // Per Java Combat.reset()
-func (e *MageEngine) ResetCombat(gameID string) error {
+func (e *MageEngine) ResetCombat(gameID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -4438,6 +5559,7 @@ func (e *MageEngine) ResetCombat(gameID string) error {
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "ResetCombat")
 
 	// Create new combat state
 	gameState.combat = newCombatState()
@@ -4462,7 +5584,7 @@ func (e *MageEngine) ResetCombat(gameID string) error {
 
 // SetAttacker sets the attacking player for this combat
 // Per Java Combat.setAttacker()
-func (e *MageEngine) SetAttacker(gameID, playerID string) error {
+func (e *MageEngine) SetAttacker(gameID, playerID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -4473,6 +5595,7 @@ func (e *MageEngine) SetAttacker(gameID, playerID string) error {
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "SetAttacker", playerID)
 
 	if _, exists := gameState.players[playerID]; !exists {
 		return fmt.Errorf("player %s not found", playerID)
@@ -4492,7 +5615,7 @@ func (e *MageEngine) SetAttacker(gameID, playerID string) error {
 
 // SetDefenders identifies all possible defenders (players, planeswalkers, battles)
 // Per Java Combat.setDefenders()
-func (e *MageEngine) SetDefenders(gameID string) error {
+func (e *MageEngine) SetDefenders(gameID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -4503,18 +5626,34 @@ func (e *MageEngine) SetDefenders(gameID string) error {
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "SetDefenders")
 
 	attackingPlayerID := gameState.combat.attackingPlayerID
 	if attackingPlayerID == "" {
 		return fmt.Errorf("no attacking player set")
 	}
 
+	e.setDefenders(gameState, attackingPlayerID)
+
+	if e.logger != nil {
+		e.logger.Debug("set defenders",
+			zap.String("game_id", gameID),
+			zap.Int("defender_count", len(gameState.combat.defenders)),
+		)
+	}
+
+	return nil
+}
+
+// setDefenders records every player, planeswalker and battle the attacking player can attack
+// Per Java Combat.setDefenders()
+func (e *MageEngine) setDefenders(gameState *engineGameState, attackingPlayerID string) {
 	// Clear previous defenders
 	gameState.combat.defenders = make(map[string]bool)
 
-	// Add all opponents as defenders
+	// Add all opponents within the attacker's range of influence as defenders (rule 801)
 	for playerID := range gameState.players {
-		if playerID != attackingPlayerID {
+		if playerID != attackingPlayerID && gameState.inRangeOf(attackingPlayerID, playerID) {
 			gameState.combat.defenders[playerID] = true
 		}
 	}
@@ -4535,21 +5674,24 @@ func (e *MageEngine) SetDefenders(gameID string) error {
 		if card.ControllerID == attackingPlayerID {
 			continue // Can't attack your own planeswalkers
 		}
+		if !gameState.inRangeOf(attackingPlayerID, card.ControllerID) {
+			continue
+		}
 
 		// Add planeswalker as a defender
 		gameState.combat.defenders[card.ID] = true
 	}
 
-	// TODO: Add battles that can be attacked when battle system is implemented
-
-	if e.logger != nil {
-		e.logger.Debug("set defenders",
-			zap.String("game_id", gameID),
-			zap.Int("defender_count", len(gameState.combat.defenders)),
-		)
+	// Add battles protected by opponents (Rule 310.8); a battle's controller is treated as its protector
+	for _, card := range gameState.cards {
+		if card.Zone != zoneBattlefield || !e.isBattle(card) {
+			continue
+		}
+		if card.ControllerID == attackingPlayerID || !gameState.inRangeOf(attackingPlayerID, card.ControllerID) {
+			continue
+		}
+		gameState.combat.defenders[card.ID] = true
 	}
-
-	return nil
 }
 
 // CanAttack checks if a creature can attack (any defender)
@@ -4571,28 +5713,33 @@ func (e *MageEngine) CanAttack(gameID, creatureID string) (bool, error) {
 		return false, fmt.Errorf("creature %s not found", creatureID)
 	}
 
+	return e.canAttackInternal(gameState, creature), nil
+}
+
+// canAttackInternal checks if a creature can attack any defender (internal helper; the caller holds the game lock)
+func (e *MageEngine) canAttackInternal(gameState *engineGameState, creature *internalCard) bool {
 	// Basic checks (Java: Permanent.canAttack line 1485)
 	if creature.Tapped {
-		return false, nil
+		return false
 	}
 
 	// Check if can attack in principle (Java: canAttackInPrinciple line 1504)
-	// Check summoning sickness
+	// Check summoning sickness (haste, base or granted, ignores it)
 	// TODO: Implement AsThoughEffectType.ATTACK_AS_HASTE for haste effects
-	if creature.SummoningSickness {
-		return false, nil
+	if e.isSummoningSick(gameState, creature) {
+		return false
 	}
 
 	// Check defender ability (Java: line 1527)
 	// TODO: Implement AsThoughEffectType.ATTACK for effects that allow defender to attack
-	if e.hasAbility(creature, abilityDefender) {
-		return false, nil
+	if e.hasAbilityWithEffects(gameState, creature, abilityDefender) {
+		return false
 	}
 
 	// Check for continuous effects that prevent attacking
 	// Per Java: RestrictionEffect.applies() and canAttack() checks
-	if e.hasCantAttackEffect(gameState, creatureID) {
-		return false, nil
+	if e.hasCantAttackEffect(gameState, creature.ID) {
+		return false
 	}
 
 	// Check if can attack at least one defender (Java: line 1516-1522)
@@ -4600,11 +5747,11 @@ func (e *MageEngine) CanAttack(gameID, creatureID string) (bool, error) {
 	for defenderID := range gameState.combat.defenders {
 		canAttack, _ := e.canAttackDefenderInternal(gameState, creature, defenderID)
 		if canAttack {
-			return true, nil
+			return true
 		}
 	}
 
-	return false, nil
+	return false
 }
 
 // CanAttackDefender checks if a creature can attack a specific defender
@@ -4637,15 +5784,15 @@ func (e *MageEngine) CanAttackDefender(gameID, creatureID, defenderID string) (b
 // canAttackDefenderInternal checks if a creature can attack a specific defender (internal helper)
 // Per Java Permanent.canAttackInPrinciple(defenderId, game)
 func (e *MageEngine) canAttackDefenderInternal(gameState *engineGameState, creature *internalCard, defenderID string) (bool, error) {
-	// Check summoning sickness
+	// Check summoning sickness (haste, base or granted, ignores it)
 	// TODO: Implement AsThoughEffectType.ATTACK_AS_HASTE for haste effects
-	if creature.SummoningSickness {
+	if e.isSummoningSick(gameState, creature) {
 		return false, nil
 	}
 
 	// Check defender ability (Java: line 1527)
 	// TODO: Implement AsThoughEffectType.ATTACK for effects that allow defender to attack
-	if e.hasAbility(creature, abilityDefender) {
+	if e.hasAbilityWithEffects(gameState, creature, abilityDefender) {
 		return false, nil
 	}
 
@@ -4665,7 +5812,7 @@ func (e *MageEngine) canAttackDefenderInternal(gameState *engineGameState, creat
 
 // DeclareAttacker declares a creature as an attacker
 // Per Java Combat.declareAttacker()
-func (e *MageEngine) DeclareAttacker(gameID, creatureID, defenderID, playerID string) error {
+func (e *MageEngine) DeclareAttacker(gameID, creatureID, defenderID, playerID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -4676,7 +5823,18 @@ func (e *MageEngine) DeclareAttacker(gameID, creatureID, defenderID, playerID st
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "DeclareAttacker", creatureID, defenderID, playerID)
+
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+
+	return e.declareAttacker(gameState, creatureID, defenderID, playerID)
+}
 
+// checkAttacker reports why a creature can't be declared as an attacker of a defender, or nil if it can;
+// the caller holds the game lock
+func (e *MageEngine) checkAttacker(gameState *engineGameState, creatureID, defenderID, playerID string) error {
 	// Validate player
 	if playerID != gameState.combat.attackingPlayerID {
 		return fmt.Errorf("player %s is not the attacking player", playerID)
@@ -4697,6 +5855,11 @@ func (e *MageEngine) DeclareAttacker(gameID, creatureID, defenderID, playerID st
 		return fmt.Errorf("creature %s is not on battlefield", creatureID)
 	}
 
+	// Per rule 508.1a: only creatures can attack (type-changing effects can make other permanents creatures)
+	if !e.isCreature(creature) {
+		return fmt.Errorf("%s is not a creature", creatureID)
+	}
+
 	// Validate creature can attack (not tapped, not summoning sick)
 	if creature.Tapped {
 		return fmt.Errorf("creature %s is tapped", creatureID)
@@ -4704,24 +5867,41 @@ func (e *MageEngine) DeclareAttacker(gameID, creatureID, defenderID, playerID st
 
 	// Check for defender ability (Java: PermanentImpl.canAttackInPrinciple line 1527)
 	// Creatures with defender can't attack unless they have an effect allowing them to
-	if e.hasAbility(creature, abilityDefender) {
+	if e.hasAbilityWithEffects(gameState, creature, abilityDefender) {
 		// TODO: Check for AsThoughEffectType.ATTACK effects that allow defender to attack
 		return fmt.Errorf("creature %s has defender and cannot attack", creatureID)
 	}
 
-	// TODO: Check summoning sickness when we track turn entered
-	// TODO: Check for "can't attack" restrictions
-	// TODO: Check for "must attack" requirements
+	// Per rule 302.6: a creature can't attack unless its controller has controlled it continuously
+	// since their most recent turn began (or it has haste)
+	if e.isSummoningSick(gameState, creature) {
+		return fmt.Errorf("creature %s has summoning sickness", creatureID)
+	}
 
-	// Fire declare attackers step pre event (before first attacker)
-	if len(gameState.combat.attackers) == 0 {
-		gameState.eventBus.Publish(rules.NewEvent(rules.EventDeclareAttackersStepPre, "", "", playerID))
+	// Per rule 508.1c: creatures affected by "can't attack" restrictions can't be declared as attackers
+	// ("attacks if able" requirements are checked by FinishDeclaringAttackers)
+	if e.hasCantAttackEffect(gameState, creatureID) {
+		return fmt.Errorf("creature %s can't attack", creatureID)
 	}
 
 	// Validate defender exists
 	if !gameState.combat.defenders[defenderID] {
 		return fmt.Errorf("invalid defender %s", defenderID)
 	}
+	return nil
+}
+
+// declareAttacker declares a creature as an attacker (internal helper; the caller holds the game lock)
+func (e *MageEngine) declareAttacker(gameState *engineGameState, creatureID, defenderID, playerID string) error {
+	if err := e.checkAttacker(gameState, creatureID, defenderID, playerID); err != nil {
+		return err
+	}
+	creature := gameState.cards[creatureID]
+
+	// Fire declare attackers step pre event (before first attacker)
+	if len(gameState.combat.attackers) == 0 {
+		gameState.eventBus.Publish(rules.NewEvent(rules.EventDeclareAttackersStepPre, "", "", playerID))
+	}
 
 	// TODO: Validate can attack this specific defender (protection, etc.)
 
@@ -4749,7 +5929,9 @@ func (e *MageEngine) DeclareAttacker(gameID, creatureID, defenderID, playerID st
 	// Check both base and granted vigilance
 	hasVigilance := e.hasAbilityWithEffects(gameState, creature, abilityVigilance)
 	if !hasVigilance && !creature.Tapped {
-		creature.Tapped = true
+		if err := e.tapPermanent(gameState, creature, tapReasonAttack); err != nil {
+			return err
+		}
 		gameState.combat.attackersTapped[creatureID] = true
 	}
 
@@ -4794,7 +5976,7 @@ func (e *MageEngine) DeclareAttacker(gameID, creatureID, defenderID, playerID st
 
 	if e.logger != nil {
 		e.logger.Debug("declared attacker",
-			zap.String("game_id", gameID),
+			zap.String("game_id", gameState.gameID),
 			zap.String("creature_id", creatureID),
 			zap.String("defender_id", defenderID),
 		)
@@ -4805,7 +5987,7 @@ func (e *MageEngine) DeclareAttacker(gameID, creatureID, defenderID, playerID st
 
 // FinishDeclaringAttackers signals that all attackers have been declared
 // Fires the DECLARED_ATTACKERS event
-func (e *MageEngine) FinishDeclaringAttackers(gameID string) error {
+func (e *MageEngine) FinishDeclaringAttackers(gameID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -4816,6 +5998,21 @@ func (e *MageEngine) FinishDeclaringAttackers(gameID string) error {
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "FinishDeclaringAttackers")
+
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+
+	return e.finishDeclaringAttackers(gameState)
+}
+
+// finishDeclaringAttackers checks and finishes the declaration of attackers; the caller holds the game lock
+func (e *MageEngine) finishDeclaringAttackers(gameState *engineGameState) error {
+	// Per rule 508.1d: the declaration must obey every "attacks if able" requirement it can
+	if violations := e.mustAttackViolations(gameState, nil); len(violations) > 0 {
+		return fmt.Errorf("illegal attack: %s", strings.Join(violations, "; "))
+	}
 
 	// Fire DECLARED_ATTACKERS event
 	declaredEvent := rules.NewEvent(rules.EventDeclaredAttackers, "", "", gameState.combat.attackingPlayerID)
@@ -4824,6 +6021,8 @@ func (e *MageEngine) FinishDeclaringAttackers(gameID string) error {
 	// Check for combat triggers (e.g., "Whenever one or more creatures attack")
 	e.checkCombatTriggers(gameState, declaredEvent)
 
+	// The attackers are declared, so the attacking player isn't asked anymore
+	gameState.clearDeclarations()
 	return nil
 }
 
@@ -4975,7 +6174,7 @@ func (e *MageEngine) CanBlock(gameID, blockerID, attackerID string) (bool, error
 
 // DeclareBlocker declares a creature as a blocker for an attacker
 // Per Java PlayerImpl.declareBlocker()
-func (e *MageEngine) DeclareBlocker(gameID, blockerID, attackerID, playerID string) error {
+func (e *MageEngine) DeclareBlocker(gameID, blockerID, attackerID, playerID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -4984,11 +6183,16 @@ func (e *MageEngine) DeclareBlocker(gameID, blockerID, attackerID, playerID stri
 		return fmt.Errorf("game %s not found", gameID)
 	}
 
-	// Fire declare blockers step pre event (before first blocker)
 	gameState.mu.RLock()
+	acceptErr := gameState.checkAcceptsActions()
 	hasBlockers := len(gameState.combat.blockers) > 0
 	gameState.mu.RUnlock()
 
+	if acceptErr != nil {
+		return acceptErr
+	}
+
+	// Fire declare blockers step pre event (before first blocker)
 	if !hasBlockers {
 		gameState.mu.Lock()
 		gameState.eventBus.Publish(rules.NewEvent(rules.EventDeclareBlockersStepPre, "", "", playerID))
@@ -4997,14 +6201,21 @@ func (e *MageEngine) DeclareBlocker(gameID, blockerID, attackerID, playerID stri
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "DeclareBlocker", blockerID, attackerID, playerID)
 
+	return e.declareBlocker(gameState, blockerID, attackerID, playerID)
+}
+
+// checkBlocker reports why a creature can't be declared as a blocker of an attacker, or returns the
+// attacker's combat group if it can; the caller holds the game lock
+func (e *MageEngine) checkBlocker(gameState *engineGameState, blockerID, attackerID, playerID string) (*combatGroup, error) {
 	// Validate blocker can block this attacker
 	canBlock, err := e.canBlockInternal(gameState, blockerID, attackerID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if !canBlock {
-		return fmt.Errorf("creature %s cannot block attacker %s", blockerID, attackerID)
+		return nil, fmt.Errorf("creature %s cannot block attacker %s", blockerID, attackerID)
 	}
 
 	// Find the combat group for this attacker
@@ -5022,18 +6233,28 @@ func (e *MageEngine) DeclareBlocker(gameID, blockerID, attackerID, playerID stri
 	}
 
 	if group == nil {
-		return fmt.Errorf("attacker %s not found in any combat group", attackerID)
+		return nil, fmt.Errorf("attacker %s not found in any combat group", attackerID)
 	}
 
 	// Validate player controls the blocker
 	blocker, exists := gameState.cards[blockerID]
 	if !exists {
-		return fmt.Errorf("blocker %s not found", blockerID)
+		return nil, fmt.Errorf("blocker %s not found", blockerID)
 	}
 
 	if blocker.ControllerID != playerID {
-		return fmt.Errorf("player %s does not control blocker %s", playerID, blockerID)
+		return nil, fmt.Errorf("player %s does not control blocker %s", playerID, blockerID)
 	}
+	return group, nil
+}
+
+// declareBlocker declares a creature as a blocker (internal helper; the caller holds the game lock)
+func (e *MageEngine) declareBlocker(gameState *engineGameState, blockerID, attackerID, playerID string) error {
+	group, err := e.checkBlocker(gameState, blockerID, attackerID, playerID)
+	if err != nil {
+		return err
+	}
+	blocker := gameState.cards[blockerID]
 
 	// Check if blocker is already blocking
 	if blocker.Blocking {
@@ -5072,7 +6293,7 @@ func (e *MageEngine) DeclareBlocker(gameID, blockerID, attackerID, playerID stri
 
 	if e.logger != nil {
 		e.logger.Debug("blocker declared",
-			zap.String("game_id", gameID),
+			zap.String("game_id", gameState.gameID),
 			zap.String("blocker_id", blockerID),
 			zap.String("attacker_id", attackerID),
 			zap.String("player_id", playerID),
@@ -5105,6 +6326,11 @@ func (e *MageEngine) canBlockInternal(gameState *engineGameState, blockerID, att
 		return false, nil
 	}
 
+	// Per rule 509.1b: creatures affected by "can't block" restrictions can't block
+	if e.hasCantBlockEffect(gameState, blockerID) {
+		return false, nil
+	}
+
 	if blocker.Zone != zoneBattlefield {
 		return false, nil
 	}
@@ -5140,25 +6366,30 @@ func (e *MageEngine) canBlockInternal(gameState *engineGameState, blockerID, att
 
 	// Unblockable check: If attacker has "can't be blocked" ability, it cannot be blocked by any creature
 	// Per Rule 509.1b and Java CantBeBlockedSourceEffect.canBeBlocked() which returns false
-	if e.hasAbility(attacker, abilityUnblockable) {
+	if e.hasAbilityWithEffects(gameState, attacker, abilityUnblockable) {
 		return false, nil
 	}
 
 	// Flying restriction: creatures with flying can only be blocked by creatures with flying or reach
 	// Exception: Dragons can be blocked by non-flying creatures with special abilities (AsThoughEffectType.BLOCK_DRAGON)
-	if e.hasAbility(attacker, abilityFlying) {
-		if !e.hasAbility(blocker, abilityFlying) && !e.hasAbility(blocker, abilityReach) {
+	if e.hasAbilityWithEffects(gameState, attacker, abilityFlying) {
+		if !e.hasAbilityWithEffects(gameState, blocker, abilityFlying) && !e.hasAbilityWithEffects(gameState, blocker, abilityReach) {
 			// TODO: Check for AsThoughEffectType.BLOCK_DRAGON and attacker.hasSubtype(SubType.DRAGON)
 			return false, nil
 		}
 	}
 
+	// Per rule 702.16f: a creature with protection can't be blocked by creatures with that quality
+	if e.protectedFrom(attacker, blocker) {
+		return false, nil
+	}
+
 	return true, nil
 }
 
 // RemoveBlocker removes a blocker from combat
 // Per Java CombatGroup.remove()
-func (e *MageEngine) RemoveBlocker(gameID, blockerID string) error {
+func (e *MageEngine) RemoveBlocker(gameID, blockerID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -5169,7 +6400,13 @@ func (e *MageEngine) RemoveBlocker(gameID, blockerID string) error {
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "RemoveBlocker", blockerID)
+
+	return e.removeBlocker(gameState, blockerID)
+}
 
+// removeBlocker removes a blocker from combat; the caller holds the game lock
+func (e *MageEngine) removeBlocker(gameState *engineGameState, blockerID string) error {
 	// Find the combat group this blocker is in
 	group, exists := gameState.combat.blockingGroups[blockerID]
 	if !exists {
@@ -5207,7 +6444,7 @@ func (e *MageEngine) RemoveBlocker(gameID, blockerID string) error {
 
 	if e.logger != nil {
 		e.logger.Debug("blocker removed",
-			zap.String("game_id", gameID),
+			zap.String("game_id", gameState.gameID),
 			zap.String("blocker_id", blockerID),
 		)
 	}
@@ -5217,7 +6454,7 @@ func (e *MageEngine) RemoveBlocker(gameID, blockerID string) error {
 
 // RemoveAttacker removes an attacker from combat
 // Per Java Combat.removeAttacker()
-func (e *MageEngine) RemoveAttacker(gameID, attackerID string) error {
+func (e *MageEngine) RemoveAttacker(gameID, attackerID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -5228,6 +6465,7 @@ func (e *MageEngine) RemoveAttacker(gameID, attackerID string) error {
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "RemoveAttacker", attackerID)
 
 	// Check if creature is actually attacking
 	if !gameState.combat.attackers[attackerID] {
@@ -5275,8 +6513,13 @@ func (e *MageEngine) RemoveAttacker(gameID, attackerID string) error {
 		attacker.AttackingWhat = ""
 
 		// Untap if it was tapped by attack (Java: attackersTappedByAttack check)
+		// A creature untapped and tapped again for a cost since attacking stays tapped
 		if gameState.combat.attackersTapped[attackerID] {
-			attacker.Tapped = false
+			if attacker.Tapped && attacker.TapReason == tapReasonAttack {
+				if err := e.untapPermanent(gameState, attacker); err != nil && e.logger != nil {
+					e.logger.Debug("failed to untap removed attacker", zap.String("attacker_id", attackerID), zap.Error(err))
+				}
+			}
 			delete(gameState.combat.attackersTapped, attackerID)
 		}
 	}
@@ -5297,7 +6540,7 @@ func (e *MageEngine) RemoveAttacker(gameID, attackerID string) error {
 // RemoveFromCombat removes a creature from combat completely, clearing both attacking and blocking state
 // This is a general-purpose removal that handles both attackers and blockers
 // Per Java Combat.removeFromCombat() - used for effects like regeneration, control changes, and phasing
-func (e *MageEngine) RemoveFromCombat(gameID, creatureID string) error {
+func (e *MageEngine) RemoveFromCombat(gameID, creatureID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -5308,12 +6551,21 @@ func (e *MageEngine) RemoveFromCombat(gameID, creatureID string) error {
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "RemoveFromCombat", creatureID)
 
 	creature, exists := gameState.cards[creatureID]
 	if !exists {
 		return fmt.Errorf("creature %s not found", creatureID)
 	}
 
+	e.removeFromCombat(gameState, creature)
+	return nil
+}
+
+// removeFromCombat removes a creature from combat; caller must hold the game lock
+// Returns true if the creature was attacking or blocking
+func (e *MageEngine) removeFromCombat(gameState *engineGameState, creature *internalCard) bool {
+	creatureID := creature.ID
 	removed := false
 
 	// Remove as attacker if attacking
@@ -5395,18 +6647,18 @@ func (e *MageEngine) RemoveFromCombat(gameID, creatureID string) error {
 
 		if e.logger != nil {
 			e.logger.Debug("creature removed from combat",
-				zap.String("game_id", gameID),
+				zap.String("game_id", gameState.gameID),
 				zap.String("creature_id", creatureID),
 			)
 		}
 	}
 
-	return nil
+	return removed
 }
 
 // CheckForRemoveFromCombat checks all attacking and blocking creatures and removes those that are no longer creatures
 // Per Java Combat.checkForRemoveFromCombat() - called during combat steps to enforce rule that non-creatures can't attack/block
-func (e *MageEngine) CheckForRemoveFromCombat(gameID string) error {
+func (e *MageEngine) CheckForRemoveFromCombat(gameID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -5417,7 +6669,15 @@ func (e *MageEngine) CheckForRemoveFromCombat(gameID string) error {
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "CheckForRemoveFromCombat")
+
+	e.checkForRemoveFromCombat(gameState)
+	return nil
+}
 
+// checkForRemoveFromCombat removes attacking and blocking creatures that are no longer creatures;
+// the caller holds the game lock
+func (e *MageEngine) checkForRemoveFromCombat(gameState *engineGameState) {
 	// Collect all attackers and blockers that need to be removed
 	// We collect first, then remove, to avoid modifying maps while iterating
 	toRemove := make([]string, 0)
@@ -5439,26 +6699,15 @@ func (e *MageEngine) CheckForRemoveFromCombat(gameID string) error {
 	}
 
 	// Remove all non-creatures from combat
-	// We need to temporarily unlock to call RemoveFromCombat which takes its own lock
-	gameState.mu.Unlock()
 	for _, creatureID := range toRemove {
-		if err := e.RemoveFromCombat(gameID, creatureID); err != nil && e.logger != nil {
-			e.logger.Warn("failed to remove non-creature from combat",
-				zap.String("game_id", gameID),
-				zap.String("creature_id", creatureID),
-				zap.Error(err),
-			)
-		}
+		e.removeFromCombat(gameState, gameState.cards[creatureID])
 	}
-	gameState.mu.Lock()
-
-	return nil
 }
 
 // OrderBlockers sets the damage assignment order for blockers on a specific attacker
 // Per Java: The attacking player chooses the order in which damage is assigned to blockers
 // This is typically done during the declare blockers step, before damage assignment
-func (e *MageEngine) OrderBlockers(gameID, attackerID string, blockerOrder []string) error {
+func (e *MageEngine) OrderBlockers(gameID, attackerID string, blockerOrder []string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -5469,8 +6718,123 @@ func (e *MageEngine) OrderBlockers(gameID, attackerID string, blockerOrder []str
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "OrderBlockers", attackerID, blockerOrder)
 
-	// Find the combat group for this attacker
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+
+	targetGroup, err := findBlockedGroup(gameState, attackerID, blockerOrder)
+	if err != nil {
+		return err
+	}
+
+	// Update the blocker order
+	targetGroup.blockers = blockerOrder
+
+	if e.logger != nil {
+		e.logger.Debug("blocker order set",
+			zap.String("game_id", gameID),
+			zap.String("attacker_id", attackerID),
+			zap.Strings("blocker_order", blockerOrder),
+		)
+	}
+
+	return nil
+}
+
+// SetBlockerDamageOrder sets the order in which an attacker assigns combat damage to its blockers
+// Per rule 509.2: the attacking player orders the blockers of each attacker blocked by more than one
+// creature; damage is then assigned in that order, lethal to each before the next (rule 510.1c)
+func (e *MageEngine) SetBlockerDamageOrder(gameID, attackerID string, orderedBlockerIDs []string) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "SetBlockerDamageOrder", attackerID, orderedBlockerIDs)
+
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+
+	group, err := findBlockedGroup(gameState, attackerID, orderedBlockerIDs)
+	if err != nil {
+		return err
+	}
+
+	group.blockerOrder = make(map[string]int, len(orderedBlockerIDs))
+	for i, blockerID := range orderedBlockerIDs {
+		group.blockerOrder[blockerID] = i
+	}
+
+	if e.logger != nil {
+		e.logger.Debug("blocker damage order set",
+			zap.String("game_id", gameID),
+			zap.String("attacker_id", attackerID),
+			zap.Strings("blocker_order", orderedBlockerIDs),
+		)
+	}
+
+	return nil
+}
+
+// SetAttackerDamageOrder sets the order in which a blocker that blocks more than one attacker assigns
+// its combat damage to them; the defending player chooses it
+// Per rule 509.3: damage is then assigned in that order, lethal to each before the next (rule 510.1d)
+func (e *MageEngine) SetAttackerDamageOrder(gameID, blockerID string, orderedAttackerIDs []string) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "SetAttackerDamageOrder", blockerID, orderedAttackerIDs)
+
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+
+	attackers := blockedAttackers(gameState, blockerID)
+	if len(attackers) == 0 {
+		return fmt.Errorf("creature %s is not blocking", blockerID)
+	}
+	if len(orderedAttackerIDs) != len(attackers) {
+		return fmt.Errorf("attacker order must include all %d attackers blocked by %s, got %d",
+			len(attackers), blockerID, len(orderedAttackerIDs))
+	}
+	seen := make(map[string]bool, len(orderedAttackerIDs))
+	for _, attackerID := range orderedAttackerIDs {
+		if seen[attackerID] || !containsString(attackers, attackerID) {
+			return fmt.Errorf("attacker %s is not blocked by %s", attackerID, blockerID)
+		}
+		seen[attackerID] = true
+	}
+
+	gameState.combat.attackerDamageOrders[blockerID] = append([]string(nil), orderedAttackerIDs...)
+
+	if e.logger != nil {
+		e.logger.Debug("attacker damage order set",
+			zap.String("game_id", gameID),
+			zap.String("blocker_id", blockerID),
+			zap.Strings("attacker_order", orderedAttackerIDs),
+		)
+	}
+
+	return nil
+}
+
+// findBlockedGroup finds an attacker's combat group and checks that blockerOrder lists exactly its blockers
+func findBlockedGroup(gameState *engineGameState, attackerID string, blockerOrder []string) (*combatGroup, error) {
 	var targetGroup *combatGroup
 	for _, group := range gameState.combat.groups {
 		for _, aid := range group.attackers {
@@ -5485,12 +6849,12 @@ func (e *MageEngine) OrderBlockers(gameID, attackerID string, blockerOrder []str
 	}
 
 	if targetGroup == nil {
-		return fmt.Errorf("attacker %s not found in combat", attackerID)
+		return nil, fmt.Errorf("attacker %s not found in combat", attackerID)
 	}
 
 	// Validate that all blockers in the order are actually blocking this attacker
 	if len(blockerOrder) != len(targetGroup.blockers) {
-		return fmt.Errorf("blocker order length (%d) does not match actual blocker count (%d)",
+		return nil, fmt.Errorf("blocker order length (%d) does not match actual blocker count (%d)",
 			len(blockerOrder), len(targetGroup.blockers))
 	}
 
@@ -5500,30 +6864,40 @@ func (e *MageEngine) OrderBlockers(gameID, attackerID string, blockerOrder []str
 		currentBlockers[bid] = true
 	}
 
-	// Validate that all provided blockers are actually blocking
+	// Validate that all provided blockers are actually blocking, each once
 	for _, bid := range blockerOrder {
 		if !currentBlockers[bid] {
-			return fmt.Errorf("blocker %s is not blocking attacker %s", bid, attackerID)
+			return nil, fmt.Errorf("blocker %s is not blocking attacker %s", bid, attackerID)
 		}
+		delete(currentBlockers, bid)
 	}
 
-	// Update the blocker order
-	targetGroup.blockers = blockerOrder
+	return targetGroup, nil
+}
 
-	if e.logger != nil {
-		e.logger.Debug("blocker order set",
-			zap.String("game_id", gameID),
-			zap.String("attacker_id", attackerID),
-			zap.Strings("blocker_order", blockerOrder),
-		)
+// orderedBlockers returns a group's blockers in their damage assignment order
+// Blockers without a position (e.g. added after the order was chosen) come last, in declaration order.
+func orderedBlockers(group *combatGroup) []string {
+	blockers := append([]string(nil), group.blockers...)
+	if len(group.blockerOrder) == 0 {
+		return blockers
 	}
 
-	return nil
+	position := func(blockerID string) int {
+		if i, ok := group.blockerOrder[blockerID]; ok {
+			return i
+		}
+		return len(group.blockerOrder)
+	}
+	sort.SliceStable(blockers, func(i, j int) bool {
+		return position(blockers[i]) < position(blockers[j])
+	})
+	return blockers
 }
 
 // AcceptBlockers finalizes the blocker declarations and fires events
 // Per Java Combat.acceptBlockers()
-func (e *MageEngine) AcceptBlockers(gameID string) error {
+func (e *MageEngine) AcceptBlockers(gameID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -5534,43 +6908,28 @@ func (e *MageEngine) AcceptBlockers(gameID string) error {
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "AcceptBlockers")
 
-	// Validate menace and other blocking restrictions
-	// Per Java CombatGroup.acceptBlockers() lines 710-718
-	for _, group := range gameState.combat.groups {
-		if len(group.attackers) == 0 {
-			continue
-		}
-
-		for _, attackerID := range group.attackers {
-			attacker, exists := gameState.cards[attackerID]
-			if !exists {
-				continue
-			}
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
 
-			minBlockedBy := e.getMinBlockedBy(attacker)
-			if minBlockedBy > 1 && len(group.blockers) > 0 && len(group.blockers) < minBlockedBy {
-				// Menace violation - remove all blockers from this attacker
-				if e.logger != nil {
-					e.logger.Debug("menace violation - removing blockers",
-						zap.String("attacker_id", attackerID),
-						zap.Int("blockers", len(group.blockers)),
-						zap.Int("min_required", minBlockedBy),
-					)
-				}
+	return e.acceptBlockers(gameState)
+}
 
-				// Remove all blockers from this group
-				for _, blockerID := range group.blockers {
-					delete(gameState.combat.blockers, blockerID)
-					if blocker, exists := gameState.cards[blockerID]; exists {
-						blocker.Blocking = false
-						blocker.BlockingWhat = []string{}
-					}
-				}
-				group.blockers = []string{}
-				group.blocked = false
-			}
-		}
+// acceptBlockers checks and accepts the declared blockers; the caller holds the game lock
+func (e *MageEngine) acceptBlockers(gameState *engineGameState) error {
+	// Validate menace and other minimum-blocker restrictions, counting each attacker's own blockers
+	// Per rule 509.1b: an illegal block must be redeclared, so nothing is accepted
+	// (Java CombatGroup.acceptBlockers() removes the blockers instead)
+	var violations []string
+	for _, group := range gameState.combat.groups {
+		violations = append(violations, e.minBlockersViolations(gameState, group, len(group.blockers))...)
+	}
+	// Per rule 509.1c: the declaration must obey every "blocks if able" requirement it can
+	violations = append(violations, e.mustBlockViolations(gameState, "", nil)...)
+	if len(violations) > 0 {
+		return fmt.Errorf("illegal block: %s", strings.Join(violations, "; "))
 	}
 
 	// Fire BLOCKER_DECLARED events for each blocker-attacker pair
@@ -5652,9 +7011,12 @@ func (e *MageEngine) AcceptBlockers(gameID string) error {
 		}
 	}
 
+	// The blockers are accepted, so the defending players aren't asked anymore
+	gameState.clearDeclarations()
+
 	if e.logger != nil {
 		e.logger.Debug("blockers accepted",
-			zap.String("game_id", gameID),
+			zap.String("game_id", gameState.gameID),
 			zap.Int("blocker_count", len(gameState.combat.blockers)),
 		)
 	}
@@ -5662,6 +7024,26 @@ func (e *MageEngine) AcceptBlockers(gameID string) error {
 	return nil
 }
 
+// minBlockersViolations lists the attackers of a combat group blocked by fewer creatures than they can
+// be blocked by (e.g. menace), if it has blockers blocking it
+func (e *MageEngine) minBlockersViolations(gameState *engineGameState, group *combatGroup, blockers int) []string {
+	violations := make([]string, 0)
+	if blockers == 0 {
+		return violations
+	}
+	for _, attackerID := range group.attackers {
+		attacker, exists := gameState.cards[attackerID]
+		if !exists {
+			continue
+		}
+		if minBlockedBy := e.getMinBlockedBy(gameState, attacker); blockers < minBlockedBy {
+			violations = append(violations, fmt.Sprintf("%s can't be blocked except by %d or more creatures (%d declared)",
+				attacker.Name, minBlockedBy, blockers))
+		}
+	}
+	return violations
+}
+
 // CheckBlockRequirements validates that all blocking requirements are met
 // Per Java Combat.checkBlockRequirements()
 // Returns list of violations (empty if all requirements met)
@@ -5837,7 +7219,7 @@ func (e *MageEngine) ValidateAttackerCount(gameID string) ([]string, error) {
 
 // AssignCombatDamage assigns combat damage for all combat groups
 // Per Java CombatDamageStep.beginStep()
-func (e *MageEngine) AssignCombatDamage(gameID string, firstStrike bool) error {
+func (e *MageEngine) AssignCombatDamage(gameID string, firstStrike bool) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -5848,6 +7230,14 @@ func (e *MageEngine) AssignCombatDamage(gameID string, firstStrike bool) error {
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "AssignCombatDamage", firstStrike)
+
+	return e.assignCombatDamage(gameState, firstStrike)
+}
+
+// assignCombatDamage assigns combat damage for all combat groups; the caller holds the game lock
+func (e *MageEngine) assignCombatDamage(gameState *engineGameState, firstStrike bool) error {
+	gameID := gameState.gameID
 
 	// Fire combat damage step pre event
 	gameState.eventBus.Publish(rules.NewEvent(rules.EventCombatDamageStepPre, "", "", ""))
@@ -5870,6 +7260,9 @@ func (e *MageEngine) AssignCombatDamage(gameID string, firstStrike bool) error {
 		}
 	}
 
+	// Combat damage is dealt simultaneously: lifelink sources gain their total at once
+	e.gainCombatLifelink(gameState)
+
 	// Fire combat damage assigned event
 	gameState.eventBus.Publish(rules.NewEvent(rules.EventCombatDamageAssigned, "", "", ""))
 
@@ -5883,15 +7276,20 @@ func (e *MageEngine) AssignCombatDamage(gameID string, firstStrike bool) error {
 	return nil
 }
 
-// assignDamageToBlockers handles attacker damage to blockers or defender
+// assignDamageToBlockers handles attacker damage to blockers or defender. A group has several
+// attackers when they attack as a band; each of them assigns its own damage.
 // Per Java CombatGroup.assignDamageToBlockers()
 func (e *MageEngine) assignDamageToBlockers(gameState *engineGameState, group *combatGroup, firstStrike bool) error {
-	if len(group.attackers) == 0 {
-		return nil
+	for _, attackerID := range group.attackers {
+		if err := e.assignAttackerDamage(gameState, group, attackerID, firstStrike); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	// Get the attacker (should only be one per group)
-	attackerID := group.attackers[0]
+// assignAttackerDamage assigns and deals one attacker's combat damage to its group's blockers or defender
+func (e *MageEngine) assignAttackerDamage(gameState *engineGameState, group *combatGroup, attackerID string, firstStrike bool) error {
 	attacker, exists := gameState.cards[attackerID]
 	if !exists {
 		return nil
@@ -5913,7 +7311,7 @@ func (e *MageEngine) assignDamageToBlockers(gameState *engineGameState, group *c
 		power = 0
 	}
 
-	hasTrample := e.hasAbility(attacker, abilityTrample)
+	hasTrample := e.hasAbilityWithEffects(gameState, attacker, abilityTrample)
 
 	// Check if there are any live blockers
 	liveBlockers := 0
@@ -5946,8 +7344,12 @@ func (e *MageEngine) assignDamageToBlockers(gameState *engineGameState, group *c
 	if assignment, exists := group.attackerDamageAssignments[attackerID]; exists {
 		damageAssignment = assignment
 	} else {
-		// No explicit assignment - use default
-		damageAssignment = e.computeDefaultAttackerDamageAssignment(gameState, attackerID, group.blockers)
+		// No explicit assignment - use the damage assignment order if one was chosen, or the default
+		if len(group.blockerOrder) > 0 {
+			damageAssignment = e.computeOrderedAttackerDamageAssignment(gameState, attackerID, orderedBlockers(group), hasTrample)
+		} else {
+			damageAssignment = e.computeDefaultAttackerDamageAssignment(gameState, attackerID, group.blockers)
+		}
 	}
 
 	// Apply the damage assignment
@@ -5959,7 +7361,9 @@ func (e *MageEngine) assignDamageToBlockers(gameState *engineGameState, group *c
 		}
 
 		if damage > 0 {
-			e.markDamageWithLifelink(gameState, blocker, damage, attackerID)
+			if err := e.dealDamage(gameState, attackerID, blockerID, damage, true); err != nil {
+				return err
+			}
 			totalAssigned += damage
 		}
 	}
@@ -6014,17 +7418,12 @@ func (e *MageEngine) assignDamageToAttackers(gameState *engineGameState, group *
 		var damageAssignment map[string]int
 		if assignment, exists := group.blockerDamageAssignments[blockerID]; exists {
 			damageAssignment = assignment
+		} else if order, ordered := gameState.combat.attackerDamageOrders[blockerID]; ordered {
+			// No explicit assignment - assign damage in the order the defending player chose
+			damageAssignment = e.computeOrderedBlockerDamageAssignment(gameState, blockerID, order)
 		} else {
-			// No explicit assignment - collect all attackers this blocker is blocking
-			blockingAttackers := make([]string, 0)
-			for _, g := range gameState.combat.groups {
-				for _, bid := range g.blockers {
-					if bid == blockerID {
-						blockingAttackers = append(blockingAttackers, g.attackers...)
-					}
-				}
-			}
-			damageAssignment = e.computeDefaultBlockerDamageAssignment(gameState, blockerID, blockingAttackers)
+			// No explicit assignment or order - divide damage among the attackers in declaration order
+			damageAssignment = e.computeDefaultBlockerDamageAssignment(gameState, blockerID, blockedAttackers(gameState, blockerID))
 		}
 
 		// Apply the damage assignment
@@ -6035,7 +7434,9 @@ func (e *MageEngine) assignDamageToAttackers(gameState *engineGameState, group *
 			}
 
 			if damage > 0 {
-				e.markDamageWithLifelink(gameState, attacker, damage, blockerID)
+				if err := e.dealDamage(gameState, blockerID, attackerID, damage, true); err != nil {
+					return err
+				}
 			}
 		}
 
@@ -6048,7 +7449,7 @@ func (e *MageEngine) assignDamageToAttackers(gameState *engineGameState, group *
 
 // ApplyCombatDamage applies all marked damage
 // Per Java CombatGroup.applyDamage()
-func (e *MageEngine) ApplyCombatDamage(gameID string) error {
+func (e *MageEngine) ApplyCombatDamage(gameID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -6059,18 +7460,27 @@ func (e *MageEngine) ApplyCombatDamage(gameID string) error {
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "ApplyCombatDamage")
+
+	return e.applyCombatDamage(gameState)
+}
+
+// applyCombatDamage applies all marked combat damage; the caller holds the game lock
+func (e *MageEngine) applyCombatDamage(gameState *engineGameState) error {
+	gameID := gameState.gameID
 
 	// Apply damage to all creatures in combat
-	for _, group := range gameState.combat.groups {
+	// Iterate over copies: a regenerated creature is removed from combat
+	for _, group := range append([]*combatGroup(nil), gameState.combat.groups...) {
 		// Apply damage to attackers
-		for _, attackerID := range group.attackers {
+		for _, attackerID := range append([]string(nil), group.attackers...) {
 			if err := e.applyDamageToCreature(gameState, attackerID); err != nil {
 				return err
 			}
 		}
 
 		// Apply damage to blockers
-		for _, blockerID := range group.blockers {
+		for _, blockerID := range append([]string(nil), group.blockers...) {
 			if err := e.applyDamageToCreature(gameState, blockerID); err != nil {
 				return err
 			}
@@ -6089,11 +7499,57 @@ func (e *MageEngine) ApplyCombatDamage(gameID string) error {
 	return nil
 }
 
+// resolveCombatDamage deals all combat damage for the current combat. When a creature in combat has first
+// strike or double strike there are two combat damage steps: first strikers and double strikers deal damage
+// first, state-based actions remove what they killed, then double strikers deal damage again along with every
+// creature that hasn't dealt combat damage yet. The caller must not hold the game lock.
+// Per rule 510.4 and Java Combat.hasFirstOrDoubleStrike()
+func (e *MageEngine) resolveCombatDamage(gameID string) error {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	hasFirstStrike, err := e.HasFirstOrDoubleStrike(gameID)
+	if err != nil {
+		return err
+	}
+
+	if hasFirstStrike {
+		if err := e.AssignCombatDamage(gameID, true); err != nil {
+			return fmt.Errorf("failed to assign first strike damage: %w", err)
+		}
+		if err := e.ApplyCombatDamage(gameID); err != nil {
+			return fmt.Errorf("failed to apply first strike damage: %w", err)
+		}
+
+		// Rule 510.4: creatures killed by first strike damage are gone before the second damage step
+		gameState.mu.Lock()
+		e.checkStateBasedActions(gameState)
+		gameState.mu.Unlock()
+	}
+
+	if err := e.AssignCombatDamage(gameID, false); err != nil {
+		return fmt.Errorf("failed to assign combat damage: %w", err)
+	}
+	if err := e.ApplyCombatDamage(gameID); err != nil {
+		return fmt.Errorf("failed to apply combat damage: %w", err)
+	}
+
+	gameState.mu.Lock()
+	e.checkStateBasedActions(gameState)
+	gameState.mu.Unlock()
+	return nil
+}
+
 // AssignAttackerDamage assigns how an attacker divides its damage among blockers
 // Rule 510.1c: A blocked creature assigns its combat damage divided as its controller chooses among blockers
 // Rule 702.22j: When blocked by banding creature, DEFENDING player assigns (not attacking player)
 // Per Java CombatGroup.blockerDamage() multi-amount dialog
-func (e *MageEngine) AssignAttackerDamage(gameID, attackerID, playerID string, damageMap map[string]int) error {
+func (e *MageEngine) AssignAttackerDamage(gameID, attackerID, playerID string, damageMap map[string]int) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -6104,36 +7560,15 @@ func (e *MageEngine) AssignAttackerDamage(gameID, attackerID, playerID string, d
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "AssignAttackerDamage", attackerID, playerID, damageMap)
 
-	// Find the combat group for this attacker
-	var group *combatGroup
-	for _, g := range gameState.combat.groups {
-		for _, aID := range g.attackers {
-			if aID == attackerID {
-				group = g
-				break
-			}
-		}
-		if group != nil {
-			break
-		}
-	}
-
-	if group == nil {
-		return fmt.Errorf("attacker %s not found in combat", attackerID)
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
 	}
 
-	// Rule 702.22j: When blocked by banding creature, defending player controls damage assignment
-	if e.defenderControlsDamageAssignment(gameState, group) {
-		// Defending player must assign damage, not attacking player
-		if playerID != group.defendingPlayerID {
-			return fmt.Errorf("defending player must assign damage (blocked by banding creature)")
-		}
-	} else {
-		// Normal case: attacking player assigns damage
-		if playerID != gameState.combat.attackingPlayerID {
-			return fmt.Errorf("attacking player must assign damage")
-		}
+	group, err := e.attackerDamageGroup(gameState, attackerID, playerID)
+	if err != nil {
+		return err
 	}
 
 	// Validate the damage assignment
@@ -6154,7 +7589,7 @@ func (e *MageEngine) AssignAttackerDamage(gameID, attackerID, playerID string, d
 	}
 
 	// With trample, player can assign less than full power (excess tramples through)
-	hasTrample := e.hasAbility(attacker, abilityTrample)
+	hasTrample := e.hasAbilityWithEffects(gameState, attacker, abilityTrample)
 	if hasTrample {
 		if totalAssigned > power {
 			return fmt.Errorf("cannot assign more damage (%d) than creature's power (%d)", totalAssigned, power)
@@ -6192,11 +7627,12 @@ func (e *MageEngine) AssignAttackerDamage(gameID, attackerID, playerID string, d
 	return nil
 }
 
-// AssignBlockerDamage assigns how a blocker divides its damage among attackers it's blocking
-// Rule 510.1d: A blocking creature assigns its combat damage divided as its controller chooses among attackers
-// Rule 702.22k: When blocking banding attacker, ATTACKING player assigns (not defending player)
-// Per Java CombatGroup.attackerDamage() multi-amount dialog
-func (e *MageEngine) AssignBlockerDamage(gameID, blockerID, playerID string, damageMap map[string]int) error {
+// AssignTrampleDamage assigns how an attacker with trample divides its damage between its blockers and
+// the player or permanent it's attacking. Damage can be assigned to the defender only once each blocker
+// is assigned lethal damage, counting damage already marked and deathtouch (any damage is lethal); all
+// of the attacker's power must be assigned. If no assignment is made, damage is assigned automatically.
+// Per rule 702.19b and Java CombatGroup.assignDamageToBlockers()
+func (e *MageEngine) AssignTrampleDamage(gameID, attackerID string, blockerDamage map[string]int, defenderDamage int) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -6207,37 +7643,150 @@ func (e *MageEngine) AssignBlockerDamage(gameID, blockerID, playerID string, dam
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "AssignTrampleDamage", attackerID, blockerDamage, defenderDamage)
 
-	// Validate the blocker exists
-	blocker, exists := gameState.cards[blockerID]
-	if !exists {
-		return fmt.Errorf("blocker %s not found", blockerID)
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
 	}
 
-	// Find a combat group this blocker is in (to check for banding attackers)
-	var checkGroup *combatGroup
-	for _, group := range gameState.combat.groups {
-		for _, bid := range group.blockers {
-			if bid == blockerID {
-				checkGroup = group
-				break
-			}
-		}
-		if checkGroup != nil {
-			break
-		}
+	attacker, exists := gameState.cards[attackerID]
+	if !exists {
+		return fmt.Errorf("attacker %s not found", attackerID)
+	}
+	if !e.hasAbilityWithEffects(gameState, attacker, abilityTrample) {
+		return fmt.Errorf("%s doesn't have trample", attacker.Name)
+	}
+	group, err := e.attackerDamageGroup(gameState, attackerID, attacker.ControllerID)
+	if err != nil {
+		return err
+	}
+	if defenderDamage < 0 {
+		return fmt.Errorf("damage to the defender must not be negative, got %d", defenderDamage)
 	}
 
-	// Rule 702.22k: When blocking banding attacker, attacking player controls damage assignment
-	if checkGroup != nil && e.attackerControlsDamageAssignment(gameState, checkGroup) {
-		// Attacking player must assign damage, not defending player
-		if playerID != gameState.combat.attackingPlayerID {
-			return fmt.Errorf("attacking player must assign damage (blocking banding attacker)")
+	total := defenderDamage
+	for blockerID, damage := range blockerDamage {
+		if !containsString(group.blockers, blockerID) {
+			return fmt.Errorf("creature %s is not blocking this attacker", blockerID)
 		}
-	} else {
-		// Normal case: defending player assigns damage
-		if playerID != blocker.ControllerID {
-			return fmt.Errorf("blocker's controller must assign damage")
+		if damage < 0 {
+			return fmt.Errorf("damage to %s must not be negative, got %d", blockerID, damage)
+		}
+		total += damage
+	}
+
+	power, err := e.getCreaturePower(attacker)
+	if err != nil {
+		power = 0
+	}
+	if total != power {
+		return fmt.Errorf("must assign all damage (%d), assigned %d", power, total)
+	}
+
+	if defenderDamage > 0 {
+		for _, blockerID := range group.blockers {
+			blocker, exists := gameState.cards[blockerID]
+			if !exists || blocker.Zone != zoneBattlefield {
+				continue
+			}
+			if lethal := e.getLethalDamageWithAttacker(gameState, blocker, attackerID); blockerDamage[blockerID] < lethal {
+				return fmt.Errorf("%s must be assigned lethal damage (%d) before damage tramples over, got %d", blocker.Name, lethal, blockerDamage[blockerID])
+			}
+		}
+	}
+
+	// The excess over the blockers' damage goes to the defender when damage is dealt
+	group.attackerDamageAssignments[attackerID] = blockerDamage
+
+	if e.logger != nil {
+		e.logger.Debug("trample damage assigned",
+			zap.String("attacker_id", attackerID),
+			zap.Any("blocker_damage", blockerDamage),
+			zap.Int("defender_damage", defenderDamage),
+		)
+	}
+
+	return nil
+}
+
+// attackerDamageGroup finds the combat group of an attacker and checks that the player may assign its
+// combat damage
+func (e *MageEngine) attackerDamageGroup(gameState *engineGameState, attackerID, playerID string) (*combatGroup, error) {
+	var group *combatGroup
+	for _, g := range gameState.combat.groups {
+		if containsString(g.attackers, attackerID) {
+			group = g
+			break
+		}
+	}
+	if group == nil {
+		return nil, fmt.Errorf("attacker %s not found in combat", attackerID)
+	}
+
+	// Rule 702.22j: When blocked by banding creature, defending player controls damage assignment
+	if e.defenderControlsDamageAssignment(gameState, group) {
+		// Defending player must assign damage, not attacking player
+		if playerID != group.defendingPlayerID {
+			return nil, fmt.Errorf("defending player must assign damage (blocked by banding creature)")
+		}
+	} else if playerID != gameState.combat.attackingPlayerID {
+		// Normal case: attacking player assigns damage
+		return nil, fmt.Errorf("attacking player must assign damage")
+	}
+	return group, nil
+}
+
+// AssignBlockerDamage assigns how a blocker divides its damage among attackers it's blocking
+// Rule 510.1d: A blocking creature assigns its combat damage divided as its controller chooses among attackers
+// Rule 702.22k: When blocking banding attacker, ATTACKING player assigns (not defending player)
+// Per Java CombatGroup.attackerDamage() multi-amount dialog
+func (e *MageEngine) AssignBlockerDamage(gameID, blockerID, playerID string, damageMap map[string]int) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "AssignBlockerDamage", blockerID, playerID, damageMap)
+
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+
+	// Validate the blocker exists
+	blocker, exists := gameState.cards[blockerID]
+	if !exists {
+		return fmt.Errorf("blocker %s not found", blockerID)
+	}
+
+	// Find a combat group this blocker is in (to check for banding attackers)
+	var checkGroup *combatGroup
+	for _, group := range gameState.combat.groups {
+		for _, bid := range group.blockers {
+			if bid == blockerID {
+				checkGroup = group
+				break
+			}
+		}
+		if checkGroup != nil {
+			break
+		}
+	}
+
+	// Rule 702.22k: When blocking banding attacker, attacking player controls damage assignment
+	if checkGroup != nil && e.attackerControlsDamageAssignment(gameState, checkGroup) {
+		// Attacking player must assign damage, not defending player
+		if playerID != gameState.combat.attackingPlayerID {
+			return fmt.Errorf("attacking player must assign damage (blocking banding attacker)")
+		}
+	} else {
+		// Normal case: defending player assigns damage
+		if playerID != blocker.ControllerID {
+			return fmt.Errorf("blocker's controller must assign damage")
 		}
 	}
 
@@ -6309,60 +7858,83 @@ func (e *MageEngine) computeDefaultAttackerDamageAssignment(gameState *engineGam
 		power = 0
 	}
 
-	hasTrample := e.hasAbility(attacker, abilityTrample)
-	assignment := make(map[string]int)
+	// With trample: assign lethal damage to each blocker in order
+	if e.hasAbilityWithEffects(gameState, attacker, abilityTrample) {
+		return e.computeOrderedAttackerDamageAssignment(gameState, attackerID, blockers, true)
+	}
 
-	if hasTrample {
-		// With trample: assign lethal damage to each blocker in order
-		remainingDamage := power
-		for _, blockerID := range blockers {
-			blocker, exists := gameState.cards[blockerID]
-			if !exists || blocker.Zone != zoneBattlefield {
-				continue
-			}
+	// Without trample: divide damage evenly among blockers
+	assignment := make(map[string]int)
+	if len(blockers) == 0 {
+		return assignment
+	}
 
-			lethalDamage := e.getLethalDamageWithAttacker(gameState, blocker, attackerID)
-			damageToAssign := lethalDamage
-			if damageToAssign > remainingDamage {
-				damageToAssign = remainingDamage
-			}
+	damagePerBlocker := power / len(blockers)
+	remainingDamage := power % len(blockers)
 
-			if damageToAssign > 0 {
-				assignment[blockerID] = damageToAssign
-				remainingDamage -= damageToAssign
-			}
+	for i, blockerID := range blockers {
+		_, exists := gameState.cards[blockerID]
+		if !exists {
+			continue
+		}
 
-			if remainingDamage <= 0 {
-				break
-			}
+		damage := damagePerBlocker
+		if i == 0 {
+			damage += remainingDamage // Give remainder to first blocker
 		}
-		// Remaining damage tramples through (not part of blocker assignment)
-	} else {
-		// Without trample: divide damage evenly among blockers
-		if len(blockers) == 0 {
-			return assignment
+
+		if damage > 0 {
+			assignment[blockerID] = damage
 		}
+	}
 
-		damagePerBlocker := power / len(blockers)
-		remainingDamage := power % len(blockers)
+	return assignment
+}
 
-		for i, blockerID := range blockers {
-			_, exists := gameState.cards[blockerID]
-			if !exists {
-				continue
-			}
+// computeOrderedAttackerDamageAssignment assigns an attacker's damage to its blockers in damage assignment
+// order, lethal damage to each before the next
+// Blockers that left the battlefield (e.g. killed by first strike) are skipped, so their share isn't lost.
+// With trample the damage left after every blocker has lethal damage tramples through (not part of the
+// assignment); without trample it's assigned to the last blocker.
+// Per rule 510.1c
+func (e *MageEngine) computeOrderedAttackerDamageAssignment(gameState *engineGameState, attackerID string, blockers []string, trample bool) map[string]int {
+	assignment := make(map[string]int)
+	attacker, exists := gameState.cards[attackerID]
+	if !exists {
+		return assignment
+	}
 
-			damage := damagePerBlocker
-			if i == 0 {
-				damage += remainingDamage // Give remainder to first blocker
-			}
+	remainingDamage, err := e.getCreaturePower(attacker)
+	if err != nil {
+		remainingDamage = 0
+	}
 
-			if damage > 0 {
-				assignment[blockerID] = damage
-			}
+	lastBlockerID := ""
+	for _, blockerID := range blockers {
+		if remainingDamage <= 0 {
+			break
+		}
+		blocker, exists := gameState.cards[blockerID]
+		if !exists || blocker.Zone != zoneBattlefield {
+			continue
+		}
+		lastBlockerID = blockerID
+
+		damageToAssign := e.getLethalDamageWithAttacker(gameState, blocker, attackerID)
+		if damageToAssign > remainingDamage {
+			damageToAssign = remainingDamage
+		}
+
+		if damageToAssign > 0 {
+			assignment[blockerID] = damageToAssign
+			remainingDamage -= damageToAssign
 		}
 	}
 
+	if !trample && remainingDamage > 0 && lastBlockerID != "" {
+		assignment[lastBlockerID] += remainingDamage
+	}
+
 	return assignment
 }
 
@@ -6409,9 +7981,64 @@ func (e *MageEngine) computeDefaultBlockerDamageAssignment(gameState *engineGame
 	return assignment
 }
 
+// computeOrderedBlockerDamageAssignment assigns a blocker's damage to the attackers it blocks in the
+// given order: each attacker is assigned lethal damage before the next one is assigned any, and
+// whatever is left over goes to the last attacker
+// Per rule 510.1d and Java CombatGroup.assignDamageToAttackers()
+func (e *MageEngine) computeOrderedBlockerDamageAssignment(gameState *engineGameState, blockerID string, attackers []string) map[string]int {
+	assignment := make(map[string]int)
+	blocker, exists := gameState.cards[blockerID]
+	if !exists {
+		return assignment
+	}
+
+	remainingDamage, err := e.getCreaturePower(blocker)
+	if err != nil {
+		remainingDamage = 0
+	}
+
+	lastAttackerID := ""
+	for _, attackerID := range attackers {
+		if remainingDamage <= 0 {
+			break
+		}
+		attacker, exists := gameState.cards[attackerID]
+		if !exists || attacker.Zone != zoneBattlefield {
+			continue
+		}
+		lastAttackerID = attackerID
+
+		damageToAssign := e.getLethalDamageWithAttacker(gameState, attacker, blockerID)
+		if damageToAssign > remainingDamage {
+			damageToAssign = remainingDamage
+		}
+		if damageToAssign > 0 {
+			assignment[attackerID] = damageToAssign
+			remainingDamage -= damageToAssign
+		}
+	}
+
+	if remainingDamage > 0 && lastAttackerID != "" {
+		assignment[lastAttackerID] += remainingDamage
+	}
+
+	return assignment
+}
+
+// blockedAttackers returns the attackers a creature blocks, in the order they were declared
+func blockedAttackers(gameState *engineGameState, blockerID string) []string {
+	attackers := make([]string, 0)
+	for _, group := range gameState.combat.groups {
+		if containsString(group.blockers, blockerID) {
+			attackers = append(attackers, group.attackers...)
+		}
+	}
+	return attackers
+}
+
 // EndCombat ends combat phase, clearing combat flags and moving to former groups
 // Per Java Combat.endCombat()
-func (e *MageEngine) EndCombat(gameID string) error {
+func (e *MageEngine) EndCombat(gameID string) (err error) {
 	e.mu.RLock()
 	gameState, exists := e.games[gameID]
 	e.mu.RUnlock()
@@ -6422,6 +8049,14 @@ func (e *MageEngine) EndCombat(gameID string) error {
 
 	gameState.mu.Lock()
 	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "EndCombat")
+
+	return e.endCombat(gameState)
+}
+
+// endCombat ends combat, clearing combat flags and moving groups to former groups; the caller holds the game lock
+func (e *MageEngine) endCombat(gameState *engineGameState) error {
+	gameID := gameState.gameID
 
 	// Fire end combat step pre event
 	gameState.eventBus.Publish(rules.NewEvent(rules.EventEndCombatStepPre, "", "", ""))
@@ -6474,6 +8109,7 @@ func (e *MageEngine) EndCombat(gameID string) error {
 	// Per Java: ContinuousEffects.removeEndOfCombatEffects()
 	if gameState.layerSystem != nil {
 		effects.CleanupEndOfCombatEffects(gameState.layerSystem)
+		e.recomputeContinuousEffects(gameState)
 	}
 
 	// Fire end combat event
@@ -6571,7 +8207,7 @@ func (e *MageEngine) dealsDamageThisStep(gameState *engineGameState, creature *i
 
 	if firstStrike {
 		// In first strike step, only creatures with first strike or double strike deal damage
-		if e.hasFirstOrDoubleStrike(creature) {
+		if e.hasFirstOrDoubleStrike(gameState, creature) {
 			// Record that this creature dealt damage in first strike step
 			// (This is done in assignDamageToBlockers/assignDamageToAttackers)
 			return true
@@ -6582,18 +8218,11 @@ func (e *MageEngine) dealsDamageThisStep(gameState *engineGameState, creature *i
 		// - Creatures with double strike deal damage again
 		// - Creatures without first/double strike deal damage for the first time
 		// - Creatures that already dealt damage in first strike step don't deal damage again (unless double strike)
-		return e.hasDoubleStrike(creature) || !e.wasFirstStrikingCreatureInCombat(gameState, creature.ID)
+		return e.hasDoubleStrike(gameState, creature) || !e.wasFirstStrikingCreatureInCombat(gameState, creature.ID)
 	}
 }
 
-// hasAbility checks if a creature has a specific ability by ID
-// hasAbility checks if a card has a specific ability
-// TODO: This should also check for abilities granted by continuous effects
-// Java equivalent: permanent.getAbilities(game).containsKey(abilityId)
-// Requires implementing:
-// 1. ContinuousEffects system to track temporary ability grants
-// 2. Layer system for effect ordering (Layer 6 for abilities)
-// 3. Effect duration tracking (until end of turn, until end of combat, etc.)
+// isCreature checks if a card is a creature
 func (e *MageEngine) isCreature(card *internalCard) bool {
 	if card == nil {
 		return false
@@ -6608,13 +8237,30 @@ func (e *MageEngine) isPlaneswalker(card *internalCard) bool {
 	return strings.Contains(card.Type, "Planeswalker")
 }
 
+// isBattle checks if a card is a battle (rule 310)
+func (e *MageEngine) isBattle(card *internalCard) bool {
+	if card == nil {
+		return false
+	}
+	return strings.Contains(card.Type, "Battle")
+}
+
+// damageCounterType returns the counters that damage removes from a planeswalker or battle
+// Per rule 120.3c and 120.3h
+func (e *MageEngine) damageCounterType(card *internalCard) string {
+	if e.isBattle(card) {
+		return string(counters.CounterTypeDefense)
+	}
+	return string(counters.CounterTypeLoyalty)
+}
+
 // hasBanding checks if a creature has the banding ability
 // Per Java CombatGroup.hasBanding()
-func (e *MageEngine) hasBanding(card *internalCard) bool {
+func (e *MageEngine) hasBanding(gameState *engineGameState, card *internalCard) bool {
 	if card == nil {
 		return false
 	}
-	return e.hasAbility(card, abilityBanding)
+	return e.hasAbilityWithEffects(gameState, card, abilityBanding)
 }
 
 // HasPlayerAttackedPlayerOrPlaneswalker checks if a player attacked another player or their planeswalkers this turn
@@ -6656,7 +8302,7 @@ func (e *MageEngine) defenderControlsDamageAssignment(gameState *engineGameState
 	// Check if any blocker has banding
 	for _, blockerID := range group.blockers {
 		if blocker, exists := gameState.cards[blockerID]; exists {
-			if e.hasBanding(blocker) {
+			if e.hasBanding(gameState, blocker) {
 				return true
 			}
 		}
@@ -6671,7 +8317,7 @@ func (e *MageEngine) attackerControlsDamageAssignment(gameState *engineGameState
 	// Check if any attacker has banding
 	for _, attackerID := range group.attackers {
 		if attacker, exists := gameState.cards[attackerID]; exists {
-			if e.hasBanding(attacker) {
+			if e.hasBanding(gameState, attacker) {
 				return true
 			}
 		}
@@ -6679,6 +8325,8 @@ func (e *MageEngine) attackerControlsDamageAssignment(gameState *engineGameState
 	return false
 }
 
+// hasAbility checks if a card has a specific printed ability by ID.
+// Rules that a permanent's granted abilities affect (combat keywords, haste) use hasAbilityWithEffects.
 func (e *MageEngine) hasAbility(creature *internalCard, abilityID string) bool {
 	if creature == nil {
 		return false
@@ -6724,6 +8372,52 @@ func (e *MageEngine) hasAbilityWithEffects(gameState *engineGameState, creature
 	return false
 }
 
+// isSummoningSick checks if a creature is affected by summoning sickness
+// Per rule 702.10: haste lets a creature attack and {T} regardless
+func (e *MageEngine) isSummoningSick(gameState *engineGameState, creature *internalCard) bool {
+	return creature.SummoningSickness && !e.hasAbilityWithEffects(gameState, creature, abilityHaste)
+}
+
+// tap taps a permanent, recording why
+func (c *internalCard) tap(reason string) {
+	c.Tapped = true
+	c.TapReason = reason
+}
+
+// untap untaps a permanent
+func (c *internalCard) untap() {
+	c.Tapped = false
+	c.TapReason = ""
+}
+
+// beginTurn resets per-turn permanent state at the start of a turn
+func (e *MageEngine) beginTurn(gameState *engineGameState, activePlayerID string) {
+	// Per rule 302.6: permanents the active player controls at the start of their turn
+	// are no longer summoning sick
+	e.clearSummoningSickness(gameState, activePlayerID)
+
+	// "Activate only once each turn" and loyalty ability limits start over
+	for _, card := range gameState.cards {
+		card.AbilityUses = nil
+	}
+
+	// Per rule 305.2: each player may play another land on their next turn
+	for _, player := range gameState.players {
+		player.LandsPlayedThisTurn = 0
+	}
+
+	gameState.eventBus.Publish(rules.NewEvent(rules.EventBeginTurn, activePlayerID, "", activePlayerID))
+}
+
+// clearSummoningSickness clears summoning sickness from permanents controlled by the active player
+func (e *MageEngine) clearSummoningSickness(gameState *engineGameState, activePlayerID string) {
+	for _, card := range gameState.cards {
+		if card.Zone == zoneBattlefield && card.ControllerID == activePlayerID {
+			card.SummoningSickness = false
+		}
+	}
+}
+
 // hasCantAttackEffect checks if a creature is affected by a "can't attack" continuous effect
 // Per Java: RestrictionEffect.applies() for attack restrictions
 func (e *MageEngine) hasCantAttackEffect(gameState *engineGameState, creatureID string) bool {
@@ -6787,10 +8481,10 @@ func (e *MageEngine) getMustBeBlockedEffects(gameState *engineGameState, attacke
 		return nil
 	}
 
+	// The effects apply to the blockers they name, so select them by the attacker they're about
 	result := make([]*effects.MustBeBlockedEffect, 0)
-	allEffects := gameState.layerSystem.GetEffectsForCard(attackerID)
-	for _, effect := range allEffects {
-		if mbEffect, ok := effect.(*effects.MustBeBlockedEffect); ok {
+	for _, effect := range gameState.layerSystem.AllEffects() {
+		if mbEffect, ok := effect.(*effects.MustBeBlockedEffect); ok && mbEffect.GetAttackerID() == attackerID {
 			result = append(result, mbEffect)
 		}
 	}
@@ -6798,29 +8492,56 @@ func (e *MageEngine) getMustBeBlockedEffects(gameState *engineGameState, attacke
 	return result
 }
 
+// CanBeBlockedBy returns the minimum number of creatures an attacker currently requires to be blocked
+// (1 normally, 2 with menace)
+func (e *MageEngine) CanBeBlockedBy(gameID, attackerID string) (int, error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return 0, fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+
+	attacker, exists := gameState.cards[attackerID]
+	if !exists || attacker.Zone != zoneBattlefield {
+		return 0, fmt.Errorf("creature %s not found on the battlefield", attackerID)
+	}
+
+	return e.getMinBlockedBy(gameState, attacker), nil
+}
+
 // getMinBlockedBy returns the minimum number of blockers required to block this creature
-// Per Java: Permanent.getMinBlockedBy() - default 1, menace sets to 2
-func (e *MageEngine) getMinBlockedBy(creature *internalCard) int {
-	if e.hasAbility(creature, abilityMenace) {
-		return 2
+// Per Java: Permanent.getMinBlockedBy() - default 1, menace sets to 2 (rule 702.111b)
+// Effects such as "can't be blocked except by three or more creatures" are recorded in
+// combat.minBlockersPerAttacker.
+func (e *MageEngine) getMinBlockedBy(gameState *engineGameState, creature *internalCard) int {
+	minBlockedBy := 1
+	if e.hasAbilityWithEffects(gameState, creature, abilityMenace) {
+		minBlockedBy = 2
 	}
-	// TODO: Support other effects that modify minBlockedBy (e.g., "can't be blocked except by 3 or more creatures")
-	return 1
+	if required := gameState.combat.minBlockersPerAttacker[creature.ID]; required > minBlockedBy {
+		minBlockedBy = required
+	}
+	return minBlockedBy
 }
 
-// hasFirstStrike checks if a creature has first strike (base abilities only)
-func (e *MageEngine) hasFirstStrike(creature *internalCard) bool {
-	return e.hasAbility(creature, abilityFirstStrike)
+// hasFirstStrike checks if a creature has first strike, printed or granted
+func (e *MageEngine) hasFirstStrike(gameState *engineGameState, creature *internalCard) bool {
+	return e.hasAbilityWithEffects(gameState, creature, abilityFirstStrike)
 }
 
-// hasDoubleStrike checks if a creature has double strike (base abilities only)
-func (e *MageEngine) hasDoubleStrike(creature *internalCard) bool {
-	return e.hasAbility(creature, abilityDoubleStrike)
+// hasDoubleStrike checks if a creature has double strike, printed or granted
+func (e *MageEngine) hasDoubleStrike(gameState *engineGameState, creature *internalCard) bool {
+	return e.hasAbilityWithEffects(gameState, creature, abilityDoubleStrike)
 }
 
-// hasFirstOrDoubleStrike checks if a creature has first strike or double strike (base abilities only)
-func (e *MageEngine) hasFirstOrDoubleStrike(creature *internalCard) bool {
-	return e.hasFirstStrike(creature) || e.hasDoubleStrike(creature)
+// hasFirstOrDoubleStrike checks if a creature has first strike or double strike, printed or granted
+func (e *MageEngine) hasFirstOrDoubleStrike(gameState *engineGameState, creature *internalCard) bool {
+	return e.hasFirstStrike(gameState, creature) || e.hasDoubleStrike(gameState, creature)
 }
 
 // hasFirstStrikeWithEffects checks if a creature has first strike (including granted)
@@ -6931,7 +8652,7 @@ func (e *MageEngine) getLethalDamageWithAttacker(gameState *engineGameState, cre
 		// With deathtouch, 1 damage is enough to remove all loyalty
 		if attackerID != "" {
 			if attacker, exists := gameState.cards[attackerID]; exists {
-				if e.hasAbility(attacker, abilityDeathtouch) && loyalty > 1 {
+				if e.hasAbilityWithEffects(gameState, attacker, abilityDeathtouch) && loyalty > 1 {
 					return 1
 				}
 			}
@@ -6956,7 +8677,7 @@ func (e *MageEngine) getLethalDamageWithAttacker(gameState *engineGameState, cre
 	// Check for deathtouch on attacker (Java: attacker.getAbilities(game).containsKey(DeathtouchAbility.getInstance().getId()))
 	if attackerID != "" {
 		if attacker, exists := gameState.cards[attackerID]; exists {
-			if e.hasAbility(attacker, abilityDeathtouch) {
+			if e.hasAbilityWithEffects(gameState, attacker, abilityDeathtouch) {
 				// With deathtouch, any amount of damage is lethal
 				if lethal > 1 {
 					lethal = 1
@@ -6984,34 +8705,28 @@ func (e *MageEngine) markDamage(creature *internalCard, amount int, sourceID str
 	creature.DamageSources[sourceID] += amount
 }
 
-// markDamageWithLifelink marks damage and handles lifelink
-// Per Java PermanentImpl.markDamage() lines 1119-1126
-func (e *MageEngine) markDamageWithLifelink(gameState *engineGameState, creature *internalCard, amount int, sourceID string) {
-	if amount <= 0 {
+// markDamageFromSource marks damage on a permanent, handles lifelink and fires DAMAGED_PERMANENT
+// combat distinguishes combat damage from damage dealt by spells and abilities
+func (e *MageEngine) markDamageFromSource(gameState *engineGameState, creature *internalCard, amount int, sourceID string, combat bool) {
+	if amount <= 0 || e.preventDamageFromProtection(gameState, creature, sourceID, amount) {
 		return
 	}
 
-	// Mark the damage
-	e.markDamage(creature, amount, sourceID)
-
-	// Check if source has lifelink
-	source, exists := gameState.cards[sourceID]
-	if exists && e.hasAbility(source, abilityLifelink) {
-		// Gain life equal to damage dealt
-		controller, exists := gameState.players[source.ControllerID]
-		if exists {
-			controller.Life += amount
-
-			if e.logger != nil {
-				e.logger.Debug("lifelink triggered",
-					zap.String("source_id", sourceID),
-					zap.String("controller", source.ControllerID),
-					zap.Int("life_gained", amount),
-				)
-			}
+	// Per rule 702.90c: damage from a source with infect puts -1/-1 counters on a creature instead of
+	// being marked on it
+	if source, exists := gameState.cards[sourceID]; exists && e.hasAbilityWithEffects(gameState, source, abilityInfect) {
+		if creature.Counters == nil {
+			creature.Counters = counters.NewCounters()
 		}
+		counter := counters.CounterTypeM1M1.CreateInstance(amount)
+		creature.Counters.AddCounter(counter)
+		counters.NewCounterOperations(gameState.eventBus).AddCounterToCard(creature.ID, counter, creature.ControllerID, time.Now())
+	} else {
+		e.markDamage(creature, amount, sourceID)
 	}
 
+	e.applyLifelink(gameState, sourceID, amount, combat)
+
 	// Fire damaged permanent event for triggers
 	// Per Java: DAMAGED_PERMANENT event with flag=true for combat damage
 	damagedEvent := rules.Event{
@@ -7020,155 +8735,282 @@ func (e *MageEngine) markDamageWithLifelink(gameState *engineGameState, creature
 		SourceID:   sourceID,
 		Amount:     amount,
 		Controller: creature.ControllerID,
-		Flag:       true, // Combat damage
+		Flag:       combat,
 	}
 	gameState.eventBus.Publish(damagedEvent)
 
 	// Check for combat damage triggers (e.g., "Whenever ~ deals combat damage to a creature")
-	e.checkCombatTriggers(gameState, damagedEvent)
+	if combat {
+		e.checkCombatTriggers(gameState, damagedEvent)
+	}
 }
 
-// dealDamageToDefender deals damage to a defending player or permanent
-// Per Java CombatGroup.defenderDamage()
-func (e *MageEngine) dealDamageToDefender(gameState *engineGameState, attacker *internalCard, defenderID string, amount int) error {
+// damagePlayer deals damage to a player, handles lifelink and fires DAMAGE_PLAYER/DAMAGED_PLAYER
+// Per Java PlayerImpl.doDamage()
+func (e *MageEngine) damagePlayer(gameState *engineGameState, player *internalPlayer, amount int, sourceID string, combat bool) {
+	if amount <= 0 {
+		return
+	}
+
+	controllerID := ""
+	source, sourceExists := gameState.cards[sourceID]
+	if sourceExists {
+		controllerID = source.ControllerID
+	}
+
+	// Per rule 702.90b: damage from a source with infect gives the player poison counters instead of
+	// making them lose life
+	if sourceExists && e.hasAbilityWithEffects(gameState, source, abilityInfect) {
+		player.Poison += amount
+		gameState.addMessage(fmt.Sprintf("%s gets %d poison counter(s) (now %d)", player.Name, amount, player.Poison), "combat")
+	} else {
+		player.Life -= amount
+	}
+	if combat && sourceExists && source.Commander {
+		player.addCommanderDamage(source.ID, amount)
+	}
+
+	e.applyLifelink(gameState, sourceID, amount, combat)
+
+	// Fire damage event (before damage is dealt)
+	gameState.eventBus.Publish(rules.Event{
+		Type:       rules.EventDamagePlayer,
+		TargetID:   player.PlayerID,
+		SourceID:   sourceID,
+		Amount:     amount,
+		Controller: controllerID,
+	})
+
+	// Fire damaged event (after damage is dealt) for triggers
+	// Per Java: DAMAGED_PLAYER event with flag=true for combat damage
+	damagedEvent := rules.Event{
+		Type:       rules.EventDamagedPlayer,
+		TargetID:   player.PlayerID,
+		SourceID:   sourceID,
+		Amount:     amount,
+		Controller: controllerID,
+		Flag:       combat,
+	}
+	gameState.eventBus.Publish(damagedEvent)
+
+	// Check for combat damage triggers (e.g., "Whenever ~ deals combat damage")
+	if combat {
+		e.checkCombatTriggers(gameState, damagedEvent)
+	}
+}
+
+// applyLifelink handles damage dealt by a source with lifelink: its controller gains that much life.
+// Combat damage is dealt simultaneously, so its life gain is totalled per source and gained once all
+// combat damage has been assigned (see gainCombatLifelink).
+// Per rule 702.15b / Java PlayerImpl.doDamage()
+func (e *MageEngine) applyLifelink(gameState *engineGameState, sourceID string, amount int, combat bool) {
+	source, exists := gameState.cards[sourceID]
+	if !exists || amount <= 0 || !e.hasAbilityWithEffects(gameState, source, abilityLifelink) {
+		return
+	}
+
+	if combat {
+		if gameState.combat.lifelinkDamage == nil {
+			gameState.combat.lifelinkDamage = make(map[string]int)
+		}
+		gameState.combat.lifelinkDamage[sourceID] += amount
+		return
+	}
+	e.gainLife(gameState, source.ControllerID, amount, sourceID)
+}
+
+// gainCombatLifelink gains the life for the combat damage dealt by each lifelink source this step
+// Per Java Combat / PermanentImpl.markLifelink(): one life gain for all of a source's combat damage
+func (e *MageEngine) gainCombatLifelink(gameState *engineGameState) {
+	sourceIDs := make([]string, 0, len(gameState.combat.lifelinkDamage))
+	for sourceID := range gameState.combat.lifelinkDamage {
+		sourceIDs = append(sourceIDs, sourceID)
+	}
+	sort.Strings(sourceIDs)
+
+	for _, sourceID := range sourceIDs {
+		if source, exists := gameState.cards[sourceID]; exists {
+			e.gainLife(gameState, source.ControllerID, gameState.combat.lifelinkDamage[sourceID], sourceID)
+		}
+	}
+	gameState.combat.lifelinkDamage = nil
+}
+
+// gainLife makes a player gain life and fires GAINED_LIFE
+func (e *MageEngine) gainLife(gameState *engineGameState, playerID string, amount int, sourceID string) {
+	player, exists := gameState.players[playerID]
+	if !exists || amount <= 0 {
+		return
+	}
+
+	player.Life += amount
+	gameState.addMessage(fmt.Sprintf("%s gains %d life (now %d)", player.Name, amount, player.Life), "life")
+	gameState.eventBus.Publish(rules.NewEventWithAmount(rules.EventGainedLife, playerID, sourceID, playerID, amount))
+
+	if e.logger != nil {
+		e.logger.Debug("player gained life",
+			zap.String("player_id", playerID),
+			zap.String("source_id", sourceID),
+			zap.Int("life_gained", amount),
+		)
+	}
+}
+
+// dealDamage deals damage from a source to a player or permanent; spells, abilities and combat all deal
+// damage through it. Players lose life, planeswalkers and battles lose loyalty or defense counters and
+// creatures have the damage marked. Damage a spell or ability deals to a creature is applied at once
+// (lethal damage destroys it); combat damage is applied when all of it has been dealt (ApplyCombatDamage).
+// Protection prevents the damage, and lifelink and deathtouch apply.
+// Per Java DamageTargetEffect / Permanent.damage() / Player.damage()
+func (e *MageEngine) dealDamage(gameState *engineGameState, sourceID, targetID string, amount int, combat bool) error {
 	if amount <= 0 {
 		return nil
 	}
 
-	// Check if defender is a permanent (planeswalker/battle) or player
-	if defender, exists := gameState.cards[defenderID]; exists {
-		// Defender is a permanent
+	if player, exists := gameState.players[targetID]; exists {
+		e.damagePlayer(gameState, player, amount, sourceID, combat)
+		return nil
+	}
 
-		// Rule 306.8, 120.3c: Damage dealt to planeswalker removes loyalty counters
-		if e.isPlaneswalker(defender) {
-			// Apply excess damage from "trample over planeswalkers" ability (Rule 702.19d)
-			// Example: Thrasta, Tempest's Roar
-			if e.hasAbility(attacker, abilityTrampleOverPlaneswalkers) {
-				lethalDamage := e.getLethalDamageWithAttacker(gameState, defender, attacker.ID)
-
-				if lethalDamage >= amount {
-					// Normal damage - all damage to planeswalker
-					if defender.Counters != nil {
-						defender.Counters.RemoveCounter("loyalty", amount)
-					}
+	target, exists := gameState.cards[targetID]
+	if !exists || target.Zone != zoneBattlefield {
+		return fmt.Errorf("damage target %s not found on battlefield", targetID)
+	}
 
-					// Handle lifelink for the full amount
-					if e.hasAbility(attacker, abilityLifelink) {
-						controller, exists := gameState.players[attacker.ControllerID]
-						if exists {
-							controller.Life += amount
-						}
-					}
+	// Rule 120.3c, 120.3h: Damage dealt to a planeswalker or battle removes that many loyalty or defense counters
+	if (e.isPlaneswalker(target) || e.isBattle(target)) && !e.isCreature(target) {
+		e.damageCounterPermanent(gameState, sourceID, target, amount, combat)
+		return nil
+	}
 
-					// Fire damaged permanent event
-					gameState.eventBus.Publish(rules.Event{
-						Type:       rules.EventDamagedPermanent,
-						TargetID:   defender.ID,
-						SourceID:   attacker.ID,
-						Amount:     amount,
-						Controller: defender.ControllerID,
-						Flag:       true, // Combat damage
-					})
+	// Rule 120.3e: Damage dealt to a creature is marked on it
+	e.markDamageFromSource(gameState, target, amount, sourceID, combat)
+	if combat {
+		return nil
+	}
+	return e.applyDamageToCreature(gameState, target.ID)
+}
 
-					if e.logger != nil {
-						e.logger.Debug("damage dealt to planeswalker",
-							zap.String("planeswalker_id", defender.ID),
-							zap.String("attacker_id", attacker.ID),
-							zap.Int("damage", amount),
-							zap.Int("loyalty_remaining", defender.Counters.GetCount("loyalty")),
-						)
-					}
-				} else {
-					// Damage with excess - lethal to planeswalker, excess to controller
-					if defender.Counters != nil {
-						defender.Counters.RemoveCounter("loyalty", lethalDamage)
-					}
+// DealDamage deals damage from a source to a player or permanent; combat says whether it is combat
+// damage (for lifelink, "combat damage" triggers and event flags). Spells and abilities deal damage
+// with dealDamage as they resolve; this is an admin/test operation and requires
+// SetDebugOperationsEnabled(true)
+func (e *MageEngine) DealDamage(gameID, sourceID, targetID string, amount int, combat bool) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	debugEnabled := e.debugOperationsEnabled
+	e.mu.RUnlock()
 
-					// Handle lifelink for lethal damage to planeswalker
-					if e.hasAbility(attacker, abilityLifelink) {
-						controller, exists := gameState.players[attacker.ControllerID]
-						if exists {
-							controller.Life += lethalDamage
-						}
-					}
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
 
-					// Fire damaged permanent event for planeswalker
-					gameState.eventBus.Publish(rules.Event{
-						Type:       rules.EventDamagedPermanent,
-						TargetID:   defender.ID,
-						SourceID:   attacker.ID,
-						Amount:     lethalDamage,
-						Controller: defender.ControllerID,
-						Flag:       true, // Combat damage
-					})
+	if !debugEnabled {
+		return fmt.Errorf("debug operations are disabled")
+	}
 
-					if e.logger != nil {
-						e.logger.Debug("trample over planeswalkers: lethal damage to planeswalker",
-							zap.String("planeswalker_id", defender.ID),
-							zap.String("attacker_id", attacker.ID),
-							zap.Int("lethal_damage", lethalDamage),
-							zap.Int("loyalty_remaining", defender.Counters.GetCount("loyalty")),
-						)
-					}
+	if amount <= 0 {
+		return fmt.Errorf("damage amount must be positive, got %d", amount)
+	}
 
-					// Recursively deal excess damage to planeswalker's controller
-					excessDamage := amount - lethalDamage
-					if excessDamage > 0 {
-						controllerID := defender.ControllerID
-						if e.logger != nil {
-							e.logger.Debug("trample over planeswalkers: excess damage to controller",
-								zap.String("controller_id", controllerID),
-								zap.Int("excess_damage", excessDamage),
-							)
-						}
-						return e.dealDamageToDefender(gameState, attacker, controllerID, excessDamage)
-					}
-				}
-			} else {
-				// Normal damage to planeswalker (no trample over)
-				if defender.Counters != nil {
-					defender.Counters.RemoveCounter("loyalty", amount)
-				}
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "DealDamage", sourceID, targetID, amount, combat)
 
-				// Handle lifelink
-				if e.hasAbility(attacker, abilityLifelink) {
-					controller, exists := gameState.players[attacker.ControllerID]
-					if exists {
-						controller.Life += amount
-					}
-				}
+	if gameState.state == GameStateFinished {
+		return fmt.Errorf("game %s has ended", gameID)
+	}
 
-				// Fire damaged permanent event for triggers
-				damagedEvent := rules.Event{
-					Type:       rules.EventDamagedPermanent,
-					TargetID:   defender.ID,
-					SourceID:   attacker.ID,
-					Amount:     amount,
-					Controller: defender.ControllerID,
-					Flag:       true, // Combat damage
-				}
-				gameState.eventBus.Publish(damagedEvent)
+	targetName := targetID
+	if card, exists := gameState.cards[targetID]; exists {
+		targetName = card.Name
+	}
+	message := fmt.Sprintf("%s is dealt %d damage", targetName, amount)
+	if source, exists := gameState.cards[sourceID]; exists {
+		message = fmt.Sprintf("%s deals %d damage to %s", source.Name, amount, targetName)
+	}
+
+	if err := e.dealDamage(gameState, sourceID, targetID, amount, combat); err != nil {
+		return err
+	}
+	if combat {
+		e.gainCombatLifelink(gameState)
+	}
+
+	gameState.addMessage(message, "life")
+
+	// Per rule 117.5: check state-based actions after damage is dealt
+	e.checkStateAndTriggered(gameState)
+	e.checkIfGameIsOver(gameState)
+
+	if e.logger != nil {
+		e.logger.Debug("damage dealt",
+			zap.String("game_id", gameID),
+			zap.String("source_id", sourceID),
+			zap.String("target_id", targetID),
+			zap.Int("amount", amount),
+		)
+	}
 
+	e.notifyGameStateChange(gameID, map[string]interface{}{
+		"type":      "damage_dealt",
+		"source_id": sourceID,
+		"target_id": targetID,
+		"amount":    amount,
+	})
+
+	return nil
+}
+
+// dealDamageToDefender deals damage to a defending player or permanent
+// Per Java CombatGroup.defenderDamage()
+func (e *MageEngine) dealDamageToDefender(gameState *engineGameState, attacker *internalCard, defenderID string, amount int) error {
+	if amount <= 0 {
+		return nil
+	}
+
+	// Check if defender is a permanent (planeswalker/battle) or player
+	if defender, exists := gameState.cards[defenderID]; exists {
+		// Per rule 506.4c: an attacker whose planeswalker or battle left the battlefield deals it no damage
+		if defender.Zone != zoneBattlefield {
+			if e.logger != nil {
+				e.logger.Debug("defender left the battlefield, no damage dealt",
+					zap.String("defender_id", defenderID),
+					zap.String("attacker_id", attacker.ID),
+				)
+			}
+			return nil
+		}
+
+		// Rule 306.8, 120.3c: Damage dealt to planeswalker removes loyalty counters
+		if e.isPlaneswalker(defender) {
+			toPlaneswalker, toController := e.splitPlaneswalkerDamage(gameState, attacker, defender, amount)
+			e.damageCounterPermanent(gameState, attacker.ID, defender, toPlaneswalker, true)
+
+			if toController > 0 {
 				if e.logger != nil {
-					e.logger.Debug("damage dealt to planeswalker",
-						zap.String("planeswalker_id", defender.ID),
-						zap.String("attacker_id", attacker.ID),
-						zap.Int("damage", amount),
-						zap.Int("loyalty_remaining", defender.Counters.GetCount("loyalty")),
+					e.logger.Debug("trample over planeswalkers: excess damage to controller",
+						zap.String("controller_id", defender.ControllerID),
+						zap.Int("excess_damage", toController),
 					)
 				}
+				return e.dealDamageToDefender(gameState, attacker, defender.ControllerID, toController)
 			}
 			return nil
-		} else {
-			// For other permanents (battles, creatures), mark damage normally
-			e.markDamageWithLifelink(gameState, defender, amount, attacker.ID)
 		}
-		return nil
+
+		// Rule 310.6, 120.3h: Damage dealt to a battle removes defense counters
+		if e.isBattle(defender) {
+			e.damageCounterPermanent(gameState, attacker.ID, defender, amount, true)
+			return nil
+		}
+
+		// For other permanents, mark damage normally
+		return e.dealDamage(gameState, attacker.ID, defenderID, amount, true)
 	}
 
 	// Defender is a player (or was a permanent that has left the battlefield)
-	player, exists := gameState.players[defenderID]
-	if !exists {
+	if _, exists := gameState.players[defenderID]; !exists {
 		// Defender not found - likely a permanent that left the battlefield during combat
 		// This is legal; damage simply isn't dealt
 		if e.logger != nil {
@@ -7180,50 +9022,57 @@ func (e *MageEngine) dealDamageToDefender(gameState *engineGameState, attacker *
 		return nil
 	}
 
-	// Deal damage to player
-	player.Life -= amount
+	return e.dealDamage(gameState, attacker.ID, defenderID, amount, true)
+}
 
-	// Handle lifelink
-	if e.hasAbility(attacker, abilityLifelink) {
-		controller, exists := gameState.players[attacker.ControllerID]
-		if exists {
-			controller.Life += amount
+// splitPlaneswalkerDamage divides the damage an attacker deals to the planeswalker it's attacking between
+// the planeswalker and its controller
+// Trample only carries damage past the attacker's blockers: all of it is dealt to the planeswalker, even
+// beyond its loyalty. With trample over planeswalkers (rule 702.19d, e.g. Thrasta, Tempest's Roar), the
+// planeswalker is dealt lethal damage and the excess is dealt to its controller.
+func (e *MageEngine) splitPlaneswalkerDamage(gameState *engineGameState, attacker, planeswalker *internalCard, amount int) (toPlaneswalker, toController int) {
+	if !e.hasAbilityWithEffects(gameState, attacker, abilityTrampleOverPlaneswalkers) {
+		return amount, 0
+	}
 
-			if e.logger != nil {
-				e.logger.Debug("lifelink triggered on player damage",
-					zap.String("attacker_id", attacker.ID),
-					zap.String("controller", attacker.ControllerID),
-					zap.Int("life_gained", amount),
-				)
-			}
-		}
+	lethalDamage := e.getLethalDamageWithAttacker(gameState, planeswalker, attacker.ID)
+	if lethalDamage >= amount {
+		return amount, 0
 	}
+	return lethalDamage, amount - lethalDamage
+}
 
-	// Fire damage event (before damage is dealt)
+// damageCounterPermanent deals damage to a planeswalker or battle, removing that many loyalty or defense
+// counters and handling lifelink
+func (e *MageEngine) damageCounterPermanent(gameState *engineGameState, sourceID string, permanent *internalCard, amount int, combat bool) {
+	if amount <= 0 || e.preventDamageFromProtection(gameState, permanent, sourceID, amount) {
+		return
+	}
+
+	if permanent.Counters != nil {
+		permanent.Counters.RemoveCounter(e.damageCounterType(permanent), amount)
+	}
+
+	e.applyLifelink(gameState, sourceID, amount, combat)
+
+	// Fire damaged permanent event for triggers
 	gameState.eventBus.Publish(rules.Event{
-		Type:       rules.EventDamagePlayer,
-		TargetID:   defenderID,
-		SourceID:   attacker.ID,
+		Type:       rules.EventDamagedPermanent,
+		TargetID:   permanent.ID,
+		SourceID:   sourceID,
 		Amount:     amount,
-		Controller: attacker.ControllerID,
+		Controller: permanent.ControllerID,
+		Flag:       combat,
 	})
 
-	// Fire damaged event (after damage is dealt) for triggers
-	// Per Java: DAMAGED_PLAYER event with flag=true for combat damage
-	damagedEvent := rules.Event{
-		Type:       rules.EventDamagedPlayer,
-		TargetID:   defenderID,
-		SourceID:   attacker.ID,
-		Amount:     amount,
-		Controller: attacker.ControllerID,
-		Flag:       true, // Combat damage
+	if e.logger != nil && permanent.Counters != nil {
+		e.logger.Debug("damage dealt to permanent with loyalty or defense",
+			zap.String("permanent_id", permanent.ID),
+			zap.String("source_id", sourceID),
+			zap.Int("damage", amount),
+			zap.Int("counters_remaining", permanent.Counters.GetCount(e.damageCounterType(permanent))),
+		)
 	}
-	gameState.eventBus.Publish(damagedEvent)
-
-	// Check for combat damage triggers (e.g., "Whenever ~ deals combat damage")
-	e.checkCombatTriggers(gameState, damagedEvent)
-
-	return nil
 }
 
 // applyDamageToCreature applies marked damage to a creature and checks for death
@@ -7233,31 +9082,20 @@ func (e *MageEngine) applyDamageToCreature(gameState *engineGameState, creatureI
 		return nil
 	}
 
-	if creature.Damage == 0 {
-		return nil
-	}
+	shouldDie := e.hasLethalDamage(gameState, creature)
 
-	// Get creature's toughness
-	toughness, err := e.getCreatureToughness(creature)
-	if err != nil {
-		toughness = 0
+	// Per rule 702.12b: lethal damage (including deathtouch damage) doesn't destroy an indestructible
+	// creature; the damage stays marked until cleanup
+	if shouldDie && e.hasAbilityWithEffects(gameState, creature, abilityIndestructible) {
+		return nil
 	}
 
-	// Check if any damage source has deathtouch
-	hasDeathtouch := false
-	for sourceID := range creature.DamageSources {
-		if source, exists := gameState.cards[sourceID]; exists {
-			if e.hasAbility(source, abilityDeathtouch) {
-				hasDeathtouch = true
-				break
-			}
+	if shouldDie {
+		// Lethal damage destroys the creature, so a regeneration shield replaces it (rule 701.19a)
+		if e.regenerate(gameState, creature) {
+			return nil
 		}
-	}
-
-	// Check if creature dies (damage >= toughness OR any deathtouch damage)
-	shouldDie := (creature.Damage >= toughness && toughness > 0) || (hasDeathtouch && creature.Damage > 0)
 
-	if shouldDie {
 		// Creature dies - move to graveyard
 		previousZone := creature.Zone
 		if err := e.moveCard(gameState, creature, zoneGraveyard, ""); err != nil {
@@ -7286,6 +9124,38 @@ func (e *MageEngine) applyDamageToCreature(gameState *engineGameState, creatureI
 	return nil
 }
 
+// hasLethalDamage reports whether a creature has been dealt lethal damage: damage greater than or equal
+// to its toughness, or any damage from a source with deathtouch (rules 704.5g and 704.5h)
+func (e *MageEngine) hasLethalDamage(gameState *engineGameState, creature *internalCard) bool {
+	if creature.Damage == 0 {
+		return false
+	}
+
+	toughness, err := e.getCreatureToughness(creature)
+	if err != nil {
+		toughness = 0
+	}
+	if toughness > 0 && creature.Damage >= toughness {
+		return true
+	}
+
+	for sourceID := range creature.DamageSources {
+		if source, exists := gameState.cards[sourceID]; exists && e.hasAbilityWithEffects(gameState, source, abilityDeathtouch) {
+			return true
+		}
+	}
+	return false
+}
+
+// clearMarkedDamage removes the damage marked on every permanent
+// Per rule 514.2: in the cleanup step, all damage marked on permanents is removed
+func (e *MageEngine) clearMarkedDamage(gameState *engineGameState) {
+	for _, card := range gameState.cards {
+		card.Damage = 0
+		card.DamageSources = nil
+	}
+}
+
 // GameStateAccessor implementation for engineGameState
 
 func (s *engineGameState) FindCard(cardID string) (rules.CardInfo, bool) {
@@ -7343,14 +9213,15 @@ func (s *engineGameState) FindCardForTarget(cardID string) (targeting.TargetCard
 		return targeting.TargetCardInfo{}, false
 	}
 	return targeting.TargetCardInfo{
-		ID:           card.ID,
-		Name:         card.Name,
-		Type:         card.Type,
-		Zone:         card.Zone,
-		ControllerID: card.ControllerID,
-		OwnerID:      card.OwnerID,
-		Tapped:       card.Tapped,
-		FaceDown:     card.FaceDown,
+		ID:             card.ID,
+		Name:           card.Name,
+		Type:           card.Type,
+		Zone:           card.Zone,
+		ControllerID:   card.ControllerID,
+		OwnerID:        card.OwnerID,
+		Tapped:         card.Tapped,
+		FaceDown:       card.FaceDown,
+		ProtectionFrom: protectionQualities(s.cards[cardID]),
 	}, true
 }
 
@@ -2,13 +2,39 @@ package game_test
 
 import (
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/magefree/mage-server-go/internal/game"
+	"github.com/magefree/mage-server-go/internal/game/mana"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest"
 )
 
+// addRedMana gives a player the {R} that a starter deck spell costs
+func addRedMana(t *testing.T, engine *game.MageEngine, gameID, playerID string) {
+	t.Helper()
+	if err := engine.AddMana(gameID, playerID, mana.ManaRed, 1); err != nil {
+		t.Fatalf("failed to add mana: %v", err)
+	}
+}
+
+// registerBoltLifeTrigger gives Lightning Bolt a "when you cast this spell, you gain 1 life" trigger so
+// that casting it puts a triggered ability on the stack above the spell
+func registerBoltLifeTrigger(t *testing.T, engine *game.MageEngine) {
+	t.Helper()
+	if err := engine.RegisterTrigger("Lightning Bolt", game.TriggerDefinition{
+		Event:       rules.EventSpellCast,
+		Description: "you gain 1 life",
+		Effect:      engine.GainLifeEffect(1),
+	}); err != nil {
+		t.Fatalf("failed to register trigger: %v", err)
+	}
+}
+
 func TestCardIDConsistencyAcrossZones(t *testing.T) {
 	logger := zaptest.NewLogger(t)
 	engine := game.NewMageEngine(logger)
@@ -39,6 +65,7 @@ func TestCardIDConsistencyAcrossZones(t *testing.T) {
 	}
 
 	// Cast Lightning Bolt from hand to exercise stack and battlefield transitions.
+	addRedMana(t, engine, gameID, "Alice")
 	if err := engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -113,6 +140,7 @@ func TestCardIDConsistencyAcrossZones(t *testing.T) {
 func TestStateBasedActionsBeforePriority(t *testing.T) {
 	logger := zaptest.NewLogger(t)
 	engine := game.NewMageEngine(logger)
+	engine.SetDebugOperationsEnabled(true)
 
 	gameID := "sba-test-game"
 	players := []string{"Alice", "Bob"}
@@ -123,14 +151,9 @@ func TestStateBasedActionsBeforePriority(t *testing.T) {
 
 	// Test 1: Player at 0 life loses before priority is passed
 	t.Run("PlayerLosesAtZeroLife", func(t *testing.T) {
-		// Set Alice's life to 0 using integer action
-		if err := engine.ProcessAction(gameID, game.PlayerAction{
-			PlayerID:   "Alice",
-			ActionType: "SEND_INTEGER",
-			Data:       -20, // Reduce life from 20 to 0
-			Timestamp:  time.Now(),
-		}); err != nil {
-			t.Fatalf("failed to reduce life: %v", err)
+		// Deal 20 damage to Alice to reduce her life to 0
+		if err := engine.DealDamage(gameID, "", "Alice", 20, false); err != nil {
+			t.Fatalf("failed to deal damage: %v", err)
 		}
 
 		// Try to pass priority - this should trigger state-based actions
@@ -206,6 +229,7 @@ func TestStateBasedActionsBeforePriority(t *testing.T) {
 func TestStateBasedActionsBetweenStackResolutions(t *testing.T) {
 	logger := zaptest.NewLogger(t)
 	engine := game.NewMageEngine(logger)
+	registerBoltLifeTrigger(t, engine)
 
 	gameID := "sba-stack-test"
 	players := []string{"Alice", "Bob"}
@@ -226,6 +250,7 @@ func TestStateBasedActionsBetweenStackResolutions(t *testing.T) {
 
 	// Cast a spell: Lightning Bolt (Bob has priority)
 	// This will create a spell and a triggered ability on the stack
+	addRedMana(t, engine, gameID, "Bob")
 	if err := engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Bob",
 		ActionType: "SEND_STRING",
@@ -308,6 +333,7 @@ func TestResetPassedPreservesLostLeftState(t *testing.T) {
 	}
 
 	// Cast a spell - this will call resetPassed() internally
+	addRedMana(t, engine, gameID, "Alice")
 	if err := engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -369,6 +395,7 @@ func TestCanRespondInAllPassed(t *testing.T) {
 	}
 
 	// Cast a spell to get something on the stack
+	addRedMana(t, engine, gameID, "Alice")
 	if err := engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -439,6 +466,7 @@ func TestCheckStateAndTriggered(t *testing.T) {
 	}
 
 	// Cast a spell - this will trigger checkStateAndTriggered() before priority
+	addRedMana(t, engine, gameID, "Alice")
 	if err := engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -480,6 +508,7 @@ func TestZoneTrackingAfterResolution(t *testing.T) {
 	}
 
 	// Cast Lightning Bolt (instant)
+	addRedMana(t, engine, gameID, "Alice")
 	if err := engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -567,6 +596,7 @@ func TestZoneTrackingAfterResolution(t *testing.T) {
 func TestTriggeredAbilityQueueAPNAPOrder(t *testing.T) {
 	logger := zaptest.NewLogger(t)
 	engine := game.NewMageEngine(logger)
+	registerBoltLifeTrigger(t, engine)
 
 	gameID := "apnap-test"
 	players := []string{"Alice", "Bob"}
@@ -576,6 +606,7 @@ func TestTriggeredAbilityQueueAPNAPOrder(t *testing.T) {
 	}
 
 	// Cast Lightning Bolt - this will queue a triggered ability
+	addRedMana(t, engine, gameID, "Alice")
 	if err := engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -607,7 +638,7 @@ func TestTriggeredAbilityQueueAPNAPOrder(t *testing.T) {
 	// Verify triggered ability is on stack (processed from queue before priority)
 	foundTriggered := false
 	for _, item := range view.Stack {
-		if item.Name == "Triggered ability: Alice gains 1 life" || item.DisplayName == "Triggered ability: Alice gains 1 life" {
+		if item.Name == "Lightning Bolt: you gain 1 life" || item.DisplayName == "Lightning Bolt: you gain 1 life" {
 			foundTriggered = true
 			break
 		}
@@ -645,6 +676,7 @@ func TestSimultaneousEventsProcessing(t *testing.T) {
 	// 3. No errors occur during event processing
 
 	// Cast a spell
+	addRedMana(t, engine, gameID, "Alice")
 	if err := engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -693,6 +725,7 @@ func TestSimultaneousEventsProcessing(t *testing.T) {
 func TestGameAnalytics(t *testing.T) {
 	logger := zaptest.NewLogger(t)
 	engine := game.NewMageEngine(logger)
+	registerBoltLifeTrigger(t, engine)
 
 	gameID := "analytics-test"
 	players := []string{"Alice", "Bob"}
@@ -702,6 +735,7 @@ func TestGameAnalytics(t *testing.T) {
 	}
 
 	// Cast a spell
+	addRedMana(t, engine, gameID, "Alice")
 	if err := engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -936,6 +970,7 @@ func TestPlayerObjectsRemoved(t *testing.T) {
 	}
 
 	// Alice casts a spell
+	addRedMana(t, engine, gameID, "Alice")
 	if err := engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -1169,6 +1204,7 @@ func TestChangeControlWithEvents(t *testing.T) {
 func TestBookmarkAndRestore(t *testing.T) {
 	logger := zaptest.NewLogger(t)
 	engine := game.NewMageEngine(logger)
+	engine.SetDebugOperationsEnabled(true)
 
 	gameID := "bookmark-test"
 	players := []string{"Alice", "Bob"}
@@ -1196,17 +1232,13 @@ func TestBookmarkAndRestore(t *testing.T) {
 	}
 
 	// Make some changes to the game state
-	// Change Alice's life
-	if err := engine.ProcessAction(gameID, game.PlayerAction{
-		PlayerID:   "Alice",
-		ActionType: "SEND_INTEGER",
-		Data:       -5, // Reduce life by 5
-		Timestamp:  time.Now(),
-	}); err != nil {
-		t.Fatalf("failed to change life: %v", err)
+	// Deal 5 damage to Alice
+	if err := engine.DealDamage(gameID, "", "Alice", 5, false); err != nil {
+		t.Fatalf("failed to deal damage: %v", err)
 	}
 
 	// Cast a spell
+	addRedMana(t, engine, gameID, "Alice")
 	if err := engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -1272,10 +1304,67 @@ func TestBookmarkAndRestore(t *testing.T) {
 	}
 }
 
+// TestConcurrentActions_FailedActionKeepsOtherPlayersAction verifies that an action is bookmarked and
+// processed without letting another action in between, so restoring the bookmark of a failed action
+// doesn't undo an action another player took meanwhile
+func TestConcurrentActions_FailedActionKeepsOtherPlayersAction(t *testing.T) {
+	var engine *game.MageEngine
+	gameID := "concurrent-actions"
+
+	// As soon as Bob's action is bookmarked, Alice passes; she gets in only if the game is left unlocked
+	var armed atomic.Bool
+	alicePassed := make(chan error, 1)
+	hook := zap.Hooks(func(entry zapcore.Entry) error {
+		if entry.Message != "bookmarked game state" || !armed.CompareAndSwap(true, false) {
+			return nil
+		}
+		go func() {
+			alicePassed <- engine.ProcessAction(gameID, game.PlayerAction{PlayerID: "Alice", ActionType: "PLAYER_ACTION", Data: "PASS"})
+		}()
+		select {
+		case err := <-alicePassed:
+			alicePassed <- err
+		case <-time.After(100 * time.Millisecond):
+		}
+		return nil
+	})
+	engine = game.NewMageEngine(zaptest.NewLogger(t, zaptest.WrapOptions(hook)))
+	if err := engine.StartGame(gameID, []string{"Alice", "Bob"}, "Duel"); err != nil {
+		t.Fatalf("failed to start game: %v", err)
+	}
+	armed.Store(true)
+
+	if err := engine.ProcessAction(gameID, game.PlayerAction{PlayerID: "Bob", ActionType: "PLAYER_ACTION", Data: "CONCEDE"}); err == nil {
+		t.Fatal("expected Bob's unknown action to fail")
+	}
+	if err := <-alicePassed; err != nil {
+		t.Fatalf("Alice's pass failed: %v", err)
+	}
+
+	view, err := engine.GetGameView(gameID, "Alice")
+	if err != nil {
+		t.Fatalf("failed to get view: %v", err)
+	}
+	passed := false
+	for _, msg := range view.(*game.EngineGameView).Messages {
+		if msg.Text == "Alice passes" {
+			passed = true
+		}
+	}
+	if !passed {
+		t.Fatal("Alice's pass was lost when Bob's failed action was rolled back")
+	}
+	// Alice's bookmark survived Bob's rollback, so she can still undo
+	if err := engine.Undo(gameID, "Alice"); err != nil {
+		t.Errorf("expected Alice's bookmark to survive, undo failed: %v", err)
+	}
+}
+
 // TestMultipleBookmarks verifies that multiple bookmarks can be created and managed
 func TestMultipleBookmarks(t *testing.T) {
 	logger := zaptest.NewLogger(t)
 	engine := game.NewMageEngine(logger)
+	engine.SetDebugOperationsEnabled(true)
 
 	gameID := "multi-bookmark-test"
 	players := []string{"Alice", "Bob"}
@@ -1291,13 +1380,8 @@ func TestMultipleBookmarks(t *testing.T) {
 	}
 
 	// Make a change
-	if err := engine.ProcessAction(gameID, game.PlayerAction{
-		PlayerID:   "Alice",
-		ActionType: "SEND_INTEGER",
-		Data:       -3,
-		Timestamp:  time.Now(),
-	}); err != nil {
-		t.Fatalf("failed to change life: %v", err)
+	if err := engine.DealDamage(gameID, "", "Alice", 3, false); err != nil {
+		t.Fatalf("failed to deal damage: %v", err)
 	}
 
 	// Create second bookmark
@@ -1307,13 +1391,8 @@ func TestMultipleBookmarks(t *testing.T) {
 	}
 
 	// Make another change
-	if err := engine.ProcessAction(gameID, game.PlayerAction{
-		PlayerID:   "Alice",
-		ActionType: "SEND_INTEGER",
-		Data:       -3,
-		Timestamp:  time.Now(),
-	}); err != nil {
-		t.Fatalf("failed to change life: %v", err)
+	if err := engine.DealDamage(gameID, "", "Alice", 3, false); err != nil {
+		t.Fatalf("failed to deal damage: %v", err)
 	}
 
 	// Verify we have different bookmark IDs
@@ -1452,6 +1531,7 @@ func TestPlayerUndo(t *testing.T) {
 	initialHandSize := len(initialView.Players[0].Hand)
 
 	// Cast a spell (this should create a stored bookmark for Alice)
+	addRedMana(t, engine, gameID, "Alice")
 	if err := engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -1524,6 +1604,7 @@ func TestUndoNotAvailableAfterResolution(t *testing.T) {
 	}
 
 	// Cast a spell
+	addRedMana(t, engine, gameID, "Alice")
 	if err := engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -1561,6 +1642,70 @@ func TestUndoNotAvailableAfterResolution(t *testing.T) {
 	}
 }
 
+// TestUndoRejectedAfterOpponentActs verifies that a player can't undo past another player's action
+func TestUndoRejectedAfterOpponentActs(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	engine := game.NewMageEngine(logger)
+
+	gameID := "undo-after-opponent-test"
+	players := []string{"Alice", "Bob"}
+
+	if err := engine.StartGame(gameID, players, "Duel"); err != nil {
+		t.Fatalf("failed to start game: %v", err)
+	}
+
+	// Alice casts a spell and passes priority to Bob
+	addRedMana(t, engine, gameID, "Alice")
+	for _, data := range []string{"Lightning Bolt", "Pass"} {
+		if err := engine.ProcessAction(gameID, game.PlayerAction{
+			PlayerID:   "Alice",
+			ActionType: "SEND_STRING",
+			Data:       data,
+			Timestamp:  time.Now(),
+		}); err != nil {
+			t.Fatalf("Alice failed to act (%s): %v", data, err)
+		}
+	}
+
+	// Bob responds
+	addRedMana(t, engine, gameID, "Bob")
+	if err := engine.ProcessAction(gameID, game.PlayerAction{
+		PlayerID:   "Bob",
+		ActionType: "SEND_STRING",
+		Data:       "Lightning Bolt",
+		Timestamp:  time.Now(),
+	}); err != nil {
+		t.Fatalf("Bob failed to respond: %v", err)
+	}
+
+	err := engine.Undo(gameID, "Alice")
+	if err == nil {
+		t.Fatal("expected Alice's undo to be rejected after Bob acted")
+	}
+	if !strings.Contains(err.Error(), "Bob has acted") {
+		t.Errorf("expected the error to name Bob, got %v", err)
+	}
+
+	viewRaw, err := engine.GetGameView(gameID, "Alice")
+	if err != nil {
+		t.Fatalf("failed to get view: %v", err)
+	}
+	bobSpellOnStack := false
+	for _, item := range viewRaw.(*game.EngineGameView).Stack {
+		if item.ID == "Bob-card-0" {
+			bobSpellOnStack = true
+		}
+	}
+	if !bobSpellOnStack {
+		t.Error("expected Bob's spell to stay on the stack")
+	}
+
+	// Nobody has acted since Bob's response, so Bob can still undo it
+	if err := engine.Undo(gameID, "Bob"); err != nil {
+		t.Fatalf("expected Bob's undo to succeed: %v", err)
+	}
+}
+
 // TestTurnRollback verifies that turn snapshot saving and checking works
 func TestTurnRollback(t *testing.T) {
 	logger := zaptest.NewLogger(t)
@@ -1610,6 +1755,7 @@ func TestTurnRollbackClearsPlayerBookmarks(t *testing.T) {
 	}
 
 	// Cast a spell (creates player bookmark)
+	addRedMana(t, engine, gameID, "Alice")
 	if err := engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -1632,6 +1778,7 @@ func TestTurnRollbackClearsPlayerBookmarks(t *testing.T) {
 	}
 
 	// Cast again to create a new bookmark
+	addRedMana(t, engine, gameID, "Alice")
 	if err := engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -1789,27 +1936,44 @@ func TestMulliganPhase(t *testing.T) {
 		t.Fatalf("failed to start mulligan: %v", err)
 	}
 
-	// Alice mulligans
+	// Round 1: Alice mulligans, Bob keeps
 	if err := engine.PlayerMulligan(gameID, "Alice"); err != nil {
 		t.Fatalf("failed to mulligan: %v", err)
 	}
+	if err := engine.PlayerKeepHand(gameID, "Bob"); err != nil {
+		t.Fatalf("failed to keep hand: %v", err)
+	}
 
-	// Verify Alice has 6 cards (7 - 1 mulligan)
+	// London mulligan: Alice draws a new hand of 7
 	viewRaw, err = engine.GetGameView(gameID, "Alice")
 	if err != nil {
 		t.Fatalf("failed to get view: %v", err)
 	}
 	view = viewRaw.(*game.EngineGameView)
-	if len(view.Players[0].Hand) != 6 {
-		t.Errorf("expected 6 cards after 1 mulligan, got %d", len(view.Players[0].Hand))
+	if len(view.Players[0].Hand) != 7 {
+		t.Errorf("expected a new hand of 7 cards after 1 mulligan, got %d", len(view.Players[0].Hand))
 	}
 
-	// Alice mulligans again
+	// Round 2: Alice mulligans again, then keeps in round 3
 	if err := engine.PlayerMulligan(gameID, "Alice"); err != nil {
 		t.Fatalf("failed to mulligan again: %v", err)
 	}
+	if err := engine.PlayerKeepHand(gameID, "Alice"); err != nil {
+		t.Fatalf("failed to keep hand: %v", err)
+	}
+
+	// Alice puts 2 cards on the bottom (7 - 2 mulligans)
+	decisions, err := engine.GetPendingDecisions(gameID, "Alice")
+	if err != nil || len(decisions) != 1 {
+		t.Fatalf("expected a decision to put cards on the bottom, got %v (%v)", decisions, err)
+	}
+	if decisions[0].Min != 2 {
+		t.Errorf("expected to put 2 cards on the bottom, got %d", decisions[0].Min)
+	}
+	if err := engine.RespondToDecision(gameID, "Alice", decisions[0].ID, game.Response{Choices: decisions[0].Choices[:2]}); err != nil {
+		t.Fatalf("failed to put cards on the bottom: %v", err)
+	}
 
-	// Verify Alice has 5 cards (7 - 2 mulligans)
 	viewRaw, err = engine.GetGameView(gameID, "Alice")
 	if err != nil {
 		t.Fatalf("failed to get view: %v", err)
@@ -1819,16 +1983,6 @@ func TestMulliganPhase(t *testing.T) {
 		t.Errorf("expected 5 cards after 2 mulligans, got %d", len(view.Players[0].Hand))
 	}
 
-	// Alice keeps
-	if err := engine.PlayerKeepHand(gameID, "Alice"); err != nil {
-		t.Fatalf("failed to keep hand: %v", err)
-	}
-
-	// Bob keeps (no mulligans)
-	if err := engine.PlayerKeepHand(gameID, "Bob"); err != nil {
-		t.Fatalf("failed to keep hand: %v", err)
-	}
-
 	// End mulligan phase
 	if err := engine.EndMulligan(gameID); err != nil {
 		t.Fatalf("failed to end mulligan: %v", err)
@@ -2031,6 +2185,61 @@ func TestPauseResumeValidation(t *testing.T) {
 	}
 }
 
+// TestPausedGameRejectsActions verifies that actions are rejected while the game is paused, succeed
+// after it is resumed, and that pause and resume are broadcast
+func TestPausedGameRejectsActions(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	engine := game.NewMageEngine(logger)
+
+	states := make(chan interface{}, 10)
+	engine.SetNotificationHandler(func(notification game.GameNotification) {
+		if notification.Type != "GAME_STATE_CHANGE" {
+			return
+		}
+		select {
+		case states <- notification.Data["state"]:
+		default:
+		}
+	})
+
+	gameID := "pause-actions-test"
+	if err := engine.StartGame(gameID, []string{"Alice", "Bob"}, "Duel"); err != nil {
+		t.Fatalf("failed to start game: %v", err)
+	}
+
+	pass := game.PlayerAction{PlayerID: "Alice", ActionType: "SEND_STRING", Data: "PASS", Timestamp: time.Now()}
+
+	if err := engine.PauseGame(gameID); err != nil {
+		t.Fatalf("failed to pause: %v", err)
+	}
+	err := engine.ProcessAction(gameID, pass)
+	if err == nil || !strings.Contains(err.Error(), "paused") {
+		t.Fatalf("expected the action to be rejected while paused, got %v", err)
+	}
+	if err := engine.DeclareAttacker(gameID, "any-creature", "Bob", "Alice"); err == nil || !strings.Contains(err.Error(), "paused") {
+		t.Errorf("expected combat actions to be rejected while paused, got %v", err)
+	}
+
+	if err := engine.ResumeGame(gameID); err != nil {
+		t.Fatalf("failed to resume: %v", err)
+	}
+	if err := engine.ProcessAction(gameID, pass); err != nil {
+		t.Fatalf("expected the action to succeed after resume: %v", err)
+	}
+
+	// Notifications are delivered asynchronously; wait for both broadcasts
+	seen := map[interface{}]bool{}
+	timeout := time.After(time.Second)
+	for !seen["paused"] || !seen["in_progress"] {
+		select {
+		case state := <-states:
+			seen[state] = true
+		case <-timeout:
+			t.Fatalf("expected pause and resume broadcasts, got %v", seen)
+		}
+	}
+}
+
 // TestCombatInfrastructure tests combat state structures
 func TestCombatInfrastructure(t *testing.T) {
 	logger := zaptest.NewLogger(t)
@@ -2369,6 +2578,7 @@ func TestNotificationDeadlock(t *testing.T) {
 	// Cast a spell in a goroutine so we can detect if it hangs
 	// This will trigger a STACK_UPDATE notification while holding gameState.mu lock
 	t.Logf("Casting spell to trigger STACK_UPDATE notification...")
+	addRedMana(t, engine, gameID, "Alice")
 	castDone := make(chan error, 1)
 	go func() {
 		err := engine.ProcessAction(gameID, game.PlayerAction{
@@ -16,10 +16,16 @@ type ManaCost struct {
 	Red       int
 	Green     int
 	Colorless int
+	Snow      int  // {S}: paid with mana from a snow source (rule 107.4h)
+	Energy    int  // {E}: paid with energy counters, not mana (rule 107.14)
 	X         bool // X in cost (e.g., {X}{R})
 	Hybrid    []HybridCost
+	Phyrexian []ManaType // {R/P}: one mana of the color or 2 life (rule 107.4f), in cost order
 }
 
+// PhyrexianLifeCost is the life paid instead of mana for one phyrexian symbol
+const PhyrexianLifeCost = 2
+
 // HybridCost represents a hybrid mana cost (e.g., {W/U}, {2/B}).
 type HybridCost struct {
 	Options [][]ManaType // Each option is a list of mana types that can pay for it
@@ -29,8 +35,11 @@ type HybridCost struct {
 // Supports:
 // - Generic: {1}, {2}, {3}, etc.
 // - Colored: {W}, {U}, {B}, {R}, {G}, {C}
+// - Snow: {S}
 // - X costs: {X}
+// - Energy: {E}
 // - Hybrid: {W/U}, {2/B}, etc. (basic support)
+// - Phyrexian: {W/P}, {R/P}, etc.
 func ParseCost(costStr string) (*ManaCost, error) {
 	if costStr == "" {
 		return &ManaCost{}, nil
@@ -63,10 +72,16 @@ func ParseCost(costStr string) (*ManaCost, error) {
 			cost.Green++
 		case "C":
 			cost.Colorless++
+		case "S":
+			cost.Snow++
+		case "E":
+			cost.Energy++
 		default:
 			// Check if it's a number (generic mana)
 			if num, err := strconv.Atoi(symbol); err == nil {
 				cost.Generic += num
+			} else if color, ok := parsePhyrexianSymbol(symbol); ok {
+				cost.Phyrexian = append(cost.Phyrexian, color)
 			} else if strings.Contains(symbol, "/") {
 				// Hybrid mana: {W/U}, {2/B}, etc.
 				hybrid := parseHybridCost(symbol)
@@ -82,6 +97,47 @@ func ParseCost(costStr string) (*ManaCost, error) {
 	return cost, nil
 }
 
+// parsePhyrexianSymbol parses a phyrexian mana symbol like "R/P" into its color.
+func parsePhyrexianSymbol(symbol string) (ManaType, bool) {
+	parts := strings.Split(symbol, "/")
+	if len(parts) != 2 || strings.TrimSpace(parts[1]) != "P" {
+		return "", false
+	}
+	types := parseManaTypes(strings.TrimSpace(parts[0]))
+	if len(types) != 1 || types[0] == ManaGeneric || types[0] == ManaColorless {
+		return "", false
+	}
+	return types[0], true
+}
+
+// WithPhyrexianPaid decides how each phyrexian symbol is paid: payWithLife[i] says whether the i-th
+// symbol is paid with life. It returns the cost with the symbols paid with mana turned into colored
+// pips and the life to pay; symbols without a choice are paid with mana.
+func (mc *ManaCost) WithPhyrexianPaid(payWithLife []bool) (*ManaCost, int) {
+	paid := *mc
+	paid.Phyrexian = nil
+	life := 0
+	for i, color := range mc.Phyrexian {
+		if i < len(payWithLife) && payWithLife[i] {
+			life += PhyrexianLifeCost
+			continue
+		}
+		switch color {
+		case ManaWhite:
+			paid.White++
+		case ManaBlue:
+			paid.Blue++
+		case ManaBlack:
+			paid.Black++
+		case ManaRed:
+			paid.Red++
+		case ManaGreen:
+			paid.Green++
+		}
+	}
+	return &paid, life
+}
+
 // parseHybridCost parses a hybrid mana symbol like "W/U" or "2/B".
 func parseHybridCost(symbol string) *HybridCost {
 	parts := strings.Split(symbol, "/")
@@ -137,6 +193,25 @@ func parseManaTypes(s string) []ManaType {
 	return types
 }
 
+// manaSymbol returns the symbol letter of a mana type (ManaRed -> "R").
+func manaSymbol(manaType ManaType) string {
+	switch manaType {
+	case ManaWhite:
+		return "W"
+	case ManaBlue:
+		return "U"
+	case ManaBlack:
+		return "B"
+	case ManaRed:
+		return "R"
+	case ManaGreen:
+		return "G"
+	case ManaColorless:
+		return "C"
+	}
+	return string(manaType)
+}
+
 // String returns a string representation of the mana cost.
 func (mc *ManaCost) String() string {
 	var parts []string
@@ -166,6 +241,16 @@ func (mc *ManaCost) String() string {
 	for i := 0; i < mc.Colorless; i++ {
 		parts = append(parts, "{C}")
 	}
+	for i := 0; i < mc.Snow; i++ {
+		parts = append(parts, "{S}")
+	}
+	for i := 0; i < mc.Energy; i++ {
+		parts = append(parts, "{E}")
+	}
+
+	for _, color := range mc.Phyrexian {
+		parts = append(parts, fmt.Sprintf("{%s/P}", manaSymbol(color)))
+	}
 
 	for _, hybrid := range mc.Hybrid {
 		// Simple representation - full implementation would show both options
@@ -194,6 +279,9 @@ func (mc *ManaCost) CanPay(pool *ManaPool, xValue int) bool {
 	if mc.X && xValue < 0 {
 		return false
 	}
+	if len(mc.Phyrexian) > 0 {
+		mc, _ = mc.WithPhyrexianPaid(nil)
+	}
 
 	// Check if we have enough colored mana
 	if pool.GetTotal(ManaWhite) < mc.White {
@@ -214,6 +302,9 @@ func (mc *ManaCost) CanPay(pool *ManaPool, xValue int) bool {
 	if pool.GetTotal(ManaColorless) < mc.Colorless {
 		return false
 	}
+	if pool.GetTaggedTotal(TagSnow) < mc.Snow {
+		return false
+	}
 
 	// Pay hybrid costs (simplified - full implementation would try all combinations)
 	// For now, check if we can pay at least one option for each hybrid
@@ -255,7 +346,7 @@ func (mc *ManaCost) CanPay(pool *ManaPool, xValue int) bool {
 
 	// Calculate available mana after paying colored requirements
 	// We need to ensure we have enough total mana AND enough of each specific color
-	totalRequired := mc.White + mc.Blue + mc.Black + mc.Red + mc.Green + mc.Colorless + len(mc.Hybrid) + totalGeneric
+	totalRequired := mc.White + mc.Blue + mc.Black + mc.Red + mc.Green + mc.Colorless + mc.Snow + len(mc.Hybrid) + totalGeneric
 	totalAvailable := pool.GetTotalMana()
 
 	if totalAvailable < totalRequired {
@@ -277,8 +368,11 @@ func (mc *ManaCost) ApplyReduction(genericReduction int, coloredReduction map[Ma
 		Red:       mc.Red,
 		Green:     mc.Green,
 		Colorless: mc.Colorless,
+		Snow:      mc.Snow,
+		Energy:    mc.Energy,
 		X:         mc.X,
 		Hybrid:    mc.Hybrid, // Hybrid costs don't get reduced
+		Phyrexian: mc.Phyrexian,
 	}
 
 	// Apply generic reduction
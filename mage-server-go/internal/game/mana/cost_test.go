@@ -18,6 +18,7 @@ func TestParseCost(t *testing.T) {
 		{"{X}{R}", &ManaCost{X: true, Red: 1}, false},
 		{"{W}{U}{B}{R}{G}", &ManaCost{White: 1, Blue: 1, Black: 1, Red: 1, Green: 1}, false},
 		{"{C}", &ManaCost{Colorless: 1}, false},
+		{"{E}{E}", &ManaCost{Energy: 2}, false},
 	}
 
 	for _, tt := range tests {
@@ -54,6 +55,9 @@ func TestParseCost(t *testing.T) {
 			if result.Colorless != tt.expected.Colorless {
 				t.Errorf("Colorless: expected %d, got %d", tt.expected.Colorless, result.Colorless)
 			}
+			if result.Energy != tt.expected.Energy {
+				t.Errorf("Energy: expected %d, got %d", tt.expected.Energy, result.Energy)
+			}
 			if result.X != tt.expected.X {
 				t.Errorf("X: expected %v, got %v", tt.expected.X, result.X)
 			}
@@ -61,6 +65,24 @@ func TestParseCost(t *testing.T) {
 	}
 }
 
+func TestParseCost_Phyrexian(t *testing.T) {
+	cost, err := ParseCost("{1}{R/P}{G/P}")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cost.Generic != 1 || len(cost.Phyrexian) != 2 || cost.Phyrexian[0] != ManaRed || cost.Phyrexian[1] != ManaGreen {
+		t.Fatalf("Expected {1} and phyrexian red and green, got %+v", cost)
+	}
+	if len(cost.Hybrid) != 0 {
+		t.Errorf("Expected no hybrid symbols, got %d", len(cost.Hybrid))
+	}
+
+	paid, life := cost.WithPhyrexianPaid([]bool{true})
+	if life != 2 || paid.Red != 0 || paid.Green != 1 || len(paid.Phyrexian) != 0 {
+		t.Errorf("Expected {R/P} paid with 2 life and {G/P} with green, got %+v and %d life", paid, life)
+	}
+}
+
 func TestManaCost_CanPay(t *testing.T) {
 	pool := NewManaPool()
 	pool.Add(ManaWhite, 1)
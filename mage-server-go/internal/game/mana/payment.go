@@ -12,8 +12,9 @@ type PaymentPlan struct {
 	Red       int
 	Green     int
 	Colorless int
-	Generic   int // Generic mana can be paid with any type
-	XValue    int // Value chosen for X costs
+	Generic   int              // Generic mana can be paid with any type
+	XValue    int              // Value chosen for X costs
+	Snow      map[ManaType]int // Snow mana spent for {S}, by type
 }
 
 // PaymentResult represents the result of a payment attempt.
@@ -34,6 +35,11 @@ func CalculatePayment(cost *ManaCost, pool *ManaPool, xValue int) *PaymentResult
 		}
 	}
 
+	// Phyrexian symbols not chosen to be paid with life are paid with their color
+	if len(cost.Phyrexian) > 0 {
+		cost, _ = cost.WithPhyrexianPaid(nil)
+	}
+
 	plan := &PaymentPlan{
 		XValue: xValue,
 	}
@@ -90,39 +96,55 @@ func CalculatePayment(cost *ManaCost, pool *ManaPool, xValue int) *PaymentResult
 		}
 	}
 
-	// Pay hybrid costs (simplified - try first option)
-	for _, hybrid := range cost.Hybrid {
-		paid := false
-		for _, option := range hybrid.Options {
-			if len(option) > 0 {
-				if testPool.Spend(option[0], 1) {
-					// Track which type was used (simplified)
-					switch option[0] {
-					case ManaWhite:
-						plan.White++
-					case ManaBlue:
-						plan.Blue++
-					case ManaBlack:
-						plan.Black++
-					case ManaRed:
-						plan.Red++
-					case ManaGreen:
-						plan.Green++
-					case ManaColorless:
-						plan.Colorless++
-					case ManaGeneric:
-						plan.Generic++
-					}
-					paid = true
-					break
-				}
-			}
+	// Pay hybrid costs (e.g. {R/G} with either color), choosing options so that one symbol doesn't
+	// take the only mana another symbol could be paid with
+	hybridTypes, ok := chooseHybridPayment(cost.Hybrid, testPool)
+	if !ok {
+		return &PaymentResult{
+			Success: false,
+			Reason:  "cannot pay hybrid mana cost",
 		}
-		if !paid {
-			return &PaymentResult{
-				Success: false,
-				Reason:  "cannot pay hybrid mana cost",
+	}
+	for _, mt := range hybridTypes {
+		testPool.Spend(mt, 1)
+		switch mt {
+		case ManaWhite:
+			plan.White++
+		case ManaBlue:
+			plan.Blue++
+		case ManaBlack:
+			plan.Black++
+		case ManaRed:
+			plan.Red++
+		case ManaGreen:
+			plan.Green++
+		case ManaColorless:
+			plan.Colorless++
+		}
+	}
+
+	// Pay snow costs with snow mana of any type
+	snowRemaining := cost.Snow
+	for _, mt := range []ManaType{ManaColorless, ManaWhite, ManaBlue, ManaBlack, ManaRed, ManaGreen} {
+		if snowRemaining <= 0 {
+			break
+		}
+		spend := testPool.GetTagged(TagSnow, mt)
+		if spend > snowRemaining {
+			spend = snowRemaining
+		}
+		if spend > 0 && testPool.SpendTagged(TagSnow, mt, spend) {
+			if plan.Snow == nil {
+				plan.Snow = make(map[ManaType]int)
 			}
+			plan.Snow[mt] += spend
+			snowRemaining -= spend
+		}
+	}
+	if snowRemaining > 0 {
+		return &PaymentResult{
+			Success: false,
+			Reason:  fmt.Sprintf("insufficient snow mana (need %d)", cost.Snow),
 		}
 	}
 
@@ -201,6 +223,27 @@ func CalculatePayment(cost *ManaCost, pool *ManaPool, xValue int) *PaymentResult
 	}
 }
 
+// chooseHybridPayment picks the mana type that pays each hybrid symbol, backtracking over the
+// symbols' options until every symbol can be paid from the pool. The pool is not modified.
+func chooseHybridPayment(hybrids []HybridCost, pool *ManaPool) ([]ManaType, bool) {
+	if len(hybrids) == 0 {
+		return nil, true
+	}
+	for _, option := range hybrids[0].Options {
+		if len(option) == 0 {
+			continue
+		}
+		remaining := pool.Copy()
+		if !remaining.Spend(option[0], 1) {
+			continue
+		}
+		if rest, ok := chooseHybridPayment(hybrids[1:], remaining); ok {
+			return append([]ManaType{option[0]}, rest...), true
+		}
+	}
+	return nil, false
+}
+
 // ExecutePayment executes a payment plan against a mana pool.
 func ExecutePayment(plan *PaymentPlan, pool *ManaPool) bool {
 	if plan == nil {
@@ -227,6 +270,13 @@ func ExecutePayment(plan *PaymentPlan, pool *ManaPool) bool {
 		return false
 	}
 
+	// Pay snow mana
+	for mt, amount := range plan.Snow {
+		if !pool.SpendTagged(TagSnow, mt, amount) {
+			return false
+		}
+	}
+
 	// Pay generic mana (can use any type)
 	genericRemaining := plan.Generic
 	if genericRemaining > 0 {
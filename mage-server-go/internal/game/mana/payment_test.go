@@ -79,3 +79,61 @@ func TestExecutePayment_GenericMana(t *testing.T) {
 		t.Errorf("Expected 1 white mana remaining (2 spent for generic), got %d", pool.GetRegular(ManaWhite))
 	}
 }
+
+func TestCalculatePayment_SnowMana(t *testing.T) {
+	cost, err := ParseCost("{S}{G}")
+	if err != nil {
+		t.Fatalf("Failed to parse cost: %v", err)
+	}
+	if cost.Snow != 1 || cost.String() != "{G}{S}" {
+		t.Fatalf("Expected one snow pip, got %+v (%s)", cost, cost.String())
+	}
+
+	// Untagged mana can't pay {S}
+	pool := NewManaPool()
+	pool.Add(ManaGreen, 2)
+	if result := CalculatePayment(cost, pool, 0); result.Success {
+		t.Error("Expected untagged mana to be unable to pay {S}")
+	}
+	if cost.CanPay(pool, 0) {
+		t.Error("Expected CanPay to require snow mana")
+	}
+
+	// Snow mana pays {S}; untagged green is used for {G} so the snow mana stays available
+	pool = NewManaPool()
+	pool.Add(ManaGreen, 1)
+	pool.AddTagged(ManaGreen, 1, TagSnow)
+	if !cost.CanPay(pool, 0) {
+		t.Error("Expected CanPay with snow mana")
+	}
+	result := CalculatePayment(cost, pool, 0)
+	if !result.Success {
+		t.Fatalf("Expected snow mana to pay {S}{G}, got: %s", result.Reason)
+	}
+	if !ExecutePayment(result.Plan, pool) {
+		t.Fatal("Expected payment to execute")
+	}
+	if pool.GetTotalMana() != 0 || pool.GetTaggedTotal(TagSnow) != 0 {
+		t.Errorf("Expected empty pool, got %d mana (%d snow)", pool.GetTotalMana(), pool.GetTaggedTotal(TagSnow))
+	}
+}
+
+func TestCalculatePayment_HybridChoosesWorkingColors(t *testing.T) {
+	pool := NewManaPool()
+	pool.Add(ManaRed, 1)
+	pool.Add(ManaGreen, 1)
+
+	// Paying {R/G} with red would leave nothing for {R/W}
+	cost, _ := ParseCost("{R/G}{R/W}")
+	result := CalculatePayment(cost, pool, 0)
+
+	if !result.Success {
+		t.Fatalf("Expected successful payment, got: %s", result.Reason)
+	}
+	if result.Plan.Green != 1 || result.Plan.Red != 1 {
+		t.Errorf("Expected 1 green and 1 red in plan, got %d and %d", result.Plan.Green, result.Plan.Red)
+	}
+	if !ExecutePayment(result.Plan, pool) || pool.GetTotalMana() != 0 {
+		t.Errorf("Expected the pool to be emptied, %d mana left", pool.GetTotalMana())
+	}
+}
@@ -17,6 +17,15 @@ const (
 	ManaGeneric   ManaType = "GENERIC" // Generic mana can be paid with any type
 )
 
+// ManaTag records what kind of source produced mana, for costs and effects that care
+// (e.g. snow mana for {S} per rule 107.4h, mana from a Treasure).
+type ManaTag string
+
+const (
+	TagSnow     ManaTag = "SNOW"
+	TagTreasure ManaTag = "TREASURE"
+)
+
 // ManaPool represents a player's mana pool (both regular and floating).
 type ManaPool struct {
 	mu sync.RWMutex
@@ -36,6 +45,10 @@ type ManaPool struct {
 	FloatingRed       int
 	FloatingGreen     int
 	FloatingColorless int
+
+	// Tagged mana by tag and type; a subset of the regular pool above.
+	// Untagged mana is used first so tagged mana stays available for costs that need it.
+	tagged map[ManaTag]map[ManaType]int
 }
 
 // NewManaPool creates a new empty mana pool.
@@ -67,6 +80,110 @@ func (mp *ManaPool) Add(manaType ManaType, amount int) {
 	}
 }
 
+// AddTagged adds mana to the regular pool carrying the given tags.
+func (mp *ManaPool) AddTagged(manaType ManaType, amount int, tags ...ManaTag) {
+	if amount <= 0 {
+		return
+	}
+	mp.Add(manaType, amount)
+	if len(tags) == 0 {
+		return
+	}
+
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	if mp.tagged == nil {
+		mp.tagged = make(map[ManaTag]map[ManaType]int)
+	}
+	for _, tag := range tags {
+		if mp.tagged[tag] == nil {
+			mp.tagged[tag] = make(map[ManaType]int)
+		}
+		mp.tagged[tag][manaType] += amount
+	}
+}
+
+// GetTagged returns the amount of a mana type carrying a tag.
+func (mp *ManaPool) GetTagged(tag ManaTag, manaType ManaType) int {
+	mp.mu.RLock()
+	defer mp.mu.RUnlock()
+	return mp.tagged[tag][manaType]
+}
+
+// GetTaggedTotal returns the amount of mana of any type carrying a tag.
+func (mp *ManaPool) GetTaggedTotal(tag ManaTag) int {
+	mp.mu.RLock()
+	defer mp.mu.RUnlock()
+	total := 0
+	for _, amount := range mp.tagged[tag] {
+		total += amount
+	}
+	return total
+}
+
+// SpendTagged spends mana of a type carrying a tag (e.g. snow mana for {S}).
+// Returns false without spending if there isn't enough tagged mana.
+func (mp *ManaPool) SpendTagged(tag ManaTag, manaType ManaType, amount int) bool {
+	if amount <= 0 {
+		return true
+	}
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	regular, _ := mp.counts(manaType)
+	if regular == nil || mp.tagged[tag][manaType] < amount || *regular < amount {
+		return false
+	}
+	*regular -= amount
+	mp.tagged[tag][manaType] -= amount
+	mp.clampTagged(manaType)
+	return true
+}
+
+// counts returns the regular and floating counters for a mana type (nil for unknown types).
+// Caller must hold the lock.
+func (mp *ManaPool) counts(manaType ManaType) (regular, floating *int) {
+	switch manaType {
+	case ManaWhite:
+		return &mp.White, &mp.FloatingWhite
+	case ManaBlue:
+		return &mp.Blue, &mp.FloatingBlue
+	case ManaBlack:
+		return &mp.Black, &mp.FloatingBlack
+	case ManaRed:
+		return &mp.Red, &mp.FloatingRed
+	case ManaGreen:
+		return &mp.Green, &mp.FloatingGreen
+	case ManaColorless:
+		return &mp.Colorless, &mp.FloatingColorless
+	default:
+		return nil, nil
+	}
+}
+
+// reservedTagged returns how much regular mana of a type carries any tag. Caller must hold the lock.
+func (mp *ManaPool) reservedTagged(manaType ManaType) int {
+	reserved := 0
+	for _, byType := range mp.tagged {
+		if byType[manaType] > reserved {
+			reserved = byType[manaType]
+		}
+	}
+	return reserved
+}
+
+// clampTagged keeps tagged amounts within the regular pool after spending. Tags are tracked
+// independently, so mana carrying several tags may leave another tag's count behind until then.
+// Caller must hold the lock.
+func (mp *ManaPool) clampTagged(manaType ManaType) {
+	regular, _ := mp.counts(manaType)
+	for _, byType := range mp.tagged {
+		if byType[manaType] > *regular {
+			byType[manaType] = *regular
+		}
+	}
+}
+
 // AddFloating adds mana to the floating pool.
 func (mp *ManaPool) AddFloating(manaType ManaType, amount int) {
 	if amount <= 0 {
@@ -162,7 +279,7 @@ func (mp *ManaPool) GetFloating(manaType ManaType) int {
 
 // Spend attempts to spend mana from the pool.
 // Returns true if successful, false if insufficient mana.
-// Prefers regular mana over floating mana.
+// Prefers untagged regular mana, then floating mana, then tagged mana.
 func (mp *ManaPool) Spend(manaType ManaType, amount int) bool {
 	if amount <= 0 {
 		return true
@@ -192,51 +309,21 @@ func (mp *ManaPool) Spend(manaType ManaType, amount int) bool {
 		return false
 	}
 
-	// Spend from regular pool first, then floating
-	switch manaType {
-	case ManaWhite:
-		spendFromRegular := amount
-		if spendFromRegular > mp.White {
-			spendFromRegular = mp.White
-		}
-		mp.White -= spendFromRegular
-		mp.FloatingWhite -= (amount - spendFromRegular)
-	case ManaBlue:
-		spendFromRegular := amount
-		if spendFromRegular > mp.Blue {
-			spendFromRegular = mp.Blue
-		}
-		mp.Blue -= spendFromRegular
-		mp.FloatingBlue -= (amount - spendFromRegular)
-	case ManaBlack:
-		spendFromRegular := amount
-		if spendFromRegular > mp.Black {
-			spendFromRegular = mp.Black
-		}
-		mp.Black -= spendFromRegular
-		mp.FloatingBlack -= (amount - spendFromRegular)
-	case ManaRed:
-		spendFromRegular := amount
-		if spendFromRegular > mp.Red {
-			spendFromRegular = mp.Red
-		}
-		mp.Red -= spendFromRegular
-		mp.FloatingRed -= (amount - spendFromRegular)
-	case ManaGreen:
-		spendFromRegular := amount
-		if spendFromRegular > mp.Green {
-			spendFromRegular = mp.Green
-		}
-		mp.Green -= spendFromRegular
-		mp.FloatingGreen -= (amount - spendFromRegular)
-	case ManaColorless:
-		spendFromRegular := amount
-		if spendFromRegular > mp.Colorless {
-			spendFromRegular = mp.Colorless
-		}
-		mp.Colorless -= spendFromRegular
-		mp.FloatingColorless -= (amount - spendFromRegular)
+	// Spend from untagged regular mana first, then floating, then tagged mana
+	regular, floating := mp.counts(manaType)
+	untagged := *regular - mp.reservedTagged(manaType)
+	spendFromRegular := amount
+	if spendFromRegular > untagged {
+		spendFromRegular = untagged
+	}
+	remaining := amount - spendFromRegular
+	spendFromFloating := remaining
+	if spendFromFloating > *floating {
+		spendFromFloating = *floating
 	}
+	*regular -= spendFromRegular + (remaining - spendFromFloating)
+	*floating -= spendFromFloating
+	mp.clampTagged(manaType)
 
 	return true
 }
@@ -251,6 +338,7 @@ func (mp *ManaPool) Empty() {
 	mp.Red = 0
 	mp.Green = 0
 	mp.Colorless = 0
+	mp.tagged = nil
 }
 
 // EmptyFloating empties the floating mana pool.
@@ -281,6 +369,7 @@ func (mp *ManaPool) EmptyAll() {
 	mp.FloatingRed = 0
 	mp.FloatingGreen = 0
 	mp.FloatingColorless = 0
+	mp.tagged = nil
 }
 
 // GetTotalMana returns the total mana count across all types.
@@ -295,18 +384,29 @@ func (mp *ManaPool) GetTotalMana() int {
 func (mp *ManaPool) Copy() *ManaPool {
 	mp.mu.RLock()
 	defer mp.mu.RUnlock()
+	var tagged map[ManaTag]map[ManaType]int
+	if mp.tagged != nil {
+		tagged = make(map[ManaTag]map[ManaType]int, len(mp.tagged))
+		for tag, byType := range mp.tagged {
+			tagged[tag] = make(map[ManaType]int, len(byType))
+			for manaType, amount := range byType {
+				tagged[tag][manaType] = amount
+			}
+		}
+	}
 	return &ManaPool{
-		White:            mp.White,
-		Blue:             mp.Blue,
-		Black:            mp.Black,
-		Red:              mp.Red,
-		Green:            mp.Green,
-		Colorless:        mp.Colorless,
-		FloatingWhite:    mp.FloatingWhite,
-		FloatingBlue:     mp.FloatingBlue,
-		FloatingBlack:    mp.FloatingBlack,
-		FloatingRed:      mp.FloatingRed,
-		FloatingGreen:    mp.FloatingGreen,
+		White:             mp.White,
+		Blue:              mp.Blue,
+		Black:             mp.Black,
+		Red:               mp.Red,
+		Green:             mp.Green,
+		Colorless:         mp.Colorless,
+		FloatingWhite:     mp.FloatingWhite,
+		FloatingBlue:      mp.FloatingBlue,
+		FloatingBlack:     mp.FloatingBlack,
+		FloatingRed:       mp.FloatingRed,
+		FloatingGreen:     mp.FloatingGreen,
 		FloatingColorless: mp.FloatingColorless,
+		tagged:            tagged,
 	}
 }
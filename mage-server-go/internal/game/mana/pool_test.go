@@ -84,3 +84,38 @@ func TestManaPool_Empty(t *testing.T) {
 		t.Error("Expected floating mana to be empty")
 	}
 }
+
+func TestManaPool_TaggedMana(t *testing.T) {
+	pool := NewManaPool()
+	pool.Add(ManaRed, 1)
+	pool.AddTagged(ManaRed, 2, TagSnow, TagTreasure)
+
+	if pool.GetTotal(ManaRed) != 3 {
+		t.Errorf("Expected tagged mana to count toward the total, got %d", pool.GetTotal(ManaRed))
+	}
+
+	// Untagged spending uses untagged mana first
+	if !pool.Spend(ManaRed, 1) {
+		t.Fatal("Expected to spend red mana")
+	}
+	if pool.GetTagged(TagSnow, ManaRed) != 2 {
+		t.Errorf("Expected snow mana to be kept, got %d", pool.GetTagged(TagSnow, ManaRed))
+	}
+
+	if pool.SpendTagged(TagSnow, ManaRed, 3) {
+		t.Error("Expected spending more snow mana than available to fail")
+	}
+	if !pool.SpendTagged(TagSnow, ManaRed, 2) {
+		t.Fatal("Expected to spend snow mana")
+	}
+	if pool.GetTotal(ManaRed) != 0 || pool.GetTaggedTotal(TagTreasure) != 0 {
+		t.Errorf("Expected empty pool, got %d red (%d Treasure)", pool.GetTotal(ManaRed), pool.GetTaggedTotal(TagTreasure))
+	}
+
+	pool.AddTagged(ManaBlue, 1, TagSnow)
+	copied := pool.Copy()
+	pool.Empty()
+	if pool.GetTaggedTotal(TagSnow) != 0 || copied.GetTaggedTotal(TagSnow) != 1 {
+		t.Error("Expected Empty to clear tags and Copy to keep them")
+	}
+}
@@ -0,0 +1,470 @@
+package game
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/magefree/mage-server-go/internal/game/mana"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// manaAbility is an activated mana ability of a permanent.
+// Per rule 605.3b mana abilities don't use the stack: costs are paid and mana is added immediately,
+// except that "any color" abilities wait for the controller to choose the color.
+// Per Java ActivatedManaAbilityImpl / AnyColorManaAbility
+type manaAbility struct {
+	ID        string
+	SourceID  string
+	Text      string
+	TapCost   bool          // {T} is part of the cost
+	Sacrifice bool          // Sacrificing the source is part of the cost (e.g. Treasure)
+	ManaType  mana.ManaType // Mana produced, unless AnyColor
+	AnyColor  bool          // "Add one mana of any color": the color is chosen on activation
+	Amount    int
+}
+
+// manaColors are the answers to a color choice for "mana of any color"
+var manaColors = []string{
+	string(mana.ManaWhite),
+	string(mana.ManaBlue),
+	string(mana.ManaBlack),
+	string(mana.ManaRed),
+	string(mana.ManaGreen),
+}
+
+// basicManaAbility creates "{T}: Add {X}" (basic lands, mana creatures)
+func basicManaAbility(sourceID string, manaType mana.ManaType) *manaAbility {
+	return &manaAbility{
+		SourceID: sourceID,
+		Text:     fmt.Sprintf("{T}: Add one %s mana.", strings.ToLower(string(manaType))),
+		TapCost:  true,
+		ManaType: manaType,
+		Amount:   1,
+	}
+}
+
+// anyColorManaAbility creates "{T}: Add one mana of any color." (e.g. Birds of Paradise)
+func anyColorManaAbility(sourceID string) *manaAbility {
+	return &manaAbility{
+		SourceID: sourceID,
+		Text:     "{T}: Add one mana of any color.",
+		TapCost:  true,
+		AnyColor: true,
+		Amount:   1,
+	}
+}
+
+// treasureManaAbility creates the Treasure token's ability
+// Per Java TreasureToken: "{T}, Sacrifice this artifact: Add one mana of any color."
+func treasureManaAbility(sourceID string) *manaAbility {
+	return &manaAbility{
+		SourceID:  sourceID,
+		Text:      "{T}, Sacrifice this artifact: Add one mana of any color.",
+		TapCost:   true,
+		Sacrifice: true,
+		AnyColor:  true,
+		Amount:    1,
+	}
+}
+
+// RegisterManaAbility registers a mana ability for a permanent and returns its ID
+func (e *MageEngine) RegisterManaAbility(gameID string, ability *manaAbility) (_ string, err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return "", fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	// Logged once the ability has its ID, so a replay registers it under the same one
+	defer e.logCall(gameState, &err, "RegisterManaAbility", ability)
+
+	if _, exists := gameState.cards[ability.SourceID]; !exists {
+		return "", fmt.Errorf("source %s not found", ability.SourceID)
+	}
+	if ability.ID == "" {
+		ability.ID = uuid.New().String()
+	}
+	if ability.Amount <= 0 {
+		ability.Amount = 1
+	}
+
+	gameState.manaAbilities = append(gameState.manaAbilities, ability)
+	return ability.ID, nil
+}
+
+// ActivateManaAbility activates a mana ability.
+// Abilities producing mana of any color create a choose_color decision; the mana is added to the
+// player's pool once it is answered.
+func (e *MageEngine) ActivateManaAbility(gameID, playerID, abilityID string) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "ActivateManaAbility", playerID, abilityID)
+
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+
+	var ability *manaAbility
+	for _, candidate := range gameState.manaAbilities {
+		if candidate.ID == abilityID {
+			ability = candidate
+			break
+		}
+	}
+	if ability == nil {
+		return fmt.Errorf("mana ability %s not found", abilityID)
+	}
+
+	if err := e.activateManaAbility(gameState, playerID, ability); err != nil {
+		return err
+	}
+
+	e.notifyPlayerAction(gameID, playerID, map[string]interface{}{
+		"type":       "activate_mana_ability",
+		"ability_id": abilityID,
+	})
+	return nil
+}
+
+// TapForMana taps a permanent for mana using its "{T}: Add ..." ability and adds the mana to the
+// player's pool. The ability is one registered for the permanent or, failing that, its printed one
+// (rules text such as "{T}: Add {G}." or a basic land type).
+func (e *MageEngine) TapForMana(gameID, cardID, playerID string) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "TapForMana", cardID, playerID)
+
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+
+	if err := e.tapForMana(gameState, playerID, cardID); err != nil {
+		return err
+	}
+
+	e.notifyPlayerAction(gameID, playerID, map[string]interface{}{
+		"type":    "tap_for_mana",
+		"card_id": cardID,
+	})
+	return nil
+}
+
+// handleTapForManaAction handles TAP_FOR_MANA actions, whose data is the ID of the permanent to tap
+func (e *MageEngine) handleTapForManaAction(gameState *engineGameState, action PlayerAction) error {
+	cardID, ok := action.Data.(string)
+	if !ok {
+		return fmt.Errorf("TAP_FOR_MANA data must be string")
+	}
+	return e.tapForMana(gameState, action.PlayerID, cardID)
+}
+
+// tapForMana activates a permanent's {T} mana ability; caller must hold the game lock
+func (e *MageEngine) tapForMana(gameState *engineGameState, playerID, cardID string) error {
+	card, exists := gameState.cards[cardID]
+	if !exists || card.Zone != zoneBattlefield {
+		return fmt.Errorf("permanent %s not found on the battlefield", cardID)
+	}
+	ability := e.tapManaAbility(gameState, card)
+	if ability == nil {
+		return fmt.Errorf("%s has no mana ability", card.Name)
+	}
+	return e.activateManaAbility(gameState, playerID, ability)
+}
+
+// tapManaAbility returns a permanent's {T} mana ability: a registered one, else its printed one
+func (e *MageEngine) tapManaAbility(gameState *engineGameState, card *internalCard) *manaAbility {
+	for _, ability := range gameState.manaAbilities {
+		if ability.SourceID == card.ID && ability.TapCost {
+			return ability
+		}
+	}
+	return printedManaAbility(card)
+}
+
+// printedManaAbilityPattern matches "{T}: Add {G}." in rules text
+var printedManaAbilityPattern = regexp.MustCompile(`(?i)\{T\}: Add \{([WUBRGC])\}`)
+
+// manaSymbols are the mana types of the symbols a basic mana ability can add
+var manaSymbols = map[string]mana.ManaType{
+	"W": mana.ManaWhite,
+	"U": mana.ManaBlue,
+	"B": mana.ManaBlack,
+	"R": mana.ManaRed,
+	"G": mana.ManaGreen,
+	"C": mana.ManaColorless,
+}
+
+// basicLandMana is the mana a basic land type's intrinsic ability adds (rule 305.6)
+var basicLandMana = map[string]mana.ManaType{
+	"Plains":   mana.ManaWhite,
+	"Island":   mana.ManaBlue,
+	"Swamp":    mana.ManaBlack,
+	"Mountain": mana.ManaRed,
+	"Forest":   mana.ManaGreen,
+}
+
+// printedManaAbility returns the {T} mana ability in a card's rules text or from its basic land type,
+// or nil if it has none
+func printedManaAbility(card *internalCard) *manaAbility {
+	if match := printedManaAbilityPattern.FindStringSubmatch(card.RulesText); match != nil {
+		return basicManaAbility(card.ID, manaSymbols[strings.ToUpper(match[1])])
+	}
+	for _, subType := range card.SubTypes {
+		for landType, manaType := range basicLandMana {
+			if strings.EqualFold(subType, landType) {
+				return basicManaAbility(card.ID, manaType)
+			}
+		}
+	}
+	return nil
+}
+
+// activateManaAbility pays the ability's costs and produces its mana; caller must hold the game lock
+func (e *MageEngine) activateManaAbility(gameState *engineGameState, playerID string, ability *manaAbility) error {
+	source, exists := gameState.cards[ability.SourceID]
+	if !exists || source.Zone != zoneBattlefield {
+		return fmt.Errorf("source %s is not on the battlefield", ability.SourceID)
+	}
+	if source.ControllerID != playerID {
+		return fmt.Errorf("player %s does not control %s", playerID, source.Name)
+	}
+
+	// Pay costs
+	if ability.TapCost {
+		if source.Tapped {
+			return fmt.Errorf("%s is already tapped", source.Name)
+		}
+		// Per rule 302.6: a creature's {T} abilities can't be activated while it is summoning sick
+		if e.isCreature(source) && e.isSummoningSick(gameState, source) {
+			return fmt.Errorf("%s has summoning sickness", source.Name)
+		}
+		if err := e.tapPermanent(gameState, source, tapReasonCost); err != nil {
+			return err
+		}
+	}
+	if ability.Sacrifice {
+		if err := e.sacrificePermanent(gameState, playerID, source); err != nil {
+			return err
+		}
+	}
+
+	if !ability.AnyColor {
+		e.addMana(gameState, playerID, source, ability.ManaType, ability.Amount, ability.TapCost)
+		return nil
+	}
+
+	// Per rule 106.1b: the player chooses the color as the ability resolves
+	gameState.addDecision(&Decision{
+		PlayerID: playerID,
+		Kind:     DecisionChooseColor,
+		Text:     fmt.Sprintf("Choose a color of mana to add (%s)", source.Name),
+		Choices:  append([]string(nil), manaColors...),
+		Min:      1,
+		Max:      1,
+		resolve: func(gameState *engineGameState, response Response) error {
+			e.addMana(gameState, playerID, source, mana.ManaType(response.Choices[0]), ability.Amount, ability.TapCost)
+			return nil
+		},
+	})
+	return nil
+}
+
+// addMana adds mana produced by a source to a player's mana pool
+func (e *MageEngine) addMana(gameState *engineGameState, playerID string, source *internalCard, manaType mana.ManaType, amount int, tapped bool) {
+	player, exists := gameState.players[playerID]
+	if !exists {
+		return
+	}
+	player.ManaPool.AddTagged(manaType, amount, manaTags(source)...)
+
+	if tapped {
+		gameState.eventBus.Publish(rules.NewEvent(rules.EventTappedForMana, source.ID, source.ID, playerID))
+	}
+	event := rules.NewEventWithAmount(rules.EventManaAdded, playerID, source.ID, playerID, amount)
+	event.Metadata["mana_type"] = string(manaType)
+	gameState.eventBus.Publish(event)
+
+	gameState.addMessage(fmt.Sprintf("%s adds %d %s mana with %s", playerID, amount, strings.ToLower(string(manaType)), source.Name), "action")
+}
+
+// manaTags returns the tags for mana produced by a source
+// Per rule 106.3: mana produced by a snow source is snow mana
+func manaTags(source *internalCard) []mana.ManaTag {
+	var tags []mana.ManaTag
+	if containsFold(source.SuperTypes, "Snow") || containsFold(strings.Fields(source.Type), "Snow") {
+		tags = append(tags, mana.TagSnow)
+	}
+	if containsFold(source.SubTypes, "Treasure") {
+		tags = append(tags, mana.TagTreasure)
+	}
+	return tags
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddMana adds mana that doesn't come from a permanent (an effect, or a test setting up a board)
+// to a player's mana pool
+func (e *MageEngine) AddMana(gameID, playerID string, manaType mana.ManaType, amount int) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "AddMana", playerID, manaType, amount)
+
+	player, exists := gameState.players[playerID]
+	if !exists {
+		return fmt.Errorf("player %s not found", playerID)
+	}
+	if amount <= 0 {
+		return fmt.Errorf("mana amount must be positive, got %d", amount)
+	}
+	player.ManaPool.Add(manaType, amount)
+
+	event := rules.NewEventWithAmount(rules.EventManaAdded, playerID, "", playerID, amount)
+	event.Metadata["mana_type"] = string(manaType)
+	gameState.eventBus.Publish(event)
+	return nil
+}
+
+// payLife pays life as a cost; a player who pays down to 0 life loses when state-based actions are next checked
+// Per rule 119.4: paying life is losing life
+func (e *MageEngine) payLife(gameState *engineGameState, player *internalPlayer, amount int) {
+	player.Life -= amount
+	gameState.eventBus.Publish(rules.NewEventWithAmount(rules.EventLostLife, player.PlayerID, "", player.PlayerID, amount))
+	gameState.eventBus.Publish(rules.NewEventWithAmount(rules.EventLifePaid, player.PlayerID, "", player.PlayerID, amount))
+	gameState.addMessage(fmt.Sprintf("%s pays %d life", player.PlayerID, amount), "action")
+}
+
+// emptyManaPools empties every player's mana pool as a step or phase ends (rule 500.4), unless the
+// engine retains mana between steps. Floating mana from effects that keep it is not lost. With mana
+// burn, a player loses 1 life for each mana lost.
+func (e *MageEngine) emptyManaPools(gameState *engineGameState) {
+	e.mu.RLock()
+	retain := e.retainManaBetweenSteps
+	e.mu.RUnlock()
+	if retain {
+		return
+	}
+
+	for _, playerID := range gameState.playerOrder {
+		player := gameState.players[playerID]
+		before := player.ManaPool.GetTotalMana()
+		player.ManaPool.Empty()
+		lost := before - player.ManaPool.GetTotalMana()
+		if lost <= 0 {
+			continue
+		}
+		gameState.addMessage(fmt.Sprintf("%s loses %d unused mana", playerID, lost), "action")
+		if gameState.rulesOptions.ManaBurn {
+			player.Life -= lost
+			gameState.eventBus.Publish(rules.NewEventWithAmount(rules.EventLostLife, playerID, "", playerID, lost))
+			gameState.addMessage(fmt.Sprintf("%s loses %d life to mana burn", playerID, lost), "action")
+		}
+	}
+}
+
+// PayManaCost pays a mana cost (e.g. "{1}{U}") from a player's mana pool
+func (e *MageEngine) PayManaCost(gameID, playerID, cost string) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "PayManaCost", playerID, cost)
+
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+
+	return e.payManaCost(gameState, playerID, cost)
+}
+
+// payManaCost pays a mana cost from a player's pool; nothing is spent if the cost can't be paid
+func (e *MageEngine) payManaCost(gameState *engineGameState, playerID, cost string) error {
+	return e.payManaCostWithX(gameState, playerID, cost, 0, nil)
+}
+
+// payManaCostWithX pays a mana cost with the given value for X, which adds that much generic mana.
+// payWithLife says for each phyrexian symbol, in order, whether it is paid with life instead of mana.
+func (e *MageEngine) payManaCostWithX(gameState *engineGameState, playerID, cost string, xValue int, payWithLife []bool) error {
+	player, exists := gameState.players[playerID]
+	if !exists {
+		return fmt.Errorf("player %s not found", playerID)
+	}
+
+	manaCost, err := mana.ParseCost(cost)
+	if err != nil {
+		return fmt.Errorf("invalid mana cost %s: %w", cost, err)
+	}
+	if len(payWithLife) > len(manaCost.Phyrexian) {
+		return fmt.Errorf("%s has %d phyrexian mana symbols, got %d payment choices", cost, len(manaCost.Phyrexian), len(payWithLife))
+	}
+	manaCost, life := manaCost.WithPhyrexianPaid(payWithLife)
+	// Per rule 119.4: a player can pay life only if their life total is at least the payment
+	if life > player.Life {
+		return fmt.Errorf("%s can't pay %d life with %d life", playerID, life, player.Life)
+	}
+	// Per rule 107.14: {E} is paid by removing an energy counter from the player
+	if manaCost.Energy > player.Energy {
+		return fmt.Errorf("%s can't pay %d energy with %d energy", playerID, manaCost.Energy, player.Energy)
+	}
+
+	result := mana.CalculatePayment(manaCost, player.ManaPool, xValue)
+	if !result.Success {
+		return fmt.Errorf("%s can't pay %s: %s", playerID, cost, result.Reason)
+	}
+	if !mana.ExecutePayment(result.Plan, player.ManaPool) {
+		return fmt.Errorf("%s can't pay %s", playerID, cost)
+	}
+	if life > 0 {
+		e.payLife(gameState, player, life)
+	}
+	if manaCost.Energy > 0 {
+		e.payEnergy(gameState, player, manaCost.Energy)
+	}
+
+	event := rules.NewEvent(rules.EventManaPaid, playerID, "", playerID)
+	event.Metadata["cost"] = cost
+	gameState.eventBus.Publish(event)
+	return nil
+}
@@ -0,0 +1,263 @@
+package game
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/mana"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestManaAbility_TreasureAnyColorPaysBluePip verifies that a Treasure prompts for a color, adds the
+// chosen mana to the pool and that the mana pays a blue pip
+func TestManaAbility_TreasureAnyColorPaysBluePip(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-treasure", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	gameState.cards["treasure"] = &internalCard{
+		ID:           "treasure",
+		Name:         "Treasure",
+		Type:         "Token Artifact",
+		SubTypes:     []string{"Treasure"},
+		Zone:         zoneBattlefield,
+		OwnerID:      "Alice",
+		ControllerID: "Alice",
+	}
+	gameState.mu.Unlock()
+
+	abilityID, err := h.engine.RegisterManaAbility(h.gameID, treasureManaAbility("treasure"))
+	if err != nil {
+		t.Fatalf("failed to register mana ability: %v", err)
+	}
+
+	if err := h.engine.ActivateManaAbility(h.gameID, "Bob", abilityID); err == nil {
+		t.Fatal("expected Bob to be unable to activate Alice's Treasure")
+	}
+	if err := h.engine.ActivateManaAbility(h.gameID, "Alice", abilityID); err != nil {
+		t.Fatalf("failed to activate Treasure: %v", err)
+	}
+
+	// The Treasure was sacrificed as a cost; no mana until the color is chosen
+	gameState.mu.RLock()
+	if gameState.cards["treasure"].Zone != zoneGraveyard {
+		t.Errorf("expected Treasure to be sacrificed, got zone %d", gameState.cards["treasure"].Zone)
+	}
+	if total := gameState.players["Alice"].ManaPool.GetTotalMana(); total != 0 {
+		t.Errorf("expected no mana before choosing a color, got %d", total)
+	}
+	gameState.mu.RUnlock()
+
+	decisions, err := h.engine.GetPendingDecisions(h.gameID, "Alice")
+	if err != nil {
+		t.Fatalf("failed to get decisions: %v", err)
+	}
+	if len(decisions) != 1 || decisions[0].Kind != DecisionChooseColor {
+		t.Fatalf("expected one choose_color decision, got %+v", decisions)
+	}
+
+	if err := h.engine.RespondToDecision(h.gameID, "Alice", decisions[0].ID, Response{Choices: []string{"PURPLE"}}); err == nil {
+		t.Fatal("expected an invalid color to be rejected")
+	}
+	if err := h.engine.RespondToDecision(h.gameID, "Alice", decisions[0].ID, Response{Choices: []string{string(mana.ManaBlue)}}); err != nil {
+		t.Fatalf("failed to choose blue: %v", err)
+	}
+
+	gameState.mu.RLock()
+	if blue := gameState.players["Alice"].ManaPool.GetTotal(mana.ManaBlue); blue != 1 {
+		t.Errorf("expected 1 blue mana in pool, got %d", blue)
+	}
+	gameState.mu.RUnlock()
+
+	if err := h.engine.PayManaCost(h.gameID, "Alice", "{U}"); err != nil {
+		t.Fatalf("failed to pay {U}: %v", err)
+	}
+	if err := h.engine.PayManaCost(h.gameID, "Alice", "{U}"); err == nil {
+		t.Error("expected the pool to be empty after paying {U}")
+	}
+}
+
+// TestManaAbility_TapCostRequiresUntappedNonSickSource verifies the {T} cost of mana abilities
+func TestManaAbility_TapCostRequiresUntappedNonSickSource(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-birds", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	birds := h.CreateCreature(CreatureSpec{ID: "birds", Name: "Birds of Paradise", Controller: "Alice", Power: "0", Toughness: "1"})
+	gameState.mu.Lock()
+	gameState.cards[birds].SummoningSickness = true
+	gameState.mu.Unlock()
+
+	abilityID, err := h.engine.RegisterManaAbility(h.gameID, anyColorManaAbility(birds))
+	if err != nil {
+		t.Fatalf("failed to register mana ability: %v", err)
+	}
+
+	if err := h.engine.ActivateManaAbility(h.gameID, "Alice", abilityID); err == nil {
+		t.Fatal("expected a summoning sick creature to be unable to tap for mana")
+	}
+
+	gameState.mu.Lock()
+	h.engine.clearSummoningSickness(gameState, "Alice")
+	gameState.mu.Unlock()
+
+	if err := h.engine.ActivateManaAbility(h.gameID, "Alice", abilityID); err != nil {
+		t.Fatalf("failed to activate Birds of Paradise: %v", err)
+	}
+	if err := h.engine.ActivateManaAbility(h.gameID, "Alice", abilityID); err == nil {
+		t.Error("expected a tapped source to be unable to activate again")
+	}
+
+	decisions, _ := h.engine.GetPendingDecisions(h.gameID, "Alice")
+	if len(decisions) != 1 {
+		t.Fatalf("expected one pending color choice, got %d", len(decisions))
+	}
+	if err := h.engine.RespondToDecision(h.gameID, "Alice", decisions[0].ID, Response{Choices: []string{string(mana.ManaGreen)}}); err != nil {
+		t.Fatalf("failed to choose green: %v", err)
+	}
+
+	// Green mana can't pay a blue pip, but pays generic mana
+	if err := h.engine.PayManaCost(h.gameID, "Alice", "{U}"); err == nil {
+		t.Error("expected green mana to be unable to pay {U}")
+	}
+	if err := h.engine.PayManaCost(h.gameID, "Alice", "{1}"); err != nil {
+		t.Errorf("failed to pay {1} with green mana: %v", err)
+	}
+}
+
+// TestManaAbility_SnowSourceProducesSnowMana verifies that mana from a snow permanent pays {S}
+// and mana from a non-snow permanent doesn't
+func TestManaAbility_SnowSourceProducesSnowMana(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-snow-mana", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	gameState.cards["snow-forest"] = &internalCard{ID: "snow-forest", Name: "Snow-Covered Forest", Type: "Land", SuperTypes: []string{"Basic", "Snow"}, SubTypes: []string{"Forest"}, Zone: zoneBattlefield, OwnerID: "Alice", ControllerID: "Alice"}
+	gameState.cards["forest"] = &internalCard{ID: "forest", Name: "Forest", Type: "Land", SuperTypes: []string{"Basic"}, SubTypes: []string{"Forest"}, Zone: zoneBattlefield, OwnerID: "Alice", ControllerID: "Alice"}
+	gameState.mu.Unlock()
+
+	snowAbility, err := h.engine.RegisterManaAbility(h.gameID, basicManaAbility("snow-forest", mana.ManaGreen))
+	if err != nil {
+		t.Fatalf("failed to register mana ability: %v", err)
+	}
+	forestAbility, err := h.engine.RegisterManaAbility(h.gameID, basicManaAbility("forest", mana.ManaGreen))
+	if err != nil {
+		t.Fatalf("failed to register mana ability: %v", err)
+	}
+
+	if err := h.engine.ActivateManaAbility(h.gameID, "Alice", forestAbility); err != nil {
+		t.Fatalf("failed to tap Forest: %v", err)
+	}
+	if err := h.engine.PayManaCost(h.gameID, "Alice", "{S}"); err == nil {
+		t.Fatal("expected mana from a non-snow Forest to be unable to pay {S}")
+	}
+
+	if err := h.engine.ActivateManaAbility(h.gameID, "Alice", snowAbility); err != nil {
+		t.Fatalf("failed to tap Snow-Covered Forest: %v", err)
+	}
+	if err := h.engine.PayManaCost(h.gameID, "Alice", "{S}{G}"); err != nil {
+		t.Fatalf("failed to pay {S}{G}: %v", err)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if total := gameState.players["Alice"].ManaPool.GetTotalMana(); total != 0 {
+		t.Errorf("expected an empty pool, got %d mana", total)
+	}
+}
+
+// TestTapForMana_PrintedAbilities verifies that a permanent's printed "{T}: Add ..." ability or basic
+// land type can be tapped for mana that pays costs, and that tapping fails for a summoning sick mana
+// creature, an already tapped permanent and a permanent without a mana ability
+func TestTapForMana_PrintedAbilities(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-tap-for-mana", []string{"Alice", "Bob"})
+	elves := h.CreateCreature(CreatureSpec{ID: "elves", Name: "Llanowar Elves", Power: "1", Toughness: "1", Controller: "Alice"})
+	bears := h.CreateCreature(CreatureSpec{ID: "bears", Name: "Grizzly Bears", Power: "2", Toughness: "2", Controller: "Alice"})
+	forest := h.CreateCreature(CreatureSpec{ID: "forest", Name: "Forest", Controller: "Alice"})
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.cards[elves].RulesText = "{T}: Add {G}."
+	gameState.cards[elves].SummoningSickness = true
+	gameState.cards[forest].Type = "Basic Land"
+	gameState.cards[forest].SubTypes = []string{"Forest"}
+	gameState.mu.Unlock()
+
+	if err := h.engine.TapForMana(h.gameID, elves, "Alice"); err == nil || !strings.Contains(err.Error(), "summoning sickness") {
+		t.Errorf("expected a summoning sick creature to be unable to tap for mana, got %v", err)
+	}
+	if err := h.engine.TapForMana(h.gameID, bears, "Alice"); err == nil || !strings.Contains(err.Error(), "no mana ability") {
+		t.Errorf("expected a creature without a mana ability to be rejected, got %v", err)
+	}
+
+	gameState.mu.Lock()
+	gameState.cards[elves].SummoningSickness = false
+	gameState.mu.Unlock()
+
+	if err := h.engine.TapForMana(h.gameID, elves, "Alice"); err != nil {
+		t.Fatalf("failed to tap Llanowar Elves for mana: %v", err)
+	}
+	if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Alice", ActionType: "TAP_FOR_MANA", Data: forest}); err != nil {
+		t.Fatalf("failed to tap Forest for mana: %v", err)
+	}
+	if err := h.engine.TapForMana(h.gameID, forest, "Alice"); err == nil || !strings.Contains(err.Error(), "already tapped") {
+		t.Errorf("expected a tapped land to be rejected, got %v", err)
+	}
+
+	gameState.mu.RLock()
+	if green := gameState.players["Alice"].ManaPool.GetTotal(mana.ManaGreen); green != 2 {
+		t.Errorf("expected 2 green mana in the pool, got %d", green)
+	}
+	gameState.mu.RUnlock()
+
+	if err := h.engine.PayManaCost(h.gameID, "Alice", "{1}{G}"); err != nil {
+		t.Errorf("expected the produced mana to pay {1}{G}: %v", err)
+	}
+}
+
+// TestManaPool_EmptiesBetweenSteps verifies that unused mana is lost when a step ends, with a message,
+// and that the engine can be configured to retain it for variant formats
+func TestManaPool_EmptiesBetweenSteps(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-mana-empties", []string{"Alice", "Bob"})
+	castTestSetup(t, h, "Alice", "unused", "Shock", "Instant", rules.StepMain1)
+	gameState := h.GetGameState()
+
+	endStep := func() {
+		t.Helper()
+		for _, playerID := range []string{"Alice", "Bob"} {
+			if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: playerID, ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+				t.Fatalf("failed to pass priority: %v", err)
+			}
+		}
+	}
+	poolTotal := func(playerID string) int {
+		gameState.mu.RLock()
+		defer gameState.mu.RUnlock()
+		return gameState.players[playerID].ManaPool.GetTotalMana()
+	}
+
+	if err := h.engine.AddMana(h.gameID, "Bob", mana.ManaBlue, 2); err != nil {
+		t.Fatalf("failed to add mana: %v", err)
+	}
+	endStep()
+	if alice, bob := poolTotal("Alice"), poolTotal("Bob"); alice != 0 || bob != 0 {
+		t.Errorf("expected both mana pools to empty, Alice has %d and Bob %d", alice, bob)
+	}
+	gameState.mu.RLock()
+	lostMessage := false
+	for _, message := range gameState.messages {
+		lostMessage = lostMessage || message.Text == "Bob loses 2 unused mana"
+	}
+	gameState.mu.RUnlock()
+	if !lostMessage {
+		t.Error("expected a message about Bob's lost mana")
+	}
+
+	h.engine.SetRetainManaBetweenSteps(true)
+	if err := h.engine.AddMana(h.gameID, "Alice", mana.ManaGreen, 1); err != nil {
+		t.Fatalf("failed to add mana: %v", err)
+	}
+	endStep()
+	if alice := poolTotal("Alice"); alice != 1 {
+		t.Errorf("expected Alice to retain her mana, got %d", alice)
+	}
+}
@@ -43,6 +43,12 @@ type PlayerAction struct {
 	ActionType string
 	Data       interface{}
 	Timestamp  time.Time
+	// Nonce identifies this submission of the action ("" = none). The engine rejects an action whose
+	// nonce it has already processed, so a duplicate submission (e.g. a double click) has no effect.
+	Nonce string
+	// Targets are the targets chosen for a spell cast with this action, in the order its rules text
+	// asks for them (rule 601.2c)
+	Targets []string
 }
 
 // Game represents a game instance
@@ -60,29 +66,50 @@ type Game struct {
 	Winner         string
 	ActionQueue    chan PlayerAction
 	Watchers       map[string]bool
-	mu             sync.RWMutex
+	RulesOptions   *RulesOptions // Options configured by the table (nil = the game type's defaults)
+	// reconnectTokens lets players rejoin after their session lapsed (playerID -> token)
+	reconnectTokens map[string]string
+	mu              sync.RWMutex
 }
 
 // NewGame creates a new game instance
 func NewGame(tableID, gameType string, players []string) *Game {
+	reconnectTokens := make(map[string]string, len(players))
+	for _, player := range players {
+		reconnectTokens[player] = uuid.New().String()
+	}
+
 	return &Game{
-		ID:          uuid.New().String(),
-		TableID:     tableID,
-		GameType:    gameType,
-		State:       GameStateStarting,
-		Players:     players,
-		Turn:        1,
-		StartTime:   time.Now(),
-		ActionQueue: make(chan PlayerAction, 100),
-		Watchers:    make(map[string]bool),
+		ID:              uuid.New().String(),
+		TableID:         tableID,
+		GameType:        gameType,
+		State:           GameStateStarting,
+		Players:         players,
+		Turn:            1,
+		StartTime:       time.Now(),
+		ActionQueue:     make(chan PlayerAction, 100),
+		Watchers:        make(map[string]bool),
+		reconnectTokens: reconnectTokens,
 	}
 }
 
+// ReconnectToken returns the token a player can rejoin the game with, independent of their session
+func (g *Game) ReconnectToken(playerName string) (string, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	token, ok := g.reconnectTokens[playerName]
+	return token, ok
+}
+
 // AddWatcher adds a watcher to the game
-func (g *Game) AddWatcher(playerName string) {
+func (g *Game) AddWatcher(playerName string) error {
 	g.mu.Lock()
 	defer g.mu.Unlock()
+	if g.RulesOptions != nil && !g.RulesOptions.SpectatorsAllowed {
+		return fmt.Errorf("spectators are not allowed in this game")
+	}
 	g.Watchers[playerName] = true
+	return nil
 }
 
 // RemoveWatcher removes a watcher from the game
@@ -136,37 +163,62 @@ func (g *Game) IsPlayer(playerName string) bool {
 	return false
 }
 
+// reconnectTarget is the game and player a reconnection token rejoins
+type reconnectTarget struct {
+	gameID   string
+	playerID string
+}
+
 // Manager manages game instances
 type Manager struct {
-	games        map[string]*Game
-	gamesByTable map[string]string // tableID -> gameID
-	mu           sync.RWMutex
-	logger       *zap.Logger
+	games           map[string]*Game
+	gamesByTable    map[string]string          // tableID -> gameID
+	reconnectTokens map[string]reconnectTarget // token -> game and player
+	mu              sync.RWMutex
+	logger          *zap.Logger
 }
 
 // NewManager creates a new game manager
 func NewManager(logger *zap.Logger) *Manager {
 	return &Manager{
-		games:        make(map[string]*Game),
-		gamesByTable: make(map[string]string),
-		logger:       logger,
+		games:           make(map[string]*Game),
+		gamesByTable:    make(map[string]string),
+		reconnectTokens: make(map[string]reconnectTarget),
+		logger:          logger,
 	}
 }
 
 // CreateGame creates a new game
 func (m *Manager) CreateGame(tableID, gameType string, players []string) *Game {
+	return m.createGame(NewGame(tableID, gameType, players))
+}
+
+// CreateGameWithOptions creates a new game played with the table's rules options
+func (m *Manager) CreateGameWithOptions(tableID, gameType string, players []string, options RulesOptions) (*Game, error) {
+	if err := options.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid rules options: %w", err)
+	}
+	game := NewGame(tableID, gameType, players)
+	game.RulesOptions = &options
+	return m.createGame(game), nil
+}
+
+// createGame registers a new game
+func (m *Manager) createGame(game *Game) *Game {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	game := NewGame(tableID, gameType, players)
 	m.games[game.ID] = game
-	m.gamesByTable[tableID] = game.ID
+	m.gamesByTable[game.TableID] = game.ID
+	for player, token := range game.reconnectTokens {
+		m.reconnectTokens[token] = reconnectTarget{gameID: game.ID, playerID: player}
+	}
 
 	m.logger.Info("game created",
 		zap.String("game_id", game.ID),
-		zap.String("table_id", tableID),
-		zap.String("game_type", gameType),
-		zap.Strings("players", players),
+		zap.String("table_id", game.TableID),
+		zap.String("game_type", game.GameType),
+		zap.Strings("players", game.Players),
 	)
 
 	return game
@@ -202,6 +254,9 @@ func (m *Manager) RemoveGame(gameID string) {
 
 	if game, ok := m.games[gameID]; ok {
 		delete(m.gamesByTable, game.TableID)
+		for _, token := range game.reconnectTokens {
+			delete(m.reconnectTokens, token)
+		}
 		close(game.ActionQueue)
 		delete(m.games, gameID)
 
@@ -209,6 +264,29 @@ func (m *Manager) RemoveGame(gameID string) {
 	}
 }
 
+// RedeemReconnectToken returns the game and player a reconnection token rejoins, and the token that
+// replaces it: a token can be used once, so a leaked one can't be replayed after its player rejoined.
+// Tokens expire when their game ends.
+func (m *Manager) RedeemReconnectToken(token string) (*Game, string, string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	target, ok := m.reconnectTokens[token]
+	game, exists := m.games[target.gameID]
+	if !ok || !exists || game.GetState() == GameStateFinished {
+		return nil, "", "", fmt.Errorf("invalid or expired reconnection token")
+	}
+
+	newToken := uuid.New().String()
+	game.mu.Lock()
+	game.reconnectTokens[target.playerID] = newToken
+	game.mu.Unlock()
+	delete(m.reconnectTokens, token)
+	m.reconnectTokens[newToken] = target
+
+	return game, target.playerID, newToken, nil
+}
+
 // ListGames returns all active games.
 func (m *Manager) ListGames() []*Game {
 	m.mu.RLock()
@@ -249,8 +327,9 @@ func (m *Manager) GetActiveGameCount() int {
 	return count
 }
 
-// SendPlayerAction sends a player action to a game
-func (m *Manager) SendPlayerAction(gameID, playerID, actionType string, data interface{}) error {
+// SendPlayerAction sends a player action to a game. nonce is the client's ID for this submission of the
+// action ("" = none), so the engine drops the action if the client submits it twice.
+func (m *Manager) SendPlayerAction(gameID, playerID, actionType, nonce string, data interface{}) error {
 	game, ok := m.GetGame(gameID)
 	if !ok {
 		return fmt.Errorf("game not found")
@@ -265,6 +344,7 @@ func (m *Manager) SendPlayerAction(gameID, playerID, actionType string, data int
 		ActionType: actionType,
 		Data:       data,
 		Timestamp:  time.Now(),
+		Nonce:      nonce,
 	}
 
 	select {
@@ -330,11 +410,20 @@ func (ea *EngineAdapter) ProcessGameActions(game *Game) {
 	}
 }
 
+// optionsEngine is implemented by engines that accept explicit rules options
+type optionsEngine interface {
+	StartGameWithOptions(gameID string, players []string, gameType string, options RulesOptions) error
+}
+
 // StartGame notifies the engine a game has started.
+// A game with table-configured rules options is started with them when the engine supports it.
 func (ea *EngineAdapter) StartGame(game *Game) error {
 	if ea == nil || ea.engine == nil || game == nil {
 		return nil
 	}
+	if withOptions, ok := ea.engine.(optionsEngine); ok && game.RulesOptions != nil {
+		return withOptions.StartGameWithOptions(game.ID, game.Players, game.GameType, *game.RulesOptions)
+	}
 	return ea.engine.StartGame(game.ID, game.Players, game.GameType)
 }
 
@@ -353,3 +442,39 @@ func (ea *EngineAdapter) GetGameView(gameID, playerID string) (interface{}, erro
 	}
 	return ea.engine.GetGameView(gameID, playerID)
 }
+
+// spectatorEngine is implemented by engines that can build a view of a game for spectators
+type spectatorEngine interface {
+	GetSpectatorView(gameID string) (*EngineGameView, error)
+}
+
+// GetSpectatorView retrieves a view of a game with no hidden information from the engine, for users
+// watching the game.
+func (ea *EngineAdapter) GetSpectatorView(gameID string) (interface{}, error) {
+	if ea == nil || ea.engine == nil {
+		return nil, nil
+	}
+	spectator, ok := ea.engine.(spectatorEngine)
+	if !ok {
+		return nil, fmt.Errorf("engine does not support spectator views")
+	}
+	return spectator.GetSpectatorView(gameID)
+}
+
+// viewDeltaEngine is implemented by engines that can send what changed in a view instead of all of it
+type viewDeltaEngine interface {
+	GetGameViewDelta(gameID, playerID string, sinceVersion int) (*EngineGameViewDelta, error)
+}
+
+// GetGameViewDelta retrieves what changed in a player's view of a game since the version of a view
+// they already have.
+func (ea *EngineAdapter) GetGameViewDelta(gameID, playerID string, sinceVersion int) (interface{}, error) {
+	if ea == nil || ea.engine == nil {
+		return nil, nil
+	}
+	deltas, ok := ea.engine.(viewDeltaEngine)
+	if !ok {
+		return nil, fmt.Errorf("engine does not support view deltas")
+	}
+	return deltas.GetGameViewDelta(gameID, playerID, sinceVersion)
+}
@@ -0,0 +1,135 @@
+package game
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+	"go.uber.org/zap"
+)
+
+// matchClock is a chess clock: each player has a time bank that runs down while they hold priority
+type matchClock struct {
+	banks      map[string]time.Duration // Player ID -> time left
+	playerID   string                   // Player whose bank is running ("" = stopped)
+	started    time.Time                // When playerID's bank started running
+	timer      *time.Timer
+	generation int // Incremented each time the clock starts running, so a stale timer does nothing
+}
+
+// StartMatchClock gives each player in a game a time bank of perPlayer. A player's bank runs down while
+// they have priority, except in the untap and upkeep steps, and they lose when it runs out (see
+// PlayerTimerTimeout). The clock stops while the game is paused.
+func (e *MageEngine) StartMatchClock(gameID string, perPlayer time.Duration) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+	if perPlayer <= 0 {
+		return fmt.Errorf("time bank must be positive, got %s", perPlayer)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "StartMatchClock", perPlayer)
+
+	if gameState.matchClock != nil {
+		return fmt.Errorf("game %s already has a match clock", gameID)
+	}
+	clock := &matchClock{banks: make(map[string]time.Duration, len(gameState.playerOrder))}
+	for _, playerID := range gameState.playerOrder {
+		clock.banks[playerID] = perPlayer
+	}
+	gameState.matchClock = clock
+	gameState.addMessage(fmt.Sprintf("Match clock started: %s per player", perPlayer), "system")
+	e.runMatchClock(gameState)
+	return nil
+}
+
+// runMatchClock charges the running bank for the time used, then runs the bank of the player with
+// priority, if the game has a match clock and that player's time should run now
+func (e *MageEngine) runMatchClock(gameState *engineGameState) {
+	clock := gameState.matchClock
+	if clock == nil {
+		return
+	}
+	e.stopMatchClock(gameState)
+
+	if gameState.state != GameStateInProgress {
+		return
+	}
+	// The untap and upkeep steps are played through automatically, so they use no one's time
+	if step := gameState.turnManager.CurrentStep(); step == rules.StepUntap || step == rules.StepUpkeep {
+		return
+	}
+	playerID := gameState.turnManager.PriorityPlayer()
+	bank, exists := clock.banks[playerID]
+	if !exists || bank <= 0 {
+		return
+	}
+
+	clock.generation++
+	generation := clock.generation
+	clock.playerID = playerID
+	clock.started = time.Now()
+	clock.timer = time.AfterFunc(bank, func() {
+		e.matchClockExpired(gameState, playerID, generation)
+	})
+}
+
+// stopMatchClock stops the running bank, if any, charging it for the time used
+func (e *MageEngine) stopMatchClock(gameState *engineGameState) {
+	clock := gameState.matchClock
+	if clock == nil || clock.timer == nil {
+		return
+	}
+	clock.timer.Stop()
+	clock.timer = nil
+	clock.banks[clock.playerID] = gameState.timeBankLeft(clock.playerID)
+	clock.playerID = ""
+}
+
+// matchClockExpired makes a player whose time bank ran out lose
+func (e *MageEngine) matchClockExpired(gameState *engineGameState, playerID string, generation int) {
+	gameState.mu.Lock()
+	clock := gameState.matchClock
+	if clock == nil || clock.generation != generation || clock.playerID != playerID {
+		gameState.mu.Unlock()
+		return
+	}
+	e.stopMatchClock(gameState)
+	clock.banks[playerID] = 0
+	gameState.mu.Unlock()
+
+	if err := e.PlayerTimerTimeout(gameState.gameID, playerID); err != nil && e.logger != nil {
+		e.logger.Warn("failed to time out player",
+			zap.String("game_id", gameState.gameID),
+			zap.String("player_id", playerID),
+			zap.Error(err),
+		)
+	}
+
+	gameState.mu.Lock()
+	e.runMatchClock(gameState)
+	gameState.mu.Unlock()
+}
+
+// timeBankLeft returns the time left in a player's bank, counting the time used if it's running
+// (0 = no match clock)
+func (s *engineGameState) timeBankLeft(playerID string) time.Duration {
+	clock := s.matchClock
+	if clock == nil {
+		return 0
+	}
+	left := clock.banks[playerID]
+	if clock.timer != nil && clock.playerID == playerID {
+		left -= time.Since(clock.started)
+	}
+	if left < 0 {
+		return 0
+	}
+	return left
+}
@@ -0,0 +1,88 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestMatchClock_RunsOnlyForPriorityPlayer verifies that only the priority player's bank runs, that it
+// stops during the upkeep and while paused, and that a player whose bank runs out loses
+func TestMatchClock_RunsOnlyForPriorityPlayer(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-match-clock", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	for gameState.turnManager.CurrentStep() != rules.StepUpkeep {
+		gameState.turnManager.AdvanceStep("Alice")
+	}
+	gameState.turnManager.SetPriority("Alice")
+	gameState.mu.Unlock()
+
+	if err := h.engine.StartMatchClock(h.gameID, time.Hour); err != nil {
+		t.Fatalf("failed to start match clock: %v", err)
+	}
+	if err := h.engine.StartMatchClock(h.gameID, time.Hour); err == nil {
+		t.Error("expected starting a second match clock to fail")
+	}
+	banks := func() map[string]time.Duration {
+		view, err := h.engine.GetGameView(h.gameID, "Alice")
+		if err != nil {
+			t.Fatalf("failed to get view: %v", err)
+		}
+		result := make(map[string]time.Duration)
+		for _, player := range view.(*EngineGameView).Players {
+			result[player.PlayerID] = player.TimeBank
+		}
+		return result
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	if bank := banks()["Alice"]; bank != time.Hour {
+		t.Errorf("expected Alice's bank not to run in the upkeep, got %s", bank)
+	}
+
+	gameState.mu.Lock()
+	gameState.turnManager.AdvanceStep("Alice")
+	gameState.turnManager.SetPriority("Alice")
+	h.engine.runMatchClock(gameState)
+	gameState.mu.Unlock()
+
+	time.Sleep(10 * time.Millisecond)
+	if got := banks(); got["Alice"] >= time.Hour || got["Bob"] != time.Hour {
+		t.Errorf("expected only Alice's bank to run, got %v", got)
+	}
+
+	if err := h.engine.PauseGame(h.gameID); err != nil {
+		t.Fatalf("failed to pause: %v", err)
+	}
+	paused := banks()["Alice"]
+	time.Sleep(10 * time.Millisecond)
+	if bank := banks()["Alice"]; bank != paused {
+		t.Errorf("expected Alice's bank to stop while paused, went from %s to %s", paused, bank)
+	}
+	if err := h.engine.ResumeGame(h.gameID); err != nil {
+		t.Fatalf("failed to resume: %v", err)
+	}
+
+	gameState.mu.Lock()
+	h.engine.stopMatchClock(gameState)
+	gameState.matchClock.banks["Alice"] = 20 * time.Millisecond
+	h.engine.runMatchClock(gameState)
+	gameState.mu.Unlock()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		gameState.mu.RLock()
+		timedOut := gameState.players["Alice"].TimerTimeout
+		gameState.mu.RUnlock()
+		if timedOut {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected Alice to lose when their bank ran out")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
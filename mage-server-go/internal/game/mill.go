@@ -0,0 +1,71 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// Mill has a player put the top n cards of their library into their graveyard, or their whole library
+// if it has fewer cards. Milling an empty library does nothing, and a player doesn't lose for having
+// milled their last card: only drawing from the empty library later does (rule 704.5b).
+// Per rule 701.13a and Java PlayerImpl.millCards()
+func (e *MageEngine) Mill(gameID, playerID string, n int) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "Mill", playerID, n)
+
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+	if n < 0 {
+		return fmt.Errorf("can't mill a negative number of cards, got %d", n)
+	}
+	player, exists := gameState.players[playerID]
+	if !exists {
+		return fmt.Errorf("player %s not found", playerID)
+	}
+	if player.Lost || player.Left {
+		return fmt.Errorf("player %s is no longer in the game", playerID)
+	}
+
+	milled, err := e.millCards(gameState, player, n)
+	if err != nil {
+		return err
+	}
+
+	e.notifyPlayerAction(gameID, playerID, map[string]interface{}{
+		"type":   "mill",
+		"amount": milled,
+	})
+	return nil
+}
+
+// millCards moves cards from the top of a player's library to their graveyard and returns the number
+// milled; caller must hold the game lock
+func (e *MageEngine) millCards(gameState *engineGameState, player *internalPlayer, n int) (int, error) {
+	gameState.eventBus.Publish(rules.NewEventWithAmount(rules.EventMillCards, player.PlayerID, "", player.PlayerID, n))
+
+	milled := 0
+	for milled < n && len(player.Library) > 0 {
+		card := player.Library[0]
+		if err := e.moveCard(gameState, card, zoneGraveyard, ""); err != nil {
+			return milled, fmt.Errorf("failed to mill %s: %w", card.Name, err)
+		}
+		milled++
+		gameState.eventBus.Publish(rules.NewEvent(rules.EventMilledCard, card.ID, card.ID, player.PlayerID))
+	}
+
+	if milled > 0 {
+		gameState.addMessage(fmt.Sprintf("%s mills %d card(s)", player.Name, milled), "action")
+	}
+	return milled, nil
+}
@@ -0,0 +1,66 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestMill_IntoEmptyLibrary verifies that milling more cards than a library holds mills the whole
+// library with a zone change for each card, and that the player only loses once they draw from the
+// empty library
+func TestMill_IntoEmptyLibrary(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-mill-empty-library", []string{"Alice", "Bob"})
+	ids := []string{"first", "second"}
+	for _, id := range ids {
+		h.CreateCreature(CreatureSpec{ID: id, Name: "Grizzly Bears", Power: "2", Toughness: "2", Controller: "Alice"})
+	}
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	alice := gameState.players["Alice"]
+	alice.Library = nil
+	for _, id := range ids {
+		card := gameState.cards[id]
+		card.Zone = zoneLibrary
+		alice.Library = append(alice.Library, card)
+	}
+	graveyardSize := len(alice.Graveyard)
+	zoneChanges := 0
+	gameState.eventBus.SubscribeTyped(rules.EventZoneChange, func(evt rules.Event) {
+		if evt.Zone == zoneGraveyard {
+			zoneChanges++
+		}
+	})
+	gameState.mu.Unlock()
+
+	if err := h.engine.Mill(h.gameID, "Alice", 3); err != nil {
+		t.Fatalf("failed to mill: %v", err)
+	}
+	if err := h.engine.Mill(h.gameID, "Alice", 1); err != nil {
+		t.Fatalf("failed to mill an empty library: %v", err)
+	}
+
+	gameState.mu.Lock()
+	if len(alice.Library) != 0 || len(alice.Graveyard) != graveyardSize+2 {
+		t.Errorf("expected the whole library milled, got %d in library and %d new in graveyard", len(alice.Library), len(alice.Graveyard)-graveyardSize)
+	}
+	if zoneChanges != 2 {
+		t.Errorf("expected a zone change for each milled card, got %d", zoneChanges)
+	}
+	h.engine.checkStateBasedActions(gameState)
+	if alice.Lost {
+		t.Fatal("expected milling the last card not to lose the game")
+	}
+	gameState.mu.Unlock()
+
+	if err := h.engine.DrawCard(h.gameID, "Alice", 1); err != nil {
+		t.Fatalf("failed to draw: %v", err)
+	}
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	h.engine.checkStateBasedActions(gameState)
+	if !alice.Lost {
+		t.Error("expected drawing from the milled-out library to lose the game")
+	}
+}
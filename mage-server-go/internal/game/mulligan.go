@@ -0,0 +1,325 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+	"go.uber.org/zap"
+)
+
+// mulliganStage is a step of the mulligan procedure
+type mulliganStage int
+
+const (
+	mulliganStageDeciding       mulliganStage = iota // Players keep or mulligan
+	mulliganStageAfterKeep                           // London bottoming or Vancouver scry
+	mulliganStageOpeningActions                      // Opening-hand actions (rule 103.6)
+	mulliganStageDone
+)
+
+// mulliganProcedure coordinates the mulligan procedure (rule 103.5) and the opening actions before turn 1.
+// Every round, each player who hasn't kept decides to keep or mulligan; the mulligans are taken
+// together once all of them have decided. After everyone has kept, each player who mulliganed puts
+// that many cards from their hand on the bottom of their library (London) or scries 1 (Vancouver).
+// Per Java LondonMulligan / VancouverMulligan executeMulliganPhase()
+type mulliganProcedure struct {
+	round     int
+	decisions map[string]bool // This round's decisions: true = mulligan, false = keep
+	stage     mulliganStage
+	pending   int // Unanswered decisions of the current stage
+}
+
+func newMulliganProcedure() *mulliganProcedure {
+	return &mulliganProcedure{
+		round:     1,
+		decisions: make(map[string]bool),
+		stage:     mulliganStageDeciding,
+	}
+}
+
+// decideMulligan records a player's keep or mulligan decision for the current round;
+// caller must hold the game lock
+func (e *MageEngine) decideMulligan(gameState *engineGameState, playerID string, mulligan bool) error {
+	if gameState.state != GameStateMulligan || gameState.mulligan == nil {
+		return fmt.Errorf("game is not in mulligan phase")
+	}
+
+	player, exists := gameState.players[playerID]
+	if !exists {
+		return fmt.Errorf("player %s not found", playerID)
+	}
+	if player.KeptHand {
+		return fmt.Errorf("player has already kept their hand")
+	}
+	if _, decided := gameState.mulligan.decisions[playerID]; decided {
+		return fmt.Errorf("player %s has already decided this round", playerID)
+	}
+
+	gameState.mulligan.decisions[playerID] = mulligan
+
+	for _, pid := range gameState.playerOrder {
+		if _, decided := gameState.mulligan.decisions[pid]; !decided && !gameState.players[pid].KeptHand {
+			return nil // Waiting for the other players
+		}
+	}
+
+	e.resolveMulliganRound(gameState)
+	return nil
+}
+
+// resolveMulliganRound takes this round's keeps and mulligans together, in turn order
+func (e *MageEngine) resolveMulliganRound(gameState *engineGameState) {
+	procedure := gameState.mulligan
+
+	for _, pid := range gameState.playerOrder {
+		mulligan, decided := procedure.decisions[pid]
+		if !decided {
+			continue
+		}
+		player := gameState.players[pid]
+		if mulligan {
+			e.mulliganHand(gameState, player)
+			continue
+		}
+
+		player.KeptHand = true
+		gameState.addMessage(fmt.Sprintf("%s keeps their hand", player.Name), "mulligan")
+
+		e.notifyGameStateChange(gameState.gameID, map[string]interface{}{
+			"type":      "player_keep_hand",
+			"player_id": pid,
+		})
+	}
+
+	procedure.decisions = make(map[string]bool)
+	procedure.round++
+
+	for _, pid := range gameState.playerOrder {
+		if !gameState.players[pid].KeptHand {
+			return
+		}
+	}
+	e.advanceMulligan(gameState)
+}
+
+// advanceMulligan starts the next stages of the procedure until one of them waits for decisions
+func (e *MageEngine) advanceMulligan(gameState *engineGameState) {
+	procedure := gameState.mulligan
+	for procedure.pending == 0 && procedure.stage != mulliganStageDone {
+		procedure.stage++
+		switch procedure.stage {
+		case mulliganStageAfterKeep:
+			if gameState.rulesOptions.MulliganRule == MulliganVancouver {
+				e.startVancouverScry(gameState)
+			} else {
+				e.startBottoming(gameState)
+			}
+		case mulliganStageOpeningActions:
+			e.startOpeningActions(gameState)
+		}
+	}
+}
+
+// addMulliganDecision adds a decision the current stage waits for
+func (e *MageEngine) addMulliganDecision(gameState *engineGameState, decision *Decision) {
+	resolve := decision.resolve
+	decision.resolve = func(gameState *engineGameState, response Response) error {
+		if err := resolve(gameState, response); err != nil {
+			return err
+		}
+		gameState.mulligan.pending--
+		e.advanceMulligan(gameState)
+		return nil
+	}
+	gameState.mulligan.pending++
+	gameState.addDecision(decision)
+}
+
+// mulliganHand shuffles a player's hand into their library and draws a new hand
+func (e *MageEngine) mulliganHand(gameState *engineGameState, player *internalPlayer) {
+	// Shuffle hand back into library
+	for _, card := range player.Hand {
+		card.Zone = zoneLibrary
+	}
+	player.Library = append(player.Library, player.Hand...)
+	player.Hand = make([]*internalCard, 0)
+	e.shuffleLibrary(gameState, player)
+
+	player.MulliganCount++
+
+	// London mulligan: draw a full hand; cards are put on the bottom after everyone keeps.
+	// Vancouver mulligan: draw one fewer card per mulligan.
+	handSize := gameState.rulesOptions.StartingHandSize
+	if gameState.rulesOptions.MulliganRule == MulliganVancouver {
+		handSize -= mulliganPenalty(gameState, player)
+	}
+	for i := 0; i < handSize && len(player.Library) > 0; i++ {
+		card := player.Library[0]
+		player.Library = player.Library[1:]
+		card.Zone = zoneHand
+		player.Hand = append(player.Hand, card)
+	}
+
+	gameState.addMessage(fmt.Sprintf("%s mulligans (%d)", player.Name, player.MulliganCount), "mulligan")
+
+	if e.logger != nil {
+		e.logger.Info("player mulliganed",
+			zap.String("game_id", gameState.gameID),
+			zap.String("player_id", player.PlayerID),
+			zap.Int("mulligan_count", player.MulliganCount),
+		)
+	}
+
+	e.notifyGameStateChange(gameState.gameID, map[string]interface{}{
+		"type":           "player_mulligan",
+		"player_id":      player.PlayerID,
+		"mulligan_count": player.MulliganCount,
+		"hand_size":      len(player.Hand),
+	})
+}
+
+// mulliganPenalty returns the number of mulligans that cost a card, not counting free mulligans
+func mulliganPenalty(gameState *engineGameState, player *internalPlayer) int {
+	penalty := player.MulliganCount - gameState.rulesOptions.FreeMulligans
+	if penalty < 0 {
+		return 0
+	}
+	return penalty
+}
+
+// startBottoming asks each player who mulliganed to choose the cards to put on the bottom
+// Per rule 103.5: this happens once all players have kept
+func (e *MageEngine) startBottoming(gameState *engineGameState) {
+	for _, pid := range gameState.playerOrder {
+		player := gameState.players[pid]
+		count := mulliganPenalty(gameState, player)
+		if count > len(player.Hand) {
+			count = len(player.Hand)
+		}
+		if count == 0 {
+			continue
+		}
+
+		choices := make([]string, 0, len(player.Hand))
+		for _, card := range player.Hand {
+			choices = append(choices, card.ID)
+		}
+
+		e.addMulliganDecision(gameState, &Decision{
+			PlayerID: pid,
+			Kind:     DecisionChooseCards,
+			Text:     fmt.Sprintf("Choose %d card(s) to put on the bottom of your library", count),
+			Choices:  choices,
+			Min:      count,
+			Max:      count,
+			resolve: func(gameState *engineGameState, response Response) error {
+				e.putOnBottom(gameState, player, response.Choices)
+				return nil
+			},
+		})
+	}
+}
+
+// putOnBottom moves cards from a player's hand to the bottom of their library, in the given order
+func (e *MageEngine) putOnBottom(gameState *engineGameState, player *internalPlayer, cardIDs []string) {
+	for _, cardID := range cardIDs {
+		card, exists := gameState.cards[cardID]
+		if !exists {
+			continue
+		}
+		player.Hand = e.removeCardFromSlice(player.Hand, cardID)
+		card.Zone = zoneLibrary
+		player.Library = append(player.Library, card)
+	}
+	gameState.addMessage(fmt.Sprintf("%s puts %d card(s) on the bottom of their library", player.Name, len(cardIDs)), "mulligan")
+}
+
+// startVancouverScry lets each player whose kept hand is smaller than their starting hand scry 1
+// Per rule 103.5 (Vancouver mulligan)
+func (e *MageEngine) startVancouverScry(gameState *engineGameState) {
+	for _, pid := range gameState.playerOrder {
+		player := gameState.players[pid]
+		if len(player.Hand) >= gameState.rulesOptions.StartingHandSize || len(player.Library) == 0 {
+			continue
+		}
+
+		top := player.Library[0]
+		e.addMulliganDecision(gameState, &Decision{
+			PlayerID: pid,
+			Kind:     DecisionChooseCards,
+			Text:     fmt.Sprintf("Scry 1: choose whether to put %s on the bottom of your library", top.Name),
+			Choices:  []string{top.ID},
+			Min:      0,
+			Max:      1,
+			resolve: func(gameState *engineGameState, response Response) error {
+				e.scryToBottom(gameState, player, response.Choices)
+				return nil
+			},
+		})
+	}
+}
+
+// scryToBottom finishes a scry, moving the chosen cards from the top of the library to the bottom
+func (e *MageEngine) scryToBottom(gameState *engineGameState, player *internalPlayer, cardIDs []string) {
+	for _, cardID := range cardIDs {
+		for i, card := range player.Library {
+			if card.ID == cardID {
+				player.Library = append(append(player.Library[:i:i], player.Library[i+1:]...), card)
+				break
+			}
+		}
+		gameState.eventBus.Publish(rules.NewEvent(rules.EventScryToBottom, cardID, "", player.PlayerID))
+	}
+	gameState.eventBus.Publish(rules.NewEventWithAmount(rules.EventScried, player.PlayerID, "", player.PlayerID, 1))
+	gameState.addMessage(fmt.Sprintf("%s scries 1 and puts %d card(s) on the bottom", player.Name, len(cardIDs)), "mulligan")
+}
+
+// startOpeningActions reveals companions and offers to begin the game with opening-hand permanents
+// (e.g. leylines) on the battlefield, in turn order
+// Per rule 103.6
+func (e *MageEngine) startOpeningActions(gameState *engineGameState) {
+	for _, pid := range gameState.playerOrder {
+		player := gameState.players[pid]
+
+		// Per rule 702.139a: a companion is revealed from outside the game before the game begins
+		for _, card := range gameState.command {
+			if card.OwnerID == pid && strings.Contains(strings.ToLower(card.RulesText), "companion") {
+				gameState.revealed = append(gameState.revealed, EngineRevealedView{
+					Name:  fmt.Sprintf("%s's companion", player.Name),
+					Cards: e.buildCardViews([]*internalCard{card}),
+				})
+				gameState.addMessage(fmt.Sprintf("%s reveals %s as their companion", player.Name, card.Name), "mulligan")
+			}
+		}
+
+		for _, card := range player.Hand {
+			if !beginsGameOnBattlefield(card) {
+				continue
+			}
+			card := card
+			e.addMulliganDecision(gameState, &Decision{
+				PlayerID: pid,
+				Kind:     DecisionYesNo,
+				Text:     fmt.Sprintf("Begin the game with %s on the battlefield?", card.Name),
+				resolve: func(gameState *engineGameState, response Response) error {
+					if !response.Yes || card.Zone != zoneHand {
+						return nil
+					}
+					if err := e.moveCard(gameState, card, zoneBattlefield, player.PlayerID); err != nil {
+						return err
+					}
+					gameState.addMessage(fmt.Sprintf("%s begins the game with %s on the battlefield", player.Name, card.Name), "mulligan")
+					return nil
+				},
+			})
+		}
+	}
+}
+
+// beginsGameOnBattlefield reports whether a card may begin the game on the battlefield from an opening
+// hand ("If this card is in your opening hand, you may begin the game with it on the battlefield.")
+func beginsGameOnBattlefield(card *internalCard) bool {
+	text := strings.ToLower(card.RulesText)
+	return strings.Contains(text, "opening hand") && strings.Contains(text, "begin the game with it on the battlefield")
+}
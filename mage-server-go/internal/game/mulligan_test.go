@@ -0,0 +1,245 @@
+package game
+
+import (
+	"fmt"
+	"testing"
+
+	"go.uber.org/zap/zaptest"
+)
+
+// TestMulligan_RoundsAndBottomingWaitForAllKeeps verifies that mulligans are taken together once every
+// player still deciding has decided, and that cards are put on the bottom only after everyone has kept
+func TestMulligan_RoundsAndBottomingWaitForAllKeeps(t *testing.T) {
+	engine := NewMageEngine(zaptest.NewLogger(t))
+	gameID := "test-mulligan-rounds"
+	players := []string{"Alice", "Bob", "Carol"}
+
+	if err := engine.StartGameWithOptions(gameID, players, "Free For All", DefaultRulesOptions()); err != nil {
+		t.Fatalf("failed to start game: %v", err)
+	}
+	if err := engine.StartMulligan(gameID); err != nil {
+		t.Fatalf("failed to start mulligan: %v", err)
+	}
+
+	engine.mu.RLock()
+	gameState := engine.games[gameID]
+	engine.mu.RUnlock()
+	alice, bob, carol := gameState.players["Alice"], gameState.players["Bob"], gameState.players["Carol"]
+
+	// Round 1: nothing happens until all three have decided
+	if err := engine.PlayerMulligan(gameID, "Alice"); err != nil {
+		t.Fatalf("failed to mulligan: %v", err)
+	}
+	if err := engine.PlayerMulligan(gameID, "Alice"); err == nil {
+		t.Error("expected a second decision in the same round to be rejected")
+	}
+	if err := engine.PlayerKeepHand(gameID, "Bob"); err != nil {
+		t.Fatalf("failed to keep hand: %v", err)
+	}
+	if alice.MulliganCount != 0 || bob.KeptHand {
+		t.Fatal("expected the round to wait for Carol's decision")
+	}
+	if err := engine.PlayerMulligan(gameID, "Carol"); err != nil {
+		t.Fatalf("failed to mulligan: %v", err)
+	}
+	if alice.MulliganCount != 1 || carol.MulliganCount != 1 || !bob.KeptHand {
+		t.Fatalf("expected round 1 to resolve: Alice %d, Carol %d mulligans, Bob kept=%v", alice.MulliganCount, carol.MulliganCount, bob.KeptHand)
+	}
+	if err := engine.PlayerMulligan(gameID, "Bob"); err == nil {
+		t.Error("expected a player who kept to be unable to mulligan")
+	}
+
+	// Round 2: Alice keeps, Carol mulligans again; Alice doesn't bottom while Carol is deciding
+	if err := engine.PlayerKeepHand(gameID, "Alice"); err != nil {
+		t.Fatalf("failed to keep hand: %v", err)
+	}
+	if err := engine.PlayerMulligan(gameID, "Carol"); err != nil {
+		t.Fatalf("failed to mulligan: %v", err)
+	}
+	if decisions, _ := engine.GetPendingDecisions(gameID, "Alice"); len(decisions) != 0 {
+		t.Fatalf("expected bottoming to wait until everyone has kept, got %d decisions", len(decisions))
+	}
+
+	// Round 3: Carol keeps, then Alice bottoms 1 card and Carol 2
+	if err := engine.PlayerKeepHand(gameID, "Carol"); err != nil {
+		t.Fatalf("failed to keep hand: %v", err)
+	}
+	if decisions, _ := engine.GetPendingDecisions(gameID, "Bob"); len(decisions) != 0 {
+		t.Errorf("expected Bob to have nothing to bottom, got %d decisions", len(decisions))
+	}
+	if err := engine.EndMulligan(gameID); err == nil {
+		t.Fatal("expected the mulligan phase to wait for bottoming")
+	}
+
+	for _, tc := range []struct {
+		player *internalPlayer
+		count  int
+	}{{alice, 1}, {carol, 2}} {
+		decisions, err := engine.GetPendingDecisions(gameID, tc.player.PlayerID)
+		if err != nil || len(decisions) != 1 || decisions[0].Kind != DecisionChooseCards || decisions[0].Min != tc.count {
+			t.Fatalf("expected %s to choose %d card(s) to bottom, got %+v (%v)", tc.player.PlayerID, tc.count, decisions, err)
+		}
+		bottomed := decisions[0].Choices[:tc.count]
+		if err := engine.RespondToDecision(gameID, tc.player.PlayerID, decisions[0].ID, Response{Choices: bottomed}); err != nil {
+			t.Fatalf("failed to bottom cards: %v", err)
+		}
+
+		if len(tc.player.Hand) != 7-tc.count {
+			t.Errorf("expected %s to keep %d cards, got %d", tc.player.PlayerID, 7-tc.count, len(tc.player.Hand))
+		}
+		bottom := tc.player.Library[len(tc.player.Library)-tc.count:]
+		for i, card := range bottom {
+			if card.ID != bottomed[i] || card.Zone != zoneLibrary {
+				t.Errorf("expected %s on the bottom of %s's library, got %s", bottomed[i], tc.player.PlayerID, card.ID)
+			}
+		}
+	}
+
+	if err := engine.EndMulligan(gameID); err != nil {
+		t.Fatalf("failed to end mulligan: %v", err)
+	}
+}
+
+// TestMulligan_VancouverScryAndLeylineBeforeTurnOne verifies the opening actions after everyone keeps:
+// a Vancouver mulligan offers exactly one scry, then a leyline in an opening hand can begin the game on
+// the battlefield
+func TestMulligan_VancouverScryAndLeylineBeforeTurnOne(t *testing.T) {
+	engine := NewMageEngine(zaptest.NewLogger(t))
+	gameID := "test-mulligan-vancouver"
+
+	options := DefaultRulesOptions()
+	options.MulliganRule = MulliganVancouver
+	if err := engine.StartGameWithOptions(gameID, []string{"Alice", "Bob"}, "Duel", options); err != nil {
+		t.Fatalf("failed to start game: %v", err)
+	}
+	if err := engine.StartMulligan(gameID); err != nil {
+		t.Fatalf("failed to start mulligan: %v", err)
+	}
+
+	engine.mu.RLock()
+	gameState := engine.games[gameID]
+	engine.mu.RUnlock()
+	alice, bob := gameState.players["Alice"], gameState.players["Bob"]
+
+	leyline := &internalCard{
+		ID:        "leyline",
+		Name:      "Leyline of the Void",
+		Type:      "Enchantment",
+		RulesText: "If Leyline of the Void is in your opening hand, you may begin the game with it on the battlefield.",
+		Zone:      zoneHand,
+		OwnerID:   "Bob",
+	}
+	gameState.cards[leyline.ID] = leyline
+	bob.Hand = append(bob.Hand, leyline)
+
+	// Alice mulligans to 6 and keeps; Bob keeps
+	for _, step := range []struct {
+		playerID string
+		mulligan bool
+	}{{"Alice", true}, {"Bob", false}, {"Alice", false}} {
+		decide := engine.PlayerKeepHand
+		if step.mulligan {
+			decide = engine.PlayerMulligan
+		}
+		if err := decide(gameID, step.playerID); err != nil {
+			t.Fatalf("failed mulligan decision for %s: %v", step.playerID, err)
+		}
+	}
+	if len(alice.Hand) != 6 {
+		t.Fatalf("expected a Vancouver mulligan to 6 cards, got %d", len(alice.Hand))
+	}
+
+	// Only Alice scries, once; the leyline waits for the scry
+	if decisions, _ := engine.GetPendingDecisions(gameID, "Bob"); len(decisions) != 0 {
+		t.Fatalf("expected Bob's opening actions to wait for the scry, got %d decisions", len(decisions))
+	}
+	decisions, err := engine.GetPendingDecisions(gameID, "Alice")
+	if err != nil || len(decisions) != 1 || decisions[0].Kind != DecisionChooseCards || len(decisions[0].Choices) != 1 {
+		t.Fatalf("expected exactly one scry 1 for Alice, got %+v (%v)", decisions, err)
+	}
+	top := alice.Library[0].ID
+	if err := engine.RespondToDecision(gameID, "Alice", decisions[0].ID, Response{Choices: []string{top}}); err != nil {
+		t.Fatalf("failed to scry: %v", err)
+	}
+	if alice.Library[len(alice.Library)-1].ID != top {
+		t.Error("expected the scried card on the bottom of Alice's library")
+	}
+	if decisions, _ := engine.GetPendingDecisions(gameID, "Alice"); len(decisions) != 0 {
+		t.Errorf("expected no further scry, got %d decisions", len(decisions))
+	}
+
+	if err := engine.EndMulligan(gameID); err == nil {
+		t.Fatal("expected the game to wait for opening actions")
+	}
+
+	decisions, err = engine.GetPendingDecisions(gameID, "Bob")
+	if err != nil || len(decisions) != 1 || decisions[0].Kind != DecisionYesNo {
+		t.Fatalf("expected Bob to be offered the leyline, got %+v (%v)", decisions, err)
+	}
+	if err := engine.RespondToDecision(gameID, "Bob", decisions[0].ID, Response{Yes: true}); err != nil {
+		t.Fatalf("failed to put the leyline onto the battlefield: %v", err)
+	}
+
+	if err := engine.EndMulligan(gameID); err != nil {
+		t.Fatalf("failed to end mulligan: %v", err)
+	}
+	if leyline.Zone != zoneBattlefield || leyline.ControllerID != "Bob" {
+		t.Errorf("expected the leyline on the battlefield under Bob's control, got zone %d", leyline.Zone)
+	}
+	if turn := gameState.turnManager.TurnNumber(); turn != 1 {
+		t.Errorf("expected the game to begin on turn 1, got %d", turn)
+	}
+}
+
+// TestMulligan_ShufflesHandIntoLibrary verifies that a mulligan really shuffles: across many games the
+// new hand is almost never the top of the library as it was before the mulligan, while a seeded shuffle
+// source makes the result reproducible
+func TestMulligan_ShufflesHandIntoLibrary(t *testing.T) {
+	mulliganOnce := func(engine *MageEngine, gameID string) (before, after []string) {
+		if err := engine.StartGame(gameID, []string{"Alice", "Bob"}, "Duel"); err != nil {
+			t.Fatalf("failed to start game: %v", err)
+		}
+		if err := engine.StartMulligan(gameID); err != nil {
+			t.Fatalf("failed to start mulligan: %v", err)
+		}
+		engine.mu.RLock()
+		alice := engine.games[gameID].players["Alice"]
+		engine.mu.RUnlock()
+
+		for _, card := range alice.Library[:7] {
+			before = append(before, card.ID)
+		}
+		if err := engine.PlayerMulligan(gameID, "Alice"); err != nil {
+			t.Fatalf("failed to mulligan: %v", err)
+		}
+		if err := engine.PlayerKeepHand(gameID, "Bob"); err != nil {
+			t.Fatalf("failed to keep hand: %v", err)
+		}
+		for _, card := range alice.Hand {
+			after = append(after, card.ID)
+		}
+		return before, after
+	}
+
+	engine := NewMageEngine(zaptest.NewLogger(t))
+	unshuffled := 0
+	for i := 0; i < 20; i++ {
+		before, after := mulliganOnce(engine, fmt.Sprintf("test-mulligan-shuffle-%d", i))
+		if fmt.Sprint(before) == fmt.Sprint(after) {
+			unshuffled++
+		}
+	}
+	if unshuffled > 1 {
+		t.Errorf("expected mulligans to shuffle the library, %d of 20 new hands were its previous top cards", unshuffled)
+	}
+
+	hands := make([]string, 0, 2)
+	for i := 0; i < 2; i++ {
+		seeded := NewMageEngine(zaptest.NewLogger(t)).WithSeed(RandomSeed{42})
+		_, after := mulliganOnce(seeded, "test-mulligan-seeded")
+		hands = append(hands, fmt.Sprint(after))
+	}
+	if hands[0] != hands[1] {
+		t.Errorf("expected the same seed to shuffle the same way, got %s and %s", hands[0], hands[1])
+	}
+}
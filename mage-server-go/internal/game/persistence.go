@@ -0,0 +1,693 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/magefree/mage-server-go/internal/game/counters"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+	"go.uber.org/zap"
+)
+
+// persistedGameVersion is the version of the saved game format; LoadGame rejects other versions
+const persistedGameVersion = 3
+
+// GameSaver stores a serialized in-progress game, e.g. in the games table of the repository,
+// replacing any earlier save of it
+type GameSaver func(gameID, gameType string, turnNumber int, data []byte) error
+
+// GameDeleter removes the save of a game that has ended
+type GameDeleter func(gameID string) error
+
+// persistedGame is the saved form of an in-progress game. Cards are saved once, in Cards; zones and
+// the stack refer to them by ID. Closures aren't saved: stack items are given their resolve functions
+// again from their kind and source when the game is loaded, and a game holding any other closure, or a
+// stack item that can't be given one, isn't saved at all (see unsavedState).
+type persistedGame struct {
+	Version         int
+	GameID          string
+	GameType        string
+	RulesOptions    RulesOptions
+	State           GameState
+	TurnNumber      int
+	Step            string
+	HasFirstStrike  bool
+	ActivePlayer    string
+	PriorityPlayer  string
+	PriorityPrompt  string // Text of the pending priority decision, asked again on load ("" = none)
+	PlayerOrder     []string
+	Players         []persistedPlayer
+	Cards           []persistedCard
+	Battlefield     []string
+	Exile           []string
+	Command         []string
+	Stack           []persistedStackItem // Bottom to top
+	Combat          persistedCombat
+	ManaAbilities   []manaAbility
+	Monarch         string
+	Messages        []EngineMessage
+	DroppedMessages int
+	ActionSequence  int
+	RandomSeed      RandomSeed
+	RandomState     []byte // Where the random source is in its sequence, to continue it on load
+}
+
+// persistedPlayer is a player with their zones saved as card IDs, from the top of the library
+type persistedPlayer struct {
+	internalPlayer
+	Library   []string
+	Hand      []string
+	Graveyard []string
+}
+
+// persistedCard is a card with its counters saved by name
+type persistedCard struct {
+	internalCard
+	Counters map[string]int
+	Layered  bool
+}
+
+// persistedStackItem is a spell or ability on the stack without its resolve function
+type persistedStackItem struct {
+	ID          string
+	Controller  string
+	Description string
+	Kind        rules.StackItemKind
+	SourceID    string
+	Metadata    map[string]string
+}
+
+// persistedCombat is the combat in progress: its groups and the creatures that took part
+type persistedCombat struct {
+	AttackingPlayerID    string
+	Groups               []persistedCombatGroup
+	Defenders            []string
+	AttackersTapped      []string
+	FirstStrikers        []string
+	AttackerDamageOrders map[string][]string
+}
+
+// persistedCombatGroup is a combat group: attackers, the defender they attack and their blockers
+type persistedCombatGroup struct {
+	DefenderID                string
+	DefenderIsPermanent       bool
+	DefendingPlayerID         string
+	Attackers                 []string
+	FormerAttackers           []string
+	Blockers                  []string
+	Blocked                   bool
+	BlockerOrder              map[string]int
+	AttackerDamageAssignments map[string]map[string]int
+	BlockerDamageAssignments  map[string]map[string]int
+}
+
+// SetGameSaver sets where in-progress games are saved at the start of each turn, so they can be
+// loaded with LoadGame after a crash, and where their saves are deleted once they end (nil = games
+// aren't saved). Saves are written in the background; see FlushSavedGames.
+func (e *MageEngine) SetGameSaver(saver GameSaver, deleter GameDeleter) {
+	e.storeMu.Lock()
+	defer e.storeMu.Unlock()
+	if saver == nil {
+		e.gameWriter = nil
+		return
+	}
+	e.gameWriter = newGameWriter(saver, deleter, e.logger)
+}
+
+// FlushSavedGames waits until the saves and deletes of games queued so far are written, e.g. before
+// the server shuts down
+func (e *MageEngine) FlushSavedGames() {
+	e.storeMu.Lock()
+	writer := e.gameWriter
+	e.storeMu.Unlock()
+
+	if writer != nil {
+		writer.flush()
+	}
+}
+
+// SerializeGame saves an in-progress game as JSON. A game holding state that can't be saved, such as a
+// pending decision other than priority or an ability registered with code, isn't serialized; activated
+// abilities on the stack do nothing when a loaded game resolves them.
+func (e *MageEngine) SerializeGame(gameID string) ([]byte, error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+
+	return e.serializeGame(gameState)
+}
+
+// unsavedState lists what a game holds that a saved game can't restore: closures registered with it,
+// stack items whose resolve function can't be rebuilt, decisions waiting on their answer, and the timers
+// of clocks and votes
+func (e *MageEngine) unsavedState(gameState *engineGameState) []string {
+	unsaved := make([]string, 0)
+	for _, item := range gameState.stack.List() {
+		if !e.restorable(gameState, item) {
+			unsaved = append(unsaved, "stack items that can't be restored")
+			break
+		}
+	}
+	pending := len(gameState.decisions)
+	if _, ok := gameState.decisions[gameState.priorityDecisionID]; ok {
+		pending--
+	}
+	if pending > 0 {
+		unsaved = append(unsaved, "pending decisions")
+	}
+	if len(gameState.triggeredQueue) > 0 {
+		unsaved = append(unsaved, "triggered abilities waiting for the stack")
+	}
+	if gameState.mulligan != nil {
+		unsaved = append(unsaved, "a mulligan")
+	}
+	if len(gameState.activatedAbilities) > 0 || len(gameState.staticAbilities) > 0 {
+		unsaved = append(unsaved, "registered abilities")
+	}
+	if len(gameState.layerSystem.AllEffects()) > 0 {
+		unsaved = append(unsaved, "continuous effects")
+	}
+	if len(gameState.spellEffects) > 0 || len(gameState.spellCosts) > 0 {
+		unsaved = append(unsaved, "spell effects")
+	}
+	if len(gameState.combatTriggers) > 0 {
+		unsaved = append(unsaved, "combat triggers")
+	}
+	if len(gameState.castPermissions) > 0 {
+		unsaved = append(unsaved, "cast permissions")
+	}
+	if gameState.priorityClock != nil || gameState.matchClock != nil {
+		unsaved = append(unsaved, "a clock")
+	}
+	if gameState.drawProposal != nil || gameState.rollbackVote != nil {
+		unsaved = append(unsaved, "a vote")
+	}
+	return unsaved
+}
+
+// serializeGame saves a game as JSON; the caller holds the game's lock
+func (e *MageEngine) serializeGame(gameState *engineGameState) ([]byte, error) {
+	if unsaved := e.unsavedState(gameState); len(unsaved) > 0 {
+		return nil, fmt.Errorf("game %s can't be saved: it has %s", gameState.gameID, strings.Join(unsaved, ", "))
+	}
+
+	tm := gameState.turnManager
+	saved := persistedGame{
+		Version:         persistedGameVersion,
+		GameID:          gameState.gameID,
+		GameType:        gameState.gameType,
+		RulesOptions:    gameState.rulesOptions,
+		State:           gameState.state,
+		TurnNumber:      tm.TurnNumber(),
+		Step:            tm.CurrentStep().String(),
+		HasFirstStrike:  tm.HasFirstStrike(),
+		ActivePlayer:    tm.ActivePlayer(),
+		PriorityPlayer:  tm.PriorityPlayer(),
+		PlayerOrder:     append([]string(nil), gameState.playerOrder...),
+		Battlefield:     cardIDs(gameState.battlefield),
+		Exile:           cardIDs(gameState.exile),
+		Command:         cardIDs(gameState.command),
+		Monarch:         gameState.monarchID,
+		Messages:        append([]EngineMessage(nil), gameState.messages...),
+		DroppedMessages: gameState.droppedMessages,
+		ActionSequence:  gameState.actionSequence,
+		RandomSeed:      gameState.random.seed,
+	}
+	if decision, ok := gameState.decisions[gameState.priorityDecisionID]; ok {
+		saved.PriorityPrompt = decision.Text
+	}
+	for _, ability := range gameState.manaAbilities {
+		saved.ManaAbilities = append(saved.ManaAbilities, *ability)
+	}
+	randomState, err := gameState.random.state()
+	if err != nil {
+		return nil, err
+	}
+	saved.RandomState = randomState
+
+	for _, playerID := range gameState.playerOrder {
+		player := gameState.players[playerID]
+		saved.Players = append(saved.Players, persistedPlayer{
+			internalPlayer: *player,
+			Library:        cardIDs(player.Library),
+			Hand:           cardIDs(player.Hand),
+			Graveyard:      cardIDs(player.Graveyard),
+		})
+	}
+
+	ids := make([]string, 0, len(gameState.cards))
+	for id := range gameState.cards {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		card := gameState.cards[id]
+		savedCard := persistedCard{internalCard: *card, Layered: card.layered}
+		if card.Counters != nil {
+			savedCard.Counters = make(map[string]int)
+			for name, counter := range card.Counters.GetAll() {
+				savedCard.Counters[name] = counter.Count
+			}
+		}
+		saved.Cards = append(saved.Cards, savedCard)
+	}
+
+	for _, item := range gameState.stack.List() {
+		saved.Stack = append(saved.Stack, persistedStackItem{
+			ID:          item.ID,
+			Controller:  item.Controller,
+			Description: item.Description,
+			Kind:        item.Kind,
+			SourceID:    item.SourceID,
+			Metadata:    item.Metadata,
+		})
+	}
+
+	if combat := gameState.combat; combat != nil {
+		saved.Combat = persistedCombat{
+			AttackingPlayerID:    combat.attackingPlayerID,
+			Defenders:            sortedKeys(combat.defenders),
+			AttackersTapped:      sortedKeys(combat.attackersTapped),
+			FirstStrikers:        sortedKeys(combat.firstStrikers),
+			AttackerDamageOrders: combat.attackerDamageOrders,
+		}
+		for _, group := range combat.groups {
+			saved.Combat.Groups = append(saved.Combat.Groups, persistedCombatGroup{
+				DefenderID:                group.defenderID,
+				DefenderIsPermanent:       group.defenderIsPermanent,
+				DefendingPlayerID:         group.defendingPlayerID,
+				Attackers:                 group.attackers,
+				FormerAttackers:           group.formerAttackers,
+				Blockers:                  group.blockers,
+				Blocked:                   group.blocked,
+				BlockerOrder:              group.blockerOrder,
+				AttackerDamageAssignments: group.attackerDamageAssignments,
+				BlockerDamageAssignments:  group.blockerDamageAssignments,
+			})
+		}
+	}
+
+	return json.Marshal(saved)
+}
+
+// LoadGame restores a game saved by SerializeGame, e.g. after a server restart, and returns its ID.
+// The game continues from the point it was saved; a game with that ID must not already be running.
+func (e *MageEngine) LoadGame(data []byte) (string, error) {
+	var saved persistedGame
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return "", fmt.Errorf("failed to decode saved game: %w", err)
+	}
+	if saved.Version != persistedGameVersion {
+		return "", fmt.Errorf("unsupported saved game version %d", saved.Version)
+	}
+	if saved.GameID == "" {
+		return "", fmt.Errorf("saved game has no game ID")
+	}
+
+	gameState := newEngineGameState(saved.GameID, saved.GameType, saved.RulesOptions)
+	gameState.state = saved.State
+	gameState.monarchID = saved.Monarch
+	gameState.messages = append(gameState.messages, saved.Messages...)
+	gameState.droppedMessages = saved.DroppedMessages
+	gameState.actionSequence = saved.ActionSequence
+	random, err := restoreGameRandom(saved.RandomSeed, saved.RandomState)
+	if err != nil {
+		return "", err
+	}
+	gameState.random = random
+
+	for i := range saved.Cards {
+		savedCard := &saved.Cards[i]
+		card := savedCard.internalCard
+		card.layered = savedCard.Layered
+		card.Counters = counters.NewCounters()
+		for name, count := range savedCard.Counters {
+			card.Counters.AddCounter(counters.NewCounter(name, count))
+		}
+		gameState.cards[card.ID] = &card
+	}
+
+	lookup := func(ids []string) []*internalCard {
+		cards := make([]*internalCard, 0, len(ids))
+		for _, id := range ids {
+			card, found := gameState.cards[id]
+			if !found {
+				if err == nil {
+					err = fmt.Errorf("saved game refers to unknown card %s", id)
+				}
+				continue
+			}
+			cards = append(cards, card)
+		}
+		return cards
+	}
+
+	for i := range saved.Players {
+		savedPlayer := &saved.Players[i]
+		player := savedPlayer.internalPlayer
+		player.Library = lookup(savedPlayer.Library)
+		player.Hand = lookup(savedPlayer.Hand)
+		player.Graveyard = lookup(savedPlayer.Graveyard)
+		gameState.players[player.PlayerID] = &player
+	}
+	for _, playerID := range saved.PlayerOrder {
+		if _, exists := gameState.players[playerID]; !exists {
+			return "", fmt.Errorf("saved game has no player %s", playerID)
+		}
+		gameState.playerOrder = append(gameState.playerOrder, playerID)
+	}
+	gameState.battlefield = lookup(saved.Battlefield)
+	gameState.exile = lookup(saved.Exile)
+	gameState.command = lookup(saved.Command)
+	if err != nil {
+		return "", err
+	}
+
+	gameState.turnManager, err = rules.RestoreTurnManager(saved.TurnNumber, saved.Step, saved.HasFirstStrike, saved.ActivePlayer, saved.PriorityPlayer)
+	if err != nil {
+		return "", fmt.Errorf("failed to restore turn: %w", err)
+	}
+	restoreCombat(gameState, saved.Combat)
+	e.initGameSystems(gameState)
+	for i := range saved.ManaAbilities {
+		gameState.manaAbilities = append(gameState.manaAbilities, &saved.ManaAbilities[i])
+	}
+	if saved.PriorityPrompt != "" {
+		e.requestPriorityDecision(gameState, saved.PriorityPlayer, saved.PriorityPrompt)
+	}
+
+	for _, savedItem := range saved.Stack {
+		item := rules.StackItem{
+			ID:          savedItem.ID,
+			Controller:  savedItem.Controller,
+			Description: savedItem.Description,
+			Kind:        savedItem.Kind,
+			SourceID:    savedItem.SourceID,
+			Metadata:    savedItem.Metadata,
+		}
+		if item.Metadata == nil {
+			item.Metadata = make(map[string]string)
+		}
+		item.Resolve = e.restoredResolver(gameState, item)
+		gameState.stack.Push(item)
+	}
+	gameState.updateSplitSecond()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, exists := e.games[saved.GameID]; exists {
+		return "", fmt.Errorf("game %s already exists", saved.GameID)
+	}
+	e.games[saved.GameID] = gameState
+
+	if e.logger != nil {
+		e.logger.Info("game loaded",
+			zap.String("game_id", saved.GameID),
+			zap.Int("turn", saved.TurnNumber),
+			zap.String("step", saved.Step),
+		)
+	}
+	return saved.GameID, nil
+}
+
+// restorable reports whether a stack item can be given its resolve function again when its game is
+// loaded (see restoredResolver). Activated abilities and triggers built by the engine rather than
+// registered for a card can't.
+func (e *MageEngine) restorable(gameState *engineGameState, item rules.StackItem) bool {
+	switch item.Kind {
+	case rules.StackItemKindSpell:
+		return true
+	case rules.StackItemKindTriggered:
+		return e.restoredTriggeredAbility(gameState, item) != nil
+	default:
+		return false
+	}
+}
+
+// restoredResolver gives a loaded stack item its resolve function again. Spells resolve from their
+// card; triggered abilities from the registered trigger of their source with the same description.
+// Games with other items aren't saved; an item whose trigger is no longer registered when the game is
+// loaded does nothing as it resolves.
+func (e *MageEngine) restoredResolver(gameState *engineGameState, item rules.StackItem) func() error {
+	switch item.Kind {
+	case rules.StackItemKindSpell:
+		return e.spellResolver(gameState, item.SourceID)
+	case rules.StackItemKindTriggered:
+		if ability := e.restoredTriggeredAbility(gameState, item); ability != nil {
+			return e.triggeredAbilityResolver(gameState, ability)
+		}
+	}
+
+	if e.logger != nil {
+		e.logger.Warn("stack item of loaded game can't be resolved",
+			zap.String("game_id", gameState.gameID),
+			zap.String("item_id", item.ID),
+			zap.String("description", item.Description),
+		)
+	}
+	return func() error {
+		gameState.addMessage(fmt.Sprintf("%s does nothing: it couldn't be restored", item.Description), "action")
+		return nil
+	}
+}
+
+// restoredTriggeredAbility finds the registered card trigger a loaded triggered ability was created
+// from (see queueCardTriggers); nil if there is none
+func (e *MageEngine) restoredTriggeredAbility(gameState *engineGameState, item rules.StackItem) *triggeredAbilityQueueItem {
+	source, exists := gameState.cards[item.SourceID]
+	if !exists {
+		return nil
+	}
+
+	e.triggersMu.RLock()
+	defs := e.cardTriggers[strings.ToLower(source.Name)]
+	e.triggersMu.RUnlock()
+
+	for _, def := range defs {
+		if fmt.Sprintf("%s: %s", source.Name, def.Description) != item.Description {
+			continue
+		}
+		def := def
+		sourceID, controllerID := item.SourceID, item.Controller
+		return &triggeredAbilityQueueItem{
+			ID:          item.ID,
+			SourceID:    sourceID,
+			Controller:  controllerID,
+			Description: item.Description,
+			UsesStack:   true,
+			Optional:    def.Optional,
+			Resolve: func(gs *engineGameState) error {
+				return def.Effect(gs, sourceID, controllerID)
+			},
+		}
+	}
+	return nil
+}
+
+// restoreCombat rebuilds a loaded game's combat groups and the lookups derived from them
+func restoreCombat(gameState *engineGameState, saved persistedCombat) {
+	combat := newCombatState()
+	combat.attackingPlayerID = saved.AttackingPlayerID
+	for _, id := range saved.Defenders {
+		combat.defenders[id] = true
+	}
+	for _, id := range saved.AttackersTapped {
+		combat.attackersTapped[id] = true
+	}
+	for _, id := range saved.FirstStrikers {
+		combat.firstStrikers[id] = true
+	}
+	for blockerID, order := range saved.AttackerDamageOrders {
+		combat.attackerDamageOrders[blockerID] = order
+	}
+
+	for _, savedGroup := range saved.Groups {
+		group := newCombatGroup(savedGroup.DefenderID, savedGroup.DefenderIsPermanent, savedGroup.DefendingPlayerID)
+		group.attackers = append(group.attackers, savedGroup.Attackers...)
+		group.formerAttackers = append(group.formerAttackers, savedGroup.FormerAttackers...)
+		group.blockers = append(group.blockers, savedGroup.Blockers...)
+		group.blocked = savedGroup.Blocked
+		for id, order := range savedGroup.BlockerOrder {
+			group.blockerOrder[id] = order
+		}
+		for id, damage := range savedGroup.AttackerDamageAssignments {
+			group.attackerDamageAssignments[id] = damage
+		}
+		for id, damage := range savedGroup.BlockerDamageAssignments {
+			group.blockerDamageAssignments[id] = damage
+		}
+
+		for _, attackerID := range group.attackers {
+			combat.attackers[attackerID] = true
+		}
+		for _, blockerID := range group.blockers {
+			combat.blockers[blockerID] = true
+			combat.blockingGroups[blockerID] = group
+		}
+		combat.groups = append(combat.groups, group)
+	}
+	gameState.combat = combat
+}
+
+// cardIDs returns the IDs of cards, in order
+func cardIDs(cards []*internalCard) []string {
+	ids := make([]string, 0, len(cards))
+	for _, card := range cards {
+		ids = append(ids, card.ID)
+	}
+	return ids
+}
+
+// sortedKeys returns the keys of a set that are true, sorted
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key, member := range set {
+		if member {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// saveGame queues a save of an in-progress game with the engine's game saver, if there is one; the
+// caller holds the game's lock. The game is serialized now and written in the background, so the game
+// loop doesn't wait on the database. A failed save is logged and doesn't stop the game.
+func (e *MageEngine) saveGame(gameState *engineGameState, turnNumber int) {
+	e.storeMu.Lock()
+	writer := e.gameWriter
+	e.storeMu.Unlock()
+
+	if writer == nil {
+		return
+	}
+
+	data, err := e.serializeGame(gameState)
+	if err != nil {
+		if e.logger != nil {
+			e.logger.Warn("game not saved",
+				zap.String("game_id", gameState.gameID),
+				zap.Int("turn", turnNumber),
+				zap.Error(err),
+			)
+		}
+		return
+	}
+	writer.queue(gameState.gameID, gameWrite{gameType: gameState.gameType, turnNumber: turnNumber, data: data})
+}
+
+// deleteSavedGame queues the delete of an ended game's save, if games are saved
+func (e *MageEngine) deleteSavedGame(gameID string) {
+	e.storeMu.Lock()
+	writer := e.gameWriter
+	e.storeMu.Unlock()
+
+	if writer != nil {
+		writer.queue(gameID, gameWrite{})
+	}
+}
+
+// gameWrite is a save of a game waiting to be written, or its delete if data is nil
+type gameWrite struct {
+	gameType   string
+	turnNumber int
+	data       []byte
+}
+
+// gameWriter writes saves and deletes of games in the background, one at a time and in the order they
+// were queued. A game with a write already waiting only keeps the latest one, so a slow database
+// doesn't build up a backlog of stale saves.
+type gameWriter struct {
+	saver   GameSaver
+	deleter GameDeleter
+	logger  *zap.Logger
+
+	mu      sync.Mutex
+	idle    *sync.Cond           // Broadcast when the last queued write is done
+	pending map[string]gameWrite // Game ID -> write waiting to be made
+	order   []string             // Games with a write waiting, in the order they were queued
+	running bool                 // A goroutine is making the waiting writes
+}
+
+func newGameWriter(saver GameSaver, deleter GameDeleter, logger *zap.Logger) *gameWriter {
+	w := &gameWriter{
+		saver:   saver,
+		deleter: deleter,
+		logger:  logger,
+		pending: make(map[string]gameWrite),
+	}
+	w.idle = sync.NewCond(&w.mu)
+	return w
+}
+
+// queue adds a write of a game, replacing the one waiting for it if there is one
+func (w *gameWriter) queue(gameID string, write gameWrite) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if _, waiting := w.pending[gameID]; !waiting {
+		w.order = append(w.order, gameID)
+	}
+	w.pending[gameID] = write
+	if !w.running {
+		w.running = true
+		go w.run()
+	}
+}
+
+// run makes the waiting writes until there are none left
+func (w *gameWriter) run() {
+	w.mu.Lock()
+	for len(w.order) > 0 {
+		gameID := w.order[0]
+		w.order = w.order[1:]
+		write := w.pending[gameID]
+		delete(w.pending, gameID)
+
+		w.mu.Unlock()
+		w.write(gameID, write)
+		w.mu.Lock()
+	}
+	w.running = false
+	w.idle.Broadcast()
+	w.mu.Unlock()
+}
+
+// write makes one save or delete, logging it if it fails
+func (w *gameWriter) write(gameID string, write gameWrite) {
+	var err error
+	if write.data == nil {
+		if w.deleter != nil {
+			err = w.deleter(gameID)
+		}
+	} else {
+		err = w.saver(gameID, write.gameType, write.turnNumber, write.data)
+	}
+	if err != nil && w.logger != nil {
+		w.logger.Error("failed to write saved game",
+			zap.String("game_id", gameID),
+			zap.Int("turn", write.turnNumber),
+			zap.Bool("delete", write.data == nil),
+			zap.Error(err),
+		)
+	}
+}
+
+// flush waits until no write is waiting or being made
+func (w *gameWriter) flush() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for w.running {
+		w.idle.Wait()
+	}
+}
@@ -0,0 +1,191 @@
+package game
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/counters"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestSerializeGame_RoundTrip verifies that a saved game loads in another engine with the same turn,
+// players, zones, counters and combat
+func TestSerializeGame_RoundTrip(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-persist", []string{"Alice", "Bob"})
+	h.CreateAttacker("bears", "Grizzly Bears", "Alice", "2", "2")
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.cards["bears"].Counters.AddCounter(counters.NewCounter("+1/+1", 2))
+	gameState.players["Bob"].Life = 13
+	gameState.players["Alice"].Energy = 3
+	alice := gameState.players["Alice"]
+	discarded := alice.Hand[0]
+	alice.Hand = alice.Hand[1:]
+	discarded.Zone = zoneGraveyard
+	alice.Graveyard = append(alice.Graveyard, discarded)
+	group := newCombatGroup("Bob", false, "Bob")
+	group.attackers = append(group.attackers, "bears")
+	gameState.combat.attackingPlayerID = "Alice"
+	gameState.combat.groups = append(gameState.combat.groups, group)
+	gameState.combat.attackers["bears"] = true
+	turn := gameState.turnManager.TurnNumber()
+	step := gameState.turnManager.CurrentStep()
+	gameState.mu.Unlock()
+
+	data, err := h.engine.SerializeGame(h.gameID)
+	if err != nil {
+		t.Fatalf("failed to serialize game: %v", err)
+	}
+	if _, err := h.engine.LoadGame(data); err == nil {
+		t.Error("expected loading a game that is already running to fail")
+	}
+
+	engine := NewMageEngine(zaptest.NewLogger(t))
+	gameID, err := engine.LoadGame(data)
+	if err != nil {
+		t.Fatalf("failed to load game: %v", err)
+	}
+	if gameID != h.gameID {
+		t.Fatalf("expected game %s, got %s", h.gameID, gameID)
+	}
+
+	loaded := engine.games[gameID]
+	loaded.mu.RLock()
+	defer loaded.mu.RUnlock()
+	if loaded.turnManager.TurnNumber() != turn || loaded.turnManager.CurrentStep() != step {
+		t.Errorf("expected turn %d %s, got turn %d %s", turn, step, loaded.turnManager.TurnNumber(), loaded.turnManager.CurrentStep())
+	}
+	if loaded.players["Bob"].Life != 13 || loaded.players["Alice"].Energy != 3 {
+		t.Errorf("expected Bob at 13 life and Alice with 3 energy, got %d and %d", loaded.players["Bob"].Life, loaded.players["Alice"].Energy)
+	}
+	loadedAlice := loaded.players["Alice"]
+	if len(loadedAlice.Hand) != len(alice.Hand) || len(loadedAlice.Library) != len(alice.Library) {
+		t.Errorf("expected %d cards in hand and %d in library, got %d and %d", len(alice.Hand), len(alice.Library), len(loadedAlice.Hand), len(loadedAlice.Library))
+	}
+	if len(loadedAlice.Graveyard) != 1 || loadedAlice.Graveyard[0] != loaded.cards[discarded.ID] {
+		t.Error("expected the graveyard to hold the loaded discarded card")
+	}
+	bears := loaded.cards["bears"]
+	if bears == nil || bears.Zone != zoneBattlefield || bears.Counters.GetCount("+1/+1") != 2 {
+		t.Errorf("expected the bears on the battlefield with two +1/+1 counters, got %+v", bears)
+	}
+	if len(loaded.combat.groups) != 1 || !loaded.combat.attackers["bears"] || loaded.combat.groups[0].defenderID != "Bob" {
+		t.Error("expected the bears attacking Bob after loading")
+	}
+}
+
+// TestSerializeGame_RefusesUnsavedState verifies that a game holding state a save can't restore, here
+// a draw vote with its timer, isn't serialized
+func TestSerializeGame_RefusesUnsavedState(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-persist-unsaved", []string{"Alice", "Bob"})
+	if err := h.engine.ProposeDraw(h.gameID, "Alice"); err != nil {
+		t.Fatalf("failed to propose draw: %v", err)
+	}
+
+	_, err := h.engine.SerializeGame(h.gameID)
+	if err == nil || !strings.Contains(err.Error(), "a vote") {
+		t.Fatalf("expected serializing a game with a vote to fail, got %v", err)
+	}
+}
+
+// TestSerializeGame_RefusesUnrestorableStackItems verifies that a game is only saved while every item on
+// its stack can be resolved after loading: a registered card trigger can, an activated ability or a
+// trigger built by the engine can't
+func TestSerializeGame_RefusesUnrestorableStackItems(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-persist-stack", []string{"Alice", "Bob"})
+	h.CreateAttacker("bears", "Grizzly Bears", "Alice", "2", "2")
+	if err := h.engine.RegisterTrigger("Grizzly Bears", TriggerDefinition{
+		Event:       rules.EventEntersTheBattlefield,
+		Description: "you gain 1 life",
+		Effect: func(gameState *engineGameState, sourceID, controllerID string) error {
+			gameState.players[controllerID].Life++
+			return nil
+		},
+	}); err != nil {
+		t.Fatalf("failed to register trigger: %v", err)
+	}
+
+	push := func(item rules.StackItem) {
+		gameState := h.GetGameState()
+		gameState.mu.Lock()
+		defer gameState.mu.Unlock()
+		item.Controller, item.SourceID = "Alice", "bears"
+		item.Resolve = func() error { return nil }
+		gameState.stack.Push(item)
+	}
+	pop := func() {
+		gameState := h.GetGameState()
+		gameState.mu.Lock()
+		defer gameState.mu.Unlock()
+		if _, err := gameState.stack.Pop(); err != nil {
+			t.Fatalf("failed to pop the stack: %v", err)
+		}
+	}
+
+	push(rules.StackItem{ID: "trigger", Kind: rules.StackItemKindTriggered, Description: "Grizzly Bears: you gain 1 life"})
+	if _, err := h.engine.SerializeGame(h.gameID); err != nil {
+		t.Fatalf("expected a game with a registered card trigger on the stack to be saved: %v", err)
+	}
+
+	for _, item := range []rules.StackItem{
+		{ID: "ability", Kind: rules.StackItemKindActivated, Description: "Grizzly Bears: draw a card"},
+		{ID: "engine-trigger", Kind: rules.StackItemKindTriggered, Description: "Grizzly Bears: deals 1 damage"},
+	} {
+		push(item)
+		_, err := h.engine.SerializeGame(h.gameID)
+		if err == nil || !strings.Contains(err.Error(), "stack items that can't be restored") {
+			t.Errorf("expected serializing a game with %s on the stack to fail, got %v", item.ID, err)
+		}
+		pop()
+	}
+}
+
+// TestSaveGame_WritesInBackgroundAndDeletesAtEnd verifies that a queued save reaches the game saver and
+// that the save is deleted once the game ends
+func TestSaveGame_WritesInBackgroundAndDeletesAtEnd(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-persist-writer", []string{"Alice", "Bob"})
+
+	var mu sync.Mutex
+	saved := make(map[string][]byte)
+	h.engine.SetGameSaver(func(gameID, gameType string, turnNumber int, data []byte) error {
+		mu.Lock()
+		defer mu.Unlock()
+		saved[gameID] = data
+		return nil
+	}, func(gameID string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		delete(saved, gameID)
+		return nil
+	})
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	h.engine.saveGame(gameState, gameState.turnManager.TurnNumber())
+	gameState.mu.Unlock()
+	h.engine.FlushSavedGames()
+
+	mu.Lock()
+	data := saved[h.gameID]
+	mu.Unlock()
+	if data == nil {
+		t.Fatal("expected the game to be saved")
+	}
+	if _, err := NewMageEngine(zaptest.NewLogger(t)).LoadGame(data); err != nil {
+		t.Fatalf("failed to load saved game: %v", err)
+	}
+
+	if err := h.engine.EndGame(h.gameID, "Alice"); err != nil {
+		t.Fatalf("failed to end game: %v", err)
+	}
+	h.engine.FlushSavedGames()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if _, exists := saved[h.gameID]; exists {
+		t.Error("expected the save to be deleted when the game ended")
+	}
+}
@@ -0,0 +1,164 @@
+package game
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// maxPriorityTimeouts is the number of times in a row a player may let their priority clock run out;
+// the last one makes them lose for being idle (see PlayerIdleTimeout)
+const maxPriorityTimeouts = 3
+
+// priorityClock limits how long a player may hold priority before passing it automatically
+type priorityClock struct {
+	timeout    time.Duration
+	playerID   string // Player the clock is running for ("" = stopped)
+	deadline   time.Time
+	timer      *time.Timer
+	generation int            // Incremented each time the clock restarts, so a stale timer does nothing
+	timeouts   map[string]int // Player ID -> clocks they let run out in a row
+}
+
+// SetPriorityTimeout sets how long players in a game may hold priority: a player who doesn't act in
+// time passes automatically, and one who runs out of time maxPriorityTimeouts times in a row loses for
+// being idle. The clock restarts whenever the player with priority acts. Zero turns the clock off.
+func (e *MageEngine) SetPriorityTimeout(gameID string, d time.Duration) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+	if d < 0 {
+		return fmt.Errorf("priority timeout must not be negative, got %s", d)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "SetPriorityTimeout", d)
+
+	if d == 0 {
+		e.stopPriorityClock(gameState)
+		gameState.priorityClock = nil
+		return nil
+	}
+	if gameState.priorityClock == nil {
+		gameState.priorityClock = &priorityClock{timeouts: make(map[string]int)}
+	}
+	gameState.priorityClock.timeout = d
+	e.startPriorityClock(gameState)
+	return nil
+}
+
+// startPriorityClock (re)starts the priority clock, if the game has one, for the player with priority;
+// it stays stopped while the game isn't in progress
+func (e *MageEngine) startPriorityClock(gameState *engineGameState) {
+	clock := gameState.priorityClock
+	if clock == nil {
+		return
+	}
+	e.stopPriorityClock(gameState)
+
+	playerID := gameState.turnManager.PriorityPlayer()
+	if gameState.state != GameStateInProgress || playerID == "" {
+		return
+	}
+	clock.generation++
+	generation := clock.generation
+	clock.playerID = playerID
+	clock.deadline = time.Now().Add(clock.timeout)
+	clock.timer = time.AfterFunc(clock.timeout, func() {
+		e.priorityClockExpired(gameState, playerID, generation)
+	})
+}
+
+// stopPriorityClock stops the priority clock, if it is running
+func (e *MageEngine) stopPriorityClock(gameState *engineGameState) {
+	clock := gameState.priorityClock
+	if clock == nil || clock.timer == nil {
+		return
+	}
+	clock.timer.Stop()
+	clock.timer = nil
+	clock.playerID = ""
+}
+
+// resetPriorityClock restarts the priority clock after a player acted, if they had priority or
+// priority has moved on since the clock started
+func (e *MageEngine) resetPriorityClock(gameState *engineGameState, actingPlayerID string) {
+	clock := gameState.priorityClock
+	if clock == nil {
+		return
+	}
+	delete(clock.timeouts, actingPlayerID)
+	if actingPlayerID == clock.playerID || gameState.turnManager.PriorityPlayer() != clock.playerID {
+		e.startPriorityClock(gameState)
+	}
+}
+
+// priorityClockExpired passes priority for a player whose clock ran out, or makes them lose for being
+// idle if it ran out too many times in a row
+func (e *MageEngine) priorityClockExpired(gameState *engineGameState, playerID string, generation int) {
+	gameState.mu.Lock()
+	clock := gameState.priorityClock
+	if clock == nil || clock.generation != generation || gameState.state != GameStateInProgress ||
+		gameState.turnManager.PriorityPlayer() != playerID {
+		gameState.mu.Unlock()
+		return
+	}
+	player, exists := gameState.players[playerID]
+	if !exists {
+		gameState.mu.Unlock()
+		return
+	}
+
+	clock.timeouts[playerID]++
+	if clock.timeouts[playerID] >= maxPriorityTimeouts {
+		e.stopPriorityClock(gameState)
+		gameState.mu.Unlock()
+
+		if err := e.PlayerIdleTimeout(gameState.gameID, playerID); err != nil && e.logger != nil {
+			e.logger.Warn("failed to time out idle player",
+				zap.String("game_id", gameState.gameID),
+				zap.String("player_id", playerID),
+				zap.Error(err),
+			)
+		}
+
+		gameState.mu.Lock()
+		e.startPriorityClock(gameState)
+		e.runMatchClock(gameState)
+		gameState.mu.Unlock()
+		return
+	}
+
+	gameState.addMessage(fmt.Sprintf("%s ran out of time and passes priority", player.Name), "system")
+	if err := e.handlePass(gameState, playerID); err != nil && e.logger != nil {
+		e.logger.Warn("failed to pass priority for player out of time",
+			zap.String("game_id", gameState.gameID),
+			zap.String("player_id", playerID),
+			zap.Error(err),
+		)
+	} else if err == nil {
+		// Logged as a pass so a replay of the game passes at the same point
+		e.logAction(gameState, PlayerAction{PlayerID: playerID, ActionType: "PLAYER_ACTION", Data: "PASS", Timestamp: time.Now()})
+	}
+	e.startPriorityClock(gameState)
+	e.runMatchClock(gameState)
+	gameState.mu.Unlock()
+}
+
+// priorityTimeLeft returns the time left on the priority clock (0 = no clock running)
+func (s *engineGameState) priorityTimeLeft() time.Duration {
+	clock := s.priorityClock
+	if clock == nil || clock.timer == nil {
+		return 0
+	}
+	if left := time.Until(clock.deadline); left > 0 {
+		return left
+	}
+	return 0
+}
@@ -0,0 +1,73 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+// TestPriorityClock_AutoPassesThenIdlesOut verifies that a player who lets the priority clock run out
+// passes automatically, that pausing stops the clock, and that running out repeatedly makes them lose
+func TestPriorityClock_AutoPassesThenIdlesOut(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-priority-clock", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	priorityPlayer := func() string {
+		gameState.mu.RLock()
+		defer gameState.mu.RUnlock()
+		return gameState.turnManager.PriorityPlayer()
+	}
+	waitFor := func(what string, done func() bool) {
+		t.Helper()
+		deadline := time.Now().Add(2 * time.Second)
+		for !done() {
+			if time.Now().After(deadline) {
+				t.Fatalf("timed out waiting for %s", what)
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+
+	first := priorityPlayer()
+	if err := h.engine.SetPriorityTimeout(h.gameID, time.Hour); err != nil {
+		t.Fatalf("failed to set priority timeout: %v", err)
+	}
+	view, err := h.engine.GetGameView(h.gameID, first)
+	if err != nil {
+		t.Fatalf("failed to get view: %v", err)
+	}
+	if left := view.(*EngineGameView).PriorityTimeLeft; left <= 0 || left > time.Hour {
+		t.Errorf("expected the view to show the time left, got %s", left)
+	}
+
+	// Pausing cancels the clock
+	if err := h.engine.PauseGame(h.gameID); err != nil {
+		t.Fatalf("failed to pause: %v", err)
+	}
+	if err := h.engine.SetPriorityTimeout(h.gameID, 20*time.Millisecond); err != nil {
+		t.Fatalf("failed to set priority timeout: %v", err)
+	}
+	time.Sleep(60 * time.Millisecond)
+	if player := priorityPlayer(); player != first {
+		t.Fatalf("expected no automatic pass while paused, priority moved to %s", player)
+	}
+
+	if err := h.engine.ResumeGame(h.gameID); err != nil {
+		t.Fatalf("failed to resume: %v", err)
+	}
+	waitFor("the automatic pass", func() bool { return priorityPlayer() != first })
+
+	// A player who has already run out of time twice in a row loses the third time
+	gameState.mu.Lock()
+	holder := gameState.turnManager.PriorityPlayer()
+	gameState.priorityClock.timeouts[holder] = maxPriorityTimeouts - 1
+	gameState.mu.Unlock()
+	waitFor("the idle timeout", func() bool {
+		gameState.mu.RLock()
+		defer gameState.mu.RUnlock()
+		return gameState.players[holder].IdleTimeout
+	})
+
+	if err := h.engine.SetPriorityTimeout(h.gameID, 0); err != nil {
+		t.Fatalf("failed to turn off the priority clock: %v", err)
+	}
+}
@@ -0,0 +1,123 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/magefree/mage-server-go/internal/game/counters"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// Proliferate has a player proliferate. choices maps the permanents and players they chose, each of
+// which must have a counter, to the kinds of counters to add; an empty list means every kind already
+// there. Each chosen object gets one more counter of each of those kinds.
+// Per rule 701.34a and Java ProliferateEffect
+func (e *MageEngine) Proliferate(gameID, playerID string, choices map[string][]string) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "Proliferate", playerID, choices)
+
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+	if _, exists := gameState.players[playerID]; !exists {
+		return fmt.Errorf("player %s not found", playerID)
+	}
+
+	// Check every choice before adding anything
+	objectIDs := make([]string, 0, len(choices))
+	kinds := make(map[string][]string, len(choices))
+	for objectID, chosenKinds := range choices {
+		present := e.counterKinds(gameState, objectID)
+		if present == nil {
+			return fmt.Errorf("%s is not a permanent or player", objectID)
+		}
+		if len(present) == 0 {
+			return fmt.Errorf("%s has no counters to proliferate", objectID)
+		}
+		if len(chosenKinds) == 0 {
+			chosenKinds = present
+		}
+		for _, kind := range chosenKinds {
+			if !containsString(present, kind) {
+				return fmt.Errorf("%s has no %s counters", objectID, kind)
+			}
+		}
+		objectIDs = append(objectIDs, objectID)
+		kinds[objectID] = chosenKinds
+	}
+	sort.Strings(objectIDs)
+
+	gameState.eventBus.Publish(rules.NewEvent(rules.EventProliferate, "", "", playerID))
+	counterOps := counters.NewCounterOperations(gameState.eventBus)
+	now := time.Now()
+	for _, objectID := range objectIDs {
+		for _, kind := range kinds[objectID] {
+			if player, isPlayer := gameState.players[objectID]; isPlayer {
+				switch counters.CounterType(kind) {
+				case counters.CounterTypePoison:
+					player.Poison++
+				case counters.CounterTypeEnergy:
+					player.Energy++
+				}
+				event := rules.NewEventWithAmount(rules.EventCounterAdded, objectID, objectID, objectID, 1)
+				event.Data = kind
+				event.Metadata["counter_name"] = kind
+				gameState.eventBus.Publish(event)
+				gameState.addMessage(fmt.Sprintf("%s gets a %s counter", player.Name, kind), "action")
+				continue
+			}
+
+			card := gameState.cards[objectID]
+			counter := counters.NewCounter(kind, 1)
+			card.Counters.AddCounter(counter)
+			counterOps.AddCounterToCard(card.ID, counter, card.ControllerID, now)
+			gameState.addMessage(fmt.Sprintf("%s gets a %s counter", card.Name, kind), "action")
+		}
+	}
+	gameState.eventBus.Publish(rules.NewEvent(rules.EventProliferated, "", "", playerID))
+
+	e.notifyPlayerAction(gameID, playerID, map[string]interface{}{
+		"type":    "proliferate",
+		"objects": objectIDs,
+	})
+	return nil
+}
+
+// counterKinds returns the kinds of counters on a permanent or player, sorted; nil if objectID is
+// neither a permanent on the battlefield nor a player
+func (e *MageEngine) counterKinds(gameState *engineGameState, objectID string) []string {
+	kinds := make([]string, 0)
+	if player, exists := gameState.players[objectID]; exists {
+		if player.Poison > 0 {
+			kinds = append(kinds, string(counters.CounterTypePoison))
+		}
+		if player.Energy > 0 {
+			kinds = append(kinds, string(counters.CounterTypeEnergy))
+		}
+		return kinds
+	}
+
+	card, exists := gameState.cards[objectID]
+	if !exists || card.Zone != zoneBattlefield {
+		return nil
+	}
+	if card.Counters != nil {
+		for name, counter := range card.Counters.GetAll() {
+			if counter.Count > 0 {
+				kinds = append(kinds, name)
+			}
+		}
+	}
+	sort.Strings(kinds)
+	return kinds
+}
@@ -0,0 +1,46 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/counters"
+)
+
+// TestProliferate_AddsCounterOfEachKind verifies that proliferating gives each chosen permanent and
+// player another counter of each kind they have, leaves unchosen objects alone, and rejects choosing
+// objects without counters
+func TestProliferate_AddsCounterOfEachKind(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-proliferate", []string{"Alice", "Bob"})
+	bears := h.CreateAttacker("bears", "Grizzly Bears", "Alice", "2", "2")
+	ogre := h.CreateAttacker("ogre", "Gray Ogre", "Alice", "2", "2")
+	knight := h.CreateBlocker("knight", "Knight Errant", "Bob", "2", "2")
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.cards[bears].Counters.AddCounter(counters.CounterTypeP1P1.CreateInstance(2))
+	gameState.cards[ogre].Counters.AddCounter(counters.CounterTypeP1P1.CreateInstance(1))
+	gameState.players["Bob"].Poison = 3
+	gameState.mu.Unlock()
+
+	if err := h.engine.Proliferate(h.gameID, "Alice", map[string][]string{knight: nil}); err == nil {
+		t.Error("expected a permanent without counters to be rejected")
+	}
+	if err := h.engine.Proliferate(h.gameID, "Alice", map[string][]string{"Bob": {string(counters.CounterTypeEnergy)}}); err == nil {
+		t.Error("expected a kind of counter the player doesn't have to be rejected")
+	}
+	if err := h.engine.Proliferate(h.gameID, "Alice", map[string][]string{bears: nil, "Bob": nil}); err != nil {
+		t.Fatalf("failed to proliferate: %v", err)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if count := gameState.cards[bears].Counters.GetCount(string(counters.CounterTypeP1P1)); count != 3 {
+		t.Errorf("expected Grizzly Bears to have 3 +1/+1 counters, got %d", count)
+	}
+	if count := gameState.cards[ogre].Counters.GetCount(string(counters.CounterTypeP1P1)); count != 1 {
+		t.Errorf("expected the unchosen Gray Ogre to keep 1 +1/+1 counter, got %d", count)
+	}
+	if poison := gameState.players["Bob"].Poison; poison != 4 {
+		t.Errorf("expected Bob to have 4 poison counters, got %d", poison)
+	}
+}
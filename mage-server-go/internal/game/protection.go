@@ -0,0 +1,108 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// colorNames are the five colors (rule 105.1), as qualities protection can name
+var colorNames = []string{"white", "blue", "black", "red", "green"}
+
+// protectionQualities returns the qualities a card has protection from, read from its abilities and
+// rules text, e.g. "Protection from red and from blue" -> [red blue]. "Protection from all colors"
+// expands to the five colors.
+// Per rule 702.16a and Java ProtectionAbility
+func protectionQualities(card *internalCard) []string {
+	if card == nil {
+		return nil
+	}
+	texts := []string{card.RulesText}
+	for _, ability := range card.Abilities {
+		texts = append(texts, ability.Text, ability.Rule)
+	}
+
+	qualities := make([]string, 0)
+	for _, text := range texts {
+		text = strings.ToLower(text)
+		for {
+			i := strings.Index(text, "protection from ")
+			if i < 0 {
+				break
+			}
+			text = text[i+len("protection from "):]
+			clause := text
+			if end := strings.IndexAny(clause, ".(\n;"); end >= 0 {
+				clause = clause[:end]
+			}
+			clause = strings.NewReplacer(" and from ", ",", ", from ", ",", ", and ", ",", " and ", ",").Replace(clause)
+			for _, quality := range strings.Split(clause, ",") {
+				quality = strings.TrimSpace(quality)
+				switch {
+				case quality == "":
+				case quality == "all colors":
+					for _, color := range colorNames {
+						qualities = appendUnique(qualities, color)
+					}
+				default:
+					qualities = appendUnique(qualities, quality)
+				}
+			}
+		}
+	}
+	return qualities
+}
+
+func appendUnique(values []string, value string) []string {
+	if containsString(values, value) {
+		return values
+	}
+	return append(values, value)
+}
+
+// objectColors returns the colors of an object in lowercase, e.g. "White Blue" -> [white blue]
+func objectColors(card *internalCard) []string {
+	if card == nil {
+		return nil
+	}
+	return strings.Fields(strings.ToLower(card.Color))
+}
+
+// hasProtectionFrom checks if a card has protection from a quality, such as a color ("red")
+func (e *MageEngine) hasProtectionFrom(card *internalCard, quality string) bool {
+	quality = strings.ToLower(strings.TrimSpace(quality))
+	for _, protected := range protectionQualities(card) {
+		if protected == quality || protected == "everything" {
+			return true
+		}
+	}
+	return false
+}
+
+// protectedFrom checks if a card has protection from a source object: from one of its colors, or from
+// everything. Other qualities (card types, players) aren't supported yet.
+func (e *MageEngine) protectedFrom(card, source *internalCard) bool {
+	if card == nil || source == nil {
+		return false
+	}
+	if e.hasProtectionFrom(card, "everything") {
+		return true
+	}
+	for _, color := range objectColors(source) {
+		if e.hasProtectionFrom(card, color) {
+			return true
+		}
+	}
+	return false
+}
+
+// preventDamageFromProtection prevents damage that a source would deal to a permanent with protection
+// from it, reporting whether the damage was prevented
+// Per rule 702.16e
+func (e *MageEngine) preventDamageFromProtection(gameState *engineGameState, target *internalCard, sourceID string, amount int) bool {
+	source, exists := gameState.cards[sourceID]
+	if !exists || !e.protectedFrom(target, source) {
+		return false
+	}
+	gameState.addMessage(fmt.Sprintf("%d damage from %s to %s is prevented (protection)", amount, source.Name, target.Name), "action")
+	return true
+}
@@ -0,0 +1,77 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestProtection_ParsesQualities verifies that protection qualities are read from abilities and rules text
+func TestProtection_ParsesQualities(t *testing.T) {
+	for _, tc := range []struct {
+		text string
+		want []string
+	}{
+		{"Protection from red", []string{"red"}},
+		{"Flying, protection from red and from blue (This creature can't be blocked by red or blue creatures.)", []string{"red", "blue"}},
+		{"Protection from all colors", colorNames},
+		{"Flying", []string{}},
+	} {
+		card := &internalCard{Abilities: []EngineAbilityView{{ID: "ProtectionAbility", Text: tc.text}}}
+		if got := protectionQualities(card); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%q: expected %v, got %v", tc.text, tc.want, got)
+		}
+	}
+}
+
+// TestProtection_BlockingDamageAndTargeting verifies that a creature with protection from red can't be
+// blocked by red creatures, is dealt no damage by red sources and can't be targeted by red spells
+func TestProtection_BlockingDamageAndTargeting(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-protection", []string{"Alice", "Bob"})
+	knight := h.CreateCreature(CreatureSpec{ID: "knight", Name: "White Knight", Power: "2", Toughness: "2", Controller: "Alice"})
+	goblin := h.CreateCreature(CreatureSpec{ID: "goblin", Name: "Raging Goblin", Power: "1", Toughness: "1", Controller: "Bob"})
+	bears := h.CreateCreature(CreatureSpec{ID: "bears", Name: "Grizzly Bears", Power: "2", Toughness: "2", Controller: "Bob"})
+	castTestSetup(t, h, "Bob", "chain", "Chain Lightning", "Instant", rules.StepMain1)
+	h.engine.SetDebugOperationsEnabled(true)
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.cards[knight].Abilities = []EngineAbilityView{{ID: "ProtectionAbility", Text: "Protection from red"}}
+	gameState.cards[goblin].Color = "Red"
+	gameState.cards[bears].Color = "Green"
+	chain := gameState.cards["chain"]
+	chain.Color = "Red"
+	chain.RulesText = "Chain Lightning deals 3 damage to any target."
+	gameState.mu.Unlock()
+
+	h.SetupCombat("Alice")
+	h.DeclareAttacker(knight, "Bob", "Alice")
+	gameState.mu.RLock()
+	redCanBlock, _ := h.engine.canBlockInternal(gameState, goblin, knight)
+	greenCanBlock, _ := h.engine.canBlockInternal(gameState, bears, knight)
+	gameState.mu.RUnlock()
+	if redCanBlock || !greenCanBlock {
+		t.Errorf("expected only the green creature to block, red=%v green=%v", redCanBlock, greenCanBlock)
+	}
+
+	if err := h.engine.DealDamage(h.gameID, goblin, knight, 2, false); err != nil {
+		t.Fatalf("failed to deal damage: %v", err)
+	}
+	if err := h.engine.DealDamage(h.gameID, bears, knight, 1, false); err != nil {
+		t.Fatalf("failed to deal damage: %v", err)
+	}
+	gameState.mu.RLock()
+	damage := gameState.cards[knight].Damage
+	gameState.mu.RUnlock()
+	if damage != 1 {
+		t.Errorf("expected only the damage from the green source to be dealt, got %d", damage)
+	}
+
+	if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Bob", ActionType: "SEND_STRING", Data: "Chain Lightning", Targets: []string{knight}}); err == nil {
+		t.Error("expected a red spell to be unable to target a creature with protection from red")
+	}
+	if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Bob", ActionType: "SEND_STRING", Data: "Chain Lightning", Targets: []string{"Alice"}}); err != nil {
+		t.Errorf("failed to cast Chain Lightning at Alice: %v", err)
+	}
+}
@@ -0,0 +1,138 @@
+package game
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// gameLock is the lock of a game. It counts the times its write lock is released, after which the
+// game may have changed; a view built since the last release still shows the game as it is, so it can
+// be handed out again without taking the lock (see publishedViews).
+type gameLock struct {
+	sync.RWMutex
+	generation atomic.Uint64
+}
+
+// Unlock releases the write lock, marking the game as possibly changed
+func (l *gameLock) Unlock() {
+	l.generation.Add(1)
+	l.RWMutex.Unlock()
+}
+
+// publishedViews are the views of a game built since its lock was last released for writing. They are
+// replaced, never modified, so readers load them without locking.
+type publishedViews struct {
+	generation uint64
+	views      map[string]*publishedView // Player ID -> view
+}
+
+// publishedView is a built view with what's needed to bring its clocks up to date when handed out
+type publishedView struct {
+	view             *EngineGameView
+	priorityDeadline time.Time     // When the priority clock runs out (zero = not running)
+	bankPlayerID     string        // Player whose time bank is running ("" = none)
+	bankStarted      time.Time     // When it started running
+	bankLeft         time.Duration // Time left in it when it started
+}
+
+// publishedView returns a player's view if it was built since the game last changed (nil = none)
+func (s *engineGameState) publishedView(playerID string) *EngineGameView {
+	published := s.published.Load()
+	if published == nil || published.generation != s.mu.generation.Load() {
+		return nil
+	}
+	entry, exists := published.views[playerID]
+	if !exists {
+		return nil
+	}
+
+	// Copied so callers can't change the published view, with the clocks brought up to date
+	view := entry.view.clone()
+	if !entry.priorityDeadline.IsZero() {
+		view.PriorityTimeLeft = max(time.Until(entry.priorityDeadline), 0)
+	}
+	for i := range view.Players {
+		if view.Players[i].PlayerID == entry.bankPlayerID {
+			view.Players[i].TimeBank = max(entry.bankLeft-time.Since(entry.bankStarted), 0)
+		}
+	}
+	return view
+}
+
+// publishView publishes a copy of a player's view; the caller holds the game's read lock, so the game
+// can't change meanwhile
+func (s *engineGameState) publishView(playerID string, view *EngineGameView) {
+	entry := &publishedView{view: view.clone()}
+	if clock := s.priorityClock; clock != nil && clock.timer != nil {
+		entry.priorityDeadline = clock.deadline
+	}
+	if clock := s.matchClock; clock != nil && clock.timer != nil {
+		entry.bankPlayerID = clock.playerID
+		entry.bankStarted = clock.started
+		entry.bankLeft = clock.banks[clock.playerID]
+	}
+
+	s.publishMu.Lock()
+	defer s.publishMu.Unlock()
+	generation := s.mu.generation.Load()
+	views := make(map[string]*publishedView)
+	if published := s.published.Load(); published != nil && published.generation == generation {
+		for id, existing := range published.views {
+			views[id] = existing
+		}
+	}
+	views[playerID] = entry
+	s.published.Store(&publishedViews{generation: generation, views: views})
+}
+
+// clone returns a deep copy of a view that shares no slices with it, so a caller changing its view
+// can't change the published one
+func (view *EngineGameView) clone() *EngineGameView {
+	c := *view
+	c.Players = slices.Clone(view.Players)
+	for i := range c.Players {
+		c.Players[i].Hand = cloneCardViews(c.Players[i].Hand)
+		c.Players[i].Graveyard = cloneCardViews(c.Players[i].Graveyard)
+	}
+	c.Battlefield = cloneCardViews(view.Battlefield)
+	c.Stack = cloneCardViews(view.Stack)
+	c.Exile = cloneCardViews(view.Exile)
+	c.Command = cloneCardViews(view.Command)
+	c.Revealed = slices.Clone(view.Revealed)
+	for i := range c.Revealed {
+		c.Revealed[i].Cards = cloneCardViews(c.Revealed[i].Cards)
+	}
+	c.LookedAt = slices.Clone(view.LookedAt)
+	for i := range c.LookedAt {
+		c.LookedAt[i].Cards = cloneCardViews(c.LookedAt[i].Cards)
+	}
+	c.Combat.Groups = slices.Clone(view.Combat.Groups)
+	for i := range c.Combat.Groups {
+		c.Combat.Groups[i].Attackers = slices.Clone(c.Combat.Groups[i].Attackers)
+		c.Combat.Groups[i].Blockers = slices.Clone(c.Combat.Groups[i].Blockers)
+	}
+	c.Messages = slices.Clone(view.Messages)
+	c.Prompts = slices.Clone(view.Prompts)
+	for i := range c.Prompts {
+		c.Prompts[i].Options = slices.Clone(c.Prompts[i].Options)
+	}
+	c.Decisions = slices.Clone(view.Decisions)
+	for i := range c.Decisions {
+		c.Decisions[i].Choices = slices.Clone(c.Decisions[i].Choices)
+	}
+	return &c
+}
+
+// cloneCardViews copies card views, sharing no slices with them
+func cloneCardViews(views []EngineCardView) []EngineCardView {
+	if views == nil {
+		return nil
+	}
+	c := make([]EngineCardView, len(views))
+	for i, view := range views {
+		c[i] = view.clone()
+	}
+	return c
+}
@@ -0,0 +1,126 @@
+package game
+
+import (
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestPublishedView_ServedWithoutLockUntilGameChanges verifies that an unchanged game's view is handed
+// out while the game is locked, and that it is rebuilt once the game changes
+func TestPublishedView_ServedWithoutLockUntilGameChanges(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-published-view", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	if _, err := h.engine.GetGameView(h.gameID, "Alice"); err != nil {
+		t.Fatalf("failed to get view: %v", err)
+	}
+
+	gameState.mu.Lock()
+	done := make(chan *EngineGameView, 1)
+	go func() {
+		view, _ := h.engine.GetGameView(h.gameID, "Alice")
+		done <- view.(*EngineGameView)
+	}()
+	select {
+	case view := <-done:
+		view.Players[0].Life = 1 // Must not change the published view
+	case <-time.After(time.Second):
+		gameState.mu.Unlock()
+		t.Fatal("expected the published view to be handed out while the game is locked")
+	}
+	gameState.players["Bob"].Life = 12
+	gameState.mu.Unlock()
+
+	view, err := h.engine.GetGameView(h.gameID, "Alice")
+	if err != nil {
+		t.Fatalf("failed to get view: %v", err)
+	}
+	for _, player := range view.(*EngineGameView).Players {
+		if player.PlayerID == "Alice" && player.Life != 20 {
+			t.Errorf("expected a caller's change not to reach the published view, Alice has %d life", player.Life)
+		}
+		if player.PlayerID == "Bob" && player.Life != 12 {
+			t.Errorf("expected the view to be rebuilt after the game changed, Bob has %d life", player.Life)
+		}
+	}
+}
+
+// TestPublishedView_CloneSharesNothing verifies that a cloned view shares no slice with the original at
+// any depth, so every slice field added to the views is copied
+func TestPublishedView_CloneSharesNothing(t *testing.T) {
+	view := &EngineGameView{}
+	fillSlices(reflect.ValueOf(view).Elem())
+	clone := view.clone()
+	checkNoSharedSlices(t, "EngineGameView", reflect.ValueOf(view).Elem(), reflect.ValueOf(clone).Elem())
+}
+
+// fillSlices gives every settable slice in a value one element, recursively
+func fillSlices(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Field(i).CanSet() {
+				fillSlices(v.Field(i))
+			}
+		}
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fillSlices(v.Index(0))
+	}
+}
+
+// checkNoSharedSlices reports slices of a and b, at the same place, that share their backing array
+func checkNoSharedSlices(t *testing.T, path string, a, b reflect.Value) {
+	t.Helper()
+	switch a.Kind() {
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if a.Type().Field(i).IsExported() {
+				checkNoSharedSlices(t, path+"."+a.Type().Field(i).Name, a.Field(i), b.Field(i))
+			}
+		}
+	case reflect.Slice:
+		if a.Len() > 0 && a.Pointer() == b.Pointer() {
+			t.Errorf("%s is shared between a view and its clone", path)
+			return
+		}
+		for i := 0; i < a.Len() && i < b.Len(); i++ {
+			checkNoSharedSlices(t, path+"[]", a.Index(i), b.Index(i))
+		}
+	}
+}
+
+// BenchmarkGetGameViewDuringActions gets views from many goroutines while players keep acting
+func BenchmarkGetGameViewDuringActions(b *testing.B) {
+	engine := NewMageEngine(nil)
+	if err := engine.StartGame("bench-views", []string{"Alice", "Bob"}, "Duel"); err != nil {
+		b.Fatalf("failed to start game: %v", err)
+	}
+	gameState := engine.games["bench-views"]
+
+	var stop atomic.Bool
+	acting := make(chan struct{})
+	go func() {
+		defer close(acting)
+		for !stop.Load() {
+			gameState.mu.RLock()
+			playerID := gameState.turnManager.PriorityPlayer()
+			gameState.mu.RUnlock()
+			_ = engine.ProcessAction("bench-views", PlayerAction{PlayerID: playerID, ActionType: "PLAYER_ACTION", Data: "PASS"})
+			time.Sleep(100 * time.Microsecond)
+		}
+	}()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, _ = engine.GetGameView("bench-views", "Alice")
+		}
+	})
+	b.StopTimer()
+	stop.Store(true)
+	<-acting
+}
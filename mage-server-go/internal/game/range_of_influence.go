@@ -0,0 +1,82 @@
+package game
+
+// Range of influence (rule 801): in a multiplayer game with a limited range of influence, a player can
+// only affect players seated within that many seats of them and the objects those players control.
+// Per Java GameState.getPlayersInRange()
+
+// seatDistance returns how many seats apart two players sit, counting around the table in whichever
+// direction is shorter. Players who have left the game or lost no longer occupy a seat (rule 800.4a).
+func (s *engineGameState) seatDistance(playerID, otherID string) int {
+	seats := make([]string, 0, len(s.playerOrder))
+	for _, pid := range s.playerOrder {
+		if player := s.players[pid]; pid == playerID || pid == otherID || (player != nil && !player.Lost && !player.Left) {
+			seats = append(seats, pid)
+		}
+	}
+
+	from, to := -1, -1
+	for i, pid := range seats {
+		if pid == playerID {
+			from = i
+		}
+		if pid == otherID {
+			to = i
+		}
+	}
+	if from < 0 || to < 0 {
+		return len(s.playerOrder)
+	}
+
+	distance := from - to
+	if distance < 0 {
+		distance = -distance
+	}
+	if around := len(seats) - distance; around < distance {
+		distance = around
+	}
+	return distance
+}
+
+// inRangeOf reports whether otherID is within playerID's range of influence.
+// Every player is in range when the game has no limited range of influence.
+func (s *engineGameState) inRangeOf(playerID, otherID string) bool {
+	limit := s.rulesOptions.RangeOfInfluence
+	if limit <= 0 || playerID == otherID {
+		return true
+	}
+	if _, isPlayer := s.players[playerID]; !isPlayer {
+		return true
+	}
+	return s.seatDistance(playerID, otherID) <= limit
+}
+
+// objectInRangeOf reports whether a player or object is within playerID's range of influence;
+// an object is in range when its controller is (rule 801.3)
+func (s *engineGameState) objectInRangeOf(playerID, objectID string) bool {
+	if _, isPlayer := s.players[objectID]; isPlayer {
+		return s.inRangeOf(playerID, objectID)
+	}
+	card, exists := s.cards[objectID]
+	if !exists {
+		return true
+	}
+	controllerID := card.ControllerID
+	if controllerID == "" {
+		controllerID = card.OwnerID
+	}
+	return s.inRangeOf(playerID, controllerID)
+}
+
+// cardsInRangeOf returns the cards controlled by players within playerID's range of influence
+func (s *engineGameState) cardsInRangeOf(playerID string, cards []*internalCard) []*internalCard {
+	if s.rulesOptions.RangeOfInfluence <= 0 {
+		return cards
+	}
+	result := make([]*internalCard, 0, len(cards))
+	for _, card := range cards {
+		if s.objectInRangeOf(playerID, card.ID) {
+			result = append(result, card)
+		}
+	}
+	return result
+}
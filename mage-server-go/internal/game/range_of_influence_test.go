@@ -0,0 +1,65 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/targeting"
+)
+
+// TestRangeOfInfluence_AdjacentPlayersOnly verifies that with a range of influence of 1 in a five-player
+// game a player can only target, attack and see the players seated next to them and what they control
+func TestRangeOfInfluence_AdjacentPlayersOnly(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-range-of-influence", []string{"Alice", "Bob", "Carol", "Dave", "Eve"})
+	for _, spec := range []CreatureSpec{
+		{ID: "alice-bear", Name: "Grizzly Bears", Controller: "Alice"},
+		{ID: "bob-bear", Name: "Grizzly Bears", Controller: "Bob"},
+		{ID: "carol-bear", Name: "Grizzly Bears", Controller: "Carol"},
+	} {
+		spec.Power, spec.Toughness = "2", "2"
+		h.CreateCreature(spec)
+	}
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.rulesOptions.RangeOfInfluence = 1
+	for _, id := range []string{"alice-bear", "bob-bear", "carol-bear"} {
+		gameState.battlefield = append(gameState.battlefield, gameState.cards[id])
+	}
+	players := h.engine.requestTargetDecision(gameState, "Alice", "Choose target player",
+		targeting.TargetRequirement{Type: targeting.TargetTypePlayer, MinTargets: 1, MaxTargets: 1}, nil).Choices
+	creatures := h.engine.requestTargetDecision(gameState, "Alice", "Choose target creature",
+		targeting.TargetRequirement{Type: targeting.TargetTypeCreature, MinTargets: 1, MaxTargets: 1}, nil).Choices
+	gameState.mu.Unlock()
+
+	if want := []string{"Alice", "Bob", "Eve"}; !reflect.DeepEqual(players, want) {
+		t.Errorf("expected Alice to be able to target %v, got %v", want, players)
+	}
+	if want := []string{"alice-bear", "bob-bear"}; !reflect.DeepEqual(creatures, want) {
+		t.Errorf("expected Alice to be able to target %v, got %v", want, creatures)
+	}
+
+	h.SetupCombat("Alice")
+	if err := h.engine.DeclareAttacker(h.gameID, "alice-bear", "Carol", "Alice"); err == nil {
+		t.Error("expected attacking Carol, two seats away, to be rejected")
+	}
+	h.DeclareAttacker("alice-bear", "Bob", "Alice")
+
+	view, err := h.engine.GetGameView(h.gameID, "Alice")
+	if err != nil {
+		t.Fatalf("failed to get game view: %v", err)
+	}
+	gameView := view.(*EngineGameView)
+	visible := make([]string, 0, len(gameView.Players))
+	for _, player := range gameView.Players {
+		visible = append(visible, player.PlayerID)
+	}
+	if want := []string{"Alice", "Bob", "Eve"}; !reflect.DeepEqual(visible, want) {
+		t.Errorf("expected Alice to see %v, got %v", want, visible)
+	}
+	for _, card := range gameView.Battlefield {
+		if card.ID == "carol-bear" {
+			t.Error("expected Carol's creature to be outside Alice's view")
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// GrantRegeneration gives a permanent a regeneration shield ("Regenerate target creature")
+func (e *MageEngine) GrantRegeneration(gameID, cardID string) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "GrantRegeneration", cardID)
+
+	card, exists := gameState.cards[cardID]
+	if !exists || card.Zone != zoneBattlefield {
+		return fmt.Errorf("permanent %s not found on the battlefield", cardID)
+	}
+
+	e.grantRegeneration(gameState, card)
+	return nil
+}
+
+// grantRegeneration adds a regeneration shield to a permanent; caller must hold the game lock
+// Per rule 701.19a: the next time the permanent would be destroyed this turn, it's regenerated instead
+func (e *MageEngine) grantRegeneration(gameState *engineGameState, card *internalCard) {
+	card.RegenerationShields++
+	gameState.addMessage(fmt.Sprintf("%s gains a regeneration shield", card.Name), "action")
+}
+
+// grantRegenerationAll adds a regeneration shield to each permanent matching the predicate
+// (e.g. "Regenerate each creature you control"); caller must hold the game lock
+// Returns the number of permanents that got a shield
+func (e *MageEngine) grantRegenerationAll(gameState *engineGameState, predicate func(card *internalCard) bool) int {
+	granted := 0
+	for _, card := range gameState.battlefield {
+		if card.Zone != zoneBattlefield || !predicate(card) {
+			continue
+		}
+		e.grantRegeneration(gameState, card)
+		granted++
+	}
+	return granted
+}
+
+// clearRegenerationShields removes unused regeneration shields
+// Per rule 514.2: shields wear off in the cleanup step along with "until end of turn" effects
+func (e *MageEngine) clearRegenerationShields(gameState *engineGameState) {
+	for _, card := range gameState.cards {
+		card.RegenerationShields = 0
+	}
+}
+
+// DestroyPermanent destroys a permanent ("Destroy target creature")
+func (e *MageEngine) DestroyPermanent(gameID, cardID, sourceID string) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "DestroyPermanent", cardID, sourceID)
+
+	card, exists := gameState.cards[cardID]
+	if !exists || card.Zone != zoneBattlefield {
+		return fmt.Errorf("permanent %s not found on the battlefield", cardID)
+	}
+
+	_, err = e.destroyPermanent(gameState, card, sourceID)
+	return err
+}
+
+// destroyPermanent destroys a permanent unless it's indestructible or a regeneration shield replaces
+// it; caller must hold the game lock
+// Returns true if the permanent was put into its owner's graveyard
+// Per Java PermanentImpl.destroy()
+func (e *MageEngine) destroyPermanent(gameState *engineGameState, card *internalCard, sourceID string) (bool, error) {
+	// Per rule 702.12b: indestructible permanents can't be destroyed
+	if e.hasAbilityWithEffects(gameState, card, abilityIndestructible) {
+		return false, nil
+	}
+
+	if e.regenerate(gameState, card) {
+		return false, nil
+	}
+
+	if err := e.moveCard(gameState, card, zoneGraveyard, ""); err != nil {
+		return false, err
+	}
+	gameState.eventBus.Publish(rules.NewEvent(rules.EventDestroyedPermanent, card.ID, sourceID, card.ControllerID))
+	gameState.addMessage(fmt.Sprintf("%s is destroyed", card.Name), "action")
+	return true, nil
+}
+
+// regenerate uses a regeneration shield instead of destroying a permanent
+// Returns false if the permanent has no shield
+// Per rule 701.19a: the permanent is tapped, all damage is removed from it and it's removed from combat
+// Per Java PermanentImpl.regenerate()
+func (e *MageEngine) regenerate(gameState *engineGameState, card *internalCard) bool {
+	if card.RegenerationShields <= 0 {
+		return false
+	}
+	card.RegenerationShields--
+
+	if !card.Tapped {
+		_ = e.tapPermanent(gameState, card, tapReasonEffect)
+	}
+	card.Damage = 0
+	card.DamageSources = nil
+	e.removeFromCombat(gameState, card)
+
+	gameState.eventBus.Publish(rules.NewEvent(rules.EventRegenerated, card.ID, card.ID, card.ControllerID))
+	gameState.addMessage(fmt.Sprintf("%s regenerates", card.Name), "action")
+	return true
+}
@@ -0,0 +1,78 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/counters"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestRegeneration_GrantAllSurvivesDestroyAndExpiresAtCleanup verifies "regenerate each creature you
+// control" against a destroy board wipe, and that unused shields wear off in the cleanup step
+func TestRegeneration_GrantAllSurvivesDestroyAndExpiresAtCleanup(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-regenerate-all", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	troll := h.CreateCreature(CreatureSpec{ID: "troll", Name: "River Troll", Controller: "Alice", Power: "2", Toughness: "2"})
+	ape := h.CreateCreature(CreatureSpec{ID: "ape", Name: "Gorilla Warrior", Controller: "Alice", Power: "3", Toughness: "2"})
+	knight := h.CreateCreature(CreatureSpec{ID: "knight", Name: "Knight Errant", Controller: "Bob", Power: "2", Toughness: "2"})
+
+	gameState.mu.Lock()
+	for _, id := range []string{troll, ape, knight} {
+		gameState.cards[id].Counters = counters.NewCounters()
+		gameState.battlefield = append(gameState.battlefield, gameState.cards[id])
+	}
+	gameState.cards[ape].Damage = 1
+
+	granted := h.engine.grantRegenerationAll(gameState, func(card *internalCard) bool {
+		return card.ControllerID == "Alice" && h.engine.isCreature(card)
+	})
+	if granted != 2 {
+		t.Errorf("expected 2 shields granted, got %d", granted)
+	}
+
+	// Wrath of God: destroy all creatures
+	for _, card := range append([]*internalCard(nil), gameState.battlefield...) {
+		if _, err := h.engine.destroyPermanent(gameState, card, "wrath"); err != nil {
+			t.Fatalf("failed to destroy %s: %v", card.Name, err)
+		}
+	}
+	gameState.mu.Unlock()
+
+	h.AssertCreatureDead(knight)
+	for _, id := range []string{troll, ape} {
+		h.AssertCreatureAlive(id)
+		h.AssertCreatureTapped(id, true)
+		h.AssertCreatureDamage(id, 0)
+		if shields := gameState.cards[id].RegenerationShields; shields != 0 {
+			t.Errorf("expected %s's shield to be used, got %d", id, shields)
+		}
+	}
+
+	// An unused shield wears off in the cleanup step
+	if err := h.engine.GrantRegeneration(h.gameID, troll); err != nil {
+		t.Fatalf("failed to grant regeneration: %v", err)
+	}
+
+	gameState.mu.Lock()
+	for gameState.turnManager.CurrentStep() != rules.StepEnd {
+		gameState.turnManager.AdvanceStep("Alice")
+	}
+	gameState.turnManager.SetPriority("Alice")
+	gameState.mu.Unlock()
+
+	for _, playerID := range []string{"Alice", "Bob"} {
+		if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: playerID, ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+			t.Fatalf("failed to pass priority: %v", err)
+		}
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if step := gameState.turnManager.CurrentStep(); step != rules.StepCleanup {
+		t.Fatalf("expected the cleanup step, got %s", step)
+	}
+	if shields := gameState.cards[troll].RegenerationShields; shields != 0 {
+		t.Errorf("expected the shield to wear off in the cleanup step, got %d", shields)
+	}
+}
@@ -0,0 +1,205 @@
+package game
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// rollbackVoteTimeout is how long players have to approve a rollback before it is declined
+const rollbackVoteTimeout = 2 * time.Minute
+
+// rollbackVote is a turn rollback the players in a game are voting on. Per Java, all players must
+// agree to a rollback; until they do, the game accepts no actions.
+type rollbackVote struct {
+	requestedBy string
+	turns       int
+	currentTurn int
+	targetTurn  int
+	snapshot    *gameStateSnapshot // Taken at the start of targetTurn
+	approved    map[string]bool    // Player ID -> approved
+	timer       *time.Timer
+	generation  int // Number of the vote in the game, so the timer of an earlier one does nothing
+}
+
+// RequestRollback asks the other players to agree to roll a game back the given number of turns. The
+// requesting player approves it; the rollback runs once every player still in the game approves with
+// ApproveRollback, and is called off if one declines with DeclineRollback, leaves the game or doesn't
+// vote within rollbackVoteTimeout.
+func (e *MageEngine) RequestRollback(gameID string, turns int, playerID string) (err error) {
+	canRollback, err := e.CanRollbackTurns(gameID, turns)
+	if err != nil {
+		return err
+	}
+
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	var targetTurn int
+	var snapshot *gameStateSnapshot
+	if exists {
+		targetTurn = gameState.turnManager.TurnNumber() - turns
+		snapshot = e.turnSnapshots[gameID][targetTurn]
+	}
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "RequestRollback", turns, playerID)
+
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+	player, err := gameState.rollbackVoter(playerID)
+	if err != nil {
+		return err
+	}
+	// The turn may have ended before the game lock was taken
+	currentTurn := gameState.turnManager.TurnNumber()
+	if !canRollback || snapshot == nil || turns < 1 || currentTurn-turns != targetTurn {
+		return fmt.Errorf("cannot roll back %d turns from turn %d", turns, currentTurn)
+	}
+
+	gameState.rollbackVotes++
+	generation := gameState.rollbackVotes
+	vote := &rollbackVote{
+		requestedBy: playerID,
+		turns:       turns,
+		currentTurn: currentTurn,
+		targetTurn:  targetTurn,
+		snapshot:    snapshot,
+		approved:    map[string]bool{playerID: true},
+		generation:  generation,
+	}
+	vote.timer = time.AfterFunc(rollbackVoteTimeout, func() {
+		e.rollbackVoteExpired(gameState, generation)
+	})
+	gameState.rollbackVote = vote
+	e.stopPriorityClock(gameState)
+	gameState.addMessage(fmt.Sprintf("%s asks to roll back to the start of turn %d", player.Name, targetTurn), "system")
+	e.notifyGameStateChange(gameID, map[string]interface{}{
+		"type":        "rollback_requested",
+		"player_id":   playerID,
+		"to_turn":     targetTurn,
+		"deadline_ms": rollbackVoteTimeout.Milliseconds(),
+	})
+
+	e.resolveRollbackVote(gameState)
+	return nil
+}
+
+// ApproveRollback approves the rollback requested in a game
+func (e *MageEngine) ApproveRollback(gameID, playerID string) error {
+	return e.voteOnRollback(gameID, playerID, true)
+}
+
+// DeclineRollback declines the rollback requested in a game, calling it off
+func (e *MageEngine) DeclineRollback(gameID, playerID string) error {
+	return e.voteOnRollback(gameID, playerID, false)
+}
+
+// voteOnRollback records a player's vote on the requested rollback
+func (e *MageEngine) voteOnRollback(gameID, playerID string, approve bool) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	if approve {
+		defer e.logCall(gameState, &err, "ApproveRollback", playerID)
+	} else {
+		defer e.logCall(gameState, &err, "DeclineRollback", playerID)
+	}
+
+	vote := gameState.rollbackVote
+	if vote == nil {
+		return fmt.Errorf("no rollback has been requested in game %s", gameID)
+	}
+	player, err := gameState.rollbackVoter(playerID)
+	if err != nil {
+		return err
+	}
+
+	if !approve {
+		e.cancelRollbackVote(gameState, fmt.Sprintf("%s declines the rollback", player.Name))
+		return nil
+	}
+	vote.approved[playerID] = true
+	gameState.addMessage(fmt.Sprintf("%s approves the rollback", player.Name), "system")
+	e.resolveRollbackVote(gameState)
+	return nil
+}
+
+// rollbackVoter returns a player who can vote on a rollback: one still in the game
+func (s *engineGameState) rollbackVoter(playerID string) (*internalPlayer, error) {
+	player, exists := s.players[playerID]
+	if !exists {
+		return nil, fmt.Errorf("player %s not found", playerID)
+	}
+	if !player.canRespond() {
+		return nil, fmt.Errorf("player %s is no longer in the game", playerID)
+	}
+	return player, nil
+}
+
+// resolveRollbackVote rolls the game back if every player still in it has approved the rollback
+func (e *MageEngine) resolveRollbackVote(gameState *engineGameState) {
+	vote := gameState.rollbackVote
+	if vote == nil {
+		return
+	}
+	for _, pid := range gameState.playerOrder {
+		if player := gameState.players[pid]; player.canRespond() && !vote.approved[pid] {
+			return
+		}
+	}
+
+	vote.timer.Stop()
+	gameState.rollbackVote = nil
+	if e.logger != nil {
+		e.logger.Info("players agreed to a rollback",
+			zap.String("game_id", gameState.gameID),
+			zap.String("requested_by", vote.requestedBy),
+			zap.Int("turns", vote.turns),
+		)
+	}
+	e.restoreTurnSnapshot(gameState, vote.snapshot, vote.currentTurn, vote.targetTurn)
+	e.startPriorityClock(gameState)
+}
+
+// cancelRollbackVote calls off the rollback the players are voting on, if any
+func (e *MageEngine) cancelRollbackVote(gameState *engineGameState, reason string) {
+	vote := gameState.rollbackVote
+	if vote == nil {
+		return
+	}
+	vote.timer.Stop()
+	gameState.rollbackVote = nil
+	gameState.addMessage(reason, "system")
+	e.notifyGameStateChange(gameState.gameID, map[string]interface{}{
+		"type":   "rollback_declined",
+		"reason": reason,
+	})
+	e.startPriorityClock(gameState)
+}
+
+// rollbackVoteExpired declines a rollback the players didn't all approve in time
+func (e *MageEngine) rollbackVoteExpired(gameState *engineGameState, generation int) {
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+
+	if vote := gameState.rollbackVote; vote == nil || vote.generation != generation {
+		return
+	}
+	e.cancelRollbackVote(gameState, "The rollback vote expired")
+	e.logCall(gameState, nil, "rollbackVoteExpired", generation)
+}
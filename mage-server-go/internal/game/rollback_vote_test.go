@@ -0,0 +1,147 @@
+package game
+
+import "testing"
+
+// rollbackVoteSetup starts a game on turn 2 in which Bob is down to 10 life since turn 1
+func rollbackVoteSetup(t *testing.T, gameID string, players []string) *CombatTestHarness {
+	t.Helper()
+	h := NewCombatTestHarness(t, gameID, players)
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	for i := 0; gameState.turnManager.TurnNumber() < 2; i++ {
+		if i > 20 {
+			t.Fatal("could not reach turn 2")
+		}
+		gameState.turnManager.AdvanceStep("Bob")
+	}
+	gameState.players["Bob"].Life = 10
+	gameState.turnManager.SetPriority("Bob")
+	gameState.mu.Unlock()
+	return h
+}
+
+func bobLife(h *CombatTestHarness) int {
+	gameState := h.GetGameState()
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	return gameState.players["Bob"].Life
+}
+
+// TestRequestRollback_RunsOnceAllApprove verifies that a requested rollback waits for every player's
+// approval, with game actions rejected meanwhile
+func TestRequestRollback_RunsOnceAllApprove(t *testing.T) {
+	h := rollbackVoteSetup(t, "test-rollback-approve", []string{"Alice", "Bob"})
+
+	if err := h.engine.ApproveRollback(h.gameID, "Bob"); err == nil {
+		t.Error("expected approving a rollback nobody requested to fail")
+	}
+	if err := h.engine.RequestRollback(h.gameID, 1, "Alice"); err != nil {
+		t.Fatalf("failed to request rollback: %v", err)
+	}
+	if bobLife(h) != 10 {
+		t.Fatal("expected the rollback to wait for Bob's approval")
+	}
+	if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Bob", ActionType: "PLAYER_ACTION", Data: "PASS"}); err == nil {
+		t.Error("expected actions to be rejected while the players vote on a rollback")
+	}
+
+	if err := h.engine.ApproveRollback(h.gameID, "Bob"); err != nil {
+		t.Fatalf("failed to approve rollback: %v", err)
+	}
+	if life := bobLife(h); life != 20 {
+		t.Errorf("expected the game to be rolled back to turn 1 with Bob at 20 life, got %d", life)
+	}
+	gameState := h.GetGameState()
+	gameState.mu.RLock()
+	priority := gameState.turnManager.PriorityPlayer()
+	gameState.mu.RUnlock()
+	if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: priority, ActionType: "PLAYER_ACTION", Data: "PASS"}); err != nil {
+		t.Errorf("expected actions to be accepted after the rollback: %v", err)
+	}
+}
+
+// TestRequestRollback_DeclineCancels verifies that one player declining calls the rollback off
+func TestRequestRollback_DeclineCancels(t *testing.T) {
+	h := rollbackVoteSetup(t, "test-rollback-decline", []string{"Alice", "Bob"})
+
+	if err := h.engine.RequestRollback(h.gameID, 1, "Alice"); err != nil {
+		t.Fatalf("failed to request rollback: %v", err)
+	}
+	if err := h.engine.DeclineRollback(h.gameID, "Bob"); err != nil {
+		t.Fatalf("failed to decline rollback: %v", err)
+	}
+	if err := h.engine.ApproveRollback(h.gameID, "Bob"); err == nil {
+		t.Error("expected the declined rollback to be called off")
+	}
+	if life := bobLife(h); life != 10 {
+		t.Errorf("expected the game not to be rolled back, Bob has %d life", life)
+	}
+	if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Bob", ActionType: "PLAYER_ACTION", Data: "PASS"}); err != nil {
+		t.Errorf("expected actions to be accepted again: %v", err)
+	}
+}
+
+// TestRequestRollback_PlayerLeavingCancels verifies that a player leaving during the vote calls the
+// rollback off rather than bringing them back into the game
+func TestRequestRollback_PlayerLeavingCancels(t *testing.T) {
+	h := rollbackVoteSetup(t, "test-rollback-leave", []string{"Alice", "Bob", "Carol"})
+
+	if err := h.engine.RequestRollback(h.gameID, 1, "Alice"); err != nil {
+		t.Fatalf("failed to request rollback: %v", err)
+	}
+	if err := h.engine.ApproveRollback(h.gameID, "Bob"); err != nil {
+		t.Fatalf("failed to approve rollback: %v", err)
+	}
+	if err := h.engine.PlayerConcede(h.gameID, "Carol"); err != nil {
+		t.Fatalf("failed to concede: %v", err)
+	}
+
+	gameState := h.GetGameState()
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if gameState.rollbackVote != nil {
+		t.Error("expected the rollback vote to be called off when Carol left")
+	}
+	if !gameState.players["Carol"].Left || gameState.players["Bob"].Life != 10 {
+		t.Error("expected the game not to be rolled back")
+	}
+}
+
+// TestRequestRollback_ExpiresWithoutVotes verifies that a rollback vote a player never answers is
+// declined when it times out, so the game can't be frozen, and that no rollback skips the vote
+func TestRequestRollback_ExpiresWithoutVotes(t *testing.T) {
+	h := rollbackVoteSetup(t, "test-rollback-expire", []string{"Alice", "Bob"})
+
+	if err := h.engine.RequestRollback(h.gameID, 1, "Alice"); err != nil {
+		t.Fatalf("failed to request rollback: %v", err)
+	}
+	if err := h.engine.RollbackTurns(h.gameID, 1); err == nil {
+		t.Error("expected a rollback without a vote to be rejected while the players vote")
+	}
+
+	gameState := h.GetGameState()
+	gameState.mu.RLock()
+	generation := gameState.rollbackVote.generation
+	gameState.mu.RUnlock()
+	h.engine.rollbackVoteExpired(gameState, generation-1)
+	gameState.mu.RLock()
+	pending := gameState.rollbackVote != nil
+	gameState.mu.RUnlock()
+	if !pending {
+		t.Fatal("expected the timer of an earlier vote to leave the current one alone")
+	}
+
+	h.engine.rollbackVoteExpired(gameState, generation)
+	gameState.mu.RLock()
+	pending = gameState.rollbackVote != nil
+	gameState.mu.RUnlock()
+	if pending {
+		t.Fatal("expected the rollback vote to expire")
+	}
+	if life := bobLife(h); life != 10 {
+		t.Errorf("expected the game not to be rolled back, Bob has %d life", life)
+	}
+	if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Bob", ActionType: "PLAYER_ACTION", Data: "PASS"}); err != nil {
+		t.Errorf("expected actions to be accepted again: %v", err)
+	}
+}
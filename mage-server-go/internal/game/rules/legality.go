@@ -2,6 +2,7 @@ package rules
 
 import (
 	"fmt"
+	"strings"
 )
 
 // LegalityChecker validates stack items before resolution.
@@ -187,11 +188,10 @@ func (lc *LegalityChecker) extractTargets(item StackItem) ([]string, bool) {
 	if targetsStr, ok := item.Metadata["targets"]; ok && targetsStr != "" {
 		// Parse comma-separated targets
 		targets := []string{}
-		// Simple parsing - in production would handle more formats
-		if targetsStr != "" {
-			// For now, assume single target or comma-separated
-			// Full implementation would parse properly
-			targets = append(targets, targetsStr)
+		for _, targetID := range strings.Split(targetsStr, ",") {
+			if targetID = strings.TrimSpace(targetID); targetID != "" {
+				targets = append(targets, targetID)
+			}
 		}
 		return targets, len(targets) > 0
 	}
@@ -242,10 +242,12 @@ func (lc *LegalityChecker) validateTargets(targets []string) LegalityResult {
 		}
 	}
 
-	if len(invalidTargets) > 0 {
+	// Per rule 608.2b: a spell or ability is only removed when all of its targets are illegal;
+	// otherwise it resolves and doesn't affect the illegal ones
+	if len(invalidTargets) == len(targets) {
 		return LegalityResult{
 			Legal:  false,
-			Reason: "One or more targets are illegal",
+			Reason: "All targets are illegal",
 			Details: map[string]string{
 				"invalid_targets": fmt.Sprintf("%v", invalidTargets),
 			},
@@ -254,7 +256,7 @@ func (lc *LegalityChecker) validateTargets(targets []string) LegalityResult {
 
 	return LegalityResult{
 		Legal:  true,
-		Reason: "All targets are legal",
+		Reason: "At least one target is legal",
 	}
 }
 
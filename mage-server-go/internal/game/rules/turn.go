@@ -151,6 +151,31 @@ func NewTurnManager(activePlayer string) *TurnManager {
 	}
 }
 
+// RestoreTurnManager creates a turn manager at a saved point of the game: the turn number, the step
+// (by name, e.g. "MAIN1") in a turn sequence with or without the first strike damage step, and the
+// active and priority players.
+func RestoreTurnManager(turnNumber int, stepName string, hasFirstStrike bool, activePlayer, priorityPlayer string) (*TurnManager, error) {
+	if turnNumber < 1 {
+		return nil, fmt.Errorf("invalid turn number %d", turnNumber)
+	}
+	tm := NewTurnManager(activePlayer)
+	tm.turnNumber = turnNumber
+	tm.sequence = buildTurnSequence(hasFirstStrike)
+	tm.hasFirstStrike = hasFirstStrike
+	tm.orderIndex = -1
+	for i, entry := range tm.sequence {
+		if entry.step.String() == stepName {
+			tm.orderIndex = i
+			break
+		}
+	}
+	if tm.orderIndex < 0 {
+		return nil, fmt.Errorf("unknown step %q", stepName)
+	}
+	tm.SetPriority(priorityPlayer)
+	return tm, nil
+}
+
 // CurrentPhase returns the phase currently in progress.
 func (tm *TurnManager) CurrentPhase() Phase {
 	return tm.sequence[tm.orderIndex].phase
@@ -233,6 +258,11 @@ func (tm *TurnManager) SetHasFirstStrike(hasFirstStrike bool) {
 	tm.hasFirstStrike = hasFirstStrike
 }
 
+// HasFirstStrike reports whether the current turn sequence includes the first strike damage step.
+func (tm *TurnManager) HasFirstStrike() bool {
+	return tm.hasFirstStrike
+}
+
 // GetSequence returns the current turn sequence for testing/inspection
 func (tm *TurnManager) GetSequence() []turnEntry {
 	return tm.sequence
@@ -0,0 +1,131 @@
+package game
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/magefree/mage-server-go/internal/plugin"
+)
+
+// DrawResolution decides the result of a game that ends at a turn or time limit rather than by
+// players losing
+type DrawResolution string
+
+const (
+	DrawResolutionTrueDraw     DrawResolution = "TRUE_DRAW"     // The game is a draw
+	DrawResolutionHighestLife  DrawResolution = "HIGHEST_LIFE"  // The player with the highest life total wins
+	DrawResolutionFewestPoison DrawResolution = "FEWEST_POISON" // The player with the fewest poison counters wins
+)
+
+// MulliganRule selects the mulligan procedure (rule 103.5)
+type MulliganRule string
+
+const (
+	MulliganLondon    MulliganRule = "LONDON"    // Draw a full hand, then put a card on the bottom per mulligan
+	MulliganVancouver MulliganRule = "VANCOUVER" // Draw one fewer card per mulligan, then scry 1 with a smaller hand
+)
+
+// RulesOptions aggregates the rules toggles that differ between formats and variants.
+// Options are chosen from the game type at StartGame and consulted instead of hardcoded constants.
+type RulesOptions struct {
+	StartingLife     int // Per rule 103.4 (20, or 40 in Commander)
+	StartingHandSize int // Per rule 103.5 (7)
+	MinimumDeckSize  int // Per rule 100.2a: cards a decklist needs at least (60, or 100 in Commander; 0 = no minimum)
+	PoisonThreshold  int // Per rule 704.5c: poison counters at which a player loses (10)
+	FreeMulligans    int // Mulligans that don't reduce hand size (e.g. the free first mulligan in multiplayer, rule 103.5c)
+	MulliganRule     MulliganRule
+	TurnLimit        int           // The game ends when this turn is over (0 = no limit)
+	TimeLimit        time.Duration // The game ends when this much time has passed since it started (0 = no limit)
+	// DrawResolution decides games ended by TurnLimit or EndGameAtLimit; a tie for the best value is a draw
+	DrawResolution    DrawResolution
+	RollbackAllowed   bool // Players may roll back turns (Per Java MatchOptions.rollbackTurnsAllowed)
+	SpectatorsAllowed bool // Users who aren't playing may watch the game
+	// RangeOfInfluence limits what a player can affect to players seated within this many seats (rule 801; 0 = unlimited)
+	RangeOfInfluence int
+	// SkipFirstDraw makes the starting player skip the draw of their first turn (rule 103.8a;
+	// multiplayer games don't skip it, rule 103.8c)
+	SkipFirstDraw bool
+	// ManaBurn makes players lose 1 life for each mana left in their pool as it empties (pre-Magic 2010 rule 300.3)
+	ManaBurn bool
+	// CommanderDamage is the combat damage from a single commander at which a player loses (rule 704.6c;
+	// 0 = commander damage isn't tracked)
+	CommanderDamage int
+}
+
+// DefaultRulesOptions returns the options for a standard constructed game
+func DefaultRulesOptions() RulesOptions {
+	return RulesOptions{
+		StartingLife:      20,
+		StartingHandSize:  7,
+		MinimumDeckSize:   60,
+		PoisonThreshold:   10,
+		FreeMulligans:     0,
+		MulliganRule:      MulliganLondon,
+		TurnLimit:         0,
+		DrawResolution:    DrawResolutionTrueDraw,
+		RollbackAllowed:   true,
+		SpectatorsAllowed: true,
+		SkipFirstDraw:     true,
+	}
+}
+
+// RulesOptionsForGameType returns the rules options for a game type, from the rules of the registered game
+// type with that name (see plugin.GameType); an unregistered game type gets the default options
+func RulesOptionsForGameType(gameType string) RulesOptions {
+	options := DefaultRulesOptions()
+	registered, err := plugin.GetGameType(gameType)
+	if err != nil {
+		return options
+	}
+
+	rules := registered.Rules()
+	options.StartingLife = rules.StartingLife
+	options.MinimumDeckSize = rules.MinimumDeckSize
+	options.FreeMulligans = rules.FreeMulligans
+	options.SkipFirstDraw = rules.SkipFirstDraw
+	options.ManaBurn = rules.ManaBurn
+	options.CommanderDamage = rules.CommanderDamage
+	return options
+}
+
+// Validate checks that the options describe a playable game
+func (o RulesOptions) Validate() error {
+	if o.StartingLife <= 0 {
+		return fmt.Errorf("starting life must be positive, got %d", o.StartingLife)
+	}
+	if o.StartingHandSize < 0 {
+		return fmt.Errorf("starting hand size must not be negative, got %d", o.StartingHandSize)
+	}
+	if o.MinimumDeckSize < 0 {
+		return fmt.Errorf("minimum deck size must not be negative, got %d", o.MinimumDeckSize)
+	}
+	if o.PoisonThreshold <= 0 {
+		return fmt.Errorf("poison threshold must be positive, got %d", o.PoisonThreshold)
+	}
+	if o.FreeMulligans < 0 {
+		return fmt.Errorf("free mulligans must not be negative, got %d", o.FreeMulligans)
+	}
+	switch o.MulliganRule {
+	case "", MulliganLondon, MulliganVancouver:
+	default:
+		return fmt.Errorf("unknown mulligan rule %q", o.MulliganRule)
+	}
+	if o.TurnLimit < 0 {
+		return fmt.Errorf("turn limit must not be negative, got %d", o.TurnLimit)
+	}
+	if o.TimeLimit < 0 {
+		return fmt.Errorf("time limit must not be negative, got %s", o.TimeLimit)
+	}
+	if o.CommanderDamage < 0 {
+		return fmt.Errorf("commander damage must not be negative, got %d", o.CommanderDamage)
+	}
+	if o.RangeOfInfluence < 0 {
+		return fmt.Errorf("range of influence must not be negative, got %d", o.RangeOfInfluence)
+	}
+	switch o.DrawResolution {
+	case "", DrawResolutionTrueDraw, DrawResolutionHighestLife, DrawResolutionFewestPoison:
+	default:
+		return fmt.Errorf("unknown draw resolution %q", o.DrawResolution)
+	}
+	return nil
+}
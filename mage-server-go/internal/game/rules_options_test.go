@@ -0,0 +1,261 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/mana"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+	"github.com/magefree/mage-server-go/internal/plugin"
+
+	"go.uber.org/zap/zaptest"
+)
+
+// TestRulesOptions_CustomOptionsHonored verifies that starting life, hand size and poison threshold
+// come from the game's rules options
+func TestRulesOptions_CustomOptionsHonored(t *testing.T) {
+	engine := NewMageEngine(zaptest.NewLogger(t))
+	gameID := "test-rules-options"
+
+	options := DefaultRulesOptions()
+	options.StartingLife = 30
+	options.StartingHandSize = 5
+	options.PoisonThreshold = 3
+
+	if err := engine.StartGameWithOptions(gameID, []string{"Alice", "Bob"}, "Duel", options); err != nil {
+		t.Fatalf("failed to start game: %v", err)
+	}
+
+	engine.mu.RLock()
+	gameState := engine.games[gameID]
+	engine.mu.RUnlock()
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+
+	for _, playerID := range []string{"Alice", "Bob"} {
+		player := gameState.players[playerID]
+		if player.Life != 30 {
+			t.Errorf("expected %s to start at 30 life, got %d", playerID, player.Life)
+		}
+		if len(player.Hand) != 5 {
+			t.Errorf("expected %s to start with 5 cards, got %d", playerID, len(player.Hand))
+		}
+	}
+
+	// 3 poison counters are lethal under these options
+	gameState.players["Bob"].Poison = 2
+	engine.checkStateBasedActions(gameState)
+	if gameState.players["Bob"].Lost {
+		t.Fatal("expected Bob to survive 2 poison counters")
+	}
+
+	gameState.players["Bob"].Poison = 3
+	engine.checkStateBasedActions(gameState)
+	if !gameState.players["Bob"].Lost {
+		t.Error("expected Bob to lose at the custom poison threshold")
+	}
+}
+
+// TestRulesOptions_GameTypeDefaults verifies the options chosen from the game type at StartGame
+func TestRulesOptions_GameTypeDefaults(t *testing.T) {
+	engine := NewMageEngine(zaptest.NewLogger(t))
+
+	if err := engine.StartGame("test-rules-commander", []string{"Alice", "Bob"}, "Commander Duel"); err != nil {
+		t.Fatalf("failed to start game: %v", err)
+	}
+	if err := engine.StartGame("test-rules-duel", []string{"Alice", "Bob"}, "Duel"); err != nil {
+		t.Fatalf("failed to start game: %v", err)
+	}
+
+	engine.mu.RLock()
+	commander := engine.games["test-rules-commander"]
+	duel := engine.games["test-rules-duel"]
+	engine.mu.RUnlock()
+
+	if commander.players["Alice"].Life != 40 || commander.rulesOptions.MinimumDeckSize != 100 {
+		t.Errorf("expected Commander to start at 40 life with 100-card decks, got %+v", commander.rulesOptions)
+	}
+	if duel.players["Alice"].Life != 20 || duel.rulesOptions.PoisonThreshold != 10 || duel.rulesOptions.MinimumDeckSize != 60 {
+		t.Errorf("expected default options for a duel, got %+v", duel.rulesOptions)
+	}
+	if !commander.rulesOptions.SkipFirstDraw || RulesOptionsForGameType("Free For All").SkipFirstDraw {
+		t.Error("expected only two-player games to skip the first draw")
+	}
+
+	bad := DefaultRulesOptions()
+	bad.StartingLife = 0
+	if err := engine.StartGameWithOptions("test-rules-bad", []string{"Alice", "Bob"}, "Duel", bad); err == nil {
+		t.Error("expected invalid rules options to be rejected")
+	}
+}
+
+// TestRulesOptions_DrawResolutionAtTurnLimit verifies each draw resolution policy when the game ends at
+// its turn limit with differing life totals and poison counters
+func TestRulesOptions_DrawResolutionAtTurnLimit(t *testing.T) {
+	tests := []struct {
+		policy DrawResolution
+		winner string // "" for a draw
+	}{
+		{DrawResolutionTrueDraw, ""},
+		{DrawResolutionHighestLife, "Alice"},
+		{DrawResolutionFewestPoison, "Bob"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			engine := NewMageEngine(zaptest.NewLogger(t))
+			gameID := "test-draw-" + string(tt.policy)
+
+			options := DefaultRulesOptions()
+			options.TurnLimit = 1
+			options.DrawResolution = tt.policy
+			if err := engine.StartGameWithOptions(gameID, []string{"Alice", "Bob"}, "Duel", options); err != nil {
+				t.Fatalf("failed to start game: %v", err)
+			}
+
+			engine.mu.RLock()
+			gameState := engine.games[gameID]
+			engine.mu.RUnlock()
+
+			// Alice has more life, Bob has fewer poison counters
+			gameState.mu.Lock()
+			gameState.players["Alice"].Life = 15
+			gameState.players["Alice"].Poison = 3
+			gameState.players["Bob"].Life = 12
+			for gameState.turnManager.CurrentStep() != rules.StepCleanup {
+				gameState.turnManager.AdvanceStep("Alice")
+			}
+			gameState.turnManager.SetPriority("Alice")
+			gameState.mu.Unlock()
+
+			// Passing through the cleanup step of the last turn ends the game
+			for _, playerID := range []string{"Alice", "Bob"} {
+				if err := engine.ProcessAction(gameID, PlayerAction{PlayerID: playerID, ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+					t.Fatalf("failed to pass priority: %v", err)
+				}
+			}
+
+			gameState.mu.RLock()
+			defer gameState.mu.RUnlock()
+			if gameState.state != GameStateFinished {
+				t.Fatalf("expected the game to end at the turn limit, got state %v", gameState.state)
+			}
+			for _, playerID := range []string{"Alice", "Bob"} {
+				wins := gameState.players[playerID].Wins
+				if playerID == tt.winner && wins != 1 {
+					t.Errorf("expected %s to win under %s", playerID, tt.policy)
+				}
+				if playerID != tt.winner && wins != 0 {
+					t.Errorf("expected %s not to win under %s", playerID, tt.policy)
+				}
+			}
+		})
+	}
+
+	// Tied life totals are a draw under HighestLife
+	engine := NewMageEngine(zaptest.NewLogger(t))
+	options := DefaultRulesOptions()
+	options.DrawResolution = DrawResolutionHighestLife
+	if err := engine.StartGameWithOptions("test-draw-tie", []string{"Alice", "Bob"}, "Duel", options); err != nil {
+		t.Fatalf("failed to start game: %v", err)
+	}
+	if err := engine.EndGameAtLimit("test-draw-tie", "time limit reached"); err != nil {
+		t.Fatalf("failed to end game: %v", err)
+	}
+	engine.mu.RLock()
+	gameState := engine.games["test-draw-tie"]
+	engine.mu.RUnlock()
+	if gameState.players["Alice"].Wins != 0 || gameState.players["Bob"].Wins != 0 {
+		t.Error("expected tied life totals to be a draw")
+	}
+
+	options.DrawResolution = "COIN_FLIP"
+	if err := engine.StartGameWithOptions("test-draw-bad", []string{"Alice", "Bob"}, "Duel", options); err == nil {
+		t.Error("expected an unknown draw resolution to be rejected")
+	}
+}
+
+// TestRulesOptions_FromGameTypeRules verifies that each registered game type's options come from the
+// rules it is configured with
+func TestRulesOptions_FromGameTypeRules(t *testing.T) {
+	for _, gameType := range plugin.GetAllGameTypes() {
+		rules := gameType.Rules()
+		options := RulesOptionsForGameType(gameType.Name())
+		if options.StartingLife != rules.StartingLife || options.MinimumDeckSize != rules.MinimumDeckSize ||
+			options.FreeMulligans != rules.FreeMulligans || options.SkipFirstDraw != rules.SkipFirstDraw ||
+			options.ManaBurn != rules.ManaBurn || options.CommanderDamage != rules.CommanderDamage {
+			t.Errorf("%s: options %+v don't match the game type's rules %+v", gameType.Name(), options, rules)
+		}
+		if err := options.Validate(); err != nil {
+			t.Errorf("%s: %v", gameType.Name(), err)
+		}
+	}
+
+	if options := RulesOptionsForGameType("Commander Free For All"); options.CommanderDamage != 21 || options.SkipFirstDraw {
+		t.Errorf("expected multiplayer Commander to track commander damage and not skip the first draw, got %+v", options)
+	}
+	if options := RulesOptionsForGameType("Brawl"); options.StartingLife != 25 || options.CommanderDamage != 0 {
+		t.Errorf("expected Brawl to start at 25 life without commander damage, got %+v", options)
+	}
+	if options := RulesOptionsForGameType("Canadian Highlander"); options.MinimumDeckSize != 100 {
+		t.Errorf("expected Canadian Highlander decks of at least 100 cards, got %d", options.MinimumDeckSize)
+	}
+	if RulesOptionsForGameType("Duel") != DefaultRulesOptions() {
+		t.Error("expected an unregistered game type to get the default options")
+	}
+}
+
+// TestRulesOptions_ManaBurn verifies that with mana burn a player loses life for mana left in their pool
+// as a step ends
+func TestRulesOptions_ManaBurn(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-mana-burn", []string{"Alice", "Bob"})
+	castTestSetup(t, h, "Alice", "unused", "Shock", "Instant", rules.StepMain1)
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.rulesOptions.ManaBurn = true
+	gameState.mu.Unlock()
+
+	if err := h.engine.AddMana(h.gameID, "Bob", mana.ManaBlue, 2); err != nil {
+		t.Fatalf("failed to add mana: %v", err)
+	}
+	for _, playerID := range []string{"Alice", "Bob"} {
+		if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: playerID, ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+			t.Fatalf("failed to pass priority: %v", err)
+		}
+	}
+
+	// Alice also loses the {R} castTestSetup gave her
+	h.AssertPlayerLife("Bob", 18)
+	h.AssertPlayerLife("Alice", 19)
+}
+
+// TestRulesOptions_CommanderDamage verifies that a player dealt the commander damage threshold in combat
+// by one commander loses, however much life they have left
+func TestRulesOptions_CommanderDamage(t *testing.T) {
+	for _, commander := range []bool{true, false} {
+		h := NewCombatTestHarness(t, "test-commander-damage", []string{"Alice", "Bob"})
+		h.CreateAttacker("general", "Marit Lage", "Alice", "21", "21")
+		gameState := h.GetGameState()
+		gameState.mu.Lock()
+		gameState.rulesOptions.CommanderDamage = 21
+		gameState.players["Bob"].Life = 40
+		gameState.mu.Unlock()
+		if commander {
+			if err := h.engine.SetCommander(h.gameID, "general"); err != nil {
+				t.Fatalf("failed to set commander: %v", err)
+			}
+		}
+
+		h.RunFullCombat("Alice", map[string]string{"general": "Bob"}, nil)
+		gameState.mu.Lock()
+		h.engine.checkStateBasedActions(gameState)
+		bob := gameState.players["Bob"]
+		if bob.Life != 19 {
+			t.Errorf("expected Bob at 19 life, got %d", bob.Life)
+		}
+		if bob.Lost != commander {
+			t.Errorf("commander %t: expected Bob to have lost %t, got %t", commander, commander, bob.Lost)
+		}
+		gameState.mu.Unlock()
+	}
+}
@@ -0,0 +1,231 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// Sacrifice makes a player sacrifice a permanent they control, moving it to its owner's graveyard.
+// Sacrificing isn't destroying, so regeneration and indestructible don't stop it (rule 701.21a).
+func (e *MageEngine) Sacrifice(gameID, playerID, cardID string) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "Sacrifice", playerID, cardID)
+
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+	card, exists := gameState.cards[cardID]
+	if !exists {
+		return fmt.Errorf("card %s not found", cardID)
+	}
+	if err := e.sacrificePermanent(gameState, playerID, card); err != nil {
+		return err
+	}
+	for e.checkStateBasedActions(gameState) {
+		// Continue checking until stable
+	}
+	return nil
+}
+
+// sacrificePermanent moves a permanent its controller sacrifices to its owner's graveyard
+// Per Java PermanentImpl.sacrifice()
+func (e *MageEngine) sacrificePermanent(gameState *engineGameState, playerID string, card *internalCard) error {
+	if card.Zone != zoneBattlefield {
+		return fmt.Errorf("%s is not on the battlefield", card.Name)
+	}
+	// Per rule 701.21a: a player can sacrifice only a permanent they control
+	if card.ControllerID != playerID {
+		return fmt.Errorf("player %s does not control %s", playerID, card.Name)
+	}
+	if err := e.moveCard(gameState, card, zoneGraveyard, ""); err != nil {
+		return fmt.Errorf("failed to sacrifice %s: %w", card.Name, err)
+	}
+	gameState.eventBus.Publish(rules.NewEvent(rules.EventSacrificedPermanent, card.ID, card.ID, playerID))
+	gameState.addMessage(fmt.Sprintf("%s sacrifices %s", playerID, card.Name), "action")
+	return nil
+}
+
+// abilityCost is a cost of an ability or spell other than mana, {T} and loyalty, such as sacrificing
+// a creature. Costs paid with objects the player chooses list the candidates, and the chosen objects
+// are passed to pay.
+// Per Java Cost
+type abilityCost interface {
+	// text describes the cost (e.g. "Sacrifice a creature")
+	text() string
+	// candidates returns the objects a player may pay the cost with and how many they choose
+	// (0 = the cost involves no choice)
+	candidates(e *MageEngine, gameState *engineGameState, playerID, sourceID string) ([]string, int)
+	// pay pays the cost with the chosen objects
+	pay(e *MageEngine, gameState *engineGameState, playerID, sourceID string, chosen []string) error
+}
+
+// sacrificeCost is "Sacrifice a creature" and the like: sacrificing permanents of the player's choice
+// Per Java SacrificeTargetCost
+type sacrificeCost struct {
+	Description string                        // e.g. "Sacrifice a creature"
+	Count       int                           // Permanents to sacrifice (0 = 1)
+	Filter      func(card *internalCard) bool // Permanents that can be sacrificed (nil = any)
+}
+
+func (c *sacrificeCost) text() string {
+	return c.Description
+}
+
+func (c *sacrificeCost) candidates(e *MageEngine, gameState *engineGameState, playerID, sourceID string) ([]string, int) {
+	ids := make([]string, 0)
+	for _, card := range gameState.battlefield {
+		if card.ControllerID == playerID && (c.Filter == nil || c.Filter(card)) {
+			ids = append(ids, card.ID)
+		}
+	}
+	return ids, max(c.Count, 1)
+}
+
+func (c *sacrificeCost) pay(e *MageEngine, gameState *engineGameState, playerID, sourceID string, chosen []string) error {
+	for _, id := range chosen {
+		card, exists := gameState.cards[id]
+		if !exists {
+			return fmt.Errorf("card %s not found", id)
+		}
+		if err := e.sacrificePermanent(gameState, playerID, card); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// costPayment returns what a player pays a cost with: the chosen objects, which must be legal, or
+// without a choice every candidate if there are just as many as the cost needs
+func (e *MageEngine) costPayment(gameState *engineGameState, playerID, sourceID string, cost abilityCost, chosen []string) ([]string, error) {
+	candidates, count := cost.candidates(e, gameState, playerID, sourceID)
+	if count == 0 {
+		return nil, nil
+	}
+	if len(candidates) < count {
+		return nil, fmt.Errorf("can't pay %q", cost.text())
+	}
+	if chosen == nil {
+		if len(candidates) > count {
+			return nil, fmt.Errorf("choose what to pay %q with", cost.text())
+		}
+		return candidates, nil
+	}
+	if len(chosen) != count {
+		return nil, fmt.Errorf("%q needs %d choices, got %d", cost.text(), count, len(chosen))
+	}
+	seen := make(map[string]bool, len(chosen))
+	for _, id := range chosen {
+		if seen[id] || !containsString(candidates, id) {
+			return nil, fmt.Errorf("%s can't pay %q", id, cost.text())
+		}
+		seen[id] = true
+	}
+	return append([]string(nil), chosen...), nil
+}
+
+// checkCostsPayable checks that a player has enough objects to pay each cost
+func (e *MageEngine) checkCostsPayable(gameState *engineGameState, playerID, sourceID string, costs []abilityCost) error {
+	for _, cost := range costs {
+		if candidates, count := cost.candidates(e, gameState, playerID, sourceID); len(candidates) < count {
+			return fmt.Errorf("can't pay %q", cost.text())
+		}
+	}
+	return nil
+}
+
+// chooseCostPayments asks a player, one cost at a time, what to pay costs with, then calls onChosen with
+// the objects chosen for each cost; costs without a choice to make are answered without asking
+func (e *MageEngine) chooseCostPayments(gameState *engineGameState, playerID, sourceID string, costs []abilityCost, chosen [][]string, onChosen func(gameState *engineGameState, payments [][]string) error) error {
+	for len(chosen) < len(costs) {
+		cost := costs[len(chosen)]
+		candidates, count := cost.candidates(e, gameState, playerID, sourceID)
+		if count == 0 || len(candidates) <= count {
+			payment, err := e.costPayment(gameState, playerID, sourceID, cost, nil)
+			if err != nil {
+				return err
+			}
+			chosen = append(chosen, payment)
+			continue
+		}
+		gameState.addDecision(&Decision{
+			PlayerID: playerID,
+			Kind:     DecisionChooseCards,
+			Text:     fmt.Sprintf("Choose how to pay: %s", cost.text()),
+			Choices:  candidates,
+			Min:      count,
+			Max:      count,
+			resolve: func(gameState *engineGameState, response Response) error {
+				return e.chooseCostPayments(gameState, playerID, sourceID, costs, append(chosen, response.Choices), onChosen)
+			},
+		})
+		return nil
+	}
+	return onChosen(gameState, chosen)
+}
+
+// checkCostPayments checks what each cost is paid with (see costPayment) and returns the payments;
+// they are checked before any cost is paid
+func (e *MageEngine) checkCostPayments(gameState *engineGameState, playerID, sourceID string, costs []abilityCost, payments [][]string) ([][]string, error) {
+	checked := make([][]string, len(costs))
+	used := make(map[string]bool)
+	for i, cost := range costs {
+		var chosen []string
+		if i < len(payments) {
+			chosen = payments[i]
+		}
+		payment, err := e.costPayment(gameState, playerID, sourceID, cost, chosen)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range payment {
+			if used[id] {
+				return nil, fmt.Errorf("%s can't pay more than one cost", id)
+			}
+			used[id] = true
+		}
+		checked[i] = payment
+	}
+	return checked, nil
+}
+
+// payCosts pays costs with payments returned by checkCostPayments
+func (e *MageEngine) payCosts(gameState *engineGameState, playerID, sourceID string, costs []abilityCost, payments [][]string) error {
+	for i, cost := range costs {
+		if err := cost.pay(e, gameState, playerID, sourceID, payments[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RegisterSpellCost adds an additional cost a card has as it is cast as a spell (rule 601.2f),
+// e.g. "As an additional cost to cast this spell, sacrifice a creature"
+func (e *MageEngine) RegisterSpellCost(gameID, cardID string, cost abilityCost) error {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+
+	if gameState.spellCosts == nil {
+		gameState.spellCosts = make(map[string][]abilityCost)
+	}
+	gameState.spellCosts[cardID] = append(gameState.spellCosts[cardID], cost)
+	gameState.markUnreplayable("RegisterSpellCost")
+	return nil
+}
@@ -0,0 +1,85 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/counters"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestSacrificeCost_IndestructibleCreature verifies that an ability's "Sacrifice a creature" cost is
+// paid with the creature the player chooses, that indestructible doesn't save it, and that a player
+// can't sacrifice a permanent they don't control
+func TestSacrificeCost_IndestructibleCreature(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-sacrifice-cost", []string{"Alice", "Bob"})
+	h.CreateCreature(CreatureSpec{ID: "golem", Name: "Darksteel Myr", Power: "0", Toughness: "1", Controller: "Alice", Abilities: []string{abilityIndestructible}})
+	h.CreateAttacker("bears", "Grizzly Bears", "Alice", "2", "2")
+	h.CreateBlocker("ogre", "Hill Giant", "Bob", "3", "3")
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	gameState.cards["altar"] = &internalCard{ID: "altar", Name: "Altar of Dementia", Type: "Artifact", Zone: zoneBattlefield, OwnerID: "Alice", ControllerID: "Alice", Counters: counters.NewCounters()}
+	for _, id := range []string{"golem", "bears", "ogre", "altar"} {
+		gameState.battlefield = append(gameState.battlefield, gameState.cards[id])
+	}
+	gameState.turnManager.SetPriority("Alice")
+	var sacrificed []string
+	gameState.eventBus.SubscribeTyped(rules.EventSacrificedPermanent, func(event rules.Event) {
+		sacrificed = append(sacrificed, event.TargetID)
+	})
+	gameState.mu.Unlock()
+
+	abilityID, err := h.engine.RegisterActivatedAbility(h.gameID, &activatedAbility{
+		SourceID: "altar",
+		Text:     "Sacrifice a creature: You gain 2 life.",
+		Costs:    []abilityCost{&sacrificeCost{Description: "Sacrifice a creature", Filter: h.engine.isCreature}},
+		Resolve: func(gameState *engineGameState, controllerID string, _ []string) error {
+			gameState.players[controllerID].Life += 2
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to register ability: %v", err)
+	}
+
+	if err := h.engine.ActivateAbility(h.gameID, "altar", abilityID, "Alice"); err != nil {
+		t.Fatalf("failed to activate ability: %v", err)
+	}
+	decisions, err := h.engine.GetPendingDecisions(h.gameID, "Alice")
+	if err != nil || len(decisions) != 1 {
+		t.Fatalf("expected Alice to choose the creature to sacrifice, got %v (%v)", decisions, err)
+	}
+	if choices := decisions[0].Choices; len(choices) != 2 || containsString(choices, "ogre") {
+		t.Errorf("expected only Alice's creatures to be sacrificeable, got %v", choices)
+	}
+	if err := h.engine.RespondToDecision(h.gameID, "Alice", decisions[0].ID, Response{Choices: []string{"golem"}}); err != nil {
+		t.Fatalf("failed to choose the creature to sacrifice: %v", err)
+	}
+
+	gameState.mu.Lock()
+	if err := h.engine.resolveStack(gameState); err != nil {
+		t.Fatalf("failed to resolve stack: %v", err)
+	}
+	if life := gameState.players["Alice"].Life; life != 22 {
+		t.Errorf("expected Alice to gain 2 life, got %d", life)
+	}
+	golem := gameState.cards["golem"]
+	if golem.Zone != zoneGraveyard {
+		t.Errorf("expected the indestructible creature to be sacrificed, got zone %s", zoneToString(golem.Zone))
+	}
+	if gameState.cards["bears"].Zone != zoneBattlefield {
+		t.Error("expected the creature that wasn't chosen to stay on the battlefield")
+	}
+	if len(sacrificed) != 1 || sacrificed[0] != "golem" {
+		t.Errorf("expected a sacrificed event for the creature, got %v", sacrificed)
+	}
+	gameState.mu.Unlock()
+
+	if err := h.engine.Sacrifice(h.gameID, "Alice", "ogre"); err == nil {
+		t.Error("expected Alice not to be able to sacrifice Bob's creature")
+	}
+	if err := h.engine.Sacrifice(h.gameID, "Bob", "ogre"); err != nil {
+		t.Fatalf("failed to sacrifice: %v", err)
+	}
+	h.AssertCreatureDead("ogre")
+}
@@ -0,0 +1,244 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// pendingLook is a scry or surveil whose looked-at cards are waiting for the player to put them back
+type pendingLook struct {
+	action  string   // "scry" or "surveil"
+	cardIDs []string // The looked-at cards, from the top of the library
+}
+
+// Scry has a player look at the top n cards of their library. The cards stay where they are, shown
+// among the looked-at cards, until the player puts them back with ReorderScry.
+// Per rule 701.22a and Java PlayerImpl.scry()
+func (e *MageEngine) Scry(gameID, playerID string, n int) error {
+	return e.lookAtTop(gameID, playerID, n, "scry", rules.EventScry)
+}
+
+// ReorderScry finishes a player's scry: toTop are put back on top of their library with the first card
+// on top, and toBottom on the bottom in that order. Together they must be exactly the cards looked at.
+func (e *MageEngine) ReorderScry(gameID, playerID string, toTop []string, toBottom []string) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "ReorderScry", playerID, toTop, toBottom)
+
+	player, exists := gameState.players[playerID]
+	if !exists {
+		return fmt.Errorf("player %s not found", playerID)
+	}
+	cards, rest, err := e.takeLookedAtCards(gameState, player, "scry", toTop, toBottom)
+	if err != nil {
+		return err
+	}
+
+	library := make([]*internalCard, 0, len(player.Library))
+	for _, cardID := range toTop {
+		library = append(library, cards[cardID])
+	}
+	library = append(library, rest...)
+	for _, cardID := range toBottom {
+		library = append(library, cards[cardID])
+		gameState.eventBus.Publish(rules.NewEvent(rules.EventScryToBottom, cardID, "", playerID))
+	}
+	player.Library = library
+
+	e.endLook(gameState, player)
+	gameState.eventBus.Publish(rules.NewEventWithAmount(rules.EventScried, playerID, "", playerID, len(cards)))
+	gameState.addMessage(fmt.Sprintf("%s puts %d card(s) on top and %d on the bottom of their library", player.Name, len(toTop), len(toBottom)), "action")
+
+	e.notifyPlayerAction(gameID, playerID, map[string]interface{}{
+		"type":      "reorder_scry",
+		"to_top":    len(toTop),
+		"to_bottom": len(toBottom),
+	})
+	return nil
+}
+
+// Surveil has a player look at the top n cards of their library. The cards stay where they are, shown
+// among the looked-at cards, until the player puts them back with ReorderSurveil.
+// Per rule 701.25a and Java PlayerImpl.surveil()
+func (e *MageEngine) Surveil(gameID, playerID string, n int) error {
+	return e.lookAtTop(gameID, playerID, n, "surveil", rules.EventSurveil)
+}
+
+// ReorderSurveil finishes a player's surveil: toTop are put back on top of their library with the first
+// card on top, and toGraveyard into their graveyard. Together they must be exactly the cards looked at.
+func (e *MageEngine) ReorderSurveil(gameID, playerID string, toTop []string, toGraveyard []string) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "ReorderSurveil", playerID, toTop, toGraveyard)
+
+	player, exists := gameState.players[playerID]
+	if !exists {
+		return fmt.Errorf("player %s not found", playerID)
+	}
+	cards, rest, err := e.takeLookedAtCards(gameState, player, "surveil", toTop, toGraveyard)
+	if err != nil {
+		return err
+	}
+
+	library := make([]*internalCard, 0, len(player.Library))
+	for _, cardID := range toTop {
+		library = append(library, cards[cardID])
+	}
+	player.Library = append(library, rest...)
+	for _, cardID := range toGraveyard {
+		if err := e.moveCard(gameState, cards[cardID], zoneGraveyard, ""); err != nil {
+			return fmt.Errorf("failed to put %s into the graveyard: %w", cards[cardID].Name, err)
+		}
+	}
+
+	e.endLook(gameState, player)
+	gameState.eventBus.Publish(rules.NewEventWithAmount(rules.EventSurveiled, playerID, "", playerID, len(cards)))
+	gameState.addMessage(fmt.Sprintf("%s puts %d card(s) on top of their library and %d into their graveyard", player.Name, len(toTop), len(toGraveyard)), "action")
+
+	e.notifyPlayerAction(gameID, playerID, map[string]interface{}{
+		"type":         "reorder_surveil",
+		"to_top":       len(toTop),
+		"to_graveyard": len(toGraveyard),
+	})
+	return nil
+}
+
+// lookAtTop starts a scry or surveil of the top n cards of a player's library
+func (e *MageEngine) lookAtTop(gameID, playerID string, n int, action string, eventType rules.EventType) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	if action == "scry" {
+		defer e.logCall(gameState, &err, "Scry", playerID, n)
+	} else {
+		defer e.logCall(gameState, &err, "Surveil", playerID, n)
+	}
+
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+	player, exists := gameState.players[playerID]
+	if !exists {
+		return fmt.Errorf("player %s not found", playerID)
+	}
+	if n <= 0 {
+		return fmt.Errorf("%s amount must be positive, got %d", action, n)
+	}
+	if look, pending := gameState.pendingLooks[playerID]; pending {
+		return fmt.Errorf("player %s has not finished their %s", playerID, look.action)
+	}
+
+	// Per rules 701.22b and 701.25b: a player can look at more cards than are in their library
+	if n > len(player.Library) {
+		n = len(player.Library)
+	}
+	top := player.Library[:n]
+	cardIDs := make([]string, 0, n)
+	for _, card := range top {
+		cardIDs = append(cardIDs, card.ID)
+	}
+
+	if gameState.pendingLooks == nil {
+		gameState.pendingLooks = make(map[string]*pendingLook)
+	}
+	gameState.pendingLooks[playerID] = &pendingLook{action: action, cardIDs: cardIDs}
+	gameState.lookedAt = append(gameState.lookedAt, EngineLookedAtView{
+		Name:  lookViewName(player, action),
+		Cards: e.buildCardViews(top),
+	})
+	gameState.eventBus.Publish(rules.NewEventWithAmount(eventType, playerID, "", playerID, n))
+	gameState.addMessage(fmt.Sprintf("%s looks at the top %d card(s) of their library to %s", player.Name, n, action), "action")
+
+	e.notifyPlayerAction(gameID, playerID, map[string]interface{}{
+		"type":   action,
+		"amount": n,
+	})
+	return nil
+}
+
+// takeLookedAtCards checks that toTop and elsewhere are exactly the cards of the player's pending scry
+// or surveil, and splits their library into those cards, by ID, and the rest
+func (e *MageEngine) takeLookedAtCards(gameState *engineGameState, player *internalPlayer, action string, toTop, elsewhere []string) (map[string]*internalCard, []*internalCard, error) {
+	look, pending := gameState.pendingLooks[player.PlayerID]
+	if !pending || look.action != action {
+		return nil, nil, fmt.Errorf("player %s is not %sing", player.PlayerID, action)
+	}
+
+	remaining := make(map[string]bool, len(look.cardIDs))
+	for _, cardID := range look.cardIDs {
+		remaining[cardID] = true
+	}
+	for _, cardID := range append(append([]string(nil), toTop...), elsewhere...) {
+		if !remaining[cardID] {
+			return nil, nil, fmt.Errorf("card %s is not a card being %s or was chosen twice", cardID, pastTense(action))
+		}
+		delete(remaining, cardID)
+	}
+	if len(remaining) > 0 {
+		return nil, nil, fmt.Errorf("every %s card must be put somewhere, %d left", pastTense(action), len(remaining))
+	}
+
+	cards := make(map[string]*internalCard, len(look.cardIDs))
+	rest := make([]*internalCard, 0, len(player.Library))
+	for _, card := range player.Library {
+		if containsString(look.cardIDs, card.ID) {
+			cards[card.ID] = card
+			continue
+		}
+		rest = append(rest, card)
+	}
+	if len(cards) != len(look.cardIDs) {
+		return nil, nil, fmt.Errorf("%s cards of player %s are no longer in their library", pastTense(action), player.PlayerID)
+	}
+	return cards, rest, nil
+}
+
+// endLook clears a player's finished scry or surveil and its looked-at cards
+func (e *MageEngine) endLook(gameState *engineGameState, player *internalPlayer) {
+	look := gameState.pendingLooks[player.PlayerID]
+	delete(gameState.pendingLooks, player.PlayerID)
+	name := lookViewName(player, look.action)
+	for i, view := range gameState.lookedAt {
+		if view.Name == name {
+			gameState.lookedAt = append(gameState.lookedAt[:i:i], gameState.lookedAt[i+1:]...)
+			break
+		}
+	}
+}
+
+// lookViewName names the looked-at cards of a player's scry or surveil
+func lookViewName(player *internalPlayer, action string) string {
+	return fmt.Sprintf("%s's %s", player.Name, action)
+}
+
+// pastTense returns "scried" or "surveiled"
+func pastTense(action string) string {
+	if action == "scry" {
+		return "scried"
+	}
+	return action + "ed"
+}
@@ -0,0 +1,111 @@
+package game
+
+import (
+	"testing"
+)
+
+// TestScry_BottomOneKeepOne verifies that scrying shows the top cards as looked at, that a card can be
+// put on the bottom while another stays on top, and that the next draw takes the card kept on top
+func TestScry_BottomOneKeepOne(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-scry", []string{"Alice", "Bob"})
+
+	ids := []string{"first", "second", "third"}
+	for _, id := range ids {
+		h.CreateCreature(CreatureSpec{ID: id, Name: "Grizzly Bears", Power: "2", Toughness: "2", Controller: "Alice"})
+	}
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	alice := gameState.players["Alice"]
+	alice.Library = nil
+	for _, id := range ids {
+		card := gameState.cards[id]
+		card.Zone = zoneLibrary
+		alice.Library = append(alice.Library, card)
+	}
+	gameState.mu.Unlock()
+
+	if err := h.engine.Scry(h.gameID, "Alice", 2); err != nil {
+		t.Fatalf("failed to scry: %v", err)
+	}
+	gameState.mu.RLock()
+	if len(gameState.lookedAt) != 1 || len(gameState.lookedAt[0].Cards) != 2 {
+		t.Errorf("expected the 2 scried cards to be looked at, got %+v", gameState.lookedAt)
+	}
+	gameState.mu.RUnlock()
+
+	if err := h.engine.ReorderScry(h.gameID, "Alice", []string{"second"}, nil); err == nil {
+		t.Error("expected a reorder missing a scried card to be rejected")
+	}
+	if err := h.engine.ReorderScry(h.gameID, "Alice", []string{"second"}, []string{"first", "third"}); err == nil {
+		t.Error("expected a reorder with a card that wasn't scried to be rejected")
+	}
+	if err := h.engine.ReorderScry(h.gameID, "Alice", []string{"second"}, []string{"first"}); err != nil {
+		t.Fatalf("failed to finish the scry: %v", err)
+	}
+
+	gameState.mu.RLock()
+	order := make([]string, 0, len(alice.Library))
+	for _, card := range alice.Library {
+		order = append(order, card.ID)
+	}
+	if len(order) != 3 || order[0] != "second" || order[1] != "third" || order[2] != "first" {
+		t.Errorf("expected library second, third, first, got %v", order)
+	}
+	if len(gameState.lookedAt) != 0 {
+		t.Errorf("expected no looked-at cards after the scry, got %d", len(gameState.lookedAt))
+	}
+	gameState.mu.RUnlock()
+
+	if err := h.engine.DrawCard(h.gameID, "Alice", 1); err != nil {
+		t.Fatalf("failed to draw: %v", err)
+	}
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if card := gameState.cards["second"]; card.Zone != zoneHand {
+		t.Errorf("expected Alice to draw the card kept on top, got zone %v", card.Zone)
+	}
+}
+
+// TestSurveil_GraveyardOneKeepOne verifies that surveil puts the chosen cards into the graveyard and
+// the rest back on top in the chosen order
+func TestSurveil_GraveyardOneKeepOne(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-surveil", []string{"Alice", "Bob"})
+	ids := []string{"first", "second", "third"}
+	for _, id := range ids {
+		h.CreateCreature(CreatureSpec{ID: id, Name: "Grizzly Bears", Power: "2", Toughness: "2", Controller: "Alice"})
+	}
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	alice := gameState.players["Alice"]
+	alice.Library = nil
+	for _, id := range ids {
+		card := gameState.cards[id]
+		card.Zone = zoneLibrary
+		alice.Library = append(alice.Library, card)
+	}
+	gameState.mu.Unlock()
+
+	if err := h.engine.Surveil(h.gameID, "Alice", 2); err != nil {
+		t.Fatalf("failed to surveil: %v", err)
+	}
+	if err := h.engine.ReorderScry(h.gameID, "Alice", []string{"first", "second"}, nil); err == nil {
+		t.Error("expected finishing a surveil as a scry to be rejected")
+	}
+	if err := h.engine.ReorderSurveil(h.gameID, "Alice", []string{"second"}, []string{"first"}); err != nil {
+		t.Fatalf("failed to finish the surveil: %v", err)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if len(alice.Library) != 2 || alice.Library[0].ID != "second" || alice.Library[1].ID != "third" {
+		t.Errorf("expected library second, third, got %d cards", len(alice.Library))
+	}
+	if card := gameState.cards["first"]; card.Zone != zoneGraveyard {
+		t.Errorf("expected the first card in the graveyard, got zone %v", card.Zone)
+	}
+	if len(gameState.lookedAt) != 0 {
+		t.Errorf("expected no looked-at cards after the surveil, got %d", len(gameState.lookedAt))
+	}
+}
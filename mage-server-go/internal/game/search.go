@@ -0,0 +1,168 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+	"go.uber.org/zap"
+)
+
+// librarySearch describes a "search your library" effect (tutors, fetch lands, etc.)
+// Per rule 701.19b, a player searching a hidden zone for cards with a stated quality isn't
+// required to find them even if they're present.
+type librarySearch struct {
+	SourceID    string                        // Object whose effect performs the search
+	PlayerID    string                        // Player searching their own library
+	Filter      func(card *internalCard) bool // Cards that can be found (nil = any card)
+	MaxCards    int                           // Maximum number of cards to find (defaults to 1)
+	Optional    bool                          // "You may search": the player may decline to find anything
+	Destination int                           // Zone found cards are put into
+	Shuffle     bool                          // Shuffle the library afterwards, even if nothing was found
+}
+
+// searchLibrary starts a library search.
+// If the library contains matching cards, the player is asked to choose them with a choose_cards
+// decision; the search completes when the decision is answered. If nothing matches, the search
+// completes immediately (nothing is moved, but the library is still shuffled).
+// Caller must hold the game lock.
+func (e *MageEngine) searchLibrary(gameState *engineGameState, search librarySearch) (*Decision, error) {
+	player, exists := gameState.players[search.PlayerID]
+	if !exists {
+		return nil, fmt.Errorf("player %s not found", search.PlayerID)
+	}
+	if search.MaxCards <= 0 {
+		search.MaxCards = 1
+	}
+
+	gameState.eventBus.Publish(rules.Event{
+		Type:       rules.EventSearchLibrary,
+		SourceID:   search.SourceID,
+		TargetID:   search.PlayerID,
+		PlayerID:   search.PlayerID,
+		Controller: search.PlayerID,
+		Amount:     search.MaxCards,
+		Flag:       search.Optional,
+		Zone:       zoneLibrary,
+	})
+
+	matches := make([]string, 0)
+	for _, card := range player.Library {
+		if search.Filter == nil || search.Filter(card) {
+			matches = append(matches, card.ID)
+		}
+	}
+
+	if len(matches) == 0 {
+		// Search but find nothing: still a search, still a shuffle
+		return nil, e.completeLibrarySearch(gameState, search, nil)
+	}
+
+	minCards := search.MaxCards
+	if len(matches) < minCards {
+		minCards = len(matches)
+	}
+	if search.Optional {
+		minCards = 0
+	}
+
+	return gameState.addDecision(&Decision{
+		PlayerID: search.PlayerID,
+		Kind:     DecisionChooseCards,
+		Text:     fmt.Sprintf("Search your library for up to %d card(s)", search.MaxCards),
+		Choices:  matches,
+		Min:      minCards,
+		Max:      search.MaxCards,
+		resolve: func(gameState *engineGameState, response Response) error {
+			return e.completeLibrarySearch(gameState, search, response.Choices)
+		},
+	}), nil
+}
+
+// searchLibraryEffect returns the effect of a spell whose controller searches their library, to register
+// with RegisterSpellEffect (e.g. Demonic Tutor: "search your library for a card, put that card into your
+// hand, then shuffle"); the spell is the search's source
+func (e *MageEngine) searchLibraryEffect(search librarySearch) spellEffect {
+	return func(gameState *engineGameState, spell *internalCard, _ int) error {
+		spellSearch := search
+		spellSearch.SourceID = spell.ID
+		spellSearch.PlayerID = spell.ControllerID
+		_, err := e.searchLibrary(gameState, spellSearch)
+		return err
+	}
+}
+
+// completeLibrarySearch moves the found cards to their destination and shuffles the library
+func (e *MageEngine) completeLibrarySearch(gameState *engineGameState, search librarySearch, found []string) error {
+	player := gameState.players[search.PlayerID]
+
+	for _, cardID := range found {
+		card, exists := gameState.cards[cardID]
+		if !exists || card.Zone != zoneLibrary || card.OwnerID != search.PlayerID {
+			return fmt.Errorf("card %s is not in %s's library", cardID, search.PlayerID)
+		}
+		if err := e.moveCard(gameState, card, search.Destination, search.PlayerID); err != nil {
+			return err
+		}
+	}
+
+	gameState.eventBus.Publish(rules.Event{
+		Type:       rules.EventLibrarySearched,
+		SourceID:   search.SourceID,
+		TargetID:   search.PlayerID,
+		PlayerID:   search.PlayerID,
+		Controller: search.PlayerID,
+		Amount:     len(found),
+		Targets:    append([]string(nil), found...),
+		Zone:       zoneLibrary,
+	})
+
+	if len(found) == 0 {
+		gameState.addMessage(fmt.Sprintf("%s searches their library and finds nothing", player.Name), "action")
+	} else {
+		names := make([]string, 0, len(found))
+		for _, cardID := range found {
+			names = append(names, gameState.cards[cardID].Name)
+		}
+		gameState.addPrivateMessage(search.PlayerID,
+			fmt.Sprintf("%s searches their library and finds %s", player.Name, strings.Join(names, ", ")),
+			fmt.Sprintf("%s searches their library and finds %d card(s)", player.Name, len(found)),
+			"action")
+	}
+
+	if search.Shuffle {
+		e.shuffleLibrary(gameState, player)
+	}
+
+	if e.logger != nil {
+		e.logger.Debug("library searched",
+			zap.String("game_id", gameState.gameID),
+			zap.String("player_id", search.PlayerID),
+			zap.Int("found", len(found)),
+			zap.Bool("optional", search.Optional),
+		)
+	}
+
+	return nil
+}
+
+// shuffleLibrary randomizes the order of a player's library
+func (e *MageEngine) shuffleLibrary(gameState *engineGameState, player *internalPlayer) {
+	e.shuffleCards(gameState, player.Library)
+
+	gameState.eventBus.Publish(rules.Event{
+		Type:       rules.EventLibraryShuffled,
+		TargetID:   player.PlayerID,
+		PlayerID:   player.PlayerID,
+		Controller: player.PlayerID,
+		Zone:       zoneLibrary,
+	})
+}
+
+// shuffleCards puts cards in a random order (Fisher-Yates), using the game's random source
+func (e *MageEngine) shuffleCards(gameState *engineGameState, cards []*internalCard) {
+	for i := len(cards) - 1; i > 0; i-- {
+		j := gameState.random.Intn(i + 1)
+		cards[i], cards[j] = cards[j], cards[i]
+	}
+}
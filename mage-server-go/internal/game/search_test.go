@@ -0,0 +1,171 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+func searchTestHarness(t *testing.T, gameID string) (*CombatTestHarness, *engineGameState, *int) {
+	h := NewCombatTestHarness(t, gameID, []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	shuffles := 0
+	gameState.eventBus.SubscribeTyped(rules.EventLibraryShuffled, func(evt rules.Event) {
+		if evt.PlayerID == "Alice" {
+			shuffles++
+		}
+	})
+	return h, gameState, &shuffles
+}
+
+// TestSearchLibrary_TutorSpell verifies that a tutor cast and resolved through the engine asks its
+// controller to choose a card, then puts the chosen card into their hand and shuffles
+func TestSearchLibrary_TutorSpell(t *testing.T) {
+	h, gameState, shuffles := searchTestHarness(t, "test-search-tutor")
+	castTestSetup(t, h, "Alice", "tutor", "Demonic Tutor", "Sorcery", rules.StepMain1)
+
+	gameState.mu.Lock()
+	gameState.cards["tutor"].ManaCost = "{R}"
+	gameState.mu.Unlock()
+	if err := h.engine.RegisterSpellEffect(h.gameID, "tutor", h.engine.searchLibraryEffect(librarySearch{
+		Filter:      func(card *internalCard) bool { return card.Name == "Counterspell" },
+		Destination: zoneHand,
+		Shuffle:     true,
+	})); err != nil {
+		t.Fatalf("failed to register the spell effect: %v", err)
+	}
+
+	if err := cast(h, "Alice", "Demonic Tutor"); err != nil {
+		t.Fatalf("failed to cast the tutor: %v", err)
+	}
+	resolveTopOfStack(t, h)
+
+	decision := pendingDecisionOfKind(t, h, "Alice", DecisionChooseCards)
+	if decision.Min != 1 || decision.Max != 1 || len(decision.Choices) == 0 {
+		t.Fatalf("expected to choose one of the Counterspells, got min %d max %d of %v", decision.Min, decision.Max, decision.Choices)
+	}
+	found := decision.Choices[0]
+	if err := h.engine.RespondToDecision(h.gameID, "Alice", decision.ID, Response{Choices: []string{found}}); err != nil {
+		t.Fatalf("failed to choose the card: %v", err)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if card := gameState.cards[found]; card.Zone != zoneHand {
+		t.Errorf("expected the found Counterspell in Alice's hand, got zone %v", card.Zone)
+	}
+	if card := gameState.cards["tutor"]; card.Zone != zoneGraveyard {
+		t.Errorf("expected the tutor in the graveyard, got zone %v", card.Zone)
+	}
+	if *shuffles != 1 {
+		t.Errorf("expected library to be shuffled once, got %d", *shuffles)
+	}
+}
+
+// TestSearchLibrary_DeclineToFind verifies that an optional search can find nothing even if
+// matching cards exist, and the library is still shuffled
+func TestSearchLibrary_DeclineToFind(t *testing.T) {
+	h, gameState, shuffles := searchTestHarness(t, "test-search-decline")
+
+	gameState.mu.Lock()
+	libraryBefore := len(gameState.players["Alice"].Library)
+	handBefore := len(gameState.players["Alice"].Hand)
+	decision, err := h.engine.searchLibrary(gameState, librarySearch{
+		PlayerID:    "Alice",
+		Filter:      func(card *internalCard) bool { return card.Name == "Counterspell" },
+		Optional:    true,
+		Destination: zoneHand,
+		Shuffle:     true,
+	})
+	gameState.mu.Unlock()
+	if err != nil {
+		t.Fatalf("failed to search library: %v", err)
+	}
+	if decision == nil {
+		t.Fatal("expected a choose_cards decision when matching cards exist")
+	}
+	if decision.Min != 0 || len(decision.Choices) == 0 {
+		t.Errorf("expected optional search to allow finding nothing among %d matches, got min %d", len(decision.Choices), decision.Min)
+	}
+
+	if err := h.engine.RespondToDecision(h.gameID, "Alice", decision.ID, Response{}); err != nil {
+		t.Fatalf("failed to decline search: %v", err)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if got := len(gameState.players["Alice"].Library); got != libraryBefore {
+		t.Errorf("expected library size %d, got %d", libraryBefore, got)
+	}
+	if got := len(gameState.players["Alice"].Hand); got != handBefore {
+		t.Errorf("expected hand size %d, got %d", handBefore, got)
+	}
+	if *shuffles != 1 {
+		t.Errorf("expected library to be shuffled once, got %d", *shuffles)
+	}
+}
+
+// TestSearchLibrary_NoMatches verifies that a search finding no matching cards is a no-op
+// apart from the shuffle
+func TestSearchLibrary_NoMatches(t *testing.T) {
+	h, gameState, shuffles := searchTestHarness(t, "test-search-no-matches")
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+
+	libraryBefore := len(gameState.players["Alice"].Library)
+	decision, err := h.engine.searchLibrary(gameState, librarySearch{
+		PlayerID:    "Alice",
+		Filter:      func(card *internalCard) bool { return card.Name == "Black Lotus" },
+		Destination: zoneHand,
+		Shuffle:     true,
+	})
+	if err != nil {
+		t.Fatalf("failed to search library: %v", err)
+	}
+	if decision != nil {
+		t.Error("expected no decision when nothing matches")
+	}
+	if got := len(gameState.players["Alice"].Library); got != libraryBefore {
+		t.Errorf("expected library size %d, got %d", libraryBefore, got)
+	}
+	if *shuffles != 1 {
+		t.Errorf("expected library to be shuffled once, got %d", *shuffles)
+	}
+}
+
+// TestSearchLibrary_MandatoryFind verifies that a non-optional search must take a matching card
+func TestSearchLibrary_MandatoryFind(t *testing.T) {
+	h, gameState, shuffles := searchTestHarness(t, "test-search-find")
+
+	gameState.mu.Lock()
+	decision, err := h.engine.searchLibrary(gameState, librarySearch{
+		PlayerID:    "Alice",
+		Filter:      func(card *internalCard) bool { return card.Name == "Shock" },
+		Destination: zoneHand,
+		Shuffle:     true,
+	})
+	gameState.mu.Unlock()
+	if err != nil || decision == nil {
+		t.Fatalf("expected a search decision, got %v (err %v)", decision, err)
+	}
+
+	if err := h.engine.RespondToDecision(h.gameID, "Alice", decision.ID, Response{}); err == nil {
+		t.Error("expected mandatory search to reject finding nothing")
+	}
+
+	found := decision.Choices[0]
+	if err := h.engine.RespondToDecision(h.gameID, "Alice", decision.ID, Response{Choices: []string{found}}); err != nil {
+		t.Fatalf("failed to find card: %v", err)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if gameState.cards[found].Zone != zoneHand {
+		t.Errorf("expected found card in hand, got zone %d", gameState.cards[found].Zone)
+	}
+	if *shuffles != 1 {
+		t.Errorf("expected library to be shuffled once, got %d", *shuffles)
+	}
+}
@@ -180,9 +180,11 @@ func (snapshot *gameStateSnapshot) buildDeterministicRepresentation() string {
 	buf.WriteString("\n")
 
 	// Stack - order matters for stack (LIFO), so don't sort
+	// Item IDs are random, so items are identified by what they are instead; this keeps the checksum of
+	// a replayed game equal to the original's
 	buf.WriteString("STACK:\n")
 	for i, item := range snapshot.StackItems {
-		buf.WriteString(fmt.Sprintf("  %d:%s|%s\n", i, item.ID, item.Description))
+		buf.WriteString(fmt.Sprintf("  %d:%s|%s|%s\n", i, item.Kind, item.SourceID, item.Description))
 	}
 
 	// Player order - order matters
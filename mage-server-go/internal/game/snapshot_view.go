@@ -0,0 +1,92 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// GetGameViewAtBookmark returns a player's view of the game as it was when a bookmark was taken,
+// without restoring it; the live game is not changed. Used for replays and rewind previews.
+func (e *MageEngine) GetGameViewAtBookmark(gameID string, bookmarkID int, playerID string) (*EngineGameView, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	gameState, exists := e.games[gameID]
+	if !exists {
+		return nil, fmt.Errorf("game %s not found", gameID)
+	}
+
+	e.bookmarksMu.Lock()
+	bookmarks := e.bookmarks[gameID]
+	e.bookmarksMu.Unlock()
+	if bookmarkID < 1 || bookmarkID > len(bookmarks) {
+		return nil, fmt.Errorf("bookmark %d not found for game %s", bookmarkID, gameID)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+
+	return e.buildSnapshotView(gameState, bookmarks[bookmarkID-1], playerID), nil
+}
+
+// GetGameViewAtTurn returns a player's view of the game as it was at the start of a turn, from the
+// turn snapshots kept for rollback, without altering the live game
+func (e *MageEngine) GetGameViewAtTurn(gameID string, turnNumber int, playerID string) (*EngineGameView, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	gameState, exists := e.games[gameID]
+	if !exists {
+		return nil, fmt.Errorf("game %s not found", gameID)
+	}
+
+	snapshot, exists := e.turnSnapshots[gameID][turnNumber]
+	if !exists {
+		return nil, fmt.Errorf("no snapshot available for turn %d", turnNumber)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+
+	return e.buildSnapshotView(gameState, snapshot, playerID), nil
+}
+
+// buildSnapshotView builds a game view from a stored snapshot. The snapshot is read through a detached
+// game state, so the view builders used for the live game apply unchanged.
+// Combat, revealed cards and pending decisions aren't snapshotted and are left empty.
+func (e *MageEngine) buildSnapshotView(gameState *engineGameState, snapshot *gameStateSnapshot, playerID string) *EngineGameView {
+	past := &engineGameState{
+		gameID:      snapshot.GameID,
+		players:     snapshot.Players,
+		playerOrder: snapshot.PlayerOrder,
+		cards:       snapshot.Cards,
+		stack:       rules.NewStackManager(),
+	}
+	for _, item := range snapshot.StackItems {
+		past.stack.Push(item)
+	}
+
+	view := &EngineGameView{
+		GameID:         snapshot.GameID,
+		State:          snapshot.State,
+		Phase:          snapshot.Phase,
+		Step:           snapshot.Step,
+		Turn:           snapshot.TurnNumber,
+		ActivePlayerID: snapshot.ActivePlayer,
+		PriorityPlayer: snapshot.PriorityPlayer,
+		Players:        e.buildPlayerViews(past, playerID),
+		Battlefield:    e.buildCardViews(snapshot.Battlefield),
+		Stack:          e.buildStackViews(past),
+		Exile:          e.buildCardViews(snapshot.Exile),
+		Command:        e.buildCardViews(snapshot.Command),
+		Monarch:        snapshot.Monarch,
+		StartedAt:      gameState.startedAt,
+		Messages:       visibleMessages(snapshot.Messages, playerID, nil),
+		Prompts:        make([]EnginePrompt, len(snapshot.Prompts)),
+		Decisions:      []Decision{},
+	}
+	copy(view.Prompts, snapshot.Prompts)
+
+	return view
+}
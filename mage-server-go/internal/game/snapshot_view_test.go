@@ -0,0 +1,71 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestGameViewAtBookmark_ShowsPastStateWithoutRestoring verifies that a bookmark's view shows the
+// game as it was when bookmarked while the live game keeps its later state
+func TestGameViewAtBookmark_ShowsPastStateWithoutRestoring(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-view-at-bookmark", []string{"Alice", "Bob"})
+	castTestSetup(t, h, "Alice", "alice-opt", "Opt", "Instant", rules.StepMain1)
+
+	if err := cast(h, "Alice", "Opt"); err != nil {
+		t.Fatalf("failed to cast: %v", err)
+	}
+	bookmarkID, err := h.engine.BookmarkState(h.gameID)
+	if err != nil {
+		t.Fatalf("failed to bookmark: %v", err)
+	}
+
+	// Change the game: the spell resolves and Alice loses life
+	for _, playerID := range []string{"Alice", "Bob"} {
+		if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: playerID, ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+			t.Fatalf("failed to pass priority: %v", err)
+		}
+	}
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.players["Alice"].Life = 13
+	gameState.mu.Unlock()
+
+	past, err := h.engine.GetGameViewAtBookmark(h.gameID, bookmarkID, "Alice")
+	if err != nil {
+		t.Fatalf("failed to get the bookmark view: %v", err)
+	}
+	if len(past.Stack) != 1 || past.Stack[0].ID != "alice-opt" {
+		t.Errorf("expected the bookmark view to show Opt on the stack, got %+v", past.Stack)
+	}
+	if life := past.Players[0].Life; life != 20 {
+		t.Errorf("expected Alice at 20 life in the bookmark view, got %d", life)
+	}
+	if past.Step != rules.StepMain1.String() {
+		t.Errorf("expected the bookmark view in the main phase, got %s", past.Step)
+	}
+
+	liveView, err := h.engine.GetGameView(h.gameID, "Alice")
+	if err != nil {
+		t.Fatalf("failed to get the live view: %v", err)
+	}
+	live := liveView.(*EngineGameView)
+	if len(live.Stack) != 0 || live.Players[0].Life != 13 {
+		t.Errorf("expected the live game to keep its changes, got stack %d and life %d", len(live.Stack), live.Players[0].Life)
+	}
+
+	// Turn snapshots are taken at the start of each turn
+	turnView, err := h.engine.GetGameViewAtTurn(h.gameID, 1, "Alice")
+	if err != nil {
+		t.Fatalf("failed to get the turn view: %v", err)
+	}
+	if turnView.Turn != 1 || len(turnView.Stack) != 0 {
+		t.Errorf("expected an empty stack at the start of turn 1, got turn %d with %d items", turnView.Turn, len(turnView.Stack))
+	}
+	if _, err := h.engine.GetGameViewAtTurn(h.gameID, 5, "Alice"); err == nil {
+		t.Error("expected no view for a turn that hasn't been played")
+	}
+	if _, err := h.engine.GetGameViewAtBookmark(h.gameID, 99, "Alice"); err == nil {
+		t.Error("expected no view for an unknown bookmark")
+	}
+}
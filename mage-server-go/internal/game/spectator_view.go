@@ -0,0 +1,85 @@
+package game
+
+import (
+	"fmt"
+)
+
+// GetSpectatorView returns the game as a user who isn't playing sees it, e.g. for spectator mode or
+// streaming: only public information. Hands are shown as card counts, cards looked at privately (scry,
+// surveil) and pending prompts and decisions are left out, and face-down cards are masked even for
+// their controller. Revealed cards are shown, since every player saw them.
+func (e *MageEngine) GetSpectatorView(gameID string) (*EngineGameView, error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+
+	if !gameState.rulesOptions.SpectatorsAllowed {
+		return nil, fmt.Errorf("spectators are not allowed in this game")
+	}
+
+	// A viewer who isn't a player sees every player's hand hidden and only the public text of messages
+	players := e.buildPlayerViews(gameState, "")
+	for i := range players {
+		players[i].Hand = []EngineCardView{}
+		players[i].Graveyard = maskFaceDownCards(players[i].Graveyard)
+	}
+
+	revealed := make([]EngineRevealedView, len(gameState.revealed))
+	for i, reveal := range gameState.revealed {
+		revealed[i] = EngineRevealedView{Name: reveal.Name, Cards: maskFaceDownCards(reveal.Cards)}
+	}
+
+	return &EngineGameView{
+		GameID:           gameID,
+		State:            gameState.state,
+		Phase:            gameState.turnManager.CurrentPhase().String(),
+		Step:             gameState.turnManager.CurrentStep().String(),
+		Turn:             gameState.turnManager.TurnNumber(),
+		ActivePlayerID:   gameState.turnManager.ActivePlayer(),
+		PriorityPlayer:   gameState.turnManager.PriorityPlayer(),
+		Players:          players,
+		Battlefield:      maskFaceDownCards(e.cachedCardViews(gameState, gameState.battlefield)),
+		Stack:            maskFaceDownCards(e.buildStackViews(gameState)),
+		Exile:            maskFaceDownCards(e.cachedCardViews(gameState, gameState.exile)),
+		Command:          maskFaceDownCards(e.cachedCardViews(gameState, gameState.command)),
+		Revealed:         revealed,
+		LookedAt:         []EngineLookedAtView{},
+		Combat:           e.buildCombatView(gameState),
+		Monarch:          gameState.monarchID,
+		StartedAt:        gameState.startedAt,
+		Messages:         visibleMessages(gameState.messages, "", nil),
+		Prompts:          []EnginePrompt{},
+		Decisions:        []Decision{},
+		PriorityTimeLeft: gameState.priorityTimeLeft(),
+	}, nil
+}
+
+// maskFaceDownCards hides what face-down cards are, keeping only what can be seen of them: where they
+// are, who controls them, whether they're tapped, their counters and what they're attached to
+// Per rule 708.2: a face-down permanent has no name, mana cost, types or abilities
+func maskFaceDownCards(views []EngineCardView) []EngineCardView {
+	for i, view := range views {
+		if !view.FaceDown {
+			continue
+		}
+		views[i] = EngineCardView{
+			ID:             view.ID,
+			Tapped:         view.Tapped,
+			TapReason:      view.TapReason,
+			FaceDown:       true,
+			Zone:           view.Zone,
+			ControllerID:   view.ControllerID,
+			OwnerID:        view.OwnerID,
+			AttachedToCard: view.AttachedToCard,
+			Counters:       view.Counters,
+		}
+	}
+	return views
+}
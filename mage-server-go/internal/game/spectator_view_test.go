@@ -0,0 +1,56 @@
+package game
+
+import (
+	"testing"
+)
+
+// TestSpectatorView_HidesHiddenInformation verifies that spectators see hand sizes but no hand cards,
+// no face-down card details and no privately looked-at cards, while revealed cards stay public
+func TestSpectatorView_HidesHiddenInformation(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-spectator-view", []string{"Alice", "Bob"})
+	h.CreateCreature(CreatureSpec{ID: "morph", Name: "Exalted Angel", Power: "2", Toughness: "2", Controller: "Alice"})
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	morph := gameState.cards["morph"]
+	morph.FaceDown = true
+	gameState.battlefield = append(gameState.battlefield, morph)
+	handSize := len(gameState.players["Alice"].Hand)
+	gameState.revealed = append(gameState.revealed, EngineRevealedView{Name: "Bob's reveal", Cards: h.engine.buildCardViews(gameState.players["Bob"].Hand[:1])})
+	gameState.mu.Unlock()
+
+	if err := h.engine.Scry(h.gameID, "Alice", 1); err != nil {
+		t.Fatalf("failed to scry: %v", err)
+	}
+
+	view, err := h.engine.GetSpectatorView(h.gameID)
+	if err != nil {
+		t.Fatalf("failed to get the spectator view: %v", err)
+	}
+	for _, player := range view.Players {
+		if len(player.Hand) != 0 {
+			t.Errorf("expected %s's hand to be hidden, got %d cards", player.Name, len(player.Hand))
+		}
+	}
+	if view.Players[0].HandCount != handSize {
+		t.Errorf("expected Alice's hand count %d, got %d", handSize, view.Players[0].HandCount)
+	}
+	if len(view.Battlefield) != 1 || view.Battlefield[0].Name != "" || !view.Battlefield[0].FaceDown {
+		t.Errorf("expected the face-down creature masked, got %+v", view.Battlefield)
+	}
+	if len(view.LookedAt) != 0 {
+		t.Errorf("expected Alice's scry to be hidden from spectators, got %+v", view.LookedAt)
+	}
+	if len(view.Revealed) != 1 || len(view.Revealed[0].Cards) != 1 || view.Revealed[0].Cards[0].Name == "" {
+		t.Errorf("expected the revealed card to be shown, got %+v", view.Revealed)
+	}
+
+	// The players' own views are unchanged: Alice still sees what their face-down creature is
+	playerView, err := h.engine.GetGameView(h.gameID, "Alice")
+	if err != nil {
+		t.Fatalf("failed to get Alice's view: %v", err)
+	}
+	if alice := playerView.(*EngineGameView); alice.Battlefield[0].Name != "Exalted Angel" {
+		t.Errorf("expected Alice to see their face-down creature, got %+v", alice.Battlefield[0])
+	}
+}
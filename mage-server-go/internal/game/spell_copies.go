@@ -0,0 +1,143 @@
+package game
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/magefree/mage-server-go/internal/game/counters"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+	"github.com/magefree/mage-server-go/internal/game/targeting"
+	"go.uber.org/zap"
+)
+
+// CopySpellOnStack puts a copy of a spell on the stack with the same targets, for storm and effects such
+// as Fork, and returns the copy's ID. See CopySpellOnStackWithTargets.
+func (e *MageEngine) CopySpellOnStack(gameID, stackItemID, newController string) (string, error) {
+	return e.CopySpellOnStackWithTargets(gameID, stackItemID, newController, nil)
+}
+
+// CopySpellOnStackWithTargets puts a copy of a spell on top of the stack, controlled by newController
+// ("" = the spell's controller), and returns the copy's ID. The copy isn't cast, so no costs are paid
+// and no cast triggers fire; it copies the spell's characteristics, X and targets (rule 707.10), except
+// that targets, if given, replace the copied ones (rule 707.10c). It resolves with the spell's effect as
+// an object of its own. A copy isn't a card: it ceases to exist when it leaves the stack (rule 707.10a),
+// unless it is a permanent spell, which resolves into a token.
+// Per Java Spell.copySpell()
+func (e *MageEngine) CopySpellOnStackWithTargets(gameID, stackItemID, newController string, targets []string) (_ string, err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return "", fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "CopySpellOnStackWithTargets", stackItemID, newController, targets)
+
+	var item *rules.StackItem
+	for _, candidate := range gameState.stack.List() {
+		if candidate.ID == stackItemID {
+			item = &candidate
+			break
+		}
+	}
+	if item == nil {
+		return "", fmt.Errorf("stack item %s not found", stackItemID)
+	}
+	spell, found := gameState.cards[item.SourceID]
+	if item.Kind != rules.StackItemKindSpell || !found {
+		return "", fmt.Errorf("%s is not a spell", item.Description)
+	}
+	if newController == "" {
+		newController = item.Controller
+	}
+	if _, exists := gameState.players[newController]; !exists {
+		return "", fmt.Errorf("player %s not found", newController)
+	}
+
+	spellCopy := e.copyCard(spell)
+	spellCopy.ID = gameState.newSpellCopyID(spell)
+	spellCopy.CopyOf = spell.ID
+	if spell.CopyOf != "" {
+		spellCopy.CopyOf = spell.CopyOf
+	}
+	// Per rule 707.10: a copy of a spell is owned by the player under whose control it was put on the stack
+	spellCopy.ControllerID = newController
+	spellCopy.OwnerID = newController
+	spellCopy.Counters = counters.NewCounters()
+	if targets != nil {
+		if err := e.checkSpellTargets(gameState, newController, spellCopy, targets); err != nil {
+			return "", err
+		}
+		spellCopy.Targets = append([]string(nil), targets...)
+	}
+	gameState.cards[spellCopy.ID] = spellCopy
+
+	stackItem := rules.StackItem{
+		ID:          spellCopy.ID,
+		Controller:  newController,
+		Description: fmt.Sprintf("%s copies %s", newController, spell.Name),
+		Kind:        rules.StackItemKindSpell,
+		SourceID:    spellCopy.ID,
+		Metadata:    map[string]string{"copy_of": spellCopy.CopyOf},
+		Resolve:     e.spellResolver(gameState, spellCopy.ID),
+	}
+	if hasXCost(spellCopy) {
+		stackItem.Metadata["x_value"] = strconv.Itoa(spellCopy.XValue)
+	}
+	if len(spellCopy.Targets) > 0 {
+		stackItem.Metadata["targets"] = targeting.FormatTargets(spellCopy.Targets)
+	}
+	if e.cantBeCountered(spellCopy) {
+		stackItem.Metadata["uncounterable"] = "true"
+	}
+	if e.hasSplitSecond(spellCopy) {
+		stackItem.Metadata["split_second"] = "true"
+	}
+
+	gameState.stack.Push(stackItem)
+	gameState.updateSplitSecond()
+	gameState.trackStackItem()
+	gameState.trackStackDepth()
+	gameState.addMessage(fmt.Sprintf("%s copies %s", newController, spell.Name), "action")
+
+	e.notifyStackUpdate(gameID, map[string]interface{}{
+		"action":      "spell_copied",
+		"player_id":   newController,
+		"card_name":   spell.Name,
+		"card_id":     spellCopy.ID,
+		"copy_of":     spellCopy.CopyOf,
+		"stack_depth": len(gameState.stack.List()),
+	})
+	return spellCopy.ID, nil
+}
+
+// newSpellCopyID returns an unused ID for a copy of a spell
+func (s *engineGameState) newSpellCopyID(spell *internalCard) string {
+	for n := 1; ; n++ {
+		id := fmt.Sprintf("%s-copy-%d", spell.ID, n)
+		if _, exists := s.cards[id]; !exists {
+			return id
+		}
+	}
+}
+
+// removeSpellCopy makes a copy of a spell, or the token it resolved into, cease to exist when it would
+// move to another zone (rules 707.10a and 111.7), and reports whether the object was such a copy
+func (e *MageEngine) removeSpellCopy(gameState *engineGameState, card *internalCard) bool {
+	if card.CopyOf == "" {
+		return false
+	}
+	delete(gameState.cards, card.ID)
+	gameState.cardViews.prune(gameState.cards)
+	if e.logger != nil {
+		e.logger.Debug("copy ceased to exist",
+			zap.String("card_id", card.ID),
+			zap.String("card_name", card.Name),
+			zap.Int("zone", card.Zone),
+		)
+	}
+	return true
+}
@@ -0,0 +1,102 @@
+package game
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestCopySpellOnStack_ResolvesWithoutCard verifies that a copy of Lightning Bolt resolves on its own,
+// at the copied or a new target, and ceases to exist instead of going to a graveyard
+func TestCopySpellOnStack_ResolvesWithoutCard(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-copy-spell", []string{"Alice", "Bob"})
+	ogre := h.CreateBlocker("bob-ogre", "Hill Giant", "Bob", "3", "3")
+	castTestSetup(t, h, "Alice", "bolt-1", "Lightning Bolt", "Instant", rules.StepMain1)
+
+	gameState := h.GetGameState()
+	bolts := make([]string, 0)
+	gameState.mu.Lock()
+	gameState.battlefield = append(gameState.battlefield, gameState.cards[ogre])
+	for _, card := range gameState.players["Alice"].Hand {
+		if card.Name == "Lightning Bolt" {
+			card.RulesText = "Lightning Bolt deals 3 damage to any target."
+			bolts = append(bolts, card.ID)
+		}
+	}
+	graveyardBefore := len(gameState.players["Alice"].Graveyard)
+	gameState.mu.Unlock()
+	for _, bolt := range bolts {
+		if err := h.engine.RegisterSpellEffect(h.gameID, bolt, h.engine.damageTargetEffect(3)); err != nil {
+			t.Fatalf("failed to register Lightning Bolt's effect: %v", err)
+		}
+	}
+
+	if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Alice", ActionType: "SEND_STRING", Data: "Lightning Bolt", Targets: []string{"Bob"}}); err != nil {
+		t.Fatalf("failed to cast Lightning Bolt: %v", err)
+	}
+	gameState.mu.RLock()
+	items := gameState.stack.List()
+	gameState.mu.RUnlock()
+	if len(items) != 1 {
+		t.Fatalf("expected Lightning Bolt on the stack, got %d items", len(items))
+	}
+	boltID := items[0].ID
+
+	if _, err := h.engine.CopySpellOnStackWithTargets(h.gameID, boltID, "Bob", []string{"Alice", "Bob"}); err == nil {
+		t.Error("expected a copy with too many targets to be rejected")
+	}
+	sameTarget, err := h.engine.CopySpellOnStack(h.gameID, boltID, "")
+	if err != nil {
+		t.Fatalf("failed to copy Lightning Bolt: %v", err)
+	}
+	newTarget, err := h.engine.CopySpellOnStackWithTargets(h.gameID, boltID, "Bob", []string{ogre})
+	if err != nil {
+		t.Fatalf("failed to copy Lightning Bolt with a new target: %v", err)
+	}
+
+	gameState.mu.RLock()
+	items = gameState.stack.List()
+	gameState.mu.RUnlock()
+	if len(items) != 3 {
+		t.Fatalf("expected the copies on top of Lightning Bolt, got %d items", len(items))
+	}
+	copyItem, _ := gameState.stack.Peek()
+	if copyItem.ID != newTarget || copyItem.Controller != "Bob" {
+		t.Errorf("expected Bob's copy on top of the stack, got %+v", copyItem)
+	}
+
+	for i := 0; i < 10; i++ {
+		gameState.mu.RLock()
+		empty := gameState.stack.IsEmpty()
+		priority := gameState.turnManager.PriorityPlayer()
+		gameState.mu.RUnlock()
+		if empty {
+			break
+		}
+		if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: priority, ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+			t.Fatalf("failed to pass priority: %v", err)
+		}
+	}
+
+	h.AssertPlayerLife("Bob", 14)
+	h.AssertCreatureDead(ogre)
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	for _, id := range []string{sameTarget, newTarget} {
+		if _, exists := gameState.cards[id]; exists {
+			t.Errorf("expected copy %s to cease to exist", id)
+		}
+	}
+	for _, player := range gameState.players {
+		for _, card := range player.Graveyard {
+			if strings.Contains(card.ID, "-copy-") {
+				t.Errorf("expected no copy in %s's graveyard, found %s", player.PlayerID, card.ID)
+			}
+		}
+	}
+	if got := len(gameState.players["Alice"].Graveyard); got != graveyardBefore+1 {
+		t.Errorf("expected only Lightning Bolt to go to Alice's graveyard, graveyard grew by %d", got-graveyardBefore)
+	}
+}
@@ -0,0 +1,142 @@
+package game
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/mana"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestCastSpell_PaysManaCost verifies that casting a spell pays its mana cost from the caster's pool:
+// a {1}{R} spell is rejected while only {R} is available and can be cast once one more mana of any
+// color is added, which pays the generic part
+func TestCastSpell_PaysManaCost(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-cast-pays-mana", []string{"Alice", "Bob"})
+	castTestSetup(t, h, "Alice", "hammer", "Volcanic Hammer", "Sorcery", rules.StepMain1)
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.cards["hammer"].ManaCost = "{1}{R}"
+	gameState.mu.Unlock()
+
+	err := cast(h, "Alice", "Volcanic Hammer")
+	if err == nil || !strings.Contains(err.Error(), "can't pay {1}{R}") {
+		t.Fatalf("expected the cast to be rejected for lack of mana, got %v", err)
+	}
+	gameState.mu.RLock()
+	if total := gameState.players["Alice"].ManaPool.GetTotalMana(); total != 1 {
+		t.Errorf("expected the rejected cast to leave {R} in the pool, got %d mana", total)
+	}
+	gameState.mu.RUnlock()
+
+	if err := h.engine.AddMana(h.gameID, "Alice", mana.ManaGreen, 1); err != nil {
+		t.Fatalf("failed to add mana: %v", err)
+	}
+	if err := cast(h, "Alice", "Volcanic Hammer"); err != nil {
+		t.Fatalf("failed to cast with {R}{G} available: %v", err)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if total := gameState.players["Alice"].ManaPool.GetTotalMana(); total != 0 {
+		t.Errorf("expected the cost to spend all mana, %d left", total)
+	}
+	if card := gameState.cards["hammer"]; card.Zone != zoneStack {
+		t.Errorf("expected the spell on the stack, got zone %v", card.Zone)
+	}
+}
+
+// TestCastSpell_HybridCostPaidWithEitherColor verifies that a hybrid symbol can be paid with either of
+// its colors
+func TestCastSpell_HybridCostPaidWithEitherColor(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-cast-hybrid", []string{"Alice", "Bob"})
+	castTestSetup(t, h, "Alice", "hybrid", "Boros Recruit", "Instant", rules.StepMain1)
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.cards["hybrid"].ManaCost = "{R/W}{R/G}"
+	gameState.players["Alice"].ManaPool.Add(mana.ManaWhite, 1)
+	gameState.mu.Unlock()
+
+	if err := cast(h, "Alice", "Boros Recruit"); err != nil {
+		t.Fatalf("failed to pay {R/W}{R/G} with {R}{W}: %v", err)
+	}
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if total := gameState.players["Alice"].ManaPool.GetTotalMana(); total != 0 {
+		t.Errorf("expected the cost to spend all mana, %d left", total)
+	}
+}
+
+// TestCastSpell_PhyrexianManaPaidWithLife verifies that a phyrexian symbol can be paid with its color
+// or 2 life as the caster chooses, that paying life is losing life, and that a player who pays down to
+// 0 life loses once state-based actions are checked
+func TestCastSpell_PhyrexianManaPaidWithLife(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-cast-phyrexian", []string{"Alice", "Bob"})
+	castTestSetup(t, h, "Alice", "gut-shot-1", "Gut Shot", "Instant", rules.StepMain1)
+	castTestSetup(t, h, "Alice", "gut-shot-2", "Gut Shot", "Instant", rules.StepMain1)
+
+	gameState := h.GetGameState()
+	lifeLost := 0
+	gameState.mu.Lock()
+	gameState.cards["gut-shot-1"].ManaCost = "{R/P}"
+	gameState.cards["gut-shot-2"].ManaCost = "{R/P}"
+	gameState.players["Alice"].ManaPool.Empty()
+	gameState.players["Alice"].ManaPool.Add(mana.ManaRed, 1)
+	gameState.players["Alice"].Life = 2
+	gameState.eventBus.SubscribeTyped(rules.EventLostLife, func(event rules.Event) {
+		lifeLost += event.Amount
+	})
+	gameState.mu.Unlock()
+
+	castGutShot := func(payWithLife bool) error {
+		return h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Alice", ActionType: "CAST_SPELL",
+			Data: CastSpellData{Name: "Gut Shot", PayPhyrexian: []bool{payWithLife}}})
+	}
+
+	if err := castGutShot(false); err != nil {
+		t.Fatalf("failed to pay {R/P} with red mana: %v", err)
+	}
+	gameState.mu.RLock()
+	if total, life := gameState.players["Alice"].ManaPool.GetTotalMana(), gameState.players["Alice"].Life; total != 0 || life != 2 {
+		t.Errorf("expected the red mana to be spent and no life paid, got %d mana and %d life", total, life)
+	}
+	gameState.mu.RUnlock()
+
+	if err := castGutShot(true); err != nil {
+		t.Fatalf("failed to pay {R/P} with 2 life at 2 life: %v", err)
+	}
+	gameState.mu.RLock()
+	if life := gameState.players["Alice"].Life; life != 0 || lifeLost != 2 {
+		t.Errorf("expected Alice to lose 2 life to 0, got %d life and %d lost", life, lifeLost)
+	}
+	gameState.mu.RUnlock()
+
+	if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Alice", ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+		t.Fatalf("failed to pass priority: %v", err)
+	}
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if !gameState.players["Alice"].Lost {
+		t.Error("expected Alice to lose the game at 0 life")
+	}
+}
+
+// TestCastSpell_PhyrexianLifeNeedsEnoughLife verifies that a player can't pay more life than they have
+func TestCastSpell_PhyrexianLifeNeedsEnoughLife(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-cast-phyrexian-life", []string{"Alice", "Bob"})
+	castTestSetup(t, h, "Alice", "gut-shot", "Gut Shot", "Instant", rules.StepMain1)
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.cards["gut-shot"].ManaCost = "{R/P}"
+	gameState.players["Alice"].Life = 1
+	gameState.mu.Unlock()
+
+	err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Alice", ActionType: "CAST_SPELL",
+		Data: CastSpellData{Name: "Gut Shot", PayPhyrexian: []bool{true}}})
+	if err == nil || !strings.Contains(err.Error(), "can't pay 2 life") {
+		t.Errorf("expected paying 2 life at 1 life to be rejected, got %v", err)
+	}
+}
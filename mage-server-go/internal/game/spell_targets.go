@@ -0,0 +1,55 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/magefree/mage-server-go/internal/game/targeting"
+)
+
+// checkSpellTargets checks the targets chosen for a spell against the target requirements in its rules
+// text. Targets are assigned to the requirements in order, each taking as many as it allows while
+// leaving enough for the requirements after it; every target must be legal for its requirement.
+// Per rule 601.2c and Java Targets.chooseTargets()
+func (e *MageEngine) checkSpellTargets(gameState *engineGameState, playerID string, card *internalCard, targets []string) error {
+	requirements := targeting.ParseTargetRequirements(card.Type, card.RulesText)
+	if len(requirements) == 0 {
+		if len(targets) > 0 {
+			return fmt.Errorf("%s doesn't have targets", card.Name)
+		}
+		return nil
+	}
+
+	next := 0
+	for i, requirement := range requirements {
+		laterMin := 0
+		for _, later := range requirements[i+1:] {
+			laterMin += later.MinTargets
+		}
+		count := len(targets) - next - laterMin
+		if count > requirement.MaxTargets {
+			count = requirement.MaxTargets
+		}
+		if count < requirement.MinTargets {
+			return fmt.Errorf("%s requires %s", card.Name, requirement.Description)
+		}
+
+		requirement.SourceColors = objectColors(card)
+		legal := e.legalTargets(gameState, playerID, requirement)
+		chosen := make(map[string]bool, count)
+		for _, targetID := range targets[next : next+count] {
+			// Per rule 115.3: the same object can't be chosen more than once for one "target"
+			if chosen[targetID] {
+				return fmt.Errorf("%s chosen more than once for %s", targetID, requirement.Description)
+			}
+			if !containsString(legal, targetID) {
+				return fmt.Errorf("%s is not a legal target for %s (%s)", targetID, card.Name, requirement.Description)
+			}
+			chosen[targetID] = true
+		}
+		next += count
+	}
+	if next < len(targets) {
+		return fmt.Errorf("too many targets for %s: got %d", card.Name, len(targets))
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestSpellTargets_FizzlesWhenTargetLeaves verifies that a targeted spell must be cast with a legal
+// target, carries its target on the stack, and is countered on resolution once its only target is gone
+func TestSpellTargets_FizzlesWhenTargetLeaves(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-spell-targets", []string{"Alice", "Bob"})
+	bearID := h.CreateCreature(CreatureSpec{ID: "bob-bear", Name: "Grizzly Bears", Power: "2", Toughness: "2", Controller: "Bob"})
+	castTestSetup(t, h, "Alice", "chain", "Chain Lightning", "Instant", rules.StepMain1)
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.battlefield = append(gameState.battlefield, gameState.cards[bearID])
+	gameState.cards["chain"].RulesText = "Chain Lightning deals 3 damage to any target."
+	gameState.mu.Unlock()
+
+	resolved := false
+	if err := h.engine.RegisterSpellEffect(h.gameID, "chain", func(gameState *engineGameState, spell *internalCard, _ int) error {
+		resolved = true
+		return nil
+	}); err != nil {
+		t.Fatalf("failed to register spell effect: %v", err)
+	}
+	countered := 0
+	gameState.eventBus.SubscribeTyped(rules.EventCountered, func(evt rules.Event) {
+		if evt.TargetID == "chain" {
+			countered++
+		}
+	})
+
+	castAt := func(targets ...string) error {
+		return h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Alice", ActionType: "SEND_STRING", Data: "Chain Lightning", Targets: targets})
+	}
+	if err := castAt(); err == nil {
+		t.Error("expected casting Chain Lightning without a target to be rejected")
+	}
+	if err := castAt("chain"); err == nil {
+		t.Error("expected a card in hand to be rejected as a target")
+	}
+	if err := castAt(bearID); err != nil {
+		t.Fatalf("failed to cast Chain Lightning: %v", err)
+	}
+
+	gameState.mu.RLock()
+	items := gameState.stack.List()
+	gameState.mu.RUnlock()
+	if len(items) != 1 || items[0].Metadata["targets"] != bearID {
+		t.Fatalf("expected Chain Lightning on the stack targeting %s, got %+v", bearID, items)
+	}
+
+	// The creature leaves the battlefield in response
+	if err := h.engine.DestroyPermanent(h.gameID, bearID, "response"); err != nil {
+		t.Fatalf("failed to destroy the target: %v", err)
+	}
+	for _, playerID := range []string{"Alice", "Bob"} {
+		if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: playerID, ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+			t.Fatalf("failed to pass priority: %v", err)
+		}
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if !gameState.stack.IsEmpty() {
+		t.Fatalf("expected the stack to be empty, got %d items", len(gameState.stack.List()))
+	}
+	if resolved || countered != 1 {
+		t.Errorf("expected Chain Lightning to be countered on resolution, resolved=%v countered=%d", resolved, countered)
+	}
+	if card := gameState.cards["chain"]; card.Zone != zoneGraveyard || len(card.Targets) != 0 {
+		t.Errorf("expected Chain Lightning in the graveyard without targets, got zone %v targets %v", card.Zone, card.Targets)
+	}
+}
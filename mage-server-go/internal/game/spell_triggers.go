@@ -0,0 +1,54 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/magefree/mage-server-go/internal/game/effects"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+	"github.com/magefree/mage-server-go/internal/game/watchers"
+)
+
+// collectTriggers queues the triggered abilities of all permanents that trigger on an event seen by a
+// board-wide watcher, whichever player caused it: registered triggers ("whenever an opponent casts a
+// spell") and keyword triggers such as prowess
+// Per Java TriggeredAbilities.checkTriggers()
+func (e *MageEngine) collectTriggers(gameState *engineGameState, event rules.Event) {
+	e.checkCombatTriggers(gameState, event)
+
+	if event.Type == rules.EventSpellCast && !watchers.IsCreatureSpell(event) {
+		e.collectProwessTriggers(gameState, event.PlayerID)
+	}
+}
+
+// collectProwessTriggers queues a prowess trigger for each creature the caster controls with prowess
+// Per rule 702.108a: "Whenever you cast a noncreature spell, this creature gets +1/+1 until end of turn."
+func (e *MageEngine) collectProwessTriggers(gameState *engineGameState, casterID string) {
+	for _, card := range gameState.battlefield {
+		if card.ControllerID != casterID || !e.isCreature(card) {
+			continue
+		}
+		if !e.hasAbilityWithEffects(gameState, card, abilityProwess) {
+			continue
+		}
+
+		creatureID := card.ID
+		gameState.triggeredQueue = append(gameState.triggeredQueue, &triggeredAbilityQueueItem{
+			ID:          uuid.New().String(),
+			SourceID:    creatureID,
+			Controller:  casterID,
+			Description: fmt.Sprintf("Prowess: %s gets +1/+1 until end of turn", card.Name),
+			UsesStack:   true,
+			Resolve: func(gs *engineGameState) error {
+				creature, exists := gs.cards[creatureID]
+				if !exists || creature.Zone != zoneBattlefield {
+					return nil
+				}
+				gs.layerSystem.AddEffect(effects.NewEffectBuilder(creatureID).Targeting(creatureID).UntilEndOfTurn().Boost(1, 1))
+				e.recomputeContinuousEffects(gs)
+				gs.addMessage(fmt.Sprintf("%s gets +1/+1 until end of turn (prowess)", creature.Name), "action")
+				return nil
+			},
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+	"github.com/magefree/mage-server-go/internal/game/watchers"
+)
+
+// TestProwess_NoncreatureSpellsOnly verifies that a prowess creature gets +1/+1 until end of turn when
+// its controller casts a noncreature spell but not a creature spell, and that the board-wide watcher
+// counts both
+func TestProwess_NoncreatureSpellsOnly(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-prowess", []string{"Alice", "Bob"})
+	monk := h.CreateCreature(CreatureSpec{
+		ID:         "monk",
+		Name:       "Monastery Swiftspear",
+		Power:      "1",
+		Toughness:  "2",
+		Controller: "Alice",
+		Abilities:  []string{abilityProwess},
+	})
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.battlefield = append(gameState.battlefield, gameState.cards[monk])
+	gameState.mu.Unlock()
+
+	castTestSetup(t, h, "Alice", "alice-instant", "Opt", "Instant", rules.StepMain1)
+	castTestSetup(t, h, "Alice", "alice-creature", "Grizzly Bears", "Creature", rules.StepMain1)
+
+	resolveStack := func() {
+		for i := 0; ; i++ {
+			gameState.mu.RLock()
+			empty := gameState.stack.IsEmpty()
+			gameState.mu.RUnlock()
+			if empty {
+				return
+			}
+			if i > 10 {
+				t.Fatal("stack did not resolve")
+			}
+			for _, playerID := range []string{"Alice", "Bob"} {
+				if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: playerID, ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+					t.Fatalf("failed to pass priority: %v", err)
+				}
+			}
+		}
+	}
+	assertPT := func(power, toughness string) {
+		t.Helper()
+		gameState.mu.RLock()
+		defer gameState.mu.RUnlock()
+		if card := gameState.cards[monk]; card.Power != power || card.Toughness != toughness {
+			t.Errorf("expected the monk to be %s/%s, got %s/%s", power, toughness, card.Power, card.Toughness)
+		}
+	}
+
+	if err := cast(h, "Alice", "Opt"); err != nil {
+		t.Fatalf("failed to cast the instant: %v", err)
+	}
+	resolveStack()
+	assertPT("2", "3")
+
+	if err := cast(h, "Alice", "Grizzly Bears"); err != nil {
+		t.Fatalf("failed to cast the creature: %v", err)
+	}
+	resolveStack()
+	assertPT("2", "3")
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	watcher, ok := gameState.watchers.GetWatcher("SpellCastWatcher").(*watchers.SpellCastWatcher)
+	if !ok {
+		t.Fatal("expected the spell cast watcher to be registered")
+	}
+	if creature, noncreature := watcher.GetCreatureCount("Alice"), watcher.GetNoncreatureCount("Alice"); creature != 1 || noncreature != 1 {
+		t.Errorf("expected 1 creature and 1 noncreature spell, got %d and %d", creature, noncreature)
+	}
+}
@@ -0,0 +1,39 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Split second (rule 702.61): as long as a spell with split second is on the stack, players can't cast
+// other spells or activate abilities that aren't mana abilities. Players still receive priority, so they
+// can pass it to let the spell resolve.
+// Per Java SplitSecondAbility / SplitSecondEffect
+
+// hasSplitSecond reports whether a spell has split second, from its ability or its rules text
+func (e *MageEngine) hasSplitSecond(card *internalCard) bool {
+	if e.hasAbility(card, abilitySplitSecond) {
+		return true
+	}
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(card.RulesText)), "split second")
+}
+
+// updateSplitSecond sets splitSecondActive from the items on the stack; it is called whenever items
+// are put on or leave the stack
+func (s *engineGameState) updateSplitSecond() {
+	s.splitSecondActive = false
+	for _, item := range s.stack.List() {
+		if item.Metadata["split_second"] == "true" {
+			s.splitSecondActive = true
+			return
+		}
+	}
+}
+
+// checkSplitSecond returns an error if a spell with split second is on the stack
+func (s *engineGameState) checkSplitSecond() error {
+	if s.splitSecondActive {
+		return fmt.Errorf("can't respond while a spell with split second is on the stack")
+	}
+	return nil
+}
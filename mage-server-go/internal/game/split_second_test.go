@@ -0,0 +1,52 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestSplitSecond_LocksOutResponses verifies that while a spell with split second is on the stack the
+// opponent can't cast or counter in response, but can pass priority so the spell resolves
+func TestSplitSecond_LocksOutResponses(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-split-second", []string{"Alice", "Bob"})
+	castTestSetup(t, h, "Bob", "tithe", "Mana Tithe", "Instant", rules.StepMain1)
+	castTestSetup(t, h, "Alice", "sudden-shock", "Sudden Shock", "Instant", rules.StepMain1)
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.cards["sudden-shock"].RulesText = "Split second (As long as this spell is on the stack, players can't cast spells or activate abilities that aren't mana abilities.) Sudden Shock deals 2 damage to any target."
+	gameState.mu.Unlock()
+
+	if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Alice", ActionType: "SEND_STRING", Data: "Sudden Shock", Targets: []string{"Bob"}}); err != nil {
+		t.Fatalf("failed to cast Sudden Shock: %v", err)
+	}
+	if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Alice", ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+		t.Fatalf("failed to pass priority: %v", err)
+	}
+
+	if err := cast(h, "Bob", "Mana Tithe"); err == nil {
+		t.Error("expected casting Mana Tithe in response to a split second spell to be rejected")
+	}
+	if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Bob", ActionType: "SEND_UUID", Data: "sudden-shock"}); err == nil {
+		t.Error("expected countering a split second spell to be rejected")
+	}
+	if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Bob", ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+		t.Fatalf("failed to pass priority: %v", err)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if !gameState.stack.IsEmpty() {
+		t.Fatalf("expected Sudden Shock to resolve, got %d stack items", len(gameState.stack.List()))
+	}
+	if gameState.splitSecondActive {
+		t.Error("expected split second to end once the spell left the stack")
+	}
+	if card := gameState.cards["sudden-shock"]; card.Zone != zoneGraveyard {
+		t.Errorf("expected Sudden Shock in the graveyard, got zone %v", card.Zone)
+	}
+	if card := gameState.cards["tithe"]; card.Zone != zoneHand {
+		t.Errorf("expected Mana Tithe to stay in Bob's hand, got zone %v", card.Zone)
+	}
+}
@@ -0,0 +1,115 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TapPermanent taps an untapped permanent by an effect
+func (e *MageEngine) TapPermanent(gameID, cardID string) error {
+	return e.changeTapped(gameID, cardID, true)
+}
+
+// UntapPermanent untaps a tapped permanent by an effect
+func (e *MageEngine) UntapPermanent(gameID, cardID string) error {
+	return e.changeTapped(gameID, cardID, false)
+}
+
+// changeTapped taps or untaps a permanent for TapPermanent and UntapPermanent
+func (e *MageEngine) changeTapped(gameID, cardID string, tapped bool) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	if tapped {
+		defer e.logCall(gameState, &err, "TapPermanent", cardID)
+	} else {
+		defer e.logCall(gameState, &err, "UntapPermanent", cardID)
+	}
+
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+	card, exists := gameState.cards[cardID]
+	if !exists {
+		return fmt.Errorf("card %s not found", cardID)
+	}
+	if tapped {
+		return e.tapPermanent(gameState, card, tapReasonEffect)
+	}
+	return e.untapPermanent(gameState, card)
+}
+
+// tapPermanent taps an untapped permanent, for an attack, a cost or an effect (see the tap reasons),
+// and emits TAPPED for "whenever this becomes tapped" triggers and watchers
+// Per Java PermanentImpl.tap()
+func (e *MageEngine) tapPermanent(gameState *engineGameState, card *internalCard, reason string) error {
+	if card.Zone != zoneBattlefield {
+		return fmt.Errorf("%s is not on the battlefield", card.Name)
+	}
+	if card.Tapped {
+		return fmt.Errorf("%s is already tapped", card.Name)
+	}
+	gameState.eventBus.Publish(rules.NewEvent(rules.EventTap, card.ID, card.ID, card.ControllerID))
+	card.tap(reason)
+	gameState.eventBus.Publish(rules.NewEvent(rules.EventTapped, card.ID, card.ID, card.ControllerID))
+	return nil
+}
+
+// untapPermanent untaps a tapped permanent and emits UNTAPPED
+// Per Java PermanentImpl.untap()
+func (e *MageEngine) untapPermanent(gameState *engineGameState, card *internalCard) error {
+	if card.Zone != zoneBattlefield {
+		return fmt.Errorf("%s is not on the battlefield", card.Name)
+	}
+	if !card.Tapped {
+		return fmt.Errorf("%s is already untapped", card.Name)
+	}
+	gameState.eventBus.Publish(rules.NewEvent(rules.EventUntap, card.ID, card.ID, card.ControllerID))
+	card.untap()
+	gameState.eventBus.Publish(rules.NewEvent(rules.EventUntapped, card.ID, card.ID, card.ControllerID))
+	return nil
+}
+
+// tapCost is "Tap an untapped creature you control" and the like: tapping untapped permanents of the
+// player's choice. The ability's own {T} is its TapCost instead.
+// Per Java TapTargetCost
+type tapCost struct {
+	Description string                        // e.g. "Tap an untapped creature you control"
+	Count       int                           // Permanents to tap (0 = 1)
+	Filter      func(card *internalCard) bool // Permanents that can be tapped (nil = any)
+}
+
+func (c *tapCost) text() string {
+	return c.Description
+}
+
+func (c *tapCost) candidates(e *MageEngine, gameState *engineGameState, playerID, sourceID string) ([]string, int) {
+	ids := make([]string, 0)
+	for _, card := range gameState.battlefield {
+		if card.ControllerID == playerID && !card.Tapped && (c.Filter == nil || c.Filter(card)) {
+			ids = append(ids, card.ID)
+		}
+	}
+	return ids, max(c.Count, 1)
+}
+
+func (c *tapCost) pay(e *MageEngine, gameState *engineGameState, playerID, sourceID string, chosen []string) error {
+	for _, id := range chosen {
+		card, exists := gameState.cards[id]
+		if !exists {
+			return fmt.Errorf("card %s not found", id)
+		}
+		if err := e.tapPermanent(gameState, card, tapReasonCost); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/mana"
+)
+
+// cardView builds the view of a single card
+func cardView(h *CombatTestHarness, cardID string) EngineCardView {
+	gameState := h.GetGameState()
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	return h.engine.buildCardViews([]*internalCard{gameState.cards[cardID]})[0]
+}
+
+// TestTapReason_Attack verifies that a creature tapped by attacking reports the attack reason
+// and is untapped when removed from combat
+func TestTapReason_Attack(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-tap-reason-attack", []string{"Alice", "Bob"})
+
+	attacker := h.CreateAttacker("bears", "Grizzly Bears", "Alice", "2", "2")
+	h.SetupCombat("Alice")
+	h.DeclareAttacker(attacker, "Bob", "Alice")
+
+	view := cardView(h, attacker)
+	if !view.Tapped || view.TapReason != tapReasonAttack {
+		t.Fatalf("expected attacker tapped for %q, got tapped=%v reason=%q", tapReasonAttack, view.Tapped, view.TapReason)
+	}
+
+	if err := h.engine.RemoveAttacker(h.gameID, attacker); err != nil {
+		t.Fatalf("failed to remove attacker: %v", err)
+	}
+
+	view = cardView(h, attacker)
+	if view.Tapped || view.TapReason != "" {
+		t.Errorf("expected attacker untapped after removal from combat, got tapped=%v reason=%q", view.Tapped, view.TapReason)
+	}
+}
+
+// TestTapReason_ManaCost verifies that a creature tapped for mana reports the cost reason
+// and isn't untapped by removal from combat
+func TestTapReason_ManaCost(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-tap-reason-mana", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	elves := h.CreateAttacker("elves", "Llanowar Elves", "Alice", "1", "1")
+	h.SetupCombat("Alice")
+	h.DeclareAttacker(elves, "Bob", "Alice")
+
+	// Untap it (e.g. with an untap effect), then tap it for mana while attacking
+	gameState.mu.Lock()
+	gameState.cards[elves].untap()
+	gameState.mu.Unlock()
+
+	abilityID, err := h.engine.RegisterManaAbility(h.gameID, basicManaAbility(elves, mana.ManaGreen))
+	if err != nil {
+		t.Fatalf("failed to register mana ability: %v", err)
+	}
+	if err := h.engine.ActivateManaAbility(h.gameID, "Alice", abilityID); err != nil {
+		t.Fatalf("failed to tap for mana: %v", err)
+	}
+
+	view := cardView(h, elves)
+	if !view.Tapped || view.TapReason != tapReasonCost {
+		t.Fatalf("expected creature tapped for %q, got tapped=%v reason=%q", tapReasonCost, view.Tapped, view.TapReason)
+	}
+
+	if err := h.engine.RemoveAttacker(h.gameID, elves); err != nil {
+		t.Fatalf("failed to remove attacker: %v", err)
+	}
+	if view := cardView(h, elves); !view.Tapped {
+		t.Error("expected a creature tapped for a cost to stay tapped when removed from combat")
+	}
+}
@@ -0,0 +1,81 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestTapPermanent_AttackTapsOnce verifies that attacking taps a creature through the single tap path,
+// firing TAPPED exactly once, and that tapping and untapping reject permanents already in that state
+func TestTapPermanent_AttackTapsOnce(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-tap-events", []string{"Alice", "Bob"})
+	attacker := h.CreateAttacker("bears", "Grizzly Bears", "Alice", "2", "2")
+	angel := h.CreateCreature(CreatureSpec{ID: "angel", Name: "Serra Angel", Power: "4", Toughness: "4", Controller: "Alice", Abilities: []string{abilityVigilance}})
+
+	tapped := make(map[string]int)
+	untapped := make(map[string]int)
+	gameState := h.GetGameState()
+	gameState.eventBus.SubscribeTyped(rules.EventTapped, func(evt rules.Event) {
+		tapped[evt.TargetID]++
+	})
+	gameState.eventBus.SubscribeTyped(rules.EventUntapped, func(evt rules.Event) {
+		untapped[evt.TargetID]++
+	})
+
+	h.SetupCombat("Alice")
+	h.DeclareAttacker(attacker, "Bob", "Alice")
+	h.DeclareAttacker(angel, "Bob", "Alice")
+	h.AssertCreatureTapped(attacker, true)
+	if tapped[attacker] != 1 {
+		t.Errorf("expected one TAPPED event for the attacker, got %d", tapped[attacker])
+	}
+	if tapped[angel] != 0 {
+		t.Errorf("expected a creature with vigilance not to become tapped, got %d TAPPED events", tapped[angel])
+	}
+	h.EndCombat()
+
+	if err := h.engine.TapPermanent(h.gameID, attacker); err == nil {
+		t.Error("expected tapping a tapped creature to be rejected")
+	}
+	if err := h.engine.UntapPermanent(h.gameID, attacker); err != nil {
+		t.Fatalf("failed to untap: %v", err)
+	}
+	if err := h.engine.UntapPermanent(h.gameID, attacker); err == nil {
+		t.Error("expected untapping an untapped creature to be rejected")
+	}
+	if untapped[attacker] != 1 {
+		t.Errorf("expected one UNTAPPED event, got %d", untapped[attacker])
+	}
+	if err := h.engine.TapPermanent(h.gameID, angel); err != nil {
+		t.Fatalf("failed to tap: %v", err)
+	}
+	h.AssertCreatureTapped(angel, true)
+	if tapped[angel] != 1 {
+		t.Errorf("expected TapPermanent to fire TAPPED, got %d", tapped[angel])
+	}
+}
+
+// TestTapPermanent_RemovedAttackerUntaps verifies that an attacker removed from combat is untapped
+// through the single untap path, firing UNTAPPED
+func TestTapPermanent_RemovedAttackerUntaps(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-tap-remove-attacker", []string{"Alice", "Bob"})
+	attacker := h.CreateAttacker("bears", "Grizzly Bears", "Alice", "2", "2")
+
+	untapped := 0
+	h.GetGameState().eventBus.SubscribeTyped(rules.EventUntapped, func(evt rules.Event) {
+		if evt.TargetID == attacker {
+			untapped++
+		}
+	})
+
+	h.SetupCombat("Alice")
+	h.DeclareAttacker(attacker, "Bob", "Alice")
+	if err := h.engine.RemoveAttacker(h.gameID, attacker); err != nil {
+		t.Fatalf("failed to remove attacker: %v", err)
+	}
+	h.AssertCreatureTapped(attacker, false)
+	if untapped != 1 {
+		t.Errorf("expected one UNTAPPED event for the removed attacker, got %d", untapped)
+	}
+}
@@ -25,6 +25,8 @@ const (
 	TargetTypeLand TargetType = "LAND"
 	// TargetTypePlaneswalker targets planeswalkers
 	TargetTypePlaneswalker TargetType = "PLANESWALKER"
+	// TargetTypeAny targets a creature, player or planeswalker ("any target", rule 115.4)
+	TargetTypeAny TargetType = "ANY"
 )
 
 // TargetRequirement defines what targets a spell or ability requires.
@@ -39,6 +41,9 @@ type TargetRequirement struct {
 	Optional bool
 	// Description is a human-readable description of the target requirement
 	Description string
+	// SourceColors are the colors of the spell or ability's source; targets with protection from
+	// one of them are illegal (set by the engine, not parsed)
+	SourceColors []string
 }
 
 // TargetSelection represents a player's target selection for a spell or ability.
@@ -86,6 +91,15 @@ func ParseTargetRequirements(cardType string, rulesText string) []TargetRequirem
 	text := strings.ToLower(rulesText)
 	
 	// Check for common targeting patterns
+	if strings.Contains(text, "any target") {
+		requirements = append(requirements, TargetRequirement{
+			Type:        TargetTypeAny,
+			MinTargets:  1,
+			MaxTargets:  1,
+			Optional:    false,
+			Description: "any target",
+		})
+	}
 	if strings.Contains(text, "target creature") {
 		requirements = append(requirements, TargetRequirement{
 			Type:        TargetTypeCreature,
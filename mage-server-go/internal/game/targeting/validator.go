@@ -34,6 +34,8 @@ type TargetCardInfo struct {
 	OwnerID      string
 	Tapped       bool
 	FaceDown     bool
+	// ProtectionFrom lists the qualities the card has protection from, e.g. "red"
+	ProtectionFrom []string
 }
 
 // TargetPlayerInfo provides information about a player for target validation.
@@ -68,7 +70,7 @@ func (tv *TargetValidator) ValidateTarget(targetID string, requirement TargetReq
 	// Check if target is a player
 	player, isPlayer := tv.gameState.FindPlayerForTarget(targetID)
 	if isPlayer {
-		if requirement.Type != TargetTypePlayer {
+		if requirement.Type != TargetTypePlayer && requirement.Type != TargetTypeAny {
 			return fmt.Errorf("target %s is a player but requirement is %s", targetID, requirement.Type)
 		}
 		if player.Lost || player.Left {
@@ -114,11 +116,27 @@ func (tv *TargetValidator) ValidateTarget(targetID string, requirement TargetReq
 		if !strings.Contains(strings.ToLower(card.Type), "planeswalker") {
 			return fmt.Errorf("target %s is not a planeswalker", card.Name)
 		}
+	case TargetTypeAny:
+		cardType := strings.ToLower(card.Type)
+		if card.Zone != 2 || (!strings.Contains(cardType, "creature") && !strings.Contains(cardType, "planeswalker")) { // zoneBattlefield
+			return fmt.Errorf("target %s is not a creature or planeswalker", card.Name)
+		}
 	case TargetTypePlayer:
 		return fmt.Errorf("target %s is a card but requirement is player", card.Name)
 	}
 
-	// TODO: Check for hexproof, protection, shroud, etc.
+	// Per rule 702.16b: a permanent with protection can't be the target of a source with that quality
+	for _, quality := range card.ProtectionFrom {
+		protected := quality == "everything"
+		for _, color := range requirement.SourceColors {
+			protected = protected || strings.EqualFold(quality, color)
+		}
+		if protected {
+			return fmt.Errorf("target %s has protection from %s", card.Name, quality)
+		}
+	}
+
+	// TODO: Check for hexproof, shroud, etc.
 	// This would require additional card metadata
 
 	return nil
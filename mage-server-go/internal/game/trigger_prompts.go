@@ -0,0 +1,169 @@
+package game
+
+import (
+	"fmt"
+)
+
+// SetAlwaysPromptTriggers sets whether a player confirms their triggered abilities before they are
+// put on the stack, even when there's nothing to choose.
+// By default triggers without choices are put on the stack automatically.
+func (e *MageEngine) SetAlwaysPromptTriggers(gameID, playerID string, alwaysPrompt bool) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "SetAlwaysPromptTriggers", playerID, alwaysPrompt)
+
+	player, exists := gameState.players[playerID]
+	if !exists {
+		return fmt.Errorf("player %s not found", playerID)
+	}
+
+	player.AlwaysPromptTriggers = alwaysPrompt
+	return nil
+}
+
+// promptTriggeredAbilities asks a player to acknowledge their triggered abilities and choose the
+// order they are put on the stack in (the first one resolves last)
+// Per rule 603.3b: each player puts their triggered abilities on the stack in any order they choose,
+// in APNAP order; trigger processing stops until the player answers
+func (e *MageEngine) promptTriggeredAbilities(gameState *engineGameState, playerID string, abilities []*triggeredAbilityQueueItem) {
+	byID := make(map[string]*triggeredAbilityQueueItem, len(abilities))
+	choices := make([]string, 0, len(abilities))
+	for _, ability := range abilities {
+		byID[ability.ID] = ability
+		choices = append(choices, ability.ID)
+	}
+
+	text := "Put your triggered abilities on the stack (first resolves last)"
+	if len(abilities) == 1 {
+		text = fmt.Sprintf("Triggered ability: %s", abilities[0].Description)
+	}
+
+	decision := gameState.addDecision(&Decision{
+		PlayerID: playerID,
+		Kind:     DecisionOrderList,
+		Text:     text,
+		Choices:  choices,
+		resolve: func(gameState *engineGameState, response Response) error {
+			gameState.triggerDecisionID = ""
+			for _, abilityID := range response.Choices {
+				ability := byID[abilityID]
+				e.removeTriggeredAbility(gameState, ability.ID)
+				if err := e.putTriggeredAbilityOnStack(gameState, ability); err != nil {
+					return fmt.Errorf("failed to put triggered ability on stack: %w", err)
+				}
+			}
+
+			// Continue with the next player's triggers
+			e.processTriggeredAbilities(gameState)
+			return nil
+		},
+	})
+	gameState.triggerDecisionID = decision.ID
+}
+
+// OrderTriggers puts a player's pending simultaneous triggered abilities on the stack in the given
+// order: the first is put on the stack first and so resolves last. It answers the order_list decision
+// the player was given, after which the next player's triggers are processed.
+// Per rule 603.3b and Java PlayerImpl.chooseTriggeredAbility()
+func (e *MageEngine) OrderTriggers(gameID, playerID string, orderedTriggerIDs []string) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "OrderTriggers", playerID, orderedTriggerIDs)
+
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+
+	decision, pending := gameState.decisions[gameState.triggerDecisionID]
+	if !pending || decision.PlayerID != playerID {
+		return fmt.Errorf("player %s has no triggered abilities to order", playerID)
+	}
+	if err := e.respondToDecision(gameState, playerID, decision.ID, Response{Choices: orderedTriggerIDs}); err != nil {
+		return err
+	}
+
+	e.notifyPlayerAction(gameID, playerID, map[string]interface{}{
+		"type":        "order_triggers",
+		"decision_id": decision.ID,
+	})
+	return nil
+}
+
+// promptOptionalTrigger asks the controller of a resolving "may" triggered ability whether to apply
+// its effect; the effect only happens if they accept
+// Per rule 603.5 and Java TriggeredAbilityImpl.resolve() (optional abilities ask chooseUse)
+func (e *MageEngine) promptOptionalTrigger(gameState *engineGameState, ability *triggeredAbilityQueueItem) {
+	if gameState.optionalTriggers == nil {
+		gameState.optionalTriggers = make(map[string]string)
+	}
+
+	decision := gameState.addDecision(&Decision{
+		PlayerID: ability.Controller,
+		Kind:     DecisionYesNo,
+		Text:     fmt.Sprintf("Use optional ability? %s", ability.Description),
+		resolve: func(gameState *engineGameState, response Response) error {
+			delete(gameState.optionalTriggers, ability.ID)
+			if !response.Yes {
+				gameState.addMessage(fmt.Sprintf("%s declines: %s", ability.Controller, ability.Description), "action")
+				return nil
+			}
+			if ability.Resolve == nil {
+				return nil
+			}
+			return ability.Resolve(gameState)
+		},
+	})
+	gameState.optionalTriggers[ability.ID] = decision.ID
+}
+
+// ResolveOptionalTrigger answers the yes/no decision of a resolving "may" triggered ability: its effect
+// is applied if accept is true and skipped otherwise
+func (e *MageEngine) ResolveOptionalTrigger(gameID, triggerID string, accept bool) (err error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	defer e.logCall(gameState, &err, "ResolveOptionalTrigger", triggerID, accept)
+
+	if err := gameState.checkAcceptsActions(); err != nil {
+		return err
+	}
+
+	decisionID, pending := gameState.optionalTriggers[triggerID]
+	if !pending {
+		return fmt.Errorf("triggered ability %s is not waiting for a decision", triggerID)
+	}
+	decision := gameState.decisions[decisionID]
+	if err := e.respondToDecision(gameState, decision.PlayerID, decisionID, Response{Yes: accept}); err != nil {
+		return err
+	}
+
+	e.notifyPlayerAction(gameID, decision.PlayerID, map[string]interface{}{
+		"type":       "resolve_optional_trigger",
+		"trigger_id": triggerID,
+		"accept":     accept,
+	})
+	return nil
+}
@@ -0,0 +1,259 @@
+package game
+
+import (
+	"testing"
+)
+
+// queueTrigger queues a triggered ability with no choices for a player
+func queueTrigger(gameState *engineGameState, id, controllerID string) {
+	gameState.triggeredQueue = append(gameState.triggeredQueue, &triggeredAbilityQueueItem{
+		ID:          id,
+		SourceID:    id,
+		Controller:  controllerID,
+		Description: "Whenever a creature enters, you gain 1 life",
+		UsesStack:   true,
+	})
+}
+
+// TestTriggerPrompts_AlwaysPromptGetsDecisionPoint verifies that a player who always confirms triggers
+// gets a decision for a trigger with no choices, while a default player's trigger goes on the stack
+func TestTriggerPrompts_AlwaysPromptGetsDecisionPoint(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-trigger-prompts", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	if err := h.engine.SetAlwaysPromptTriggers(h.gameID, "Bob", true); err != nil {
+		t.Fatalf("failed to set trigger preference: %v", err)
+	}
+
+	gameState.mu.Lock()
+	queueTrigger(gameState, "alice-trigger", "Alice")
+	queueTrigger(gameState, "bob-trigger", "Bob")
+	h.engine.processTriggeredAbilities(gameState)
+	if items := gameState.stack.List(); len(items) != 1 || items[0].ID != "alice-trigger" {
+		t.Fatalf("expected only Alice's trigger on the stack, got %+v", items)
+	}
+	gameState.mu.Unlock()
+
+	if decisions, _ := h.engine.GetPendingDecisions(h.gameID, "Alice"); len(decisions) != 0 {
+		t.Errorf("expected no decision for Alice, got %d", len(decisions))
+	}
+	decisions, err := h.engine.GetPendingDecisions(h.gameID, "Bob")
+	if err != nil || len(decisions) != 1 || decisions[0].Kind != DecisionOrderList {
+		t.Fatalf("expected an order_list decision for Bob, got %+v (%v)", decisions, err)
+	}
+
+	if err := h.engine.RespondToDecision(h.gameID, "Bob", decisions[0].ID, Response{Choices: []string{"bob-trigger"}}); err != nil {
+		t.Fatalf("failed to acknowledge trigger: %v", err)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if items := gameState.stack.List(); len(items) != 2 || items[1].ID != "bob-trigger" {
+		t.Errorf("expected Bob's trigger on top of the stack, got %+v", items)
+	}
+	if len(gameState.triggeredQueue) != 0 {
+		t.Errorf("expected an empty trigger queue, got %d", len(gameState.triggeredQueue))
+	}
+}
+
+// TestTriggerPrompts_APNAPStopsAtActivePlayer verifies that the non-active player's triggers wait until
+// the active player has put theirs on the stack
+func TestTriggerPrompts_APNAPStopsAtActivePlayer(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-trigger-apnap", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	if err := h.engine.SetAlwaysPromptTriggers(h.gameID, "Alice", true); err != nil {
+		t.Fatalf("failed to set trigger preference: %v", err)
+	}
+
+	gameState.mu.Lock()
+	queueTrigger(gameState, "alice-trigger-1", "Alice")
+	queueTrigger(gameState, "alice-trigger-2", "Alice")
+	queueTrigger(gameState, "bob-trigger", "Bob")
+	h.engine.processTriggeredAbilities(gameState)
+	if !gameState.stack.IsEmpty() {
+		t.Fatalf("expected Bob's trigger to wait for Alice, got %d stack items", len(gameState.stack.List()))
+	}
+	gameState.mu.Unlock()
+
+	decisions, err := h.engine.GetPendingDecisions(h.gameID, "Alice")
+	if err != nil || len(decisions) != 1 {
+		t.Fatalf("expected one decision for Alice, got %+v (%v)", decisions, err)
+	}
+	order := []string{"alice-trigger-2", "alice-trigger-1"}
+	if err := h.engine.RespondToDecision(h.gameID, "Alice", decisions[0].ID, Response{Choices: order}); err != nil {
+		t.Fatalf("failed to order triggers: %v", err)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	items := gameState.stack.List()
+	want := []string{"alice-trigger-2", "alice-trigger-1", "bob-trigger"}
+	if len(items) != len(want) {
+		t.Fatalf("expected %d stack items, got %d", len(want), len(items))
+	}
+	for i, id := range want {
+		if items[i].ID != id {
+			t.Errorf("expected stack item %d to be %s, got %s", i, id, items[i].ID)
+		}
+	}
+}
+
+// TestTriggerPrompts_PlayerOrdersSimultaneousTriggers verifies that a player's simultaneous triggers wait
+// for OrderTriggers and then resolve in the reverse of the chosen order
+func TestTriggerPrompts_PlayerOrdersSimultaneousTriggers(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-trigger-order", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	resolved := make([]string, 0, 2)
+	gameState.mu.Lock()
+	for _, id := range []string{"soul-warden", "ajani-pridemate"} {
+		id := id
+		queueTrigger(gameState, id, "Alice")
+		gameState.triggeredQueue[len(gameState.triggeredQueue)-1].Resolve = func(*engineGameState) error {
+			resolved = append(resolved, id)
+			return nil
+		}
+	}
+	h.engine.processTriggeredAbilities(gameState)
+	if !gameState.stack.IsEmpty() {
+		t.Fatalf("expected the triggers to wait for Alice's order, got %d stack items", len(gameState.stack.List()))
+	}
+	gameState.mu.Unlock()
+
+	if err := h.engine.OrderTriggers(h.gameID, "Bob", []string{"ajani-pridemate", "soul-warden"}); err == nil {
+		t.Error("expected Bob to have no triggers to order")
+	}
+	if err := h.engine.OrderTriggers(h.gameID, "Alice", []string{"ajani-pridemate"}); err == nil {
+		t.Error("expected an order missing a trigger to be rejected")
+	}
+	if err := h.engine.OrderTriggers(h.gameID, "Alice", []string{"ajani-pridemate", "soul-warden"}); err != nil {
+		t.Fatalf("failed to order triggers: %v", err)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	for !gameState.stack.IsEmpty() {
+		item, err := gameState.stack.Pop()
+		if err != nil {
+			t.Fatalf("failed to pop from stack: %v", err)
+		}
+		if err := item.Resolve(); err != nil {
+			t.Fatalf("failed to resolve %s: %v", item.ID, err)
+		}
+	}
+	if len(resolved) != 2 || resolved[0] != "soul-warden" || resolved[1] != "ajani-pridemate" {
+		t.Errorf("expected the last trigger put on the stack to resolve first, got %v", resolved)
+	}
+}
+
+// TestTriggerPrompts_DecliningOptionalTriggerSkipsEffect verifies that a "may" trigger asks its controller
+// as it resolves and that declining leaves the effect undone, while accepting applies it
+func TestTriggerPrompts_DecliningOptionalTriggerSkipsEffect(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-trigger-optional", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	alice := gameState.players["Alice"]
+	handSize := len(alice.Hand)
+	resolveMayDraw := func(id string) {
+		queueTrigger(gameState, id, "Alice")
+		ability := gameState.triggeredQueue[len(gameState.triggeredQueue)-1]
+		ability.Description = "Whenever a creature enters, you may draw a card"
+		ability.Optional = true
+		ability.Resolve = func(gs *engineGameState) error {
+			h.engine.drawCards(gs, gs.players["Alice"], 1)
+			return nil
+		}
+		h.engine.processTriggeredAbilities(gameState)
+		item, err := gameState.stack.Pop()
+		if err != nil {
+			t.Fatalf("expected the trigger on the stack: %v", err)
+		}
+		if err := item.Resolve(); err != nil {
+			t.Fatalf("failed to resolve %s: %v", item.ID, err)
+		}
+	}
+	resolveMayDraw("decline-draw")
+	if len(alice.Hand) != handSize {
+		t.Errorf("expected the draw to wait for Alice's answer, got %d cards", len(alice.Hand))
+	}
+	gameState.mu.Unlock()
+
+	if err := h.engine.ResolveOptionalTrigger(h.gameID, "unknown-trigger", true); err == nil {
+		t.Error("expected a trigger without a pending decision to be rejected")
+	}
+	if err := h.engine.ResolveOptionalTrigger(h.gameID, "decline-draw", false); err != nil {
+		t.Fatalf("failed to decline the trigger: %v", err)
+	}
+
+	gameState.mu.Lock()
+	if len(alice.Hand) != handSize {
+		t.Errorf("expected declining to leave the hand at %d cards, got %d", handSize, len(alice.Hand))
+	}
+	if len(gameState.decisions) != 0 {
+		t.Errorf("expected the decision to be answered, got %d pending", len(gameState.decisions))
+	}
+	resolveMayDraw("accept-draw")
+	gameState.mu.Unlock()
+
+	if err := h.engine.ResolveOptionalTrigger(h.gameID, "accept-draw", true); err != nil {
+		t.Fatalf("failed to accept the trigger: %v", err)
+	}
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if len(alice.Hand) != handSize+1 {
+		t.Errorf("expected accepting to draw a card, got %d cards", len(alice.Hand))
+	}
+}
+
+// TestTriggerPrompts_InterveningIfConditionRecheckedOnResolution verifies that a trigger with an
+// intervening "if" clause only goes on the stack while its condition holds and does nothing if the
+// condition is false by the time it resolves
+func TestTriggerPrompts_InterveningIfConditionRecheckedOnResolution(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-trigger-intervening-if", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+	alice := gameState.players["Alice"]
+	extra := alice.Hand[1:]
+	alice.Hand = alice.Hand[:1]
+
+	resolved := false
+	queueOneCardTrigger := func(id string) {
+		queueTrigger(gameState, id, "Alice")
+		ability := gameState.triggeredQueue[len(gameState.triggeredQueue)-1]
+		ability.Description = "At the beginning of your upkeep, if you have exactly 1 card in hand, you gain 1 life"
+		ability.Condition = func(gs *engineGameState) bool { return len(gs.players["Alice"].Hand) == 1 }
+		ability.Resolve = func(*engineGameState) error {
+			resolved = true
+			return nil
+		}
+	}
+
+	queueOneCardTrigger("one-card")
+	h.engine.processTriggeredAbilities(gameState)
+	if len(gameState.stack.List()) != 1 {
+		t.Fatalf("expected the trigger on the stack while its condition holds, got %d stack items", len(gameState.stack.List()))
+	}
+
+	alice.Hand = append(alice.Hand, extra...)
+	item, err := gameState.stack.Pop()
+	if err != nil {
+		t.Fatalf("failed to pop from stack: %v", err)
+	}
+	if err := item.Resolve(); err != nil {
+		t.Fatalf("failed to resolve %s: %v", item.ID, err)
+	}
+	if resolved {
+		t.Error("expected the trigger to do nothing once Alice has more than 1 card in hand")
+	}
+
+	queueOneCardTrigger("not-triggered")
+	h.engine.processTriggeredAbilities(gameState)
+	if !gameState.stack.IsEmpty() || len(gameState.triggeredQueue) != 0 {
+		t.Errorf("expected a trigger whose condition is false not to be put on the stack, got %d stack items and %d queued",
+			len(gameState.stack.List()), len(gameState.triggeredQueue))
+	}
+}
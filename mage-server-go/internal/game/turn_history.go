@@ -0,0 +1,71 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/magefree/mage-server-go/internal/game/watchers"
+)
+
+// DiedThisTurn returns the IDs of the permanents that died this turn, in the order they died
+// Per Java CreaturesDiedWatcher
+func (e *MageEngine) DiedThisTurn(gameID string) ([]string, error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+
+	watcher, ok := gameState.watchers.GetWatcher("DiedThisTurnWatcher").(*watchers.DiedThisTurnWatcher)
+	if !ok {
+		return []string{}, nil
+	}
+	return watcher.GetDied(), nil
+}
+
+// AttackedThisTurn returns the IDs of the creatures a player attacked with this turn, in the order
+// they were declared as attackers
+// Per Java AttackedThisTurnWatcher
+func (e *MageEngine) AttackedThisTurn(gameID, playerID string) ([]string, error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+
+	watcher, ok := gameState.watchers.GetWatcher("AttackedThisTurnWatcher").(*watchers.AttackedThisTurnWatcher)
+	if !ok {
+		return []string{}, nil
+	}
+	return watcher.GetAttackersOf(playerID), nil
+}
+
+// SpellsCastThisTurn returns the number of spells a player has cast this turn
+// Per Java SpellsCastWatcher
+func (e *MageEngine) SpellsCastThisTurn(gameID, playerID string) (int, error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return 0, fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+
+	watcher, ok := gameState.watchers.GetWatcher("SpellsCastWatcher").(*watchers.SpellsCastWatcher)
+	if !ok {
+		return 0, nil
+	}
+	return watcher.GetCount(playerID), nil
+}
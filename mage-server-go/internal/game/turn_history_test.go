@@ -0,0 +1,54 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestTurnHistory_TracksTurnAndClearsOnNewTurn verifies that spells cast, attackers declared and
+// permanents that died are recorded for the turn and forgotten when the next turn begins
+func TestTurnHistory_TracksTurnAndClearsOnNewTurn(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-turn-history", []string{"Alice", "Bob"})
+	castTestSetup(t, h, "Alice", "shock", "Shock", "Instant", rules.StepMain1)
+	if err := cast(h, "Alice", "Shock"); err != nil {
+		t.Fatalf("failed to cast Shock: %v", err)
+	}
+
+	h.CreateAttacker("bears", "Grizzly Bears", "Alice", "2", "2")
+	h.CreateBlocker("wall", "Wall of Wood", "Bob", "0", "3")
+	h.SetupCombat("Alice")
+	h.DeclareAttacker("bears", "Bob", "Alice")
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	if err := h.engine.moveCard(gameState, gameState.cards["wall"], zoneGraveyard, ""); err != nil {
+		t.Fatalf("failed to move Wall of Wood: %v", err)
+	}
+	gameState.mu.Unlock()
+
+	if count, err := h.engine.SpellsCastThisTurn(h.gameID, "Alice"); err != nil || count != 1 {
+		t.Errorf("expected Alice to have cast 1 spell this turn, got %d (%v)", count, err)
+	}
+	if count, _ := h.engine.SpellsCastThisTurn(h.gameID, "Bob"); count != 0 {
+		t.Errorf("expected Bob to have cast no spells this turn, got %d", count)
+	}
+	if attackers, err := h.engine.AttackedThisTurn(h.gameID, "Alice"); err != nil || !reflect.DeepEqual(attackers, []string{"bears"}) {
+		t.Errorf("expected Alice to have attacked with Grizzly Bears this turn, got %v (%v)", attackers, err)
+	}
+	if died, err := h.engine.DiedThisTurn(h.gameID); err != nil || !reflect.DeepEqual(died, []string{"wall"}) {
+		t.Errorf("expected Wall of Wood to have died this turn, got %v (%v)", died, err)
+	}
+
+	gameState.mu.Lock()
+	h.engine.beginTurn(gameState, "Bob")
+	gameState.mu.Unlock()
+
+	count, _ := h.engine.SpellsCastThisTurn(h.gameID, "Alice")
+	attackers, _ := h.engine.AttackedThisTurn(h.gameID, "Alice")
+	died, _ := h.engine.DiedThisTurn(h.gameID)
+	if count != 0 || len(attackers) != 0 || len(died) != 0 {
+		t.Errorf("expected the history to clear on a new turn, got %d spells, attackers %v and died %v", count, attackers, died)
+	}
+}
@@ -0,0 +1,56 @@
+package game
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestCounter_UncounterableSpellsStayOnStack verifies that spells that can't be countered, from their
+// rules text or an explicit ability, are marked on the stack and can't be countered, while other spells can
+func TestCounter_UncounterableSpellsStayOnStack(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-uncounterable", []string{"Alice", "Bob"})
+	castTestSetup(t, h, "Alice", "decay", "Abrupt Decay", "Instant", rules.StepMain1)
+	castTestSetup(t, h, "Alice", "vines", "Vines of Vastwood", "Instant", rules.StepMain1)
+	castTestSetup(t, h, "Alice", "dart", "Lightning Dart", "Instant", rules.StepMain1)
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.cards["decay"].RulesText = "This spell can't be countered. Destroy target nonland permanent with mana value 3 or less."
+	gameState.cards["vines"].Abilities = []EngineAbilityView{{ID: abilityCantBeCountered}}
+	gameState.mu.Unlock()
+
+	for _, name := range []string{"Abrupt Decay", "Vines of Vastwood", "Lightning Dart"} {
+		if err := cast(h, "Alice", name); err != nil {
+			t.Fatalf("failed to cast %s: %v", name, err)
+		}
+	}
+	if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Alice", ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+		t.Fatalf("failed to pass priority: %v", err)
+	}
+
+	counter := func(cardID string) error {
+		return h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: "Bob", ActionType: "SEND_UUID", Data: cardID})
+	}
+	for _, cardID := range []string{"decay", "vines"} {
+		if err := counter(cardID); err == nil || !strings.Contains(err.Error(), "can't be countered") {
+			t.Errorf("expected countering %s to be refused, got %v", cardID, err)
+		}
+	}
+	if err := counter("dart"); err != nil {
+		t.Fatalf("failed to counter Lightning Dart: %v", err)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	items := gameState.stack.List()
+	if len(items) != 2 {
+		t.Fatalf("expected the two uncounterable spells to stay on the stack, got %d items", len(items))
+	}
+	for _, item := range items {
+		if item.Metadata["uncounterable"] != "true" {
+			t.Errorf("expected %s to be marked uncounterable", item.Description)
+		}
+	}
+}
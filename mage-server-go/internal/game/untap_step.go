@@ -0,0 +1,43 @@
+package game
+
+import (
+	"fmt"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// handleUntapStepBegin performs the untap step as it begins. No player receives priority during the
+// untap step (rule 502.4), so the game moves on to the upkeep right away; it returns the phase and step
+// the game is in afterwards.
+// Per Java UntapStep.beginStep()
+func (e *MageEngine) handleUntapStepBegin(gameState *engineGameState, phase rules.Phase, step rules.Step, activePlayerID string) (rules.Phase, rules.Step) {
+	if step != rules.StepUntap {
+		return phase, step
+	}
+
+	e.untapStep(gameState, activePlayerID)
+
+	phase, step = gameState.turnManager.AdvanceStep("")
+	gameState.addMessage(fmt.Sprintf("Game advances to %s - %s", phase.String(), step.String()), "action")
+	return phase, step
+}
+
+// untapStep untaps the permanents the active player controls, except those that don't untap during
+// their controller's untap step
+// Per rule 502.3 and Java PlayerImpl.untap()
+func (e *MageEngine) untapStep(gameState *engineGameState, activePlayerID string) {
+	gameState.eventBus.Publish(rules.NewEvent(rules.EventUntapStepPre, "", "", activePlayerID))
+
+	for _, card := range gameState.cards {
+		if card.Zone != zoneBattlefield || card.ControllerID != activePlayerID || !card.Tapped {
+			continue
+		}
+		if e.hasAbilityWithEffects(gameState, card, abilityDoesntUntap) {
+			gameState.addMessage(fmt.Sprintf("%s doesn't untap", card.Name), "action")
+			continue
+		}
+		_ = e.untapPermanent(gameState, card)
+	}
+
+	gameState.eventBus.Publish(rules.NewEvent(rules.EventUntapStep, "", "", activePlayerID))
+}
@@ -0,0 +1,70 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestUntapStep_UntapsActivePlayersPermanents verifies that tapped permanents untap at the start of
+// their controller's turn, but not on another player's turn or when they don't untap during the untap
+// step, and that no player gets priority in the untap step
+func TestUntapStep_UntapsActivePlayersPermanents(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-untap-step", []string{"Alice", "Bob"})
+	bears := h.CreateCreature(CreatureSpec{ID: "bears", Name: "Grizzly Bears", Power: "2", Toughness: "2", Controller: "Alice", Tapped: true})
+	colossus := h.CreateCreature(CreatureSpec{ID: "colossus", Name: "Colossus of Sardia", Power: "9", Toughness: "9", Controller: "Alice", Tapped: true, Abilities: []string{abilityDoesntUntap}})
+	wolf := h.CreateCreature(CreatureSpec{ID: "wolf", Name: "Timber Wolves", Power: "1", Toughness: "1", Controller: "Bob", Tapped: true})
+	gameState := h.GetGameState()
+
+	gameState.mu.Lock()
+	gameState.cards[bears].TapReason = tapReasonAttack
+	untapped := make([]string, 0)
+	gameState.eventBus.SubscribeTyped(rules.EventUntapped, func(evt rules.Event) {
+		untapped = append(untapped, evt.TargetID)
+	})
+	gameState.mu.Unlock()
+
+	passUntilTurn := func(turn int) {
+		t.Helper()
+		for i := 0; i < 50; i++ {
+			gameState.mu.RLock()
+			current, step, priority := gameState.turnManager.TurnNumber(), gameState.turnManager.CurrentStep(), gameState.turnManager.PriorityPlayer()
+			gameState.mu.RUnlock()
+			if step == rules.StepUntap && current > 1 {
+				t.Fatalf("expected no priority during the untap step of turn %d", current)
+			}
+			if current >= turn {
+				return
+			}
+			if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: priority, ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+				t.Fatalf("failed to pass priority: %v", err)
+			}
+		}
+		t.Fatalf("expected to reach turn %d", turn)
+	}
+
+	// Bob's turn: only Bob's permanents untap
+	passUntilTurn(2)
+	gameState.mu.RLock()
+	if step := gameState.turnManager.CurrentStep(); step != rules.StepUpkeep {
+		t.Errorf("expected the turn to continue to the upkeep, got %v", step)
+	}
+	if gameState.cards[wolf].Tapped || !gameState.cards[bears].Tapped {
+		t.Errorf("expected only Bob's Timber Wolves to untap on Bob's turn, got wolves tapped=%v bears tapped=%v", gameState.cards[wolf].Tapped, gameState.cards[bears].Tapped)
+	}
+	gameState.mu.RUnlock()
+
+	// Alice's turn: Grizzly Bears untaps, Colossus of Sardia doesn't
+	passUntilTurn(3)
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if card := gameState.cards[bears]; card.Tapped || card.TapReason != "" {
+		t.Errorf("expected Grizzly Bears to untap on Alice's turn, got tapped=%v reason %q", card.Tapped, card.TapReason)
+	}
+	if !gameState.cards[colossus].Tapped {
+		t.Error("expected Colossus of Sardia not to untap during the untap step")
+	}
+	if len(untapped) != 2 {
+		t.Errorf("expected 2 untapped events, got %v", untapped)
+	}
+}
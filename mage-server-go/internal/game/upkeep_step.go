@@ -0,0 +1,18 @@
+package game
+
+import (
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// handleUpkeepStepBegin announces the upkeep step as it begins, so "at the beginning of your upkeep"
+// abilities of the active player's permanents trigger and are put on the stack before the active
+// player gets priority (rule 503.1)
+// Per Java UpkeepStep.beginStep()
+func (e *MageEngine) handleUpkeepStepBegin(gameState *engineGameState, step rules.Step, activePlayerID string) {
+	if step != rules.StepUpkeep {
+		return
+	}
+
+	gameState.eventBus.Publish(rules.NewEvent(rules.EventUpkeepStepPre, "", "", activePlayerID))
+	gameState.eventBus.Publish(rules.NewEvent(rules.EventUpkeepStep, "", "", activePlayerID))
+}
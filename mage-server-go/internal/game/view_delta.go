@@ -0,0 +1,196 @@
+package game
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// maxViewHistory is the number of views kept per player to build deltas from; a client further behind
+// gets the full view
+const maxViewHistory = 8
+
+// EngineGameViewDelta is what changed in a player's view of a game since a version they have. The
+// client applies it to its copy of that version to get the current view.
+type EngineGameViewDelta struct {
+	GameID       string
+	SinceVersion int
+	Version      int
+	// Full is the whole view when there is no delta from SinceVersion, because the engine no longer has
+	// that version; the client replaces its view with it (nil = a delta)
+	Full *EngineGameView
+	// Fields are the other fields of the view that changed, by name, each sent whole
+	Fields      map[string]interface{}
+	Battlefield EngineZoneDelta
+	Exile       EngineZoneDelta
+	// PriorityTimeLeft and TimeBanks are the clocks. They run down without the game changing, so they
+	// are sent with every delta rather than as changes to the view.
+	PriorityTimeLeft time.Duration
+	TimeBanks        map[string]time.Duration `json:",omitempty"` // Player ID -> time left on their match clock
+}
+
+// EngineZoneDelta is what changed among the cards of a zone
+type EngineZoneDelta struct {
+	Changed []EngineCardView `json:",omitempty"` // Cards that changed, or entered the zone (added at the end)
+	Removed []string         `json:",omitempty"` // IDs of cards that left the zone
+}
+
+// viewHistory holds the recent views sent to each player. It has its own mutex because views are
+// built under the game's read lock.
+type viewHistory struct {
+	mu    sync.Mutex
+	views map[string][]*EngineGameView // Player ID -> recent views, oldest first
+}
+
+func newViewHistory() *viewHistory {
+	return &viewHistory{views: make(map[string][]*EngineGameView)}
+}
+
+// GetGameViewDelta returns what changed in a player's view of a game since the version of a view
+// they got before, which saves bandwidth in games with large battlefields. If that version is too old,
+// the delta holds the full view instead. A view's version is the number of times the game has changed,
+// so when it hasn't changed since, no view is built and the delta holds just the clocks.
+func (e *MageEngine) GetGameViewDelta(gameID, playerID string, sinceVersion int) (*EngineGameViewDelta, error) {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+
+	delta := &EngineGameViewDelta{
+		GameID:           gameID,
+		SinceVersion:     sinceVersion,
+		Version:          gameState.viewVersion(),
+		PriorityTimeLeft: gameState.priorityTimeLeft(),
+	}
+	if gameState.matchClock != nil {
+		delta.TimeBanks = make(map[string]time.Duration, len(gameState.playerOrder))
+		for _, pid := range gameState.playerOrder {
+			delta.TimeBanks[pid] = gameState.timeBankLeft(pid)
+		}
+	}
+
+	base := gameState.viewHistory.find(playerID, sinceVersion)
+	if base != nil && sinceVersion == delta.Version {
+		return delta, nil
+	}
+	view := e.buildGameView(gameState, playerID)
+	view.Version = gameState.recordView(playerID, view)
+	if base == nil {
+		delta.Full = view
+		return delta, nil
+	}
+	delta.Fields = diffViewFields(base, view)
+	delta.Battlefield = diffZone(base.Battlefield, view.Battlefield)
+	delta.Exile = diffZone(base.Exile, view.Exile)
+	return delta, nil
+}
+
+// viewVersion returns the version of the views of a game as it is: the number of times it has been
+// changed (see gameLock); the caller holds the game's read lock, so it can't change meanwhile
+func (s *engineGameState) viewVersion() int {
+	return int(s.mu.generation.Load())
+}
+
+// recordView gives a view the game's version and keeps a copy of it for deltas, unless the player's
+// last view kept has that version already
+func (s *engineGameState) recordView(playerID string, view *EngineGameView) int {
+	version := s.viewVersion()
+	history := s.viewHistory
+	if history == nil {
+		return version
+	}
+	history.mu.Lock()
+	defer history.mu.Unlock()
+
+	views := history.views[playerID]
+	if len(views) > 0 && views[len(views)-1].Version == version {
+		return version
+	}
+	kept := view.clone()
+	kept.Version = version
+	views = append(views, kept)
+	if len(views) > maxViewHistory {
+		views = views[len(views)-maxViewHistory:]
+	}
+	history.views[playerID] = views
+	return version
+}
+
+// find returns the view of a version sent to a player (nil = not kept)
+func (h *viewHistory) find(playerID string, version int) *EngineGameView {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, view := range h.views[playerID] {
+		if view.Version == version {
+			return view
+		}
+	}
+	return nil
+}
+
+// withoutTimeBanks returns a copy of player views with the time left on their match clocks cleared
+func withoutTimeBanks(players []EnginePlayerView) []EnginePlayerView {
+	stripped := make([]EnginePlayerView, len(players))
+	for i, player := range players {
+		player.TimeBank = 0
+		stripped[i] = player
+	}
+	return stripped
+}
+
+// diffViewFields returns the fields of a view other than the diffed zones and the clocks that changed,
+// by name
+func diffViewFields(old, current *EngineGameView) map[string]interface{} {
+	fields := make(map[string]interface{})
+	diff := func(name string, before, after interface{}) {
+		if !reflect.DeepEqual(before, after) {
+			fields[name] = after
+		}
+	}
+	diff("State", old.State, current.State)
+	diff("Phase", old.Phase, current.Phase)
+	diff("Step", old.Step, current.Step)
+	diff("Turn", old.Turn, current.Turn)
+	diff("ActivePlayerID", old.ActivePlayerID, current.ActivePlayerID)
+	diff("PriorityPlayer", old.PriorityPlayer, current.PriorityPlayer)
+	diff("Players", withoutTimeBanks(old.Players), withoutTimeBanks(current.Players))
+	diff("Stack", old.Stack, current.Stack)
+	diff("Command", old.Command, current.Command)
+	diff("Revealed", old.Revealed, current.Revealed)
+	diff("LookedAt", old.LookedAt, current.LookedAt)
+	diff("Combat", old.Combat, current.Combat)
+	diff("Monarch", old.Monarch, current.Monarch)
+	diff("Messages", old.Messages, current.Messages)
+	diff("Prompts", old.Prompts, current.Prompts)
+	diff("Decisions", old.Decisions, current.Decisions)
+	return fields
+}
+
+// diffZone returns the cards of a zone that changed, entered or left
+func diffZone(old, current []EngineCardView) EngineZoneDelta {
+	before := make(map[string]EngineCardView, len(old))
+	for _, card := range old {
+		before[card.ID] = card
+	}
+	var delta EngineZoneDelta
+	present := make(map[string]bool, len(current))
+	for _, card := range current {
+		present[card.ID] = true
+		if previous, existed := before[card.ID]; !existed || !reflect.DeepEqual(previous, card) {
+			delta.Changed = append(delta.Changed, card)
+		}
+	}
+	for _, card := range old {
+		if !present[card.ID] {
+			delta.Removed = append(delta.Removed, card.ID)
+		}
+	}
+	return delta
+}
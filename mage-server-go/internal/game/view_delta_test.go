@@ -0,0 +1,117 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// TestGetGameViewDelta_ReturnsOnlyChanges verifies that the view version only changes with the game,
+// that a delta holds just the changed cards and fields, and that an unknown version gets the full view
+func TestGetGameViewDelta_ReturnsOnlyChanges(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-view-delta", []string{"Alice", "Bob"})
+	h.CreateAttacker("bears", "Grizzly Bears", "Alice", "2", "2")
+	h.CreateBlocker("wall", "Wall of Wood", "Bob", "0", "3")
+	h.CreateBlocker("elves", "Llanowar Elves", "Bob", "1", "1")
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.battlefield = append(gameState.battlefield, gameState.cards["bears"], gameState.cards["wall"], gameState.cards["elves"])
+	gameState.mu.Unlock()
+
+	version := func() int {
+		view, err := h.engine.GetGameView(h.gameID, "Alice")
+		if err != nil {
+			t.Fatalf("failed to get view: %v", err)
+		}
+		return view.(*EngineGameView).Version
+	}
+	base := version()
+	if again := version(); again != base {
+		t.Fatalf("expected the version to stay %d while nothing changes, got %d", base, again)
+	}
+
+	gameState.mu.Lock()
+	gameState.cards["bears"].Tapped = true
+	gameState.battlefield = gameState.battlefield[:2]
+	gameState.players["Bob"].Life = 15
+	gameState.mu.Unlock()
+
+	delta, err := h.engine.GetGameViewDelta(h.gameID, "Alice", base)
+	if err != nil {
+		t.Fatalf("failed to get delta: %v", err)
+	}
+	if delta.Full != nil || delta.Version <= base {
+		t.Fatalf("expected a delta to a newer version, got full view %v at version %d", delta.Full != nil, delta.Version)
+	}
+	if len(delta.Battlefield.Changed) != 1 || delta.Battlefield.Changed[0].ID != "bears" || !delta.Battlefield.Changed[0].Tapped {
+		t.Errorf("expected only the tapped Grizzly Bears to have changed, got %+v", delta.Battlefield.Changed)
+	}
+	if len(delta.Battlefield.Removed) != 1 || delta.Battlefield.Removed[0] != "elves" {
+		t.Errorf("expected Llanowar Elves to have left, got %v", delta.Battlefield.Removed)
+	}
+	if _, changed := delta.Fields["Players"]; !changed {
+		t.Error("expected the players to have changed")
+	}
+	if _, changed := delta.Fields["Phase"]; changed {
+		t.Error("expected the phase not to be sent")
+	}
+	if version() != delta.Version {
+		t.Error("expected the full view to have the delta's version")
+	}
+
+	stale, err := h.engine.GetGameViewDelta(h.gameID, "Alice", -1)
+	if err != nil || stale.Full == nil || stale.Full.Version != delta.Version {
+		t.Errorf("expected the full view for an unknown version, got %+v (%v)", stale, err)
+	}
+}
+
+// TestGetGameViewDelta_ClocksSentSeparately verifies that running clocks don't change the view's version
+// or show up as changed players, and that each delta carries the clocks
+func TestGetGameViewDelta_ClocksSentSeparately(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-view-delta-clocks", []string{"Alice", "Bob"})
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	for gameState.turnManager.CurrentStep() != rules.StepMain1 {
+		gameState.turnManager.AdvanceStep("Alice")
+	}
+	gameState.turnManager.SetPriority("Alice")
+	gameState.mu.Unlock()
+	if err := h.engine.StartMatchClock(h.gameID, time.Hour); err != nil {
+		t.Fatalf("failed to start match clock: %v", err)
+	}
+
+	view, err := h.engine.GetGameView(h.gameID, "Alice")
+	if err != nil {
+		t.Fatalf("failed to get view: %v", err)
+	}
+	base := view.(*EngineGameView).Version
+	time.Sleep(5 * time.Millisecond)
+
+	delta, err := h.engine.GetGameViewDelta(h.gameID, "Alice", base)
+	if err != nil {
+		t.Fatalf("failed to get delta: %v", err)
+	}
+	if delta.Version != base || delta.Full != nil || len(delta.Fields) != 0 || len(delta.Battlefield.Changed) != 0 {
+		t.Errorf("expected an empty delta while only the clock runs, got %+v", delta)
+	}
+	if left := delta.TimeBanks["Alice"]; left <= 0 || left >= time.Hour {
+		t.Errorf("expected the delta to carry Alice's running time bank, got %v", left)
+	}
+
+	gameState.mu.Lock()
+	gameState.players["Bob"].Life = 15
+	gameState.mu.Unlock()
+	time.Sleep(5 * time.Millisecond)
+	delta, err = h.engine.GetGameViewDelta(h.gameID, "Alice", base)
+	if err != nil {
+		t.Fatalf("failed to get delta: %v", err)
+	}
+	if delta.Version <= base {
+		t.Errorf("expected a change to the game to give a new version, got %d", delta.Version)
+	}
+	if _, changed := delta.Fields["Players"]; !changed {
+		t.Error("expected Bob's life to be sent")
+	}
+}
@@ -0,0 +1,102 @@
+package watchers
+
+import (
+	"sync"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// SpellCastWatcher sees every player's spell casts, not just its controller's, counting creature and
+// noncreature spells per player. Each cast is handed to a trigger handler so "whenever a player casts
+// a spell" abilities (prowess, "whenever an opponent casts a spell") of all permanents can trigger.
+// The event's "creature" metadata tells creature spells ("true") from noncreature spells.
+type SpellCastWatcher struct {
+	*rules.BaseWatcher
+	mu                sync.RWMutex
+	creatureSpells    map[string]int // playerID -> creature spells cast
+	noncreatureSpells map[string]int // playerID -> noncreature spells cast
+	onCast            func(rules.Event)
+}
+
+// NewSpellCastWatcher creates a new board-wide spell cast watcher; onCast may be nil.
+func NewSpellCastWatcher(onCast func(rules.Event)) *SpellCastWatcher {
+	w := &SpellCastWatcher{
+		BaseWatcher:       rules.NewBaseWatcher(rules.WatcherScopeGame),
+		creatureSpells:    make(map[string]int),
+		noncreatureSpells: make(map[string]int),
+		onCast:            onCast,
+	}
+	w.SetKey("SpellCastWatcher")
+	return w
+}
+
+// IsCreatureSpell reports whether a spell cast event is for a creature spell.
+func IsCreatureSpell(event rules.Event) bool {
+	return event.Metadata["creature"] == "true"
+}
+
+// Watch implements the Watcher interface.
+func (w *SpellCastWatcher) Watch(event rules.Event) {
+	if event.Type != rules.EventSpellCast {
+		return
+	}
+	playerID := event.PlayerID
+	if playerID == "" {
+		playerID = event.Controller
+	}
+	if playerID == "" {
+		return
+	}
+	w.mu.Lock()
+	if IsCreatureSpell(event) {
+		w.creatureSpells[playerID]++
+	} else {
+		w.noncreatureSpells[playerID]++
+	}
+	w.mu.Unlock()
+	w.SetCondition(true)
+
+	if w.onCast != nil {
+		w.onCast(event)
+	}
+}
+
+// Reset clears the watcher's state.
+func (w *SpellCastWatcher) Reset() {
+	w.BaseWatcher.Reset()
+	w.mu.Lock()
+	w.creatureSpells = make(map[string]int)
+	w.noncreatureSpells = make(map[string]int)
+	w.mu.Unlock()
+}
+
+// GetCreatureCount returns the number of creature spells cast by a player.
+func (w *SpellCastWatcher) GetCreatureCount(playerID string) int {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.creatureSpells[playerID]
+}
+
+// GetNoncreatureCount returns the number of noncreature spells cast by a player.
+func (w *SpellCastWatcher) GetNoncreatureCount(playerID string) int {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.noncreatureSpells[playerID]
+}
+
+// Copy creates a copy of this watcher; the copy shares the trigger handler.
+func (w *SpellCastWatcher) Copy() rules.Watcher {
+	copy := NewSpellCastWatcher(w.onCast)
+	copy.SetControllerID(w.GetControllerID())
+	copy.SetSourceID(w.GetSourceID())
+	copy.SetCondition(w.ConditionMet())
+	w.mu.RLock()
+	for k, v := range w.creatureSpells {
+		copy.creatureSpells[k] = v
+	}
+	for k, v := range w.noncreatureSpells {
+		copy.noncreatureSpells[k] = v
+	}
+	w.mu.RUnlock()
+	return copy
+}
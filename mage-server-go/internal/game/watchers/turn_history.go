@@ -0,0 +1,135 @@
+package watchers
+
+import (
+	"sync"
+
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+// DiedThisTurnWatcher tracks the permanents that died (went to a graveyard from the battlefield), in
+// the order they died. It is reset at the beginning of each turn.
+type DiedThisTurnWatcher struct {
+	*rules.BaseWatcher
+	mu   sync.RWMutex
+	died []string // permanent IDs
+}
+
+// NewDiedThisTurnWatcher creates a new died this turn watcher.
+func NewDiedThisTurnWatcher() *DiedThisTurnWatcher {
+	w := &DiedThisTurnWatcher{
+		BaseWatcher: rules.NewBaseWatcher(rules.WatcherScopeGame),
+		died:        make([]string, 0),
+	}
+	w.SetKey("DiedThisTurnWatcher")
+	return w
+}
+
+// Watch implements the Watcher interface.
+func (w *DiedThisTurnWatcher) Watch(event rules.Event) {
+	if event.Type != rules.EventPermanentDies || event.TargetID == "" {
+		return
+	}
+	w.mu.Lock()
+	w.died = append(w.died, event.TargetID)
+	w.mu.Unlock()
+	w.SetCondition(true)
+}
+
+// Reset clears the watcher's state.
+func (w *DiedThisTurnWatcher) Reset() {
+	w.BaseWatcher.Reset()
+	w.mu.Lock()
+	w.died = make([]string, 0)
+	w.mu.Unlock()
+}
+
+// GetDied returns the IDs of the permanents that died.
+func (w *DiedThisTurnWatcher) GetDied() []string {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return append([]string(nil), w.died...)
+}
+
+// Copy creates a copy of this watcher.
+func (w *DiedThisTurnWatcher) Copy() rules.Watcher {
+	copy := NewDiedThisTurnWatcher()
+	copy.SetControllerID(w.GetControllerID())
+	copy.SetSourceID(w.GetSourceID())
+	copy.SetCondition(w.ConditionMet())
+	w.mu.RLock()
+	copy.died = append(copy.died, w.died...)
+	w.mu.RUnlock()
+	return copy
+}
+
+// AttackedThisTurnWatcher tracks the creatures declared as attackers, in the order they were declared,
+// and the players who attacked with them. It is reset at the beginning of each turn.
+type AttackedThisTurnWatcher struct {
+	*rules.BaseWatcher
+	mu        sync.RWMutex
+	attackers []string            // creature IDs
+	byPlayer  map[string][]string // playerID -> creature IDs
+}
+
+// NewAttackedThisTurnWatcher creates a new attacked this turn watcher.
+func NewAttackedThisTurnWatcher() *AttackedThisTurnWatcher {
+	w := &AttackedThisTurnWatcher{
+		BaseWatcher: rules.NewBaseWatcher(rules.WatcherScopeGame),
+		attackers:   make([]string, 0),
+		byPlayer:    make(map[string][]string),
+	}
+	w.SetKey("AttackedThisTurnWatcher")
+	return w
+}
+
+// Watch implements the Watcher interface.
+func (w *AttackedThisTurnWatcher) Watch(event rules.Event) {
+	if event.Type != rules.EventAttackerDeclared || event.TargetID == "" {
+		return
+	}
+	w.mu.Lock()
+	w.attackers = append(w.attackers, event.TargetID)
+	if event.PlayerID != "" {
+		w.byPlayer[event.PlayerID] = append(w.byPlayer[event.PlayerID], event.TargetID)
+	}
+	w.mu.Unlock()
+	w.SetCondition(true)
+}
+
+// Reset clears the watcher's state.
+func (w *AttackedThisTurnWatcher) Reset() {
+	w.BaseWatcher.Reset()
+	w.mu.Lock()
+	w.attackers = make([]string, 0)
+	w.byPlayer = make(map[string][]string)
+	w.mu.Unlock()
+}
+
+// GetAttackers returns the IDs of the creatures that attacked.
+func (w *AttackedThisTurnWatcher) GetAttackers() []string {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return append([]string(nil), w.attackers...)
+}
+
+// GetAttackersOf returns the IDs of the creatures a player attacked with.
+func (w *AttackedThisTurnWatcher) GetAttackersOf(playerID string) []string {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return append([]string(nil), w.byPlayer[playerID]...)
+}
+
+// Copy creates a copy of this watcher.
+func (w *AttackedThisTurnWatcher) Copy() rules.Watcher {
+	copy := NewAttackedThisTurnWatcher()
+	copy.SetControllerID(w.GetControllerID())
+	copy.SetSourceID(w.GetSourceID())
+	copy.SetCondition(w.ConditionMet())
+	w.mu.RLock()
+	copy.attackers = append(copy.attackers, w.attackers...)
+	for k, v := range w.byPlayer {
+		copy.byPlayer[k] = append([]string(nil), v...)
+	}
+	w.mu.RUnlock()
+	return copy
+}
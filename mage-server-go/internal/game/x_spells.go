@@ -0,0 +1,77 @@
+package game
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CastSpellData is the data of a CAST_SPELL action: the name of a spell in the player's hand and the
+// player's choices for paying its mana cost
+type CastSpellData struct {
+	Name string
+	X    int // The value chosen for X
+	// PayPhyrexian says for each phyrexian symbol in the cost, in order, whether it is paid with
+	// 2 life instead of mana of its color (rule 107.4f)
+	PayPhyrexian []bool
+	// Targets are the spell's targets, in the order its rules text asks for them (rule 601.2c)
+	Targets []string
+	// CardID names the card to cast from FromZone instead of a card named Name in hand (e.g. with
+	// flashback from the graveyard)
+	CardID   string
+	FromZone int
+	// CostChoices are the objects chosen to pay each of the spell's additional costs, in order (e.g. the
+	// creature sacrificed); a cost with only as many candidates as it needs may be left out
+	CostChoices [][]string
+}
+
+// spellEffect is the effect of a spell, applied as it resolves; xValue is the value chosen for X when
+// the spell was cast (0 if none was chosen)
+type spellEffect func(gameState *engineGameState, spell *internalCard, xValue int) error
+
+// RegisterSpellEffect sets the effect a card has when it resolves as a spell
+// (e.g. "Fireball deals X damage to any target")
+func (e *MageEngine) RegisterSpellEffect(gameID, cardID string, effect spellEffect) error {
+	e.mu.RLock()
+	gameState, exists := e.games[gameID]
+	e.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("game %s not found", gameID)
+	}
+
+	gameState.mu.Lock()
+	defer gameState.mu.Unlock()
+
+	if gameState.spellEffects == nil {
+		gameState.spellEffects = make(map[string]spellEffect)
+	}
+	gameState.spellEffects[cardID] = effect
+	gameState.markUnreplayable("RegisterSpellEffect")
+	return nil
+}
+
+// damageTargetEffect returns the effect of a spell that deals damage to its target, to register with
+// RegisterSpellEffect (e.g. Lightning Bolt: "deals 3 damage to any target")
+func (e *MageEngine) damageTargetEffect(amount int) spellEffect {
+	return func(gameState *engineGameState, spell *internalCard, _ int) error {
+		if len(spell.Targets) == 0 {
+			return nil
+		}
+		return e.dealDamage(gameState, spell.ID, spell.Targets[0], amount, false)
+	}
+}
+
+// hasXCost reports whether a card's mana cost contains {X}
+func hasXCost(card *internalCard) bool {
+	return strings.Contains(strings.ToUpper(card.ManaCost), "{X}")
+}
+
+// xStat resolves a printed power or toughness of "X" to the value of X chosen when the card was cast
+// (rule 107.3m); other values are returned unchanged
+func xStat(card *internalCard, value string) string {
+	if strings.EqualFold(strings.TrimSpace(value), "X") {
+		return strconv.Itoa(card.XValue)
+	}
+	return value
+}
@@ -0,0 +1,104 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/game/mana"
+	"github.com/magefree/mage-server-go/internal/game/rules"
+)
+
+func castX(h *CombatTestHarness, playerID, name string, x int) error {
+	return h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: playerID, ActionType: "CAST_SPELL", Data: CastSpellData{Name: name, X: x}})
+}
+
+func resolveTopOfStack(t *testing.T, h *CombatTestHarness) {
+	t.Helper()
+	for _, playerID := range []string{"Alice", "Bob"} {
+		if err := h.engine.ProcessAction(h.gameID, PlayerAction{PlayerID: playerID, ActionType: "SEND_STRING", Data: "PASS"}); err != nil {
+			t.Fatalf("failed to pass priority: %v", err)
+		}
+	}
+}
+
+// TestXSpell_DamageUsesChosenX verifies that casting a spell with {X} in its cost pays generic mana for
+// X, records X on the stack item, and hands X to the spell's effect as it resolves; an X the player
+// can't pay for is rejected without spending mana
+func TestXSpell_DamageUsesChosenX(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-x-spell-damage", []string{"Alice", "Bob"})
+	castTestSetup(t, h, "Alice", "fireball", "Fireball", "Sorcery", rules.StepMain1)
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	gameState.cards["fireball"].ManaCost = "{X}{R}"
+	gameState.players["Alice"].ManaPool.Add(mana.ManaRed, 3)
+	bobLife := gameState.players["Bob"].Life
+	gameState.mu.Unlock()
+
+	if err := h.engine.RegisterSpellEffect(h.gameID, "fireball", func(gameState *engineGameState, spell *internalCard, xValue int) error {
+		return h.engine.dealDamage(gameState, spell.ID, "Bob", xValue, false)
+	}); err != nil {
+		t.Fatalf("failed to register the spell effect: %v", err)
+	}
+
+	if err := castX(h, "Alice", "Fireball", 4); err == nil {
+		t.Fatal("expected X=4 to be rejected with only 4 mana available")
+	}
+	gameState.mu.RLock()
+	if total := gameState.players["Alice"].ManaPool.GetTotalMana(); total != 4 {
+		t.Errorf("expected the rejected cast to leave 4 mana, got %d", total)
+	}
+	gameState.mu.RUnlock()
+
+	if err := castX(h, "Alice", "Fireball", 3); err != nil {
+		t.Fatalf("failed to cast Fireball with X=3: %v", err)
+	}
+	gameState.mu.RLock()
+	item, ok := gameState.stack.Peek()
+	if !ok || item.Metadata["x_value"] != "3" {
+		t.Errorf("expected the stack item to record X=3, got %+v", item)
+	}
+	if total := gameState.players["Alice"].ManaPool.GetTotalMana(); total != 0 {
+		t.Errorf("expected all 4 mana to be spent, got %d left", total)
+	}
+	gameState.mu.RUnlock()
+
+	resolveTopOfStack(t, h)
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if life := gameState.players["Bob"].Life; life != bobLife-3 {
+		t.Errorf("expected Fireball to deal 3 damage, Bob is at %d from %d", life, bobLife)
+	}
+	if card := gameState.cards["fireball"]; card.Zone != zoneGraveyard || card.XValue != 0 {
+		t.Errorf("expected Fireball in the graveyard with X cleared, got zone %v and X=%d", card.Zone, card.XValue)
+	}
+}
+
+// TestXSpell_CreatureEntersWithChosenX verifies that a creature with X in its power and toughness
+// enters the battlefield with the X chosen when it was cast
+func TestXSpell_CreatureEntersWithChosenX(t *testing.T) {
+	h := NewCombatTestHarness(t, "test-x-spell-creature", []string{"Alice", "Bob"})
+	castTestSetup(t, h, "Alice", "hydra", "Endless One", "Creature", rules.StepMain1)
+
+	gameState := h.GetGameState()
+	gameState.mu.Lock()
+	hydra := gameState.cards["hydra"]
+	hydra.ManaCost = "{X}{G}"
+	hydra.Power, hydra.Toughness = "X", "X"
+	gameState.players["Alice"].ManaPool.Add(mana.ManaGreen, 2)
+	gameState.mu.Unlock()
+
+	if err := castX(h, "Alice", "Endless One", 2); err != nil {
+		t.Fatalf("failed to cast the creature with X=2: %v", err)
+	}
+	resolveTopOfStack(t, h)
+
+	gameState.mu.RLock()
+	defer gameState.mu.RUnlock()
+	if hydra.Zone != zoneBattlefield {
+		t.Fatalf("expected the creature on the battlefield, got zone %v", hydra.Zone)
+	}
+	if hydra.Power != "2" || hydra.Toughness != "2" {
+		t.Errorf("expected the creature to be 2/2, got %s/%s", hydra.Power, hydra.Toughness)
+	}
+}
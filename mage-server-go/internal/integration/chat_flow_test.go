@@ -0,0 +1,155 @@
+package integration
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/chat"
+	"github.com/magefree/mage-server-go/internal/session"
+	"github.com/magefree/mage-server-go/internal/table"
+	pb "github.com/magefree/mage-server-go/pkg/proto/mage/v1"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestSpectatorChatSeparatedFromPlayers tests that during a live game spectator messages reach other
+// spectators but never seated players, and spectators can't talk in the players' table chat
+func TestSpectatorChatSeparatedFromPlayers(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	tableMgr := table.NewManager(logger)
+	chatMgr := chat.NewManager(logger)
+
+	tbl := tableMgr.CreateTable("Duel", "Two Player Duel", "Alice", "main", 2, "")
+	for _, player := range []string{"Alice", "Bob"} {
+		if err := tbl.AddPlayer(player, "Human"); err != nil {
+			t.Fatalf("failed to seat %s: %v", player, err)
+		}
+	}
+	tbl.AddSpectator("Carol")
+	tbl.AddSpectator("Dave")
+
+	delivered := make(map[string][]chat.Message) // username -> messages
+	chatMgr.SetDeliveryHandler(func(roomID, username string, msg chat.Message) {
+		delivered[username] = append(delivered[username], msg)
+	})
+
+	playerChat := "table:" + tbl.ID
+	spectatorChat := "spectators:" + tbl.ID
+	chatMgr.GetOrCreateRoom(playerChat).SetAccess(tbl.CanUsePlayerChat)
+	chatMgr.GetOrCreateRoom(spectatorChat).SetAccess(tbl.CanUseSpectatorChat)
+
+	// Alice joins the spectator chat before the game starts
+	for _, user := range []string{"Alice", "Carol", "Dave"} {
+		if err := chatMgr.JoinRoom(spectatorChat, user); err != nil {
+			t.Fatalf("failed to join spectator chat as %s: %v", user, err)
+		}
+	}
+
+	tbl.SetState(table.TableStateDueling)
+
+	if err := chatMgr.JoinRoom(spectatorChat, "Bob"); err == nil {
+		t.Error("expected a seated player to be unable to join the spectator chat during the game")
+	}
+	if err := chatMgr.JoinRoom(playerChat, "Carol"); err == nil {
+		t.Error("expected a spectator to be unable to join the table chat during the game")
+	}
+	if err := chatMgr.SendMessage(playerChat, "Carol", "attack with everything"); err == nil {
+		t.Error("expected a spectator to be unable to talk in the table chat during the game")
+	}
+	if err := chatMgr.SendMessage(spectatorChat, "Alice", "what should I do?"); err == nil {
+		t.Error("expected a seated player to be unable to talk in the spectator chat during the game")
+	}
+
+	if err := chatMgr.SendMessage(spectatorChat, "Carol", "Alice should attack"); err != nil {
+		t.Fatalf("failed to send spectator message: %v", err)
+	}
+
+	if len(delivered["Alice"]) != 0 {
+		t.Errorf("expected the spectator message not to reach Alice, got %+v", delivered["Alice"])
+	}
+	if len(delivered["Dave"]) != 1 || delivered["Dave"][0].Text != "Alice should attack" {
+		t.Errorf("expected the spectator message to reach Dave, got %+v", delivered["Dave"])
+	}
+}
+
+// TestGameChatClosedToSpectators tests that during a live game a spectator can't join or talk in the
+// players' game chat, is sent to the spectator chat instead, and that game chat messages reach the
+// players' sessions but not the spectator's
+func TestGameChatClosedToSpectators(t *testing.T) {
+	env := newGameServerEnv(t)
+	ctx := context.Background()
+
+	tbl := env.tableMgr.CreateTable("Chat Table", "Duel", "Alice", env.roomMgr.GetMainRoomID(), 2, "")
+	for _, player := range []string{"Alice", "Bob"} {
+		if err := tbl.AddPlayer(player, "Human"); err != nil {
+			t.Fatalf("failed to seat %s: %v", player, err)
+		}
+	}
+	tbl.AddSpectator("Carol")
+
+	sessions := make(map[string]*session.Session)
+	for _, user := range []string{"Alice", "Bob", "Carol"} {
+		sessions[user] = env.sessionMgr.CreateSession(strings.ToLower(user)+"-session", "localhost")
+		sessions[user].SetUserID(user)
+	}
+
+	startResp, err := env.server.MatchStart(ctx, &pb.MatchStartRequest{SessionId: sessions["Alice"].ID, TableId: tbl.ID})
+	if err != nil || !startResp.GetSuccess() {
+		t.Fatalf("match start failed: %v, %s", err, startResp.GetError())
+	}
+	gameInstance, ok := env.gameMgr.GetGameByTable(tbl.ID)
+	if !ok {
+		t.Fatal("game not created after match start")
+	}
+
+	found, err := env.server.ChatFindByGame(ctx, &pb.ChatFindByGameRequest{SessionId: sessions["Carol"].ID, GameId: gameInstance.ID})
+	if err != nil {
+		t.Fatalf("failed to find game chat: %v", err)
+	}
+	if found.GetChatId() != "spectators:"+tbl.ID {
+		t.Errorf("expected the spectator to be sent to the spectator chat, got %s", found.GetChatId())
+	}
+	found, err = env.server.ChatFindByGame(ctx, &pb.ChatFindByGameRequest{SessionId: sessions["Alice"].ID, GameId: gameInstance.ID})
+	if err != nil {
+		t.Fatalf("failed to find game chat: %v", err)
+	}
+	gameChat := found.GetChatId()
+
+	for _, player := range []string{"Alice", "Bob"} {
+		resp, err := env.server.ChatJoin(ctx, &pb.ChatJoinRequest{SessionId: sessions[player].ID, ChatId: gameChat})
+		if err != nil || !resp.GetSuccess() {
+			t.Fatalf("%s failed to join the game chat: %v, %s", player, err, resp.GetError())
+		}
+	}
+	if resp, err := env.server.ChatJoin(ctx, &pb.ChatJoinRequest{SessionId: sessions["Carol"].ID, ChatId: gameChat}); err != nil || resp.GetSuccess() {
+		t.Errorf("expected the spectator to be unable to join the game chat, got %v, %v", resp, err)
+	}
+	if resp, err := env.server.ChatSendMessage(ctx, &pb.ChatSendMessageRequest{SessionId: sessions["Carol"].ID, ChatId: gameChat, Message: "attack now"}); err != nil || resp.GetSuccess() {
+		t.Errorf("expected the spectator to be unable to talk in the game chat, got %v, %v", resp, err)
+	}
+
+	if resp, err := env.server.ChatSendMessage(ctx, &pb.ChatSendMessageRequest{SessionId: sessions["Alice"].ID, ChatId: gameChat, Message: "good luck"}); err != nil || !resp.GetSuccess() {
+		t.Fatalf("failed to send game chat message: %v, %v", resp, err)
+	}
+	if got := chatCallbacks(sessions["Bob"]); len(got) != 1 || got[0]["message"] != "good luck" || got[0]["chat_id"] != gameChat {
+		t.Errorf("expected Bob's session to be sent the message, got %v", got)
+	}
+	if got := chatCallbacks(sessions["Carol"]); len(got) != 0 {
+		t.Errorf("expected the spectator's session not to be sent the message, got %v", got)
+	}
+}
+
+// chatCallbacks drains a session's callbacks and returns the chat messages among them
+func chatCallbacks(sess *session.Session) []map[string]interface{} {
+	messages := make([]map[string]interface{}, 0)
+	for {
+		select {
+		case event := <-sess.CallbackChan:
+			if callback, ok := event.(map[string]interface{}); ok && callback["type"] == "chat_message" {
+				messages = append(messages, callback)
+			}
+		default:
+			return messages
+		}
+	}
+}
@@ -26,6 +26,7 @@ type gameServerEnv struct {
 	server     pb.MageServerServer
 	sessionMgr session.Manager
 	roomMgr    *room.Manager
+	chatMgr    *chat.Manager
 	tableMgr   *table.Manager
 	gameMgr    *game.Manager
 	adapter    *game.EngineAdapter
@@ -71,6 +72,7 @@ func newGameServerEnv(t testing.TB) *gameServerEnv {
 		server:     srv,
 		sessionMgr: sessionMgr,
 		roomMgr:    roomMgr,
+		chatMgr:    chatMgr,
 		tableMgr:   tableMgr,
 		gameMgr:    gameMgr,
 		adapter:    adapter,
@@ -103,11 +105,11 @@ func TestGameEngineActionQueue(t *testing.T) {
 		close(done)
 	}()
 
-	if err := gameMgr.SendPlayerAction(g.ID, "Alice", "PLAYER_ACTION", "PASS"); err != nil {
+	if err := gameMgr.SendPlayerAction(g.ID, "Alice", "PLAYER_ACTION", "", "PASS"); err != nil {
 		t.Fatalf("failed to enqueue player action: %v", err)
 	}
 
-	if err := gameMgr.SendPlayerAction(g.ID, "Bob", "SEND_INTEGER", 3); err != nil {
+	if err := gameMgr.SendPlayerAction(g.ID, "Bob", "SEND_INTEGER", "", 3); err != nil {
 		t.Fatalf("failed to enqueue second player action: %v", err)
 	}
 
@@ -128,22 +130,22 @@ func TestGameEngineActionQueue(t *testing.T) {
 		t.Fatal("expected engine messages to be recorded")
 	}
 
-	var passRecorded, lifeChangeRecorded bool
+	var passRecorded, integerRecorded bool
 	for _, msg := range view.Messages {
 		textUpper := strings.ToUpper(msg.Text)
 		if strings.Contains(textUpper, "ALICE") && strings.Contains(textUpper, "PASS") {
 			passRecorded = true
 		}
-		if strings.Contains(textUpper, "BOB") && strings.Contains(textUpper, "LIFE") {
-			lifeChangeRecorded = true
+		if strings.Contains(textUpper, "BOB") && strings.Contains(textUpper, "CHOOSES 3") {
+			integerRecorded = true
 		}
 	}
 
 	if !passRecorded {
 		t.Errorf("expected pass action from Alice to be recorded in messages: %+v", view.Messages)
 	}
-	if !lifeChangeRecorded {
-		t.Errorf("expected life change from Bob to be recorded in messages: %+v", view.Messages)
+	if !integerRecorded {
+		t.Errorf("expected integer response from Bob to be recorded in messages: %+v", view.Messages)
 	}
 
 	if len(view.Prompts) == 0 {
@@ -247,12 +249,12 @@ func TestGameActionQueueOverflow(t *testing.T) {
 	g := gameMgr.CreateGame("table-1", "Duel", players)
 
 	for i := 0; i < cap(g.ActionQueue); i++ {
-		if err := gameMgr.SendPlayerAction(g.ID, "Alice", "PING", i); err != nil {
+		if err := gameMgr.SendPlayerAction(g.ID, "Alice", "PING", "", i); err != nil {
 			t.Fatalf("unexpected error enqueuing action %d: %v", i, err)
 		}
 	}
 
-	if err := gameMgr.SendPlayerAction(g.ID, "Alice", "PING", "overflow"); err == nil {
+	if err := gameMgr.SendPlayerAction(g.ID, "Alice", "PING", "", "overflow"); err == nil {
 		t.Fatalf("expected queue overflow error")
 	}
 }
@@ -322,6 +324,7 @@ func TestMatchFlowEndToEnd(t *testing.T) {
 
 	// Test basic player actions through gRPC
 	// Alice casts Lightning Bolt (a card that exists in the starting hand)
+	addRedMana(t, env.engine, gameInstance.ID, "Alice")
 	if _, err := env.server.SendPlayerString(ctx, &pb.SendPlayerStringRequest{
 		SessionId: aliceSession.ID,
 		GameId:    gameInstance.ID,
@@ -485,6 +488,72 @@ func TestTurnProgressionAfterPassChain(t *testing.T) {
 	}
 }
 
+// TestDuplicateActionNonceIgnored tests that an action sent twice through gRPC with the same nonce
+// (e.g. a double click) is only processed once, while a new nonce is processed
+func TestDuplicateActionNonceIgnored(t *testing.T) {
+	env := newGameServerEnv(t)
+	ctx := context.Background()
+
+	tbl := env.tableMgr.CreateTable("Nonce Table", "Duel", "Alice", env.roomMgr.GetMainRoomID(), 2, "")
+	for _, player := range []string{"Alice", "Bob"} {
+		if err := tbl.AddPlayer(player, "Human"); err != nil {
+			t.Fatalf("failed adding %s to table: %v", player, err)
+		}
+	}
+
+	aliceSession := env.sessionMgr.CreateSession("alice-nonce-session", "localhost")
+	aliceSession.SetUserID("Alice")
+
+	startResp, err := env.server.MatchStart(ctx, &pb.MatchStartRequest{
+		SessionId: aliceSession.ID,
+		TableId:   tbl.ID,
+	})
+	if err != nil || !startResp.GetSuccess() {
+		t.Fatalf("match start failed: %v, success=%v", err, startResp.GetSuccess())
+	}
+	gameInstance, ok := env.gameMgr.GetGameByTable(tbl.ID)
+	if !ok {
+		t.Fatal("game not created after match start")
+	}
+
+	// Enough mana for two Lightning Bolts, so only the nonce keeps the second request from casting
+	addRedMana(t, env.engine, gameInstance.ID, "Alice")
+	addRedMana(t, env.engine, gameInstance.ID, "Alice")
+
+	cast := func(nonce string) {
+		t.Helper()
+		resp, err := env.server.SendPlayerString(ctx, &pb.SendPlayerStringRequest{
+			SessionId: aliceSession.ID,
+			GameId:    gameInstance.ID,
+			Data:      "Lightning Bolt",
+			Nonce:     nonce,
+		})
+		if err != nil || !resp.GetSuccess() {
+			t.Fatalf("cast request failed: %v, error=%q", err, resp.GetError())
+		}
+		time.Sleep(25 * time.Millisecond)
+	}
+	stackSize := func() int {
+		t.Helper()
+		viewRaw, err := env.adapter.GetGameView(gameInstance.ID, "Alice")
+		if err != nil {
+			t.Fatalf("engine view retrieval failed: %v", err)
+		}
+		return len(viewRaw.(*game.EngineGameView).Stack)
+	}
+
+	cast("alice-bolt-1")
+	cast("alice-bolt-1")
+	if got := stackSize(); got != 1 {
+		t.Fatalf("expected the duplicate cast to be ignored, stack has %d items", got)
+	}
+
+	cast("alice-bolt-2")
+	if got := stackSize(); got != 2 {
+		t.Fatalf("expected a cast with a new nonce to be processed, stack has %d items", got)
+	}
+}
+
 // TODO: Re-enable when instant spells correctly resolve to graveyard instead of battlefield
 // This test expects Lightning Bolt (instant) to be on battlefield after resolution, but instants go to graveyard
 func testStackResolutionAfterPasses(t *testing.T) {
@@ -524,6 +593,7 @@ func testStackResolutionAfterPasses(t *testing.T) {
 		t.Fatal("game not created for stack progression test")
 	}
 
+	addRedMana(t, env.engine, gameInstance.ID, "Alice")
 	if _, err := env.server.SendPlayerString(ctx, &pb.SendPlayerStringRequest{
 		SessionId: aliceSession.ID,
 		GameId:    gameInstance.ID,
@@ -614,3 +684,168 @@ func testStackResolutionAfterPasses(t *testing.T) {
 		t.Fatalf("stack test match quit failed: %v", err)
 	}
 }
+
+// TestTableSettingsEnforcedInGame tests that a table's settings are validated at creation and take
+// effect in its game: rollback and spectators can be disabled per table
+func TestTableSettingsEnforcedInGame(t *testing.T) {
+	env := newGameServerEnv(t)
+	ctx := context.Background()
+
+	invalid := table.DefaultTableSettings()
+	invalid.MulliganRule = "PARIS"
+	if _, err := env.tableMgr.CreateTableWithSettings("Bad Table", "Duel", "Alice", env.roomMgr.GetMainRoomID(), 2, "", invalid); err == nil {
+		t.Fatal("expected an unknown mulligan rule to be rejected at table creation")
+	}
+
+	settings := table.DefaultTableSettings()
+	settings.StartingLife = 30
+	settings.RollbackAllowed = false
+	settings.SpectatorsAllowed = false
+	tbl, err := env.tableMgr.CreateTableWithSettings("Locked Table", "Duel", "Alice", env.roomMgr.GetMainRoomID(), 2, "", settings)
+	if err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+	for _, player := range []string{"Alice", "Bob"} {
+		if err := tbl.AddPlayer(player, "Human"); err != nil {
+			t.Fatalf("failed adding %s to table: %v", player, err)
+		}
+	}
+
+	aliceSession := env.sessionMgr.CreateSession("alice-session", "localhost")
+	aliceSession.SetUserID("Alice")
+	watcherSession := env.sessionMgr.CreateSession("spectator-session", "localhost")
+	watcherSession.SetUserID("Spectator")
+
+	if resp, err := env.server.RoomWatchTable(ctx, &pb.RoomWatchTableRequest{
+		SessionId: watcherSession.ID,
+		TableId:   tbl.ID,
+	}); err != nil || resp.GetSuccess() {
+		t.Errorf("expected watching the table to be rejected, got success=%v (%v)", resp.GetSuccess(), err)
+	}
+
+	startResp, err := env.server.MatchStart(ctx, &pb.MatchStartRequest{
+		SessionId: aliceSession.ID,
+		TableId:   tbl.ID,
+	})
+	if err != nil || !startResp.GetSuccess() {
+		t.Fatalf("match start failed: %v, success=%v", err, startResp.GetSuccess())
+	}
+	gameInstance, ok := env.gameMgr.GetGameByTable(tbl.ID)
+	if !ok {
+		t.Fatal("game not created after match start")
+	}
+
+	if resp, err := env.server.GameWatchStart(ctx, &pb.GameWatchStartRequest{
+		SessionId: watcherSession.ID,
+		GameId:    gameInstance.ID,
+	}); err != nil || resp.GetSuccess() {
+		t.Errorf("expected watching the game to be rejected, got success=%v (%v)", resp.GetSuccess(), err)
+	}
+	if watchers := gameInstance.GetWatchers(); len(watchers) != 0 {
+		t.Errorf("expected no watchers, got %v", watchers)
+	}
+
+	if err := env.engine.RollbackTurns(gameInstance.ID, 1); err == nil || !strings.Contains(err.Error(), "disabled") {
+		t.Errorf("expected turn rollback to be disabled for this game, got %v", err)
+	}
+
+	viewRaw, err := env.adapter.GetGameView(gameInstance.ID, "Alice")
+	if err != nil {
+		t.Fatalf("engine view retrieval failed: %v", err)
+	}
+	for _, player := range viewRaw.(*game.EngineGameView).Players {
+		if player.Life != 30 {
+			t.Errorf("expected %s to start at the table's 30 life, got %d", player.Name, player.Life)
+		}
+	}
+}
+
+// TestReconnectTokenResumesLapsedSession tests that a player can rejoin a game with the game's
+// reconnection token after their session expired, that a token can't be used twice and that tokens
+// expire with the game
+func TestReconnectTokenResumesLapsedSession(t *testing.T) {
+	env := newGameServerEnv(t)
+	ctx := context.Background()
+
+	tbl := env.tableMgr.CreateTable("Reconnect Table", "Duel", "Alice", env.roomMgr.GetMainRoomID(), 2, "")
+	for _, player := range []string{"Alice", "Bob"} {
+		if err := tbl.AddPlayer(player, "Human"); err != nil {
+			t.Fatalf("failed adding %s to table: %v", player, err)
+		}
+	}
+
+	aliceSession := env.sessionMgr.CreateSession("alice-session", "localhost")
+	aliceSession.SetUserID("Alice")
+
+	startResp, err := env.server.MatchStart(ctx, &pb.MatchStartRequest{
+		SessionId: aliceSession.ID,
+		TableId:   tbl.ID,
+	})
+	if err != nil || !startResp.GetSuccess() {
+		t.Fatalf("match start failed: %v, success=%v", err, startResp.GetSuccess())
+	}
+	gameInstance, ok := env.gameMgr.GetGameByTable(tbl.ID)
+	if !ok {
+		t.Fatal("game not created after match start")
+	}
+
+	var token string
+	select {
+	case event := <-aliceSession.CallbackChan:
+		payload, _ := event.(map[string]interface{})
+		if payload["type"] != "game_reconnect_token" || payload["game_id"] != gameInstance.ID {
+			t.Fatalf("unexpected callback: %+v", event)
+		}
+		token, _ = payload["reconnect_token"].(string)
+	default:
+		t.Fatal("expected Alice to be sent a reconnection token at game start")
+	}
+
+	// Alice's client crashes and their session expires
+	env.sessionMgr.RemoveSession(aliceSession.ID)
+
+	resumeResp, err := env.server.ResumeSession(ctx, &pb.ResumeSessionRequest{ReconnectToken: token})
+	if err != nil || !resumeResp.GetSuccess() {
+		t.Fatalf("failed to resume with the reconnection token: %v, error=%q", err, resumeResp.GetError())
+	}
+	if resumeResp.GetGameId() != gameInstance.ID {
+		t.Errorf("expected to resume game %s, got %s", gameInstance.ID, resumeResp.GetGameId())
+	}
+	resumed, ok := env.sessionMgr.GetSession(resumeResp.GetSessionId())
+	if !ok {
+		t.Fatal("resumed session not found")
+	}
+	if resumed.GetUserID() != "Alice" {
+		t.Errorf("expected the resumed session to belong to Alice, got %q", resumed.GetUserID())
+	}
+
+	// The token was replaced, so replaying it doesn't open another session for Alice
+	newToken := resumeResp.GetReconnectToken()
+	if newToken == "" || newToken == token {
+		t.Fatalf("expected a new reconnection token, got %q", newToken)
+	}
+	if resp, _ := env.server.ResumeSession(ctx, &pb.ResumeSessionRequest{ReconnectToken: token}); resp.GetSuccess() {
+		t.Error("expected a used reconnection token to be rejected")
+	}
+	if current, _ := gameInstance.ReconnectToken("Alice"); current != newToken {
+		t.Errorf("expected the game to hold Alice's new token, got %q", current)
+	}
+
+	if resp, err := env.server.GameJoin(ctx, &pb.GameJoinRequest{
+		SessionId: resumed.ID,
+		GameId:    gameInstance.ID,
+	}); err != nil || !resp.GetSuccess() {
+		t.Fatalf("failed to rejoin the game: %v, success=%v", err, resp.GetSuccess())
+	}
+
+	if _, err := env.server.MatchQuit(ctx, &pb.MatchQuitRequest{
+		SessionId: resumed.ID,
+		GameId:    gameInstance.ID,
+	}); err != nil {
+		t.Fatalf("match quit failed: %v", err)
+	}
+
+	if resp, _ := env.server.ResumeSession(ctx, &pb.ResumeSessionRequest{ReconnectToken: newToken}); resp.GetSuccess() {
+		t.Error("expected the reconnection token to expire when the game ended")
+	}
+}
@@ -6,9 +6,18 @@ import (
 	"time"
 
 	"github.com/magefree/mage-server-go/internal/game"
+	"github.com/magefree/mage-server-go/internal/game/mana"
 	"go.uber.org/zap"
 )
 
+// addRedMana gives a player the {R} that a starter deck spell costs
+func addRedMana(t *testing.T, engine *game.MageEngine, gameID, playerID string) {
+	t.Helper()
+	if err := engine.AddMana(gameID, playerID, mana.ManaRed, 1); err != nil {
+		t.Fatalf("failed to add mana: %v", err)
+	}
+}
+
 // resolveStackUntilEmpty resolves all items on the stack by having all players pass repeatedly
 func resolveStackUntilEmpty(t *testing.T, engine *game.MageEngine, gameID string, players []string, maxCycles int) {
 	t.Helper()
@@ -52,6 +61,7 @@ func TestMultiObjectStackResolution(t *testing.T) {
 	}
 
 	// Alice casts spell 1
+	addRedMana(t, engine, gameID, "Alice")
 	err = engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -74,6 +84,7 @@ func TestMultiObjectStackResolution(t *testing.T) {
 	}
 
 	// Bob casts spell 2 (goes on top)
+	addRedMana(t, engine, gameID, "Bob")
 	err = engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Bob",
 		ActionType: "SEND_STRING",
@@ -96,6 +107,7 @@ func TestMultiObjectStackResolution(t *testing.T) {
 	}
 
 	// Alice casts spell 3 (goes on top)
+	addRedMana(t, engine, gameID, "Alice")
 	err = engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -185,6 +197,7 @@ func TestCounterspell(t *testing.T) {
 	}
 
 	// Alice casts Lightning Bolt
+	addRedMana(t, engine, gameID, "Alice")
 	err = engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -287,6 +300,7 @@ func testNestedResponses(t *testing.T) {
 	}
 
 	// Alice casts Lightning Bolt
+	addRedMana(t, engine, gameID, "Alice")
 	err = engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -309,6 +323,7 @@ func testNestedResponses(t *testing.T) {
 	}
 
 	// Bob casts Counterspell
+	addRedMana(t, engine, gameID, "Bob")
 	err = engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Bob",
 		ActionType: "SEND_STRING",
@@ -410,6 +425,7 @@ func TestPriorityLoops(t *testing.T) {
 	}
 
 	// Alice casts spell
+	addRedMana(t, engine, gameID, "Alice")
 	err = engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -603,6 +619,7 @@ func TestPriorityAfterResolution(t *testing.T) {
 	}
 
 	// Alice casts spell
+	addRedMana(t, engine, gameID, "Alice")
 	err = engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -675,6 +692,7 @@ func TestStackWithStateBasedActions(t *testing.T) {
 	}
 
 	// Alice casts spell that deals damage
+	addRedMana(t, engine, gameID, "Alice")
 	err = engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -827,6 +845,7 @@ func TestStackLegalityChecks(t *testing.T) {
 	}
 
 	// Alice casts spell
+	addRedMana(t, engine, gameID, "Alice")
 	err = engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -909,6 +928,7 @@ func TestComplexStackScenario(t *testing.T) {
 	}
 
 	// Alice casts spell 1
+	addRedMana(t, engine, gameID, "Alice")
 	err = engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -931,6 +951,7 @@ func TestComplexStackScenario(t *testing.T) {
 	}
 
 	// Bob casts counterspell
+	addRedMana(t, engine, gameID, "Bob")
 	err = engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Bob",
 		ActionType: "SEND_STRING",
@@ -953,6 +974,7 @@ func TestComplexStackScenario(t *testing.T) {
 	}
 
 	// Alice casts another spell
+	addRedMana(t, engine, gameID, "Alice")
 	err = engine.ProcessAction(gameID, game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
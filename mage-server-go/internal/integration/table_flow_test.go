@@ -0,0 +1,89 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/magefree/mage-server-go/internal/chat"
+	"github.com/magefree/mage-server-go/internal/table"
+)
+
+// newKickTable creates a waiting table hosted by Alice with Bob and Carol seated
+func newKickTable(t *testing.T, env *gameServerEnv) *table.Table {
+	t.Helper()
+
+	tbl := env.tableMgr.CreateTable("Kick Table", "Free For All", "Alice", env.roomMgr.GetMainRoomID(), 3, "")
+	for _, player := range []string{"Alice", "Bob", "Carol"} {
+		if err := tbl.AddPlayer(player, "Human"); err != nil {
+			t.Fatalf("failed adding %s to table: %v", player, err)
+		}
+	}
+	return tbl
+}
+
+// TestKickFromTable_HostKicksWaitingPlayer tests that the host can kick a player before the game starts,
+// freeing the seat and notifying the room
+func TestKickFromTable_HostKicksWaitingPlayer(t *testing.T) {
+	env := newGameServerEnv(t)
+	tbl := newKickTable(t, env)
+
+	roomChat := "room:" + tbl.RoomID
+	delivered := make([]chat.Message, 0)
+	env.chatMgr.SetDeliveryHandler(func(roomID, username string, msg chat.Message) {
+		if roomID == roomChat && username == "Dave" {
+			delivered = append(delivered, msg)
+		}
+	})
+	if err := env.chatMgr.JoinRoom(roomChat, "Dave"); err != nil {
+		t.Fatalf("failed to join room chat: %v", err)
+	}
+
+	if err := env.tableMgr.KickFromTable(tbl.ID, "Bob", "Alice"); err != nil {
+		t.Fatalf("failed to kick Bob: %v", err)
+	}
+
+	if tbl.IsSeated("Bob") {
+		t.Error("expected Bob to lose their seat")
+	}
+	if err := tbl.AddPlayer("Dave", "Human"); err != nil {
+		t.Errorf("expected the freed seat to be available: %v", err)
+	}
+	if len(delivered) != 1 || delivered[0].Type != "STATUS" || delivered[0].Text != "Bob was kicked from Kick Table" {
+		t.Errorf("expected the room to be notified of the kick, got %+v", delivered)
+	}
+
+	// An admin who doesn't control the table may kick too
+	admin := env.sessionMgr.CreateSession("admin-session", "localhost")
+	admin.SetUserID("Moderator")
+	admin.SetAdmin(true)
+	if err := env.tableMgr.KickFromTable(tbl.ID, "Carol", "Moderator"); err != nil {
+		t.Errorf("expected an admin to be able to kick: %v", err)
+	}
+}
+
+// TestKickFromTable_NonHostRejected tests that players who don't control the table can't kick
+func TestKickFromTable_NonHostRejected(t *testing.T) {
+	env := newGameServerEnv(t)
+	tbl := newKickTable(t, env)
+
+	if err := env.tableMgr.KickFromTable(tbl.ID, "Carol", "Bob"); err == nil {
+		t.Error("expected a non-host kick to be rejected")
+	}
+	if !tbl.IsSeated("Carol") {
+		t.Error("expected Carol to keep their seat")
+	}
+}
+
+// TestKickFromTable_RejectedAfterGameStart tests that players can't be kicked once the game is in progress
+func TestKickFromTable_RejectedAfterGameStart(t *testing.T) {
+	env := newGameServerEnv(t)
+	tbl := newKickTable(t, env)
+
+	tbl.SetState(table.TableStateDueling)
+
+	if err := env.tableMgr.KickFromTable(tbl.ID, "Bob", "Alice"); err == nil {
+		t.Error("expected kicking after the game started to be rejected")
+	}
+	if !tbl.IsSeated("Bob") {
+		t.Error("expected Bob to keep their seat")
+	}
+}
@@ -366,6 +366,7 @@ func TestWatcherRealGameFlow(t *testing.T) {
 	now := time.Now()
 
 	// 1. Alice casts a spell
+	addRedMana(t, engine, gameID, "Alice")
 	castAction := game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -24,6 +24,7 @@ func TestWatcherIntegration_SpellCasting(t *testing.T) {
 	}
 
 	// Cast a spell
+	addRedMana(t, engine, gameID, "Alice")
 	action := game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
@@ -174,6 +175,7 @@ func TestWatcherIntegration_EndToEnd(t *testing.T) {
 	now := time.Now()
 
 	// 1. Alice casts a spell
+	addRedMana(t, engine, gameID, "Alice")
 	castAction := game.PlayerAction{
 		PlayerID:   "Alice",
 		ActionType: "SEND_STRING",
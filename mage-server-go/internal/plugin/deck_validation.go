@@ -0,0 +1,90 @@
+package plugin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DeckViolation describes a single card that makes a deck illegal in a format
+type DeckViolation struct {
+	CardName string
+	Reason   string
+}
+
+// DeckValidationError is returned when a deck breaks a format's banned/restricted lists
+type DeckValidationError struct {
+	Format     string
+	Violations []DeckViolation
+}
+
+func (e *DeckValidationError) Error() string {
+	parts := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		parts = append(parts, fmt.Sprintf("%s: %s", v.CardName, v.Reason))
+	}
+	return fmt.Sprintf("deck is not legal in %s: %s", e.Format, strings.Join(parts, "; "))
+}
+
+// ValidateDeck checks a deck (main deck and sideboard combined) against a game type's
+// banned and restricted lists. Banned cards are rejected outright, restricted cards
+// are limited to a single copy. Card names are compared case-insensitively.
+// Per Java DeckValidator.validate()
+func ValidateDeck(gt GameType, cards []string) error {
+	if gt == nil {
+		return nil
+	}
+
+	banned := make(map[string]bool)
+	for _, name := range gt.BannedCards() {
+		banned[normalizeCardName(name)] = true
+	}
+	restricted := make(map[string]bool)
+	for _, name := range gt.RestrictedCards() {
+		restricted[normalizeCardName(name)] = true
+	}
+
+	counts := make(map[string]int)
+	displayNames := make(map[string]string)
+	for _, card := range cards {
+		key := normalizeCardName(card)
+		if key == "" {
+			continue
+		}
+		counts[key]++
+		if _, seen := displayNames[key]; !seen {
+			displayNames[key] = strings.TrimSpace(card)
+		}
+	}
+
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	violations := make([]DeckViolation, 0)
+	for _, key := range keys {
+		switch {
+		case banned[key]:
+			violations = append(violations, DeckViolation{
+				CardName: displayNames[key],
+				Reason:   "banned",
+			})
+		case restricted[key] && counts[key] > 1:
+			violations = append(violations, DeckViolation{
+				CardName: displayNames[key],
+				Reason:   fmt.Sprintf("restricted to 1 copy, found %d", counts[key]),
+			})
+		}
+	}
+
+	if len(violations) > 0 {
+		return &DeckValidationError{Format: gt.Name(), Violations: violations}
+	}
+	return nil
+}
+
+func normalizeCardName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
@@ -0,0 +1,74 @@
+package plugin
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateDeckRejectsBannedCard(t *testing.T) {
+	commander, err := GetGameType("Commander Duel")
+	if err != nil {
+		t.Fatalf("failed to get game type: %v", err)
+	}
+
+	deck := []string{"Forest", "Forest", "Llanowar Elves", "Black Lotus"}
+
+	err = ValidateDeck(commander, deck)
+	if err == nil {
+		t.Fatal("expected deck with banned card to be rejected")
+	}
+
+	var validationErr *DeckValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected DeckValidationError, got %T", err)
+	}
+	if len(validationErr.Violations) != 1 {
+		t.Fatalf("expected 1 violation, got %d", len(validationErr.Violations))
+	}
+	if validationErr.Violations[0].CardName != "Black Lotus" || validationErr.Violations[0].Reason != "banned" {
+		t.Errorf("unexpected violation: %+v", validationErr.Violations[0])
+	}
+	if !strings.Contains(err.Error(), "Black Lotus") {
+		t.Errorf("expected error to name the banned card, got %q", err.Error())
+	}
+}
+
+func TestValidateDeckRejectsMultipleRestrictedCopies(t *testing.T) {
+	vintage, err := GetGameType("Vintage Duel")
+	if err != nil {
+		t.Fatalf("failed to get game type: %v", err)
+	}
+
+	if err := ValidateDeck(vintage, []string{"Island", "Sol Ring"}); err != nil {
+		t.Fatalf("expected single restricted copy to be legal, got %v", err)
+	}
+
+	err = ValidateDeck(vintage, []string{"Island", "Sol Ring", "sol ring"})
+	if err == nil {
+		t.Fatal("expected deck with two restricted copies to be rejected")
+	}
+
+	var validationErr *DeckValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected DeckValidationError, got %T", err)
+	}
+	if len(validationErr.Violations) != 1 || validationErr.Violations[0].CardName != "Sol Ring" {
+		t.Errorf("unexpected violations: %+v", validationErr.Violations)
+	}
+	if !strings.Contains(err.Error(), "restricted to 1 copy, found 2") {
+		t.Errorf("expected restricted violation in error, got %q", err.Error())
+	}
+}
+
+func TestValidateDeckAllowsCardsInUnrestrictedFormat(t *testing.T) {
+	duel, err := GetGameType("Two Player Duel")
+	if err != nil {
+		t.Fatalf("failed to get game type: %v", err)
+	}
+
+	deck := []string{"Black Lotus", "Sol Ring", "Sol Ring"}
+	if err := ValidateDeck(duel, deck); err != nil {
+		t.Errorf("expected deck to be legal in %s, got %v", duel.Name(), err)
+	}
+}
@@ -1,5 +1,113 @@
 package plugin
 
+// commanderBannedCards is the Commander format banned list
+// Per Java mage.deck.Commander banned list
+var commanderBannedCards = []string{
+	"Ancestral Recall",
+	"Balance",
+	"Biorhythm",
+	"Black Lotus",
+	"Braids, Cabal Minion",
+	"Channel",
+	"Emrakul, the Aeons Torn",
+	"Fastbond",
+	"Flash",
+	"Griselbrand",
+	"Karakas",
+	"Library of Alexandria",
+	"Limited Resources",
+	"Mox Emerald",
+	"Mox Jet",
+	"Mox Pearl",
+	"Mox Ruby",
+	"Mox Sapphire",
+	"Panoptic Mirror",
+	"Paradox Engine",
+	"Primeval Titan",
+	"Prophet of Kruphix",
+	"Recurring Nightmare",
+	"Rofellos, Llanowar Emissary",
+	"Shahrazad",
+	"Sundering Titan",
+	"Sylvan Primordial",
+	"Time Vault",
+	"Time Walk",
+	"Tinker",
+	"Tolarian Academy",
+	"Trade Secrets",
+	"Upheaval",
+	"Yawgmoth's Bargain",
+}
+
+// vintageBannedCards is the Vintage format banned list (ante, manual dexterity and conspiracies)
+var vintageBannedCards = []string{
+	"Amulet of Quoz",
+	"Bronze Tablet",
+	"Chaos Orb",
+	"Contract from Below",
+	"Darkpact",
+	"Demonic Attorney",
+	"Falling Star",
+	"Jeweled Bird",
+	"Rebirth",
+	"Shahrazad",
+	"Tempest Efreet",
+	"Timmerian Fiends",
+}
+
+// vintageRestrictedCards is the Vintage format restricted list (limited to one copy)
+var vintageRestrictedCards = []string{
+	"Ancestral Recall",
+	"Black Lotus",
+	"Brainstorm",
+	"Demonic Tutor",
+	"Gitaxian Probe",
+	"Library of Alexandria",
+	"Lodestone Golem",
+	"Mana Vault",
+	"Merchant Scroll",
+	"Mox Emerald",
+	"Mox Jet",
+	"Mox Pearl",
+	"Mox Ruby",
+	"Mox Sapphire",
+	"Mystical Tutor",
+	"Ponder",
+	"Sol Ring",
+	"Strip Mine",
+	"Time Walk",
+	"Timetwister",
+	"Tinker",
+	"Tolarian Academy",
+	"Trinisphere",
+	"Vampiric Tutor",
+	"Wheel of Fortune",
+	"Yawgmoth's Will",
+}
+
+// duelRules are the rules of a two-player constructed game
+var duelRules = GameRules{
+	StartingLife:    20,
+	MinimumDeckSize: 60,
+	SkipFirstDraw:   true,
+}
+
+// multiplayerRules are the rules of a multiplayer constructed game: the first mulligan is free
+// (rule 103.5c) and the starting player draws on their first turn (rule 103.8c)
+var multiplayerRules = GameRules{
+	StartingLife:    20,
+	MinimumDeckSize: 60,
+	FreeMulligans:   1,
+}
+
+// commanderRules are the rules of a multiplayer Commander game (rule 903)
+var commanderRules = GameRules{
+	StartingLife:    40,  // Per rule 903.7
+	MinimumDeckSize: 100, // Per rule 903.5a
+	FreeMulligans:   1,
+	CommanderDamage: 21, // Per rule 704.6c
+}
+
 // TwoPlayerDuel represents a standard 1v1 game
 type TwoPlayerDuel struct{}
 
@@ -23,6 +131,53 @@ func (g *TwoPlayerDuel) Description() string {
 	return "Standard 1v1 Magic game with 20 life"
 }
 
+func (g *TwoPlayerDuel) BannedCards() []string {
+	return nil
+}
+
+func (g *TwoPlayerDuel) RestrictedCards() []string {
+	return nil
+}
+
+func (g *TwoPlayerDuel) Rules() GameRules {
+	return duelRules
+}
+
+// VintageDuel represents a 1v1 game using the Vintage banned and restricted lists
+type VintageDuel struct{}
+
+func init() {
+	RegisterGameType(&VintageDuel{})
+}
+
+func (g *VintageDuel) Name() string {
+	return "Vintage Duel"
+}
+
+func (g *VintageDuel) MinPlayers() int {
+	return 2
+}
+
+func (g *VintageDuel) MaxPlayers() int {
+	return 2
+}
+
+func (g *VintageDuel) Description() string {
+	return "1v1 game with the Vintage banned and restricted lists"
+}
+
+func (g *VintageDuel) BannedCards() []string {
+	return vintageBannedCards
+}
+
+func (g *VintageDuel) RestrictedCards() []string {
+	return vintageRestrictedCards
+}
+
+func (g *VintageDuel) Rules() GameRules {
+	return duelRules
+}
+
 // FreeForAll represents a multiplayer free-for-all game
 type FreeForAll struct{}
 
@@ -46,6 +201,18 @@ func (g *FreeForAll) Description() string {
 	return "Multiplayer free-for-all game"
 }
 
+func (g *FreeForAll) BannedCards() []string {
+	return nil
+}
+
+func (g *FreeForAll) RestrictedCards() []string {
+	return nil
+}
+
+func (g *FreeForAll) Rules() GameRules {
+	return multiplayerRules
+}
+
 // CommanderFreeForAll represents a Commander format multiplayer game
 type CommanderFreeForAll struct{}
 
@@ -69,6 +236,18 @@ func (g *CommanderFreeForAll) Description() string {
 	return "Commander format multiplayer game with 40 life"
 }
 
+func (g *CommanderFreeForAll) BannedCards() []string {
+	return commanderBannedCards
+}
+
+func (g *CommanderFreeForAll) RestrictedCards() []string {
+	return nil
+}
+
+func (g *CommanderFreeForAll) Rules() GameRules {
+	return commanderRules
+}
+
 // CommanderDuel represents a 1v1 Commander game
 type CommanderDuel struct{}
 
@@ -92,6 +271,20 @@ func (g *CommanderDuel) Description() string {
 	return "1v1 Commander game"
 }
 
+func (g *CommanderDuel) BannedCards() []string {
+	return commanderBannedCards
+}
+
+func (g *CommanderDuel) RestrictedCards() []string {
+	return nil
+}
+
+func (g *CommanderDuel) Rules() GameRules {
+	rules := commanderRules
+	rules.SkipFirstDraw = true
+	return rules
+}
+
 // Brawl represents Brawl format
 type Brawl struct{}
 
@@ -115,6 +308,25 @@ func (g *Brawl) Description() string {
 	return "Brawl format game"
 }
 
+func (g *Brawl) BannedCards() []string {
+	return nil
+}
+
+func (g *Brawl) RestrictedCards() []string {
+	return nil
+}
+
+func (g *Brawl) Rules() GameRules {
+	// Per rule 903.12: Brawl decks have 60 cards, players start at 25 life and
+	// commander damage isn't tracked
+	return GameRules{
+		StartingLife:    25,
+		MinimumDeckSize: 60,
+		FreeMulligans:   1,
+		SkipFirstDraw:   true,
+	}
+}
+
 // CanadianHighlander represents Canadian Highlander format
 type CanadianHighlander struct{}
 
@@ -138,6 +350,20 @@ func (g *CanadianHighlander) Description() string {
 	return "Canadian Highlander singleton format"
 }
 
+func (g *CanadianHighlander) BannedCards() []string {
+	return nil
+}
+
+func (g *CanadianHighlander) RestrictedCards() []string {
+	return nil
+}
+
+func (g *CanadianHighlander) Rules() GameRules {
+	rules := multiplayerRules
+	rules.MinimumDeckSize = 100
+	return rules
+}
+
 // Add more game types as needed:
 // - Momir Basic
 // - Oathbreaker
@@ -11,6 +11,22 @@ type GameType interface {
 	MinPlayers() int
 	MaxPlayers() int
 	Description() string
+	// BannedCards returns card names that may not appear in a deck for this format
+	BannedCards() []string
+	// RestrictedCards returns card names limited to a single copy for this format
+	RestrictedCards() []string
+	// Rules returns the rules the format plays by, from which games of this type are configured
+	Rules() GameRules
+}
+
+// GameRules is the rules configuration of a game type
+type GameRules struct {
+	StartingLife    int  // Per rule 103.4 (20, or 40 in Commander)
+	MinimumDeckSize int  // Per rule 100.2a (0 = no minimum)
+	FreeMulligans   int  // Mulligans that don't reduce hand size (rule 103.5c)
+	SkipFirstDraw   bool // The starting player skips the draw of their first turn (rule 103.8a)
+	ManaBurn        bool // Players lose life for mana left in their pools as steps end (pre-Magic 2010 rule 300.3)
+	CommanderDamage int  // Combat damage from one commander at which a player loses (rule 704.6c; 0 = not tracked)
 }
 
 // TournamentType represents a tournament type (e.g., Constructed, Draft, Sealed)
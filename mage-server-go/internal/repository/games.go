@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// GameRecord is the saved state of an in-progress game
+type GameRecord struct {
+	GameID     string
+	GameType   string
+	TurnNumber int
+	StateData  []byte // Serialized engine state
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// GameRepository handles saved game state database operations
+type GameRepository struct {
+	db *DB
+}
+
+// NewGameRepository creates a new game repository
+func NewGameRepository(db *DB) *GameRepository {
+	return &GameRepository{db: db}
+}
+
+// Save inserts or replaces the saved state of a game
+func (r *GameRepository) Save(ctx context.Context, record *GameRecord) error {
+	query := `
+		INSERT INTO games (game_id, game_type, turn_number, state_data)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (game_id) DO UPDATE
+		SET game_type = EXCLUDED.game_type,
+		    turn_number = EXCLUDED.turn_number,
+		    state_data = EXCLUDED.state_data
+		RETURNING created_at, updated_at
+	`
+
+	err := r.db.Pool.QueryRow(ctx, query,
+		record.GameID, record.GameType, record.TurnNumber, record.StateData,
+	).Scan(&record.CreatedAt, &record.UpdatedAt)
+
+	if err != nil {
+		return fmt.Errorf("failed to save game: %w", err)
+	}
+
+	return nil
+}
+
+// Get retrieves the saved state of a game
+func (r *GameRepository) Get(ctx context.Context, gameID string) (*GameRecord, error) {
+	query := `
+		SELECT game_id, game_type, turn_number, state_data, created_at, updated_at
+		FROM games
+		WHERE game_id = $1
+	`
+
+	record := &GameRecord{}
+	err := r.db.Pool.QueryRow(ctx, query, gameID).Scan(
+		&record.GameID, &record.GameType, &record.TurnNumber, &record.StateData,
+		&record.CreatedAt, &record.UpdatedAt,
+	)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("game not found: %s", gameID)
+		}
+		return nil, fmt.Errorf("failed to get game: %w", err)
+	}
+
+	return record, nil
+}
+
+// List retrieves all saved games, most recently saved first
+func (r *GameRepository) List(ctx context.Context) ([]*GameRecord, error) {
+	query := `
+		SELECT game_id, game_type, turn_number, state_data, created_at, updated_at
+		FROM games
+		ORDER BY updated_at DESC
+	`
+
+	rows, err := r.db.Pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list games: %w", err)
+	}
+	defer rows.Close()
+
+	var records []*GameRecord
+	for rows.Next() {
+		record := &GameRecord{}
+		if err := rows.Scan(
+			&record.GameID, &record.GameType, &record.TurnNumber, &record.StateData,
+			&record.CreatedAt, &record.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan game: %w", err)
+		}
+		records = append(records, record)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating games: %w", err)
+	}
+
+	return records, nil
+}
+
+// Delete removes the saved state of a game, e.g. once it has ended
+func (r *GameRepository) Delete(ctx context.Context, gameID string) error {
+	query := `DELETE FROM games WHERE game_id = $1`
+
+	if _, err := r.db.Pool.Exec(ctx, query, gameID); err != nil {
+		return fmt.Errorf("failed to delete game: %w", err)
+	}
+
+	return nil
+}
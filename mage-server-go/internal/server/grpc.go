@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/magefree/mage-server-go/internal/auth"
@@ -81,7 +82,7 @@ func NewMageServer(
 	logger *zap.Logger,
 	gameAdapter *game.EngineAdapter,
 ) *mageServer {
-	return &mageServer{
+	srv := &mageServer{
 		config:        cfg,
 		logger:        logger,
 		serverVersion: serverVersion,
@@ -100,6 +101,16 @@ func NewMageServer(
 		savedDecks:    make(map[string][]savedDeck),
 		gameAdapter:   gameAdapter,
 	}
+
+	if tableMgr != nil {
+		tableMgr.SetAdminCheck(srv.isAdminUser)
+		tableMgr.SetRoomNotifier(srv.notifyRoom)
+	}
+	if chatMgr != nil {
+		chatMgr.SetDeliveryHandler(srv.deliverChatMessage)
+	}
+
+	return srv
 }
 
 // ==================== Authentication & Connection Methods ====================
@@ -153,7 +164,7 @@ func (s *mageServer) ConnectUser(ctx context.Context, req *pb.ConnectUserRequest
 
 	mainRoomID := s.roomMgr.GetMainRoomID()
 	_ = s.roomMgr.UserJoinRoom(mainRoomID, u.Name)
-	s.chatMgr.JoinRoom(mainRoomID, u.Name)
+	_ = s.chatMgr.JoinRoom(mainRoomID, u.Name)
 
 	s.logger.Info("user connected",
 		zap.String("username", u.Name),
@@ -170,6 +181,37 @@ func (s *mageServer) ConnectUser(ctx context.Context, req *pb.ConnectUserRequest
 	}, nil
 }
 
+// ResumeSession starts a new session for a player rejoining a game with the game's reconnection token,
+// even if the session they played with has lapsed. The token is replaced by the one in the response.
+func (s *mageServer) ResumeSession(ctx context.Context, req *pb.ResumeSessionRequest) (*pb.ResumeSessionResponse, error) {
+	gameInstance, player, newToken, err := s.gameMgr.RedeemReconnectToken(strings.TrimSpace(req.GetReconnectToken()))
+	if err != nil {
+		return &pb.ResumeSessionResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	sess := s.sessionMgr.CreateSession(uuid.NewString(), extractHostFromContext(ctx))
+	sess.SetUserID(player)
+	sess.UpdateActivity()
+
+	_ = s.roomMgr.UserJoinRoom(s.roomMgr.GetMainRoomID(), player)
+
+	s.logger.Info("player resumed session with reconnection token",
+		zap.String("username", player),
+		zap.String("game_id", gameInstance.ID),
+		zap.String("session_id", sess.ID),
+	)
+
+	return &pb.ResumeSessionResponse{
+		Success:        true,
+		SessionId:      sess.ID,
+		GameId:         gameInstance.ID,
+		ReconnectToken: newToken,
+	}, nil
+}
+
 // ConnectAdmin handles admin connection
 func (s *mageServer) ConnectAdmin(ctx context.Context, req *pb.ConnectAdminRequest) (*pb.ConnectAdminResponse, error) {
 	if s.config.Auth.AdminPassword == "" {
@@ -573,7 +615,13 @@ func (s *mageServer) RoomCreateTable(ctx context.Context, req *pb.RoomCreateTabl
 	numSeats := deriveSeatCount(gameType)
 	password := ""
 
-	newTable := s.tableMgr.CreateTable(tableName, gameType, controller, roomID, numSeats, password)
+	newTable, err := s.tableMgr.CreateTableWithSettings(tableName, gameType, controller, roomID, numSeats, password, tableSettings(matchOptions))
+	if err != nil {
+		return &pb.RoomCreateTableResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
 
 	if err := newTable.AddPlayer(controller, "Human"); err != nil {
 		s.logger.Debug("failed to add controller to table",
@@ -606,6 +654,34 @@ func (s *mageServer) RoomCreateTable(ctx context.Context, req *pb.RoomCreateTabl
 
 // ==================== Helper Functions ====================
 
+// isAdminUser checks if the user is connected through an admin session
+func (s *mageServer) isAdminUser(userID string) bool {
+	for _, sess := range s.sessionMgr.GetSessionsByUser(userID) {
+		if sess.IsAdminSession() {
+			return true
+		}
+	}
+	return false
+}
+
+// notifyRoom sends a status message to a room's chat
+func (s *mageServer) notifyRoom(roomID, text string) {
+	s.chatMgr.SendStatusMessage(formatChatRoomID(chatPrefixRoom, roomID), text)
+}
+
+// tableSettings returns the table settings requested by a client's match options
+func tableSettings(matchOptions *pb.MatchOptions) table.TableSettings {
+	settings := table.DefaultTableSettings()
+	if matchOptions == nil {
+		return settings
+	}
+	settings.RollbackAllowed = matchOptions.GetRollbackTurnsAllowed()
+	if minutes := matchOptions.GetTimeLimit().GetMinutes(); minutes > 0 {
+		settings.TimeLimit = time.Duration(minutes) * time.Minute
+	}
+	return settings
+}
+
 func (s *mageServer) tableToProto(t *table.Table) *pb.TableView {
 	seats := make([]*pb.SeatView, len(t.Seats))
 	for _, seat := range t.Seats {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/magefree/mage-server-go/internal/chat"
 	pb "github.com/magefree/mage-server-go/pkg/proto/mage/v1"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -16,12 +17,64 @@ const (
 	chatPrefixTable      = "table"
 	chatPrefixGame       = "game"
 	chatPrefixTournament = "tournament"
+	chatPrefixSpectators = "spectators" // Per-table spectator chat, separate from the players' table chat
 )
 
 func formatChatRoomID(prefix, id string) string {
 	return fmt.Sprintf("%s:%s", prefix, id)
 }
 
+// restrictTableChat keeps spectators and seated players apart while a table's game is live:
+// spectators can't use the table chat or the game chat, and seated players can't use the spectator chat.
+func (s *mageServer) restrictTableChat(chatID string) {
+	prefix, id, ok := strings.Cut(chatID, ":")
+	if !ok {
+		return
+	}
+
+	tableID := id
+	switch prefix {
+	case chatPrefixTable, chatPrefixSpectators:
+	case chatPrefixGame:
+		// The players' game chat follows the table the game is played at
+		game, ok := s.gameMgr.GetGame(id)
+		if !ok {
+			return
+		}
+		tableID = game.TableID
+	default:
+		return
+	}
+
+	tbl, ok := s.tableMgr.GetTable(tableID)
+	if !ok {
+		return
+	}
+
+	room := s.chatMgr.GetOrCreateRoom(chatID)
+	if prefix == chatPrefixSpectators {
+		room.SetAccess(tbl.CanUseSpectatorChat)
+	} else {
+		room.SetAccess(tbl.CanUsePlayerChat)
+	}
+}
+
+// deliverChatMessage sends a chat message to the sessions of a user in the chat room it was sent to;
+// the chat manager only delivers to users the room's access allows
+func (s *mageServer) deliverChatMessage(chatID, username string, msg chat.Message) {
+	for _, sess := range s.sessionMgr.GetSessionsByUser(username) {
+		sess.SendCallback(map[string]interface{}{
+			"type":         "chat_message",
+			"chat_id":      chatID,
+			"username":     msg.UserName,
+			"message":      msg.Text,
+			"color":        msg.Color,
+			"message_type": msg.Type,
+			"timestamp":    msg.Timestamp,
+		})
+	}
+}
+
 // ChatJoin adds the current user to the requested chat channel.
 func (s *mageServer) ChatJoin(ctx context.Context, req *pb.ChatJoinRequest) (*pb.ChatJoinResponse, error) {
 	sessionID := strings.TrimSpace(req.GetSessionId())
@@ -44,7 +97,10 @@ func (s *mageServer) ChatJoin(ctx context.Context, req *pb.ChatJoinRequest) (*pb
 		return &pb.ChatJoinResponse{Success: false, Error: "chat_id is required"}, nil
 	}
 
-	s.chatMgr.JoinRoom(chatID, username)
+	s.restrictTableChat(chatID)
+	if err := s.chatMgr.JoinRoom(chatID, username); err != nil {
+		return &pb.ChatJoinResponse{Success: false, Error: err.Error()}, nil
+	}
 
 	s.logger.Debug("user joined chat",
 		zap.String("chat_id", chatID),
@@ -113,6 +169,7 @@ func (s *mageServer) ChatSendMessage(ctx context.Context, req *pb.ChatSendMessag
 		return &pb.ChatSendMessageResponse{Success: false, Error: "message is required"}, nil
 	}
 
+	s.restrictTableChat(chatID)
 	if err := s.chatMgr.SendMessage(chatID, username, message); err != nil {
 		return &pb.ChatSendMessageResponse{Success: false, Error: err.Error()}, nil
 	}
@@ -120,33 +177,55 @@ func (s *mageServer) ChatSendMessage(ctx context.Context, req *pb.ChatSendMessag
 	return &pb.ChatSendMessageResponse{Success: true}, nil
 }
 
-// ChatFindByTable returns the chat identifier for the specified table.
+// ChatFindByTable returns the chat identifier for the specified table, or its spectator chat for spectators.
 func (s *mageServer) ChatFindByTable(ctx context.Context, req *pb.ChatFindByTableRequest) (*pb.ChatFindByTableResponse, error) {
 	tableID := strings.TrimSpace(req.GetTableId())
 	if tableID == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "table_id is required")
 	}
 
-	if _, ok := s.tableMgr.GetTable(tableID); !ok {
+	tbl, ok := s.tableMgr.GetTable(tableID)
+	if !ok {
 		return nil, status.Errorf(codes.NotFound, "table not found")
 	}
 
+	// Spectators get the table's spectator chat
+	if sess, ok := s.sessionMgr.GetSession(strings.TrimSpace(req.GetSessionId())); ok {
+		if username := sess.GetUserID(); tbl.IsSpectator(username) && !tbl.IsSeated(username) {
+			return &pb.ChatFindByTableResponse{
+				ChatId: formatChatRoomID(chatPrefixSpectators, tableID),
+			}, nil
+		}
+	}
+
 	return &pb.ChatFindByTableResponse{
 		ChatId: formatChatRoomID(chatPrefixTable, tableID),
 	}, nil
 }
 
-// ChatFindByGame returns the chat identifier for the specified game.
+// ChatFindByGame returns the chat identifier for the specified game, or its table's spectator chat for spectators.
 func (s *mageServer) ChatFindByGame(ctx context.Context, req *pb.ChatFindByGameRequest) (*pb.ChatFindByGameResponse, error) {
 	gameID := strings.TrimSpace(req.GetGameId())
 	if gameID == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "game_id is required")
 	}
 
-	if _, ok := s.gameMgr.GetGame(gameID); !ok {
+	game, ok := s.gameMgr.GetGame(gameID)
+	if !ok {
 		return nil, status.Errorf(codes.NotFound, "game not found")
 	}
 
+	// Spectators get the spectator chat of the game's table
+	if sess, ok := s.sessionMgr.GetSession(strings.TrimSpace(req.GetSessionId())); ok {
+		if tbl, ok := s.tableMgr.GetTable(game.TableID); ok {
+			if username := sess.GetUserID(); tbl.IsSpectator(username) && !tbl.IsSeated(username) {
+				return &pb.ChatFindByGameResponse{
+					ChatId: formatChatRoomID(chatPrefixSpectators, game.TableID),
+				}, nil
+			}
+		}
+	}
+
 	return &pb.ChatFindByGameResponse{
 		ChatId: formatChatRoomID(chatPrefixGame, gameID),
 	}, nil
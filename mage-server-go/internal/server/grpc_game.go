@@ -66,9 +66,13 @@ func (s *mageServer) MatchStart(ctx context.Context, req *pb.MatchStartRequest)
 		return &pb.MatchStartResponse{Success: false, Error: "not enough players to start match"}, nil
 	}
 
-	game := s.gameMgr.CreateGame(tbl.ID, tbl.GameType, players)
+	game, err := s.gameMgr.CreateGameWithOptions(tbl.ID, tbl.GameType, players, tbl.RulesOptions())
+	if err != nil {
+		return &pb.MatchStartResponse{Success: false, Error: err.Error()}, nil
+	}
 	tbl.RecordMatch(game.ID)
 	tbl.SetState(table.TableStateDueling)
+	s.sendReconnectTokens(game)
 
 	if s.gameAdapter != nil {
 		if err := s.gameAdapter.StartGame(game); err != nil {
@@ -89,6 +93,23 @@ func (s *mageServer) MatchStart(ctx context.Context, req *pb.MatchStartRequest)
 	return &pb.MatchStartResponse{Success: true}, nil
 }
 
+// sendReconnectTokens sends each player the token they can rejoin the game with if their session lapses
+func (s *mageServer) sendReconnectTokens(game *game.Game) {
+	for _, player := range game.Players {
+		token, ok := game.ReconnectToken(player)
+		if !ok {
+			continue
+		}
+		for _, sess := range s.sessionMgr.GetSessionsByUser(player) {
+			sess.SendCallback(map[string]interface{}{
+				"type":            "game_reconnect_token",
+				"game_id":         game.ID,
+				"reconnect_token": token,
+			})
+		}
+	}
+}
+
 // GameJoin registers a player to an active game session.
 func (s *mageServer) GameJoin(ctx context.Context, req *pb.GameJoinRequest) (*pb.GameJoinResponse, error) {
 	sessionID := strings.TrimSpace(req.GetSessionId())
@@ -155,7 +176,9 @@ func (s *mageServer) GameWatchStart(ctx context.Context, req *pb.GameWatchStartR
 		return &pb.GameWatchStartResponse{Success: false, Error: "session not associated with a user"}, nil
 	}
 
-	game.AddWatcher(user)
+	if err := game.AddWatcher(user); err != nil {
+		return &pb.GameWatchStartResponse{Success: false, Error: err.Error()}, nil
+	}
 	s.logger.Info("watcher added to game",
 		zap.String("game_id", game.ID),
 		zap.String("username", user),
@@ -344,7 +367,7 @@ func (s *mageServer) SendPlayerUUID(ctx context.Context, req *pb.SendPlayerUUIDR
 		return &pb.SendPlayerUUIDResponse{Success: false, Error: "uuid is required"}, nil
 	}
 
-	if err := s.gameMgr.SendPlayerAction(gameInstance.ID, player, "SEND_UUID", req.GetUuid()); err != nil {
+	if err := s.gameMgr.SendPlayerAction(gameInstance.ID, player, "SEND_UUID", req.GetNonce(), req.GetUuid()); err != nil {
 		return &pb.SendPlayerUUIDResponse{Success: false, Error: err.Error()}, nil
 	}
 
@@ -362,7 +385,7 @@ func (s *mageServer) SendPlayerString(ctx context.Context, req *pb.SendPlayerStr
 		return &pb.SendPlayerStringResponse{Success: false, Error: "data is required"}, nil
 	}
 
-	if err := s.gameMgr.SendPlayerAction(gameInstance.ID, player, "SEND_STRING", req.GetData()); err != nil {
+	if err := s.gameMgr.SendPlayerAction(gameInstance.ID, player, "SEND_STRING", req.GetNonce(), req.GetData()); err != nil {
 		return &pb.SendPlayerStringResponse{Success: false, Error: err.Error()}, nil
 	}
 
@@ -376,7 +399,7 @@ func (s *mageServer) SendPlayerBoolean(ctx context.Context, req *pb.SendPlayerBo
 		return &pb.SendPlayerBooleanResponse{Success: false, Error: errMsg}, nil
 	}
 
-	if err := s.gameMgr.SendPlayerAction(gameInstance.ID, player, "SEND_BOOLEAN", req.GetData()); err != nil {
+	if err := s.gameMgr.SendPlayerAction(gameInstance.ID, player, "SEND_BOOLEAN", req.GetNonce(), req.GetData()); err != nil {
 		return &pb.SendPlayerBooleanResponse{Success: false, Error: err.Error()}, nil
 	}
 
@@ -390,7 +413,7 @@ func (s *mageServer) SendPlayerInteger(ctx context.Context, req *pb.SendPlayerIn
 		return &pb.SendPlayerIntegerResponse{Success: false, Error: errMsg}, nil
 	}
 
-	if err := s.gameMgr.SendPlayerAction(gameInstance.ID, player, "SEND_INTEGER", req.GetData()); err != nil {
+	if err := s.gameMgr.SendPlayerAction(gameInstance.ID, player, "SEND_INTEGER", req.GetNonce(), req.GetData()); err != nil {
 		return &pb.SendPlayerIntegerResponse{Success: false, Error: err.Error()}, nil
 	}
 
@@ -409,7 +432,7 @@ func (s *mageServer) SendPlayerManaType(ctx context.Context, req *pb.SendPlayerM
 		"mana_type_str": req.GetManaTypeStr(),
 	}
 
-	if err := s.gameMgr.SendPlayerAction(gameInstance.ID, player, "SEND_MANA_TYPE", payload); err != nil {
+	if err := s.gameMgr.SendPlayerAction(gameInstance.ID, player, "SEND_MANA_TYPE", req.GetNonce(), payload); err != nil {
 		return &pb.SendPlayerManaTypeResponse{Success: false, Error: err.Error()}, nil
 	}
 
@@ -428,7 +451,7 @@ func (s *mageServer) SendPlayerAction(ctx context.Context, req *pb.SendPlayerAct
 		return &pb.SendPlayerActionResponse{Success: false, Error: "action is required"}, nil
 	}
 
-	if err := s.gameMgr.SendPlayerAction(gameInstance.ID, player, "PLAYER_ACTION", action.String()); err != nil {
+	if err := s.gameMgr.SendPlayerAction(gameInstance.ID, player, "PLAYER_ACTION", req.GetNonce(), action.String()); err != nil {
 		return &pb.SendPlayerActionResponse{Success: false, Error: err.Error()}, nil
 	}
 
@@ -674,6 +697,9 @@ func enginePromptsToMessages(prompts []game.EnginePrompt, startID int32) []*pb.G
 		if len(prompt.Options) > 0 {
 			text = fmt.Sprintf("%s (options: %s)", prompt.Text, strings.Join(prompt.Options, ", "))
 		}
+		if prompt.DecisionID != "" {
+			text = fmt.Sprintf("%s [%s decision %s]", text, prompt.Kind, prompt.DecisionID)
+		}
 		msg := &pb.GameMessage{
 			Id:    nextID,
 			Text:  fmt.Sprintf("Prompt for %s: %s", prompt.PlayerID, text),
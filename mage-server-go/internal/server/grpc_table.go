@@ -243,7 +243,12 @@ func (s *mageServer) RoomWatchTable(ctx context.Context, req *pb.RoomWatchTableR
 		}, nil
 	}
 
-	tbl.AddSpectator(username)
+	if err := tbl.AddSpectator(username); err != nil {
+		return &pb.RoomWatchTableResponse{
+			Success: false,
+			Error:   err.Error(),
+		}, nil
+	}
 
 	s.logger.Info("user watching table",
 		zap.String("table_id", tbl.ID),
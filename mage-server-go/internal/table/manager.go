@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/magefree/mage-server-go/internal/game"
+	"github.com/magefree/mage-server-go/internal/plugin"
 	"go.uber.org/zap"
 )
 
@@ -68,6 +70,7 @@ type Table struct {
 	Spectators     []string
 	SubmittedDecks map[string]DeckList
 	Matches        []string
+	Settings       TableSettings
 	mu             sync.RWMutex
 }
 
@@ -94,6 +97,7 @@ func NewTable(name, gameType, controllerName, roomID string, numSeats int) *Tabl
 		Spectators:     make([]string, 0),
 		SubmittedDecks: make(map[string]DeckList),
 		Matches:        make([]string, 0),
+		Settings:       DefaultTableSettings(),
 	}
 }
 
@@ -136,6 +140,32 @@ func (t *Table) RemovePlayer(playerName string) error {
 	return fmt.Errorf("player not found at table")
 }
 
+// KickPlayer removes a seated player before the game starts, freeing their seat.
+// The table controller can't be kicked.
+func (t *Table) KickPlayer(playerName string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.State != TableStateWaiting {
+		return fmt.Errorf("cannot kick a player once the game has started; concede or end the game instead")
+	}
+	if playerName == t.ControllerName {
+		return fmt.Errorf("cannot kick the table controller")
+	}
+
+	for _, seat := range t.Seats {
+		if seat.PlayerName == playerName {
+			seat.PlayerName = ""
+			seat.PlayerType = ""
+			seat.DeckValid = false
+			delete(t.SubmittedDecks, playerName)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("player not found at table")
+}
+
 // SwapSeats swaps two seats
 func (t *Table) SwapSeats(seat1, seat2 int) error {
 	t.mu.Lock()
@@ -181,11 +211,15 @@ func (t *Table) GetPlayerCount() int {
 }
 
 // AddSpectator adds a spectator to the table
-func (t *Table) AddSpectator(playerName string) {
+func (t *Table) AddSpectator(playerName string) error {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
+	if !t.Settings.SpectatorsAllowed {
+		return fmt.Errorf("spectators are not allowed at this table")
+	}
 	t.Spectators = append(t.Spectators, playerName)
+	return nil
 }
 
 // RemoveSpectator removes a spectator from the table
@@ -210,6 +244,16 @@ func (t *Table) SubmitDeck(playerName string, deck DeckList) error {
 		return fmt.Errorf("deck submission closed for this table")
 	}
 
+	// Enforce the format's banned/restricted lists when the game type is registered
+	if gt, err := plugin.GetGameType(t.GameType); err == nil {
+		cards := make([]string, 0, len(deck.MainDeck)+len(deck.Sideboard))
+		cards = append(cards, deck.MainDeck...)
+		cards = append(cards, deck.Sideboard...)
+		if err := plugin.ValidateDeck(gt, cards); err != nil {
+			return err
+		}
+	}
+
 	for _, seat := range t.Seats {
 		if seat.PlayerName == playerName {
 			seat.DeckValid = true
@@ -250,6 +294,13 @@ func (t *Table) RecordMatch(matchID string) {
 	t.Matches = append(t.Matches, matchID)
 }
 
+// RulesOptions returns the rules options the table's games are played with
+func (t *Table) RulesOptions() game.RulesOptions {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.Settings.RulesOptions(t.GameType)
+}
+
 // SetState sets the table state
 func (t *Table) SetState(state TableState) {
 	t.mu.Lock()
@@ -280,11 +331,61 @@ func (t *Table) IsController(playerName string) bool {
 	return t.ControllerName == playerName
 }
 
+// IsSeated checks if the given player has a seat at the table
+func (t *Table) IsSeated(playerName string) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	for _, seat := range t.Seats {
+		if seat.PlayerName == playerName {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSpectator checks if the given player is watching the table
+func (t *Table) IsSpectator(playerName string) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	for _, name := range t.Spectators {
+		if name == playerName {
+			return true
+		}
+	}
+	return false
+}
+
+// IsLive checks if the table's game is being played
+func (t *Table) IsLive() bool {
+	state := t.GetState()
+	return state == TableStateStarting || state == TableStateDueling
+}
+
+// CanUsePlayerChat checks if the given player may take part in the table's player chat.
+// Only seated players may while the game is live, so spectators can't coach them.
+func (t *Table) CanUsePlayerChat(playerName string) bool {
+	return !t.IsLive() || t.IsSeated(playerName)
+}
+
+// CanUseSpectatorChat checks if the given player may take part in the table's spectator chat.
+// Seated players are kept out while the game is live.
+func (t *Table) CanUseSpectatorChat(playerName string) bool {
+	return !t.IsLive() || !t.IsSeated(playerName)
+}
+
+// AdminCheck reports whether a user is a server admin
+type AdminCheck func(userID string) bool
+
+// RoomNotifier notifies the users in a room of a change at one of its tables
+type RoomNotifier func(roomID, text string)
+
 // Manager manages game tables
 type Manager struct {
-	tables map[string]*Table
-	mu     sync.RWMutex
-	logger *zap.Logger
+	tables     map[string]*Table
+	isAdmin    AdminCheck   // nil = nobody is an admin
+	notifyRoom RoomNotifier // nil = rooms aren't notified
+	mu         sync.RWMutex
+	logger     *zap.Logger
 }
 
 // NewManager creates a new table manager
@@ -295,13 +396,38 @@ func NewManager(logger *zap.Logger) *Manager {
 	}
 }
 
-// CreateTable creates a new table
+// SetAdminCheck sets the check deciding which users may act on tables they don't control
+func (m *Manager) SetAdminCheck(check AdminCheck) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.isAdmin = check
+}
+
+// SetRoomNotifier sets the notifier told about changes at a room's tables
+func (m *Manager) SetRoomNotifier(notifier RoomNotifier) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.notifyRoom = notifier
+}
+
+// CreateTable creates a new table with the default settings
 func (m *Manager) CreateTable(name, gameType, controllerName, roomID string, numSeats int, password string) *Table {
+	table, _ := m.CreateTableWithSettings(name, gameType, controllerName, roomID, numSeats, password, DefaultTableSettings())
+	return table
+}
+
+// CreateTableWithSettings creates a new table after validating its settings
+func (m *Manager) CreateTableWithSettings(name, gameType, controllerName, roomID string, numSeats int, password string, settings TableSettings) (*Table, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid table settings: %w", err)
+	}
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	table := NewTable(name, gameType, controllerName, roomID, numSeats)
 	table.Password = password
+	table.Settings = settings
 	m.tables[table.ID] = table
 
 	m.logger.Info("table created",
@@ -312,7 +438,7 @@ func (m *Manager) CreateTable(name, gameType, controllerName, roomID string, num
 		zap.Int("seats", numSeats),
 	)
 
-	return table
+	return table, nil
 }
 
 // GetTable retrieves a table by ID
@@ -334,6 +460,39 @@ func (m *Manager) RemoveTable(tableID string) {
 	m.logger.Info("table removed", zap.String("table_id", tableID))
 }
 
+// KickFromTable removes a player from a table that hasn't started, on behalf of the table controller
+// or an admin, and notifies the table's room
+// Per Java TableController.kickPlayer()
+func (m *Manager) KickFromTable(tableID, targetUserID, byUserID string) error {
+	m.mu.RLock()
+	table, ok := m.tables[tableID]
+	isAdmin, notifyRoom := m.isAdmin, m.notifyRoom
+	m.mu.RUnlock()
+
+	if !ok {
+		return fmt.Errorf("table %s not found", tableID)
+	}
+	if !table.IsController(byUserID) && (isAdmin == nil || !isAdmin(byUserID)) {
+		return fmt.Errorf("only the table controller or an admin can kick players")
+	}
+
+	if err := table.KickPlayer(targetUserID); err != nil {
+		return err
+	}
+
+	m.logger.Info("player kicked from table",
+		zap.String("table_id", table.ID),
+		zap.String("player", targetUserID),
+		zap.String("by", byUserID),
+	)
+
+	if notifyRoom != nil && table.RoomID != "" {
+		notifyRoom(table.RoomID, fmt.Sprintf("%s was kicked from %s", targetUserID, table.Name))
+	}
+
+	return nil
+}
+
 // GetAllTables returns all tables
 func (m *Manager) GetAllTables() []*Table {
 	m.mu.RLock()
@@ -0,0 +1,57 @@
+package table
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/magefree/mage-server-go/internal/game"
+)
+
+// TableSettings holds the format settings chosen when a table is created.
+// Per Java MatchOptions: the settings apply to every game played at the table.
+type TableSettings struct {
+	MulliganRule      string        // "LONDON" or "VANCOUVER" ("" = the game type's default)
+	StartingLife      int           // Life each player starts with (0 = the game type's default)
+	TurnLimit         int           // Games end when this turn is over (0 = no limit)
+	TimeLimit         time.Duration // Games end when this much time has passed (0 = no limit)
+	RollbackAllowed   bool          // Players may roll back turns
+	SpectatorsAllowed bool          // Users who aren't seated may watch
+	RangeOfInfluence  int           // Seats a player's influence reaches in multiplayer (0 = unlimited)
+	ManaBurn          bool          // Players lose life for unused mana (false = the game type's default)
+}
+
+// DefaultTableSettings returns the settings of a table created without explicit settings
+func DefaultTableSettings() TableSettings {
+	return TableSettings{
+		RollbackAllowed:   true,
+		SpectatorsAllowed: true,
+	}
+}
+
+// Validate checks that the settings describe a playable game
+func (s TableSettings) Validate() error {
+	if s.StartingLife < 0 {
+		return fmt.Errorf("starting life must not be negative, got %d", s.StartingLife)
+	}
+	return s.RulesOptions("").Validate()
+}
+
+// RulesOptions returns the engine's rules options for a game of the given type played with these settings
+func (s TableSettings) RulesOptions(gameType string) game.RulesOptions {
+	options := game.RulesOptionsForGameType(gameType)
+	if s.MulliganRule != "" {
+		options.MulliganRule = game.MulliganRule(s.MulliganRule)
+	}
+	if s.StartingLife > 0 {
+		options.StartingLife = s.StartingLife
+	}
+	options.TurnLimit = s.TurnLimit
+	options.TimeLimit = s.TimeLimit
+	options.RollbackAllowed = s.RollbackAllowed
+	options.SpectatorsAllowed = s.SpectatorsAllowed
+	options.RangeOfInfluence = s.RangeOfInfluence
+	if s.ManaBurn {
+		options.ManaBurn = true
+	}
+	return options
+}
@@ -68,12 +68,14 @@ type Pairing struct {
 	Player1Wins int
 	Player2Wins int
 	Draws       int
+	Finished    bool // The result has been recorded
 }
 
 // Round represents a tournament round
 type Round struct {
 	Number   int
 	Pairings []*Pairing
+	Bye      string // Player who got a bye this round ("" = none)
 	Started  bool
 	Finished bool
 }
@@ -98,6 +100,7 @@ type PairingSnapshot struct {
 	Player1Wins int
 	Player2Wins int
 	Draws       int
+	Finished    bool // The result has been recorded
 }
 
 // RoundSnapshot captures round data for external use.
@@ -106,6 +109,7 @@ type RoundSnapshot struct {
 	Started  bool
 	Finished bool
 	Pairings []PairingSnapshot
+	Bye      string
 }
 
 // TournamentSnapshot captures a consistent view of a tournament.
@@ -314,51 +318,12 @@ func (t *Tournament) CreateRound() *Round {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	t.CurrentRound++
-	round := &Round{
-		Number:   t.CurrentRound,
-		Pairings: t.generatePairings(),
-		Started:  false,
-		Finished: false,
-	}
-
-	t.Rounds = append(t.Rounds, round)
-	return round
-}
-
-// generatePairings generates pairings for the current round (Swiss pairing algorithm)
-func (t *Tournament) generatePairings() []*Pairing {
-	// Simple Swiss pairing: pair players with similar points
-	activePlayers := make([]*Player, 0)
-	for _, player := range t.Players {
-		if !player.Eliminated && !player.Quit {
-			activePlayers = append(activePlayers, player)
-		}
-	}
-
-	// Sort by points (simplified - real implementation would be more complex)
-	// For now, just pair sequentially
-	pairings := make([]*Pairing, 0)
-	for i := 0; i < len(activePlayers)-1; i += 2 {
-		pairing := &Pairing{
-			Player1: activePlayers[i].Name,
-			Player2: activePlayers[i+1].Name,
-		}
-		pairings = append(pairings, pairing)
-	}
-
-	// Handle bye if odd number of players
-	if len(activePlayers)%2 == 1 {
-		// Last player gets a bye (automatic win)
-		lastPlayer := activePlayers[len(activePlayers)-1]
-		lastPlayer.Points += 3
-		lastPlayer.Wins++
-	}
-
-	return pairings
+	return t.newRound(false)
 }
 
-// RecordMatchResult records the result of a match
+// RecordMatchResult records the result of a match. player1Wins and player2Wins are the games won by
+// player1 and player2, whichever order the pairing lists them in. A match's result can only be recorded
+// once.
 func (t *Tournament) RecordMatchResult(roundNum int, player1, player2, winner string, player1Wins, player2Wins int) error {
 	t.mu.Lock()
 	defer t.mu.Unlock()
@@ -373,6 +338,12 @@ func (t *Tournament) RecordMatchResult(roundNum int, player1, player2, winner st
 	for _, pairing := range round.Pairings {
 		if (pairing.Player1 == player1 && pairing.Player2 == player2) ||
 			(pairing.Player1 == player2 && pairing.Player2 == player1) {
+			if pairing.Finished {
+				return fmt.Errorf("result already recorded for %s vs %s", pairing.Player1, pairing.Player2)
+			}
+			if pairing.Player1 != player1 {
+				player1Wins, player2Wins = player2Wins, player1Wins
+			}
 			pairing.Winner = winner
 			pairing.Player1Wins = player1Wins
 			pairing.Player2Wins = player2Wins
@@ -380,20 +351,29 @@ func (t *Tournament) RecordMatchResult(roundNum int, player1, player2, winner st
 			// Update player stats
 			if winner == player1 {
 				t.Players[player1].Wins++
-				t.Players[player1].Points += 3
+				t.Players[player1].Points += pointsForWin
 				t.Players[player2].Losses++
 			} else if winner == player2 {
 				t.Players[player2].Wins++
-				t.Players[player2].Points += 3
+				t.Players[player2].Points += pointsForWin
 				t.Players[player1].Losses++
 			} else {
 				// Draw
 				t.Players[player1].Draws++
-				t.Players[player1].Points += 1
+				t.Players[player1].Points += pointsForDraw
 				t.Players[player2].Draws++
-				t.Players[player2].Points += 1
+				t.Players[player2].Points += pointsForDraw
+			}
+			pairing.Finished = true
+
+			// The round is finished once every match in it has a result
+			round.Finished = true
+			for _, other := range round.Pairings {
+				if !other.Finished {
+					round.Finished = false
+					break
+				}
 			}
-
 			return nil
 		}
 	}
@@ -429,15 +409,7 @@ func (t *Tournament) Start() error {
 	t.State = TournamentStateInProgress
 	t.CurrentRound = 0
 
-	t.CurrentRound++
-	round := &Round{
-		Number:   t.CurrentRound,
-		Pairings: t.generatePairings(),
-		Started:  true,
-		Finished: false,
-	}
-	t.Rounds = append(t.Rounds, round)
-
+	t.newRound(true)
 	return nil
 }
 
@@ -473,6 +445,7 @@ func (t *Tournament) Snapshot() TournamentSnapshot {
 				Player1Wins: p.Player1Wins,
 				Player2Wins: p.Player2Wins,
 				Draws:       p.Draws,
+				Finished:    p.Finished,
 			})
 		}
 
@@ -481,6 +454,7 @@ func (t *Tournament) Snapshot() TournamentSnapshot {
 			Started:  r.Started,
 			Finished: r.Finished,
 			Pairings: pairings,
+			Bye:      r.Bye,
 		})
 	}
 
@@ -164,6 +164,54 @@ func TestRecordMatchResult(t *testing.T) {
 	}
 }
 
+func TestRecordMatchResultOnce(t *testing.T) {
+	tournament := NewTournament("Test", "Constructed", "controller1", "room1", 3, 2)
+
+	tournament.AddPlayer("Alice")
+	tournament.AddPlayer("Bob")
+	tournament.CreateRound()
+
+	pairing := tournament.Rounds[0].Pairings[0]
+	if err := tournament.RecordMatchResult(1, pairing.Player1, pairing.Player2, pairing.Player1, 2, 0); err != nil {
+		t.Fatalf("failed to record match result: %v", err)
+	}
+	if err := tournament.RecordMatchResult(1, pairing.Player1, pairing.Player2, pairing.Player2, 0, 2); err == nil {
+		t.Fatal("expected a second result for the same match to be rejected")
+	}
+
+	winner := tournament.Players[pairing.Player1]
+	loser := tournament.Players[pairing.Player2]
+	if winner.Points != 3 || winner.Wins != 1 || loser.Points != 0 || loser.Wins != 0 || loser.Losses != 1 {
+		t.Errorf("expected only the first result to count, got %s %d points %d-%d, %s %d points %d-%d",
+			winner.Name, winner.Points, winner.Wins, winner.Losses, loser.Name, loser.Points, loser.Wins, loser.Losses)
+	}
+	if pairing.Winner != pairing.Player1 || pairing.Player1Wins != 2 || pairing.Player2Wins != 0 {
+		t.Errorf("expected the pairing to keep the first result, got winner %s %d-%d",
+			pairing.Winner, pairing.Player1Wins, pairing.Player2Wins)
+	}
+}
+
+func TestRecordMatchResultSwappedPlayers(t *testing.T) {
+	tournament := NewTournament("Test", "Constructed", "controller1", "room1", 3, 2)
+
+	tournament.AddPlayer("Alice")
+	tournament.AddPlayer("Bob")
+	tournament.CreateRound()
+
+	// The players are given in the opposite order to the pairing's, with their own game wins
+	pairing := tournament.Rounds[0].Pairings[0]
+	if err := tournament.RecordMatchResult(1, pairing.Player2, pairing.Player1, pairing.Player2, 2, 1); err != nil {
+		t.Fatalf("failed to record match result: %v", err)
+	}
+
+	if pairing.Winner != pairing.Player2 {
+		t.Errorf("expected %s to win, got %s", pairing.Player2, pairing.Winner)
+	}
+	if pairing.Player1Wins != 1 || pairing.Player2Wins != 2 {
+		t.Errorf("expected %s 1 - %s 2, got %d-%d", pairing.Player1, pairing.Player2, pairing.Player1Wins, pairing.Player2Wins)
+	}
+}
+
 func TestMultipleRounds(t *testing.T) {
 	tournament := NewTournament("Test", "Constructed", "controller1", "room1", 3, 2)
 
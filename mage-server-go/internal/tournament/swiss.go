@@ -0,0 +1,301 @@
+package tournament
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Match points for a match won, drawn or lost; a bye counts as a match won
+const (
+	pointsForWin  = 3
+	pointsForDraw = 1
+)
+
+// minMatchWinPercent is the floor of a player's match-win percentage when computing their opponents'
+// tiebreakers, so one bad record doesn't sink everyone who played them
+const minMatchWinPercent = 1.0 / 3
+
+// Standing is a player's place in a tournament
+type Standing struct {
+	Rank                    int
+	Name                    string
+	Points                  int
+	Wins                    int
+	Losses                  int
+	Draws                   int
+	Byes                    int
+	MatchWinPercent         float64
+	OpponentMatchWinPercent float64 // First tiebreaker
+	Dropped                 bool    // Quit or eliminated; dropped players aren't paired
+}
+
+// GeneratePairings pairs the next round of a Swiss tournament and returns it; every match of the current
+// round must have its result recorded first, so pairings see the current points and opponents
+func (m *Manager) GeneratePairings(tournamentID string) (*Round, error) {
+	tournament, ok := m.GetTournament(tournamentID)
+	if !ok {
+		return nil, fmt.Errorf("tournament not found")
+	}
+
+	tournament.mu.Lock()
+	defer tournament.mu.Unlock()
+
+	if tournament.State != TournamentStateInProgress {
+		return nil, fmt.Errorf("tournament is not in progress")
+	}
+	if tournament.NumRounds > 0 && tournament.CurrentRound >= tournament.NumRounds {
+		return nil, fmt.Errorf("all %d rounds have been played", tournament.NumRounds)
+	}
+	if n := len(tournament.Rounds); n > 0 && !tournament.Rounds[n-1].Finished {
+		return nil, fmt.Errorf("round %d is not finished", tournament.Rounds[n-1].Number)
+	}
+	return tournament.newRound(true), nil
+}
+
+// GetStandings returns the standings of a tournament
+func (m *Manager) GetStandings(tournamentID string) ([]Standing, error) {
+	tournament, ok := m.GetTournament(tournamentID)
+	if !ok {
+		return nil, fmt.Errorf("tournament not found")
+	}
+	return tournament.Standings(), nil
+}
+
+// Standings returns the players ranked by match points, then by opponents' match-win percentage
+func (t *Tournament) Standings() []Standing {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.standings()
+}
+
+// newRound pairs the next round and adds it to the tournament; caller must hold the lock. A round without
+// matches (the only player left got the bye) is finished as soon as it is created.
+func (t *Tournament) newRound(started bool) *Round {
+	t.CurrentRound++
+	round := &Round{
+		Number:  t.CurrentRound,
+		Started: started,
+	}
+	round.Pairings, round.Bye = t.generatePairings()
+	round.Finished = len(round.Pairings) == 0
+	t.Rounds = append(t.Rounds, round)
+	return round
+}
+
+// generatePairings pairs the players still in the tournament for a new round (Swiss pairing).
+// Players are taken in order of standing and paired with the next-highest player they haven't played,
+// so they meet players of their score bracket or the nearest one. Pairings are backtracked so nobody
+// meets an opponent twice; if that is impossible, or the search gives up (see maxPairingSearch), players
+// are paired greedily and rematches are allowed. With an odd number of players, the lowest-ranked player
+// who hasn't had a bye gets one, worth a match win.
+func (t *Tournament) generatePairings() ([]*Pairing, string) {
+	active := make([]string, 0)
+	for _, standing := range t.standings() {
+		if !standing.Dropped {
+			active = append(active, standing.Name)
+		}
+	}
+	played := t.opponents()
+
+	bye := ""
+	if len(active)%2 == 1 {
+		bye = t.chooseBye(active, played)
+		active = removeName(active, bye)
+		player := t.Players[bye]
+		player.Points += pointsForWin
+		player.Wins++
+	}
+
+	matches, ok := pairWithoutRematches(active, played)
+	if !ok {
+		matches = pairGreedily(active, played)
+	}
+
+	pairings := make([]*Pairing, 0, len(matches))
+	for _, match := range matches {
+		pairings = append(pairings, &Pairing{Player1: match[0], Player2: match[1]})
+	}
+	return pairings, bye
+}
+
+// chooseBye returns the lowest-ranked player who hasn't had a bye, preferring one whose bye leaves
+// the others pairable without rematches
+func (t *Tournament) chooseBye(ranked []string, played map[string]map[string]bool) string {
+	byes := t.byes()
+	fallback := ""
+	for i := len(ranked) - 1; i >= 0; i-- {
+		name := ranked[i]
+		if byes[name] > 0 {
+			continue
+		}
+		if fallback == "" {
+			fallback = name
+		}
+		if _, ok := pairWithoutRematches(removeName(ranked, name), played); ok {
+			return name
+		}
+	}
+	if fallback == "" {
+		// Everyone has had a bye
+		return ranked[len(ranked)-1]
+	}
+	return fallback
+}
+
+// maxPairingSearch bounds the number of pairings pairWithoutRematches tries. Late in a large event there
+// may be no pairing without rematches, and proving that by backtracking takes exponential time.
+const maxPairingSearch = 10000
+
+// pairWithoutRematches pairs players in order, each with the next player they haven't played,
+// backtracking when the rest can't be paired; it reports false if no such pairing exists or none was
+// found within maxPairingSearch tries
+func pairWithoutRematches(players []string, played map[string]map[string]bool) ([][2]string, bool) {
+	budget := maxPairingSearch
+	return searchPairings(players, played, &budget)
+}
+
+// searchPairings is the backtracking search of pairWithoutRematches; budget is the number of tries left
+func searchPairings(players []string, played map[string]map[string]bool, budget *int) ([][2]string, bool) {
+	if len(players) == 0 {
+		return nil, true
+	}
+	first := players[0]
+	for i := 1; i < len(players); i++ {
+		if played[first][players[i]] {
+			continue
+		}
+		if *budget <= 0 {
+			return nil, false
+		}
+		*budget--
+		rest := make([]string, 0, len(players)-2)
+		rest = append(rest, players[1:i]...)
+		rest = append(rest, players[i+1:]...)
+		if matches, ok := searchPairings(rest, played, budget); ok {
+			return append([][2]string{{first, players[i]}}, matches...), true
+		}
+	}
+	return nil, false
+}
+
+// pairGreedily pairs players in order, each with the next player they haven't played if there is one
+// and otherwise with the next player
+func pairGreedily(players []string, played map[string]map[string]bool) [][2]string {
+	matches := make([][2]string, 0, len(players)/2)
+	remaining := players
+	for len(remaining) >= 2 {
+		first := remaining[0]
+		partner := 1
+		for i := 1; i < len(remaining); i++ {
+			if !played[first][remaining[i]] {
+				partner = i
+				break
+			}
+		}
+		matches = append(matches, [2]string{first, remaining[partner]})
+		remaining = removeName(remaining[1:], remaining[partner])
+	}
+	return matches
+}
+
+// opponents returns who each player has been paired against
+func (t *Tournament) opponents() map[string]map[string]bool {
+	played := make(map[string]map[string]bool)
+	for _, round := range t.Rounds {
+		for _, pairing := range round.Pairings {
+			if played[pairing.Player1] == nil {
+				played[pairing.Player1] = make(map[string]bool)
+			}
+			if played[pairing.Player2] == nil {
+				played[pairing.Player2] = make(map[string]bool)
+			}
+			played[pairing.Player1][pairing.Player2] = true
+			played[pairing.Player2][pairing.Player1] = true
+		}
+	}
+	return played
+}
+
+// byes returns how many byes each player has had
+func (t *Tournament) byes() map[string]int {
+	byes := make(map[string]int)
+	for _, round := range t.Rounds {
+		if round.Bye != "" {
+			byes[round.Bye]++
+		}
+	}
+	return byes
+}
+
+// matchWinPercent returns the share of the match points a player could have earned that they did
+func matchWinPercent(player *Player) float64 {
+	matches := player.Wins + player.Losses + player.Draws
+	if matches == 0 {
+		return 0
+	}
+	return float64(player.Points) / float64(pointsForWin*matches)
+}
+
+// standings ranks the players; caller must hold the lock
+func (t *Tournament) standings() []Standing {
+	played := t.opponents()
+	byes := t.byes()
+
+	standings := make([]Standing, 0, len(t.PlayerOrder))
+	order := make(map[string]int, len(t.PlayerOrder))
+	for i, name := range t.PlayerOrder {
+		player, ok := t.Players[name]
+		if !ok {
+			continue
+		}
+		order[name] = i
+
+		// Byes don't count towards opponents' match-win percentage
+		opponentPercent := 0.0
+		for opponent := range played[name] {
+			if other, ok := t.Players[opponent]; ok {
+				opponentPercent += max(matchWinPercent(other), minMatchWinPercent)
+			}
+		}
+		if len(played[name]) > 0 {
+			opponentPercent /= float64(len(played[name]))
+		}
+
+		standings = append(standings, Standing{
+			Name:                    name,
+			Points:                  player.Points,
+			Wins:                    player.Wins,
+			Losses:                  player.Losses,
+			Draws:                   player.Draws,
+			Byes:                    byes[name],
+			MatchWinPercent:         matchWinPercent(player),
+			OpponentMatchWinPercent: opponentPercent,
+			Dropped:                 player.Quit || player.Eliminated,
+		})
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		if a.OpponentMatchWinPercent != b.OpponentMatchWinPercent {
+			return a.OpponentMatchWinPercent > b.OpponentMatchWinPercent
+		}
+		return order[a.Name] < order[b.Name]
+	})
+	for i := range standings {
+		standings[i].Rank = i + 1
+	}
+	return standings
+}
+
+func removeName(names []string, name string) []string {
+	result := make([]string, 0, len(names))
+	for _, n := range names {
+		if n != name {
+			result = append(result, n)
+		}
+	}
+	return result
+}
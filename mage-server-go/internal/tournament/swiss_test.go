@@ -0,0 +1,215 @@
+package tournament
+
+import (
+	"fmt"
+	"testing"
+
+	"go.uber.org/zap/zaptest"
+)
+
+func TestSwissPairingsFourRounds(t *testing.T) {
+	mgr := NewManager(zaptest.NewLogger(t))
+	tournament := mgr.CreateTournament("Swiss", "Constructed", "Alice", "room1", 4, 2)
+	names := []string{"Alice", "Bob", "Charlie", "Dave", "Erin", "Frank", "Grace", "Heidi"}
+	for _, name := range names {
+		if err := tournament.AddPlayer(name); err != nil {
+			t.Fatalf("failed to add player: %v", err)
+		}
+	}
+	if err := tournament.Start(); err != nil {
+		t.Fatalf("failed to start: %v", err)
+	}
+
+	met := make(map[[2]string]int)
+	byes := make(map[string]int)
+	for roundNum := 1; roundNum <= 4; roundNum++ {
+		if roundNum > 1 {
+			// Heidi drops after round 2, leaving an odd field
+			if roundNum == 3 {
+				if err := tournament.QuitPlayer("Heidi"); err != nil {
+					t.Fatalf("failed to drop player: %v", err)
+				}
+			}
+			standings := tournament.Standings()
+			if _, err := mgr.GeneratePairings(tournament.ID); err != nil {
+				t.Fatalf("failed to pair round %d: %v", roundNum, err)
+			}
+			round := tournament.Rounds[roundNum-1]
+			if roundNum >= 3 {
+				// The bye goes to the lowest-ranked player still in who hasn't had one
+				expected := ""
+				for i := len(standings) - 1; i >= 0; i-- {
+					if !standings[i].Dropped && byes[standings[i].Name] == 0 {
+						expected = standings[i].Name
+						break
+					}
+				}
+				if round.Bye != expected {
+					t.Errorf("round %d: expected the bye to go to %s, got %q", roundNum, expected, round.Bye)
+				}
+				byes[round.Bye]++
+			} else if round.Bye != "" {
+				t.Errorf("round %d: expected no bye with 8 players, got %s", roundNum, round.Bye)
+			}
+		}
+
+		round := tournament.Rounds[roundNum-1]
+		seen := make(map[string]bool)
+		for _, pairing := range round.Pairings {
+			for _, name := range []string{pairing.Player1, pairing.Player2} {
+				if seen[name] || name == round.Bye || name == "Heidi" && roundNum > 2 {
+					t.Errorf("round %d: %s was paired illegally", roundNum, name)
+				}
+				seen[name] = true
+			}
+			key := [2]string{min(pairing.Player1, pairing.Player2), max(pairing.Player1, pairing.Player2)}
+			if met[key]++; met[key] > 1 {
+				t.Errorf("round %d: rematch between %s and %s", roundNum, key[0], key[1])
+			}
+			if err := tournament.RecordMatchResult(roundNum, pairing.Player1, pairing.Player2, pairing.Player1, 2, 0); err != nil {
+				t.Fatalf("failed to record result: %v", err)
+			}
+		}
+	}
+
+	for name, count := range byes {
+		if count > 1 {
+			t.Errorf("expected %s to get at most one bye, got %d", name, count)
+		}
+	}
+	if _, err := mgr.GeneratePairings(tournament.ID); err == nil {
+		t.Error("expected no fifth round in a 4-round event")
+	}
+
+	standings, err := mgr.GetStandings(tournament.ID)
+	if err != nil {
+		t.Fatalf("failed to get standings: %v", err)
+	}
+	for i := 1; i < len(standings); i++ {
+		prev, cur := standings[i-1], standings[i]
+		if prev.Points < cur.Points || prev.Points == cur.Points && prev.OpponentMatchWinPercent < cur.OpponentMatchWinPercent {
+			t.Errorf("expected standings ordered by points then tiebreaker, got %+v before %+v", prev, cur)
+		}
+	}
+	if standings[0].Points != 12 {
+		t.Errorf("expected the leader to have won all 4 rounds, got %d points", standings[0].Points)
+	}
+}
+
+func TestSwissPairingsWaitForResults(t *testing.T) {
+	mgr := NewManager(zaptest.NewLogger(t))
+	tournament := mgr.CreateTournament("Swiss", "Constructed", "Alice", "room1", 3, 2)
+	for _, name := range []string{"Alice", "Bob", "Charlie", "Dave"} {
+		if err := tournament.AddPlayer(name); err != nil {
+			t.Fatalf("failed to add player: %v", err)
+		}
+	}
+	if err := tournament.Start(); err != nil {
+		t.Fatalf("failed to start: %v", err)
+	}
+
+	round := tournament.Rounds[0]
+	first := round.Pairings[0]
+	if err := tournament.RecordMatchResult(1, first.Player1, first.Player2, first.Player1, 2, 0); err != nil {
+		t.Fatalf("failed to record result: %v", err)
+	}
+	if _, err := mgr.GeneratePairings(tournament.ID); err == nil {
+		t.Fatal("expected pairing to wait until every match of round 1 has a result")
+	}
+	if round.Finished {
+		t.Error("expected round 1 to be unfinished with a match still playing")
+	}
+
+	second := round.Pairings[1]
+	if err := tournament.RecordMatchResult(1, second.Player1, second.Player2, "", 1, 1); err != nil {
+		t.Fatalf("failed to record result: %v", err)
+	}
+	if !round.Finished {
+		t.Error("expected round 1 to be finished once every match has a result")
+	}
+	if _, err := mgr.GeneratePairings(tournament.ID); err != nil {
+		t.Fatalf("failed to pair round 2: %v", err)
+	}
+}
+
+func TestSwissRoundWithOnlyByeIsFinished(t *testing.T) {
+	mgr := NewManager(zaptest.NewLogger(t))
+	tournament := mgr.CreateTournament("Swiss", "Constructed", "Alice", "room1", 3, 2)
+	for _, name := range []string{"Alice", "Bob", "Charlie"} {
+		if err := tournament.AddPlayer(name); err != nil {
+			t.Fatalf("failed to add player: %v", err)
+		}
+	}
+	if err := tournament.Start(); err != nil {
+		t.Fatalf("failed to start: %v", err)
+	}
+
+	// Everyone but the round 1 bye drops after their match, leaving one player for round 2
+	pairing := tournament.Rounds[0].Pairings[0]
+	if err := tournament.RecordMatchResult(1, pairing.Player1, pairing.Player2, pairing.Player1, 2, 0); err != nil {
+		t.Fatalf("failed to record result: %v", err)
+	}
+	for _, name := range []string{pairing.Player1, pairing.Player2} {
+		if err := tournament.QuitPlayer(name); err != nil {
+			t.Fatalf("failed to quit %s: %v", name, err)
+		}
+	}
+
+	round, err := mgr.GeneratePairings(tournament.ID)
+	if err != nil {
+		t.Fatalf("failed to pair round 2: %v", err)
+	}
+	if len(round.Pairings) != 0 || round.Bye != tournament.Rounds[0].Bye {
+		t.Fatalf("expected round 2 to be only %s's bye, got %d pairings and bye %q",
+			tournament.Rounds[0].Bye, len(round.Pairings), round.Bye)
+	}
+	if !round.Finished {
+		t.Error("expected a round without matches to be finished")
+	}
+	if _, err := mgr.GeneratePairings(tournament.ID); err != nil {
+		t.Fatalf("failed to pair round 3 after a round without matches: %v", err)
+	}
+}
+
+func TestSwissPairingSearchIsBounded(t *testing.T) {
+	// Zed has played everyone else, so there is no pairing without a rematch; proving it by exhaustive
+	// backtracking over 40 players would never finish
+	players := make([]string, 0, 40)
+	played := make(map[string]map[string]bool)
+	for i := 0; i < 39; i++ {
+		players = append(players, fmt.Sprintf("Player%02d", i))
+	}
+	players = append(players, "Zed")
+	for _, name := range players {
+		played[name] = make(map[string]bool)
+	}
+	for _, name := range players[:39] {
+		played[name]["Zed"] = true
+		played["Zed"][name] = true
+	}
+
+	if _, ok := pairWithoutRematches(players, played); ok {
+		t.Fatal("expected no pairing without rematches")
+	}
+
+	matches := pairGreedily(players, played)
+	if len(matches) != 20 {
+		t.Fatalf("expected 20 matches, got %d", len(matches))
+	}
+	seen := make(map[string]bool)
+	rematches := 0
+	for _, match := range matches {
+		for _, name := range match {
+			if seen[name] {
+				t.Errorf("%s was paired twice", name)
+			}
+			seen[name] = true
+		}
+		if played[match[0]][match[1]] {
+			rematches++
+		}
+	}
+	if rematches != 1 {
+		t.Errorf("expected only Zed's match to be a rematch, got %d rematches", rematches)
+	}
+}
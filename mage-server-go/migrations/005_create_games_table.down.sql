@@ -0,0 +1,8 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_games_updated_at ON games;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_games_updated_at;
+
+-- Drop table
+DROP TABLE IF EXISTS games;
@@ -0,0 +1,17 @@
+-- Create games table for saving in-progress game state, so games survive a server restart
+CREATE TABLE IF NOT EXISTS games (
+    game_id VARCHAR(255) PRIMARY KEY,
+    game_type VARCHAR(100),
+    turn_number INTEGER NOT NULL DEFAULT 0,
+    state_data BYTEA NOT NULL,  -- JSON serialized engine state (MageEngine.SerializeGame)
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create index for finding recently saved games
+CREATE INDEX IF NOT EXISTS idx_games_updated_at ON games(updated_at DESC);
+
+-- Trigger to update updated_at timestamp
+CREATE TRIGGER update_games_updated_at BEFORE UPDATE
+    ON games FOR EACH ROW
+    EXECUTE PROCEDURE update_updated_at_column();
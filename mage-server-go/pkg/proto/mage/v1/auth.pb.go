@@ -173,6 +173,126 @@ func (x *ConnectUserResponse) GetUserId() string {
 	return ""
 }
 
+type ResumeSessionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ReconnectToken string                 `protobuf:"bytes,1,opt,name=reconnect_token,json=reconnectToken,proto3" json:"reconnect_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResumeSessionRequest) Reset() {
+	*x = ResumeSessionRequest{}
+	mi := &file_mage_v1_auth_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSessionRequest) ProtoMessage() {}
+
+func (x *ResumeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mage_v1_auth_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSessionRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_mage_v1_auth_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ResumeSessionRequest) GetReconnectToken() string {
+	if x != nil {
+		return x.ReconnectToken
+	}
+	return ""
+}
+
+type ResumeSessionResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error          string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	SessionId      string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	GameId         string                 `protobuf:"bytes,4,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	ReconnectToken string                 `protobuf:"bytes,5,opt,name=reconnect_token,json=reconnectToken,proto3" json:"reconnect_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResumeSessionResponse) Reset() {
+	*x = ResumeSessionResponse{}
+	mi := &file_mage_v1_auth_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSessionResponse) ProtoMessage() {}
+
+func (x *ResumeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mage_v1_auth_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSessionResponse.ProtoReflect.Descriptor instead.
+func (*ResumeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_mage_v1_auth_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResumeSessionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ResumeSessionResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ResumeSessionResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ResumeSessionResponse) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ResumeSessionResponse) GetReconnectToken() string {
+	if x != nil {
+		return x.ReconnectToken
+	}
+	return ""
+}
+
 type ConnectAdminRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
@@ -183,7 +303,7 @@ type ConnectAdminRequest struct {
 
 func (x *ConnectAdminRequest) Reset() {
 	*x = ConnectAdminRequest{}
-	mi := &file_mage_v1_auth_proto_msgTypes[2]
+	mi := &file_mage_v1_auth_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -195,7 +315,7 @@ func (x *ConnectAdminRequest) String() string {
 func (*ConnectAdminRequest) ProtoMessage() {}
 
 func (x *ConnectAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mage_v1_auth_proto_msgTypes[2]
+	mi := &file_mage_v1_auth_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -208,7 +328,7 @@ func (x *ConnectAdminRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectAdminRequest.ProtoReflect.Descriptor instead.
 func (*ConnectAdminRequest) Descriptor() ([]byte, []int) {
-	return file_mage_v1_auth_proto_rawDescGZIP(), []int{2}
+	return file_mage_v1_auth_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ConnectAdminRequest) GetPassword() string {
@@ -235,7 +355,7 @@ type ConnectAdminResponse struct {
 
 func (x *ConnectAdminResponse) Reset() {
 	*x = ConnectAdminResponse{}
-	mi := &file_mage_v1_auth_proto_msgTypes[3]
+	mi := &file_mage_v1_auth_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -247,7 +367,7 @@ func (x *ConnectAdminResponse) String() string {
 func (*ConnectAdminResponse) ProtoMessage() {}
 
 func (x *ConnectAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mage_v1_auth_proto_msgTypes[3]
+	mi := &file_mage_v1_auth_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -260,7 +380,7 @@ func (x *ConnectAdminResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectAdminResponse.ProtoReflect.Descriptor instead.
 func (*ConnectAdminResponse) Descriptor() ([]byte, []int) {
-	return file_mage_v1_auth_proto_rawDescGZIP(), []int{3}
+	return file_mage_v1_auth_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ConnectAdminResponse) GetSuccess() bool {
@@ -294,7 +414,7 @@ type ConnectSetUserDataRequest struct {
 
 func (x *ConnectSetUserDataRequest) Reset() {
 	*x = ConnectSetUserDataRequest{}
-	mi := &file_mage_v1_auth_proto_msgTypes[4]
+	mi := &file_mage_v1_auth_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -306,7 +426,7 @@ func (x *ConnectSetUserDataRequest) String() string {
 func (*ConnectSetUserDataRequest) ProtoMessage() {}
 
 func (x *ConnectSetUserDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mage_v1_auth_proto_msgTypes[4]
+	mi := &file_mage_v1_auth_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -319,7 +439,7 @@ func (x *ConnectSetUserDataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectSetUserDataRequest.ProtoReflect.Descriptor instead.
 func (*ConnectSetUserDataRequest) Descriptor() ([]byte, []int) {
-	return file_mage_v1_auth_proto_rawDescGZIP(), []int{4}
+	return file_mage_v1_auth_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ConnectSetUserDataRequest) GetSessionId() string {
@@ -395,7 +515,7 @@ type ConnectSetUserDataResponse struct {
 
 func (x *ConnectSetUserDataResponse) Reset() {
 	*x = ConnectSetUserDataResponse{}
-	mi := &file_mage_v1_auth_proto_msgTypes[5]
+	mi := &file_mage_v1_auth_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -407,7 +527,7 @@ func (x *ConnectSetUserDataResponse) String() string {
 func (*ConnectSetUserDataResponse) ProtoMessage() {}
 
 func (x *ConnectSetUserDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mage_v1_auth_proto_msgTypes[5]
+	mi := &file_mage_v1_auth_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,7 +540,7 @@ func (x *ConnectSetUserDataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectSetUserDataResponse.ProtoReflect.Descriptor instead.
 func (*ConnectSetUserDataResponse) Descriptor() ([]byte, []int) {
-	return file_mage_v1_auth_proto_rawDescGZIP(), []int{5}
+	return file_mage_v1_auth_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ConnectSetUserDataResponse) GetSuccess() bool {
@@ -448,7 +568,7 @@ type AuthRegisterRequest struct {
 
 func (x *AuthRegisterRequest) Reset() {
 	*x = AuthRegisterRequest{}
-	mi := &file_mage_v1_auth_proto_msgTypes[6]
+	mi := &file_mage_v1_auth_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -460,7 +580,7 @@ func (x *AuthRegisterRequest) String() string {
 func (*AuthRegisterRequest) ProtoMessage() {}
 
 func (x *AuthRegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mage_v1_auth_proto_msgTypes[6]
+	mi := &file_mage_v1_auth_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,7 +593,7 @@ func (x *AuthRegisterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthRegisterRequest.ProtoReflect.Descriptor instead.
 func (*AuthRegisterRequest) Descriptor() ([]byte, []int) {
-	return file_mage_v1_auth_proto_rawDescGZIP(), []int{6}
+	return file_mage_v1_auth_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AuthRegisterRequest) GetUserName() string {
@@ -507,7 +627,7 @@ type AuthRegisterResponse struct {
 
 func (x *AuthRegisterResponse) Reset() {
 	*x = AuthRegisterResponse{}
-	mi := &file_mage_v1_auth_proto_msgTypes[7]
+	mi := &file_mage_v1_auth_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -519,7 +639,7 @@ func (x *AuthRegisterResponse) String() string {
 func (*AuthRegisterResponse) ProtoMessage() {}
 
 func (x *AuthRegisterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mage_v1_auth_proto_msgTypes[7]
+	mi := &file_mage_v1_auth_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +652,7 @@ func (x *AuthRegisterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthRegisterResponse.ProtoReflect.Descriptor instead.
 func (*AuthRegisterResponse) Descriptor() ([]byte, []int) {
-	return file_mage_v1_auth_proto_rawDescGZIP(), []int{7}
+	return file_mage_v1_auth_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *AuthRegisterResponse) GetSuccess() bool {
@@ -558,7 +678,7 @@ type AuthSendTokenToEmailRequest struct {
 
 func (x *AuthSendTokenToEmailRequest) Reset() {
 	*x = AuthSendTokenToEmailRequest{}
-	mi := &file_mage_v1_auth_proto_msgTypes[8]
+	mi := &file_mage_v1_auth_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -570,7 +690,7 @@ func (x *AuthSendTokenToEmailRequest) String() string {
 func (*AuthSendTokenToEmailRequest) ProtoMessage() {}
 
 func (x *AuthSendTokenToEmailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mage_v1_auth_proto_msgTypes[8]
+	mi := &file_mage_v1_auth_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -583,7 +703,7 @@ func (x *AuthSendTokenToEmailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthSendTokenToEmailRequest.ProtoReflect.Descriptor instead.
 func (*AuthSendTokenToEmailRequest) Descriptor() ([]byte, []int) {
-	return file_mage_v1_auth_proto_rawDescGZIP(), []int{8}
+	return file_mage_v1_auth_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AuthSendTokenToEmailRequest) GetEmail() string {
@@ -603,7 +723,7 @@ type AuthSendTokenToEmailResponse struct {
 
 func (x *AuthSendTokenToEmailResponse) Reset() {
 	*x = AuthSendTokenToEmailResponse{}
-	mi := &file_mage_v1_auth_proto_msgTypes[9]
+	mi := &file_mage_v1_auth_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -615,7 +735,7 @@ func (x *AuthSendTokenToEmailResponse) String() string {
 func (*AuthSendTokenToEmailResponse) ProtoMessage() {}
 
 func (x *AuthSendTokenToEmailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mage_v1_auth_proto_msgTypes[9]
+	mi := &file_mage_v1_auth_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -628,7 +748,7 @@ func (x *AuthSendTokenToEmailResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthSendTokenToEmailResponse.ProtoReflect.Descriptor instead.
 func (*AuthSendTokenToEmailResponse) Descriptor() ([]byte, []int) {
-	return file_mage_v1_auth_proto_rawDescGZIP(), []int{9}
+	return file_mage_v1_auth_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AuthSendTokenToEmailResponse) GetSuccess() bool {
@@ -656,7 +776,7 @@ type AuthResetPasswordRequest struct {
 
 func (x *AuthResetPasswordRequest) Reset() {
 	*x = AuthResetPasswordRequest{}
-	mi := &file_mage_v1_auth_proto_msgTypes[10]
+	mi := &file_mage_v1_auth_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -668,7 +788,7 @@ func (x *AuthResetPasswordRequest) String() string {
 func (*AuthResetPasswordRequest) ProtoMessage() {}
 
 func (x *AuthResetPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mage_v1_auth_proto_msgTypes[10]
+	mi := &file_mage_v1_auth_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -681,7 +801,7 @@ func (x *AuthResetPasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthResetPasswordRequest.ProtoReflect.Descriptor instead.
 func (*AuthResetPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_mage_v1_auth_proto_rawDescGZIP(), []int{10}
+	return file_mage_v1_auth_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AuthResetPasswordRequest) GetEmail() string {
@@ -715,7 +835,7 @@ type AuthResetPasswordResponse struct {
 
 func (x *AuthResetPasswordResponse) Reset() {
 	*x = AuthResetPasswordResponse{}
-	mi := &file_mage_v1_auth_proto_msgTypes[11]
+	mi := &file_mage_v1_auth_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -727,7 +847,7 @@ func (x *AuthResetPasswordResponse) String() string {
 func (*AuthResetPasswordResponse) ProtoMessage() {}
 
 func (x *AuthResetPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mage_v1_auth_proto_msgTypes[11]
+	mi := &file_mage_v1_auth_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -740,7 +860,7 @@ func (x *AuthResetPasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthResetPasswordResponse.ProtoReflect.Descriptor instead.
 func (*AuthResetPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_mage_v1_auth_proto_rawDescGZIP(), []int{11}
+	return file_mage_v1_auth_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AuthResetPasswordResponse) GetSuccess() bool {
@@ -766,7 +886,7 @@ type PingRequest struct {
 
 func (x *PingRequest) Reset() {
 	*x = PingRequest{}
-	mi := &file_mage_v1_auth_proto_msgTypes[12]
+	mi := &file_mage_v1_auth_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -778,7 +898,7 @@ func (x *PingRequest) String() string {
 func (*PingRequest) ProtoMessage() {}
 
 func (x *PingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mage_v1_auth_proto_msgTypes[12]
+	mi := &file_mage_v1_auth_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -791,7 +911,7 @@ func (x *PingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
 func (*PingRequest) Descriptor() ([]byte, []int) {
-	return file_mage_v1_auth_proto_rawDescGZIP(), []int{12}
+	return file_mage_v1_auth_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *PingRequest) GetSessionId() string {
@@ -810,7 +930,7 @@ type PingResponse struct {
 
 func (x *PingResponse) Reset() {
 	*x = PingResponse{}
-	mi := &file_mage_v1_auth_proto_msgTypes[13]
+	mi := &file_mage_v1_auth_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -822,7 +942,7 @@ func (x *PingResponse) String() string {
 func (*PingResponse) ProtoMessage() {}
 
 func (x *PingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mage_v1_auth_proto_msgTypes[13]
+	mi := &file_mage_v1_auth_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -835,7 +955,7 @@ func (x *PingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
 func (*PingResponse) Descriptor() ([]byte, []int) {
-	return file_mage_v1_auth_proto_rawDescGZIP(), []int{13}
+	return file_mage_v1_auth_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *PingResponse) GetSuccess() bool {
@@ -854,7 +974,7 @@ type GetServerStateRequest struct {
 
 func (x *GetServerStateRequest) Reset() {
 	*x = GetServerStateRequest{}
-	mi := &file_mage_v1_auth_proto_msgTypes[14]
+	mi := &file_mage_v1_auth_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -866,7 +986,7 @@ func (x *GetServerStateRequest) String() string {
 func (*GetServerStateRequest) ProtoMessage() {}
 
 func (x *GetServerStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mage_v1_auth_proto_msgTypes[14]
+	mi := &file_mage_v1_auth_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -879,7 +999,7 @@ func (x *GetServerStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServerStateRequest.ProtoReflect.Descriptor instead.
 func (*GetServerStateRequest) Descriptor() ([]byte, []int) {
-	return file_mage_v1_auth_proto_rawDescGZIP(), []int{14}
+	return file_mage_v1_auth_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetServerStateRequest) GetSessionId() string {
@@ -898,7 +1018,7 @@ type GetServerStateResponse struct {
 
 func (x *GetServerStateResponse) Reset() {
 	*x = GetServerStateResponse{}
-	mi := &file_mage_v1_auth_proto_msgTypes[15]
+	mi := &file_mage_v1_auth_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -910,7 +1030,7 @@ func (x *GetServerStateResponse) String() string {
 func (*GetServerStateResponse) ProtoMessage() {}
 
 func (x *GetServerStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mage_v1_auth_proto_msgTypes[15]
+	mi := &file_mage_v1_auth_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -923,7 +1043,7 @@ func (x *GetServerStateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServerStateResponse.ProtoReflect.Descriptor instead.
 func (*GetServerStateResponse) Descriptor() ([]byte, []int) {
-	return file_mage_v1_auth_proto_rawDescGZIP(), []int{15}
+	return file_mage_v1_auth_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetServerStateResponse) GetServerState() *ServerState {
@@ -942,7 +1062,7 @@ type ServerGetPromotionMessagesRequest struct {
 
 func (x *ServerGetPromotionMessagesRequest) Reset() {
 	*x = ServerGetPromotionMessagesRequest{}
-	mi := &file_mage_v1_auth_proto_msgTypes[16]
+	mi := &file_mage_v1_auth_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -954,7 +1074,7 @@ func (x *ServerGetPromotionMessagesRequest) String() string {
 func (*ServerGetPromotionMessagesRequest) ProtoMessage() {}
 
 func (x *ServerGetPromotionMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mage_v1_auth_proto_msgTypes[16]
+	mi := &file_mage_v1_auth_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -967,7 +1087,7 @@ func (x *ServerGetPromotionMessagesRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ServerGetPromotionMessagesRequest.ProtoReflect.Descriptor instead.
 func (*ServerGetPromotionMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_mage_v1_auth_proto_rawDescGZIP(), []int{16}
+	return file_mage_v1_auth_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ServerGetPromotionMessagesRequest) GetSessionId() string {
@@ -986,7 +1106,7 @@ type ServerGetPromotionMessagesResponse struct {
 
 func (x *ServerGetPromotionMessagesResponse) Reset() {
 	*x = ServerGetPromotionMessagesResponse{}
-	mi := &file_mage_v1_auth_proto_msgTypes[17]
+	mi := &file_mage_v1_auth_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -998,7 +1118,7 @@ func (x *ServerGetPromotionMessagesResponse) String() string {
 func (*ServerGetPromotionMessagesResponse) ProtoMessage() {}
 
 func (x *ServerGetPromotionMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mage_v1_auth_proto_msgTypes[17]
+	mi := &file_mage_v1_auth_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1011,7 +1131,7 @@ func (x *ServerGetPromotionMessagesResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ServerGetPromotionMessagesResponse.ProtoReflect.Descriptor instead.
 func (*ServerGetPromotionMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_mage_v1_auth_proto_rawDescGZIP(), []int{17}
+	return file_mage_v1_auth_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ServerGetPromotionMessagesResponse) GetMessages() []string {
@@ -1035,7 +1155,7 @@ type ServerAddFeedbackMessageRequest struct {
 
 func (x *ServerAddFeedbackMessageRequest) Reset() {
 	*x = ServerAddFeedbackMessageRequest{}
-	mi := &file_mage_v1_auth_proto_msgTypes[18]
+	mi := &file_mage_v1_auth_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1047,7 +1167,7 @@ func (x *ServerAddFeedbackMessageRequest) String() string {
 func (*ServerAddFeedbackMessageRequest) ProtoMessage() {}
 
 func (x *ServerAddFeedbackMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mage_v1_auth_proto_msgTypes[18]
+	mi := &file_mage_v1_auth_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1060,7 +1180,7 @@ func (x *ServerAddFeedbackMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerAddFeedbackMessageRequest.ProtoReflect.Descriptor instead.
 func (*ServerAddFeedbackMessageRequest) Descriptor() ([]byte, []int) {
-	return file_mage_v1_auth_proto_rawDescGZIP(), []int{18}
+	return file_mage_v1_auth_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ServerAddFeedbackMessageRequest) GetSessionId() string {
@@ -1115,7 +1235,7 @@ type ServerAddFeedbackMessageResponse struct {
 
 func (x *ServerAddFeedbackMessageResponse) Reset() {
 	*x = ServerAddFeedbackMessageResponse{}
-	mi := &file_mage_v1_auth_proto_msgTypes[19]
+	mi := &file_mage_v1_auth_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1127,7 +1247,7 @@ func (x *ServerAddFeedbackMessageResponse) String() string {
 func (*ServerAddFeedbackMessageResponse) ProtoMessage() {}
 
 func (x *ServerAddFeedbackMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mage_v1_auth_proto_msgTypes[19]
+	mi := &file_mage_v1_auth_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1140,7 +1260,7 @@ func (x *ServerAddFeedbackMessageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerAddFeedbackMessageResponse.ProtoReflect.Descriptor instead.
 func (*ServerAddFeedbackMessageResponse) Descriptor() ([]byte, []int) {
-	return file_mage_v1_auth_proto_rawDescGZIP(), []int{19}
+	return file_mage_v1_auth_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ServerAddFeedbackMessageResponse) GetSuccess() bool {
@@ -1175,7 +1295,16 @@ const file_mage_v1_auth_proto_rawDesc = "" +
 	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x03 \x01(\tR\tsessionId\x12\x17\n" +
-	"\auser_id\x18\x04 \x01(\tR\x06userId\"P\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\"?\n" +
+	"\x14ResumeSessionRequest\x12'\n" +
+	"\x0freconnect_token\x18\x01 \x01(\tR\x0ereconnectToken\"\xa8\x01\n" +
+	"\x15ResumeSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\x12\x17\n" +
+	"\agame_id\x18\x04 \x01(\tR\x06gameId\x12'\n" +
+	"\x0freconnect_token\x18\x05 \x01(\tR\x0ereconnectToken\"P\n" +
 	"\x13ConnectAdminRequest\x12\x1a\n" +
 	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x1d\n" +
 	"\n" +
@@ -1257,32 +1386,34 @@ func file_mage_v1_auth_proto_rawDescGZIP() []byte {
 	return file_mage_v1_auth_proto_rawDescData
 }
 
-var file_mage_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_mage_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_mage_v1_auth_proto_goTypes = []any{
 	(*ConnectUserRequest)(nil),                 // 0: mage.v1.ConnectUserRequest
 	(*ConnectUserResponse)(nil),                // 1: mage.v1.ConnectUserResponse
-	(*ConnectAdminRequest)(nil),                // 2: mage.v1.ConnectAdminRequest
-	(*ConnectAdminResponse)(nil),               // 3: mage.v1.ConnectAdminResponse
-	(*ConnectSetUserDataRequest)(nil),          // 4: mage.v1.ConnectSetUserDataRequest
-	(*ConnectSetUserDataResponse)(nil),         // 5: mage.v1.ConnectSetUserDataResponse
-	(*AuthRegisterRequest)(nil),                // 6: mage.v1.AuthRegisterRequest
-	(*AuthRegisterResponse)(nil),               // 7: mage.v1.AuthRegisterResponse
-	(*AuthSendTokenToEmailRequest)(nil),        // 8: mage.v1.AuthSendTokenToEmailRequest
-	(*AuthSendTokenToEmailResponse)(nil),       // 9: mage.v1.AuthSendTokenToEmailResponse
-	(*AuthResetPasswordRequest)(nil),           // 10: mage.v1.AuthResetPasswordRequest
-	(*AuthResetPasswordResponse)(nil),          // 11: mage.v1.AuthResetPasswordResponse
-	(*PingRequest)(nil),                        // 12: mage.v1.PingRequest
-	(*PingResponse)(nil),                       // 13: mage.v1.PingResponse
-	(*GetServerStateRequest)(nil),              // 14: mage.v1.GetServerStateRequest
-	(*GetServerStateResponse)(nil),             // 15: mage.v1.GetServerStateResponse
-	(*ServerGetPromotionMessagesRequest)(nil),  // 16: mage.v1.ServerGetPromotionMessagesRequest
-	(*ServerGetPromotionMessagesResponse)(nil), // 17: mage.v1.ServerGetPromotionMessagesResponse
-	(*ServerAddFeedbackMessageRequest)(nil),    // 18: mage.v1.ServerAddFeedbackMessageRequest
-	(*ServerAddFeedbackMessageResponse)(nil),   // 19: mage.v1.ServerAddFeedbackMessageResponse
-	(*ServerState)(nil),                        // 20: mage.v1.ServerState
+	(*ResumeSessionRequest)(nil),               // 2: mage.v1.ResumeSessionRequest
+	(*ResumeSessionResponse)(nil),              // 3: mage.v1.ResumeSessionResponse
+	(*ConnectAdminRequest)(nil),                // 4: mage.v1.ConnectAdminRequest
+	(*ConnectAdminResponse)(nil),               // 5: mage.v1.ConnectAdminResponse
+	(*ConnectSetUserDataRequest)(nil),          // 6: mage.v1.ConnectSetUserDataRequest
+	(*ConnectSetUserDataResponse)(nil),         // 7: mage.v1.ConnectSetUserDataResponse
+	(*AuthRegisterRequest)(nil),                // 8: mage.v1.AuthRegisterRequest
+	(*AuthRegisterResponse)(nil),               // 9: mage.v1.AuthRegisterResponse
+	(*AuthSendTokenToEmailRequest)(nil),        // 10: mage.v1.AuthSendTokenToEmailRequest
+	(*AuthSendTokenToEmailResponse)(nil),       // 11: mage.v1.AuthSendTokenToEmailResponse
+	(*AuthResetPasswordRequest)(nil),           // 12: mage.v1.AuthResetPasswordRequest
+	(*AuthResetPasswordResponse)(nil),          // 13: mage.v1.AuthResetPasswordResponse
+	(*PingRequest)(nil),                        // 14: mage.v1.PingRequest
+	(*PingResponse)(nil),                       // 15: mage.v1.PingResponse
+	(*GetServerStateRequest)(nil),              // 16: mage.v1.GetServerStateRequest
+	(*GetServerStateResponse)(nil),             // 17: mage.v1.GetServerStateResponse
+	(*ServerGetPromotionMessagesRequest)(nil),  // 18: mage.v1.ServerGetPromotionMessagesRequest
+	(*ServerGetPromotionMessagesResponse)(nil), // 19: mage.v1.ServerGetPromotionMessagesResponse
+	(*ServerAddFeedbackMessageRequest)(nil),    // 20: mage.v1.ServerAddFeedbackMessageRequest
+	(*ServerAddFeedbackMessageResponse)(nil),   // 21: mage.v1.ServerAddFeedbackMessageResponse
+	(*ServerState)(nil),                        // 22: mage.v1.ServerState
 }
 var file_mage_v1_auth_proto_depIdxs = []int32{
-	20, // 0: mage.v1.GetServerStateResponse.server_state:type_name -> mage.v1.ServerState
+	22, // 0: mage.v1.GetServerStateResponse.server_state:type_name -> mage.v1.ServerState
 	1,  // [1:1] is the sub-list for method output_type
 	1,  // [1:1] is the sub-list for method input_type
 	1,  // [1:1] is the sub-list for extension type_name
@@ -1302,7 +1433,7 @@ func file_mage_v1_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mage_v1_auth_proto_rawDesc), len(file_mage_v1_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -509,6 +509,7 @@ type SendPlayerUUIDRequest struct {
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 	Uuid          string                 `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Nonce         string                 `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -564,6 +565,13 @@ func (x *SendPlayerUUIDRequest) GetUuid() string {
 	return ""
 }
 
+func (x *SendPlayerUUIDRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
 type SendPlayerUUIDResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -621,6 +629,7 @@ type SendPlayerStringRequest struct {
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 	Data          string                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Nonce         string                 `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -676,6 +685,13 @@ func (x *SendPlayerStringRequest) GetData() string {
 	return ""
 }
 
+func (x *SendPlayerStringRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
 type SendPlayerStringResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -733,6 +749,7 @@ type SendPlayerBooleanRequest struct {
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 	Data          bool                   `protobuf:"varint,3,opt,name=data,proto3" json:"data,omitempty"`
+	Nonce         string                 `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -788,6 +805,13 @@ func (x *SendPlayerBooleanRequest) GetData() bool {
 	return false
 }
 
+func (x *SendPlayerBooleanRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
 type SendPlayerBooleanResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -845,6 +869,7 @@ type SendPlayerIntegerRequest struct {
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 	Data          int32                  `protobuf:"varint,3,opt,name=data,proto3" json:"data,omitempty"`
+	Nonce         string                 `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -900,6 +925,13 @@ func (x *SendPlayerIntegerRequest) GetData() int32 {
 	return 0
 }
 
+func (x *SendPlayerIntegerRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
 type SendPlayerIntegerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -958,6 +990,7 @@ type SendPlayerManaTypeRequest struct {
 	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 	ManaType      string                 `protobuf:"bytes,3,opt,name=mana_type,json=manaType,proto3" json:"mana_type,omitempty"`
 	ManaTypeStr   string                 `protobuf:"bytes,4,opt,name=mana_type_str,json=manaTypeStr,proto3" json:"mana_type_str,omitempty"`
+	Nonce         string                 `protobuf:"bytes,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1020,6 +1053,13 @@ func (x *SendPlayerManaTypeRequest) GetManaTypeStr() string {
 	return ""
 }
 
+func (x *SendPlayerManaTypeRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
 type SendPlayerManaTypeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -1077,6 +1117,7 @@ type SendPlayerActionRequest struct {
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 	Action        PlayerAction           `protobuf:"varint,3,opt,name=action,proto3,enum=mage.v1.PlayerAction" json:"action,omitempty"`
+	Nonce         string                 `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1132,6 +1173,13 @@ func (x *SendPlayerActionRequest) GetAction() PlayerAction {
 	return PlayerAction_PLAYER_ACTION_UNSPECIFIED
 }
 
+func (x *SendPlayerActionRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
 type SendPlayerActionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -2056,53 +2104,59 @@ const file_mage_v1_game_proto_rawDesc = "" +
 	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x1b\n" +
 	"\tplayer_id\x18\x03 \x01(\tR\bplayerId\"<\n" +
 	"\x13GameGetViewResponse\x12%\n" +
-	"\x04game\x18\x01 \x01(\v2\x11.mage.v1.GameViewR\x04game\"c\n" +
+	"\x04game\x18\x01 \x01(\v2\x11.mage.v1.GameViewR\x04game\"y\n" +
 	"\x15SendPlayerUUIDRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
 	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x12\n" +
-	"\x04uuid\x18\x03 \x01(\tR\x04uuid\"H\n" +
+	"\x04uuid\x18\x03 \x01(\tR\x04uuid\x12\x14\n" +
+	"\x05nonce\x18\x04 \x01(\tR\x05nonce\"H\n" +
 	"\x16SendPlayerUUIDResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"e\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"{\n" +
 	"\x17SendPlayerStringRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
 	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x12\n" +
-	"\x04data\x18\x03 \x01(\tR\x04data\"J\n" +
+	"\x04data\x18\x03 \x01(\tR\x04data\x12\x14\n" +
+	"\x05nonce\x18\x04 \x01(\tR\x05nonce\"J\n" +
 	"\x18SendPlayerStringResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"f\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"|\n" +
 	"\x18SendPlayerBooleanRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
 	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x12\n" +
-	"\x04data\x18\x03 \x01(\bR\x04data\"K\n" +
+	"\x04data\x18\x03 \x01(\bR\x04data\x12\x14\n" +
+	"\x05nonce\x18\x04 \x01(\tR\x05nonce\"K\n" +
 	"\x19SendPlayerBooleanResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"f\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"|\n" +
 	"\x18SendPlayerIntegerRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
 	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x12\n" +
-	"\x04data\x18\x03 \x01(\x05R\x04data\"K\n" +
+	"\x04data\x18\x03 \x01(\x05R\x04data\x12\x14\n" +
+	"\x05nonce\x18\x04 \x01(\tR\x05nonce\"K\n" +
 	"\x19SendPlayerIntegerResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"\x94\x01\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xaa\x01\n" +
 	"\x19SendPlayerManaTypeRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
 	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x1b\n" +
 	"\tmana_type\x18\x03 \x01(\tR\bmanaType\x12\"\n" +
-	"\rmana_type_str\x18\x04 \x01(\tR\vmanaTypeStr\"L\n" +
+	"\rmana_type_str\x18\x04 \x01(\tR\vmanaTypeStr\x12\x14\n" +
+	"\x05nonce\x18\x05 \x01(\tR\x05nonce\"L\n" +
 	"\x1aSendPlayerManaTypeResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"\x80\x01\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x96\x01\n" +
 	"\x17SendPlayerActionRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
 	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12-\n" +
-	"\x06action\x18\x03 \x01(\x0e2\x15.mage.v1.PlayerActionR\x06action\"J\n" +
+	"\x06action\x18\x03 \x01(\x0e2\x15.mage.v1.PlayerActionR\x06action\x12\x14\n" +
+	"\x05nonce\x18\x04 \x01(\tR\x05nonce\"J\n" +
 	"\x18SendPlayerActionResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
 	"\x05error\x18\x02 \x01(\tR\x05error\"M\n" +
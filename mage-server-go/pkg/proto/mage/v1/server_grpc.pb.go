@@ -23,6 +23,7 @@ const (
 	MageServer_AuthSendTokenToEmail_FullMethodName       = "/mage.v1.MageServer/AuthSendTokenToEmail"
 	MageServer_AuthResetPassword_FullMethodName          = "/mage.v1.MageServer/AuthResetPassword"
 	MageServer_ConnectUser_FullMethodName                = "/mage.v1.MageServer/ConnectUser"
+	MageServer_ResumeSession_FullMethodName              = "/mage.v1.MageServer/ResumeSession"
 	MageServer_ConnectAdmin_FullMethodName               = "/mage.v1.MageServer/ConnectAdmin"
 	MageServer_ConnectSetUserData_FullMethodName         = "/mage.v1.MageServer/ConnectSetUserData"
 	MageServer_Ping_FullMethodName                       = "/mage.v1.MageServer/Ping"
@@ -106,6 +107,8 @@ type MageServerClient interface {
 	AuthResetPassword(ctx context.Context, in *AuthResetPasswordRequest, opts ...grpc.CallOption) (*AuthResetPasswordResponse, error)
 	// Connect a user to the server
 	ConnectUser(ctx context.Context, in *ConnectUserRequest, opts ...grpc.CallOption) (*ConnectUserResponse, error)
+	// Resume a session with a game's reconnection token
+	ResumeSession(ctx context.Context, in *ResumeSessionRequest, opts ...grpc.CallOption) (*ResumeSessionResponse, error)
 	// Connect an admin user
 	ConnectAdmin(ctx context.Context, in *ConnectAdminRequest, opts ...grpc.CallOption) (*ConnectAdminResponse, error)
 	// Set user preferences and data
@@ -288,6 +291,16 @@ func (c *mageServerClient) ConnectUser(ctx context.Context, in *ConnectUserReque
 	return out, nil
 }
 
+func (c *mageServerClient) ResumeSession(ctx context.Context, in *ResumeSessionRequest, opts ...grpc.CallOption) (*ResumeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeSessionResponse)
+	err := c.cc.Invoke(ctx, MageServer_ResumeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mageServerClient) ConnectAdmin(ctx context.Context, in *ConnectAdminRequest, opts ...grpc.CallOption) (*ConnectAdminResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ConnectAdminResponse)
@@ -963,6 +976,8 @@ type MageServerServer interface {
 	AuthResetPassword(context.Context, *AuthResetPasswordRequest) (*AuthResetPasswordResponse, error)
 	// Connect a user to the server
 	ConnectUser(context.Context, *ConnectUserRequest) (*ConnectUserResponse, error)
+	// Resume a session with a game's reconnection token
+	ResumeSession(context.Context, *ResumeSessionRequest) (*ResumeSessionResponse, error)
 	// Connect an admin user
 	ConnectAdmin(context.Context, *ConnectAdminRequest) (*ConnectAdminResponse, error)
 	// Set user preferences and data
@@ -1117,6 +1132,9 @@ func (UnimplementedMageServerServer) AuthResetPassword(context.Context, *AuthRes
 func (UnimplementedMageServerServer) ConnectUser(context.Context, *ConnectUserRequest) (*ConnectUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConnectUser not implemented")
 }
+func (UnimplementedMageServerServer) ResumeSession(context.Context, *ResumeSessionRequest) (*ResumeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSession not implemented")
+}
 func (UnimplementedMageServerServer) ConnectAdmin(context.Context, *ConnectAdminRequest) (*ConnectAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConnectAdmin not implemented")
 }
@@ -1408,6 +1426,24 @@ func _MageServer_ConnectUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MageServer_ResumeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MageServerServer).ResumeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MageServer_ResumeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MageServerServer).ResumeSession(ctx, req.(*ResumeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MageServer_ConnectAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConnectAdminRequest)
 	if err := dec(in); err != nil {
@@ -2619,6 +2655,10 @@ var MageServer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConnectUser",
 			Handler:    _MageServer_ConnectUser_Handler,
 		},
+		{
+			MethodName: "ResumeSession",
+			Handler:    _MageServer_ResumeSession_Handler,
+		},
 		{
 			MethodName: "ConnectAdmin",
 			Handler:    _MageServer_ConnectAdmin_Handler,